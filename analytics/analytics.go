@@ -0,0 +1,194 @@
+// Package analytics records anonymous post views from a beacon endpoint and
+// rolls them up hourly for reporting.
+//
+// No cookies are set and no addresses are stored: a visitor is identified
+// only by a hash of a random daily salt, the client address and the user agent, so
+// unique visitors can be counted within a day but not followed across days.
+// Requests carrying Do Not Track or Global Privacy Control, and requests
+// from crawlers, are acknowledged but not recorded.
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deicod/ermblog/clientip"
+	"github.com/deicod/ermblog/graphql/relay"
+)
+
+// DefaultPath is where the beacon is mounted when no path is configured.
+const DefaultPath = "/track"
+
+// maxBody bounds beacon payloads; a post ID needs far less.
+const maxBody = 1 << 10
+
+// Store persists views.
+type Store interface {
+	// RecordView appends a view of a published post and reports false when
+	// the post is unknown or unpublished.
+	RecordView(ctx context.Context, postID, visitor string, at time.Time) (bool, error)
+	// Rollup moves views recorded before cutoff into hourly rollups.
+	Rollup(ctx context.Context, cutoff time.Time) (int, error)
+	// DailySalt returns the salt shared by all replicas for the UTC day of
+	// at, storing candidate when the day has none yet, and discards the
+	// salts of earlier days.
+	DailySalt(ctx context.Context, at time.Time, candidate []byte) ([]byte, error)
+}
+
+// Config configures a Tracker.
+type Config struct {
+	// TrustedProxies lists proxies whose X-Forwarded-For is honoured.
+	TrustedProxies []string
+}
+
+// Tracker serves the view beacon and maintains rollups.
+type Tracker struct {
+	store   Store
+	clients *clientip.Resolver
+	salt    *salter
+	now     func() time.Time
+}
+
+// New builds a Tracker.
+func New(cfg Config, store Store) (*Tracker, error) {
+	if store == nil {
+		return nil, errors.New("analytics: store is required")
+	}
+	clients, err := clientip.New(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		store:   store,
+		clients: clients,
+		salt:    &salter{store: store},
+		now:     time.Now,
+	}, nil
+}
+
+type beacon struct {
+	PostID string `json:"postId"`
+}
+
+// ServeHTTP accepts GET /track?post=<id> and POST bodies sent with
+// navigator.sendBeacon, either JSON {"postId": "..."} or the bare ID as
+// text. It answers 204 whether or not the view was recorded so the beacon
+// reveals nothing about filtering.
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var raw string
+	switch r.Method {
+	case http.MethodGet:
+		raw = r.URL.Query().Get("post")
+	case http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBody))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+		raw = parseBody(body)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	postID, ok := parsePostID(raw)
+	if !ok {
+		http.Error(w, "invalid post id", http.StatusBadRequest)
+		return
+	}
+	if optedOut(r) || IsBot(r.UserAgent()) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	at := t.now().UTC()
+	visitor, err := t.salt.visitorHash(r.Context(), at, t.clients.IP(r), r.UserAgent())
+	if err != nil {
+		http.Error(w, "failed to record view", http.StatusInternalServerError)
+		return
+	}
+	if _, err := t.store.RecordView(r.Context(), postID, visitor, at); err != nil {
+		http.Error(w, "failed to record view", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Run rolls up completed hours every interval until ctx is cancelled.
+func (t *Tracker) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := t.Rollup(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("analytics: roll up views: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rollup moves views from hours that have ended into the rollup table.
+func (t *Tracker) Rollup(ctx context.Context) (int, error) {
+	return t.store.Rollup(ctx, t.now().UTC().Truncate(time.Hour))
+}
+
+func parseBody(body []byte) string {
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "{") {
+		var payload beacon
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return ""
+		}
+		return payload.PostID
+	}
+	return trimmed
+}
+
+// parsePostID accepts a Post global ID or a native UUID.
+func parsePostID(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if typ, id, err := relay.FromGlobalID(raw); err == nil && typ == "Post" {
+		raw = id
+	}
+	if !isUUID(raw) {
+		return "", false
+	}
+	return strings.ToLower(raw), true
+}
+
+// isUUID reports whether s is a hyphenated UUID, which is the only form the
+// posts table stores.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, c := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func optedOut(r *http.Request) bool {
+	return r.Header.Get("DNT") == "1" || r.Header.Get("Sec-GPC") == "1"
+}
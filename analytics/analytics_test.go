@@ -0,0 +1,222 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deicod/ermblog/graphql/relay"
+)
+
+const (
+	postID    = "3f1c2a4e-8b7d-4c1e-9a2b-5d6e7f809a1b"
+	browserUA = "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0"
+)
+
+type view struct {
+	postID  string
+	visitor string
+	at      time.Time
+}
+
+type recordingStore struct {
+	mu      sync.Mutex
+	views   []view
+	cutoffs []time.Time
+	salts   map[string][]byte
+}
+
+func (s *recordingStore) RecordView(_ context.Context, postID, visitor string, at time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.views = append(s.views, view{postID: postID, visitor: visitor, at: at})
+	return true, nil
+}
+
+func (s *recordingStore) Rollup(_ context.Context, cutoff time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cutoffs = append(s.cutoffs, cutoff)
+	return 0, nil
+}
+
+func (s *recordingStore) DailySalt(_ context.Context, at time.Time, candidate []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	day := at.UTC().Format(time.DateOnly)
+	if salt, ok := s.salts[day]; ok {
+		return salt, nil
+	}
+	for stored := range s.salts {
+		if stored < day {
+			delete(s.salts, stored)
+		}
+	}
+	if s.salts == nil {
+		s.salts = make(map[string][]byte)
+	}
+	s.salts[day] = candidate
+	return candidate, nil
+}
+
+func newTestTracker(t *testing.T, cfg Config) (*Tracker, *recordingStore) {
+	t.Helper()
+	store := &recordingStore{}
+	tracker, err := New(cfg, store)
+	if err != nil {
+		t.Fatalf("new tracker: %v", err)
+	}
+	tracker.now = func() time.Time { return time.Date(2026, 10, 19, 13, 45, 0, 0, time.UTC) }
+	return tracker, store
+}
+
+func serve(tracker *Tracker, r *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	tracker.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestTrackerRecordsBeaconPayloads(t *testing.T) {
+	t.Parallel()
+
+	tracker, store := newTestTracker(t, Config{})
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/track?post="+postID, nil),
+		httptest.NewRequest(http.MethodPost, "/track", strings.NewReader(`{"postId":"`+relay.ToGlobalID("Post", postID)+`"}`)),
+		httptest.NewRequest(http.MethodPost, "/track", strings.NewReader(strings.ToUpper(postID))),
+	}
+	for i, r := range requests {
+		r.Header.Set("User-Agent", browserUA)
+		rec := serve(tracker, r)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("request %d: expected 204, got %d", i, rec.Code)
+		}
+		if cookies := rec.Result().Cookies(); len(cookies) != 0 {
+			t.Fatalf("request %d: expected no cookies, got %v", i, cookies)
+		}
+	}
+	if len(store.views) != len(requests) {
+		t.Fatalf("expected %d views, got %d", len(requests), len(store.views))
+	}
+	for _, v := range store.views {
+		if v.postID != postID {
+			t.Fatalf("expected post %s, got %s", postID, v.postID)
+		}
+		if v.visitor != store.views[0].visitor {
+			t.Fatal("expected the same visitor hash for one client within a day")
+		}
+		if strings.Contains(v.visitor, "192.0.2.1") {
+			t.Fatal("visitor hash must not contain the address")
+		}
+	}
+}
+
+func TestTrackerSkipsBotsAndOptOuts(t *testing.T) {
+	t.Parallel()
+
+	tracker, store := newTestTracker(t, Config{})
+	cases := map[string]func(*http.Request){
+		"empty agent": func(r *http.Request) {},
+		"crawler":     func(r *http.Request) { r.Header.Set("User-Agent", "Mozilla/5.0 (compatible; Googlebot/2.1)") },
+		"script":      func(r *http.Request) { r.Header.Set("User-Agent", "curl/8.5.0") },
+		"dnt": func(r *http.Request) {
+			r.Header.Set("User-Agent", browserUA)
+			r.Header.Set("DNT", "1")
+		},
+		"gpc": func(r *http.Request) {
+			r.Header.Set("User-Agent", browserUA)
+			r.Header.Set("Sec-GPC", "1")
+		},
+	}
+	for name, prepare := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/track?post="+postID, nil)
+		prepare(r)
+		if rec := serve(tracker, r); rec.Code != http.StatusNoContent {
+			t.Fatalf("%s: expected 204, got %d", name, rec.Code)
+		}
+	}
+	if len(store.views) != 0 {
+		t.Fatalf("expected no views, got %d", len(store.views))
+	}
+}
+
+func TestTrackerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := newTestTracker(t, Config{})
+	bad := httptest.NewRequest(http.MethodGet, "/track?post="+relay.ToGlobalID("User", postID)+"x", nil)
+	bad.Header.Set("User-Agent", browserUA)
+	if rec := serve(tracker, bad); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+	put := httptest.NewRequest(http.MethodPut, "/track", nil)
+	if rec := serve(tracker, put); rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestVisitorHashRotatesDaily(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	day := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	store := &recordingStore{}
+	s := &salter{store: store}
+	hash := func(at time.Time, ip string) string {
+		t.Helper()
+		value, err := s.visitorHash(ctx, at, ip, browserUA)
+		if err != nil {
+			t.Fatalf("visitor hash: %v", err)
+		}
+		return value
+	}
+	first := hash(day, "192.0.2.1")
+	if again := hash(day.Add(8*time.Hour), "192.0.2.1"); again != first {
+		t.Fatal("expected a stable hash within a day")
+	}
+	if other := hash(day, "192.0.2.2"); other == first {
+		t.Fatal("expected different clients to hash differently")
+	}
+
+	replica := &salter{store: store}
+	if value, err := replica.visitorHash(ctx, day, "192.0.2.1", browserUA); err != nil || value != first {
+		t.Fatalf("expected replicas sharing a store to agree, got %q (%v)", value, err)
+	}
+
+	if next := hash(day.Add(24*time.Hour), "192.0.2.1"); next == first {
+		t.Fatal("expected the hash to change the next day")
+	}
+	if _, ok := store.salts[day.Format(time.DateOnly)]; ok || len(store.salts) != 1 {
+		t.Fatalf("expected the previous day's salt to be discarded, got %v", store.salts)
+	}
+}
+
+func TestRollupUsesCompletedHours(t *testing.T) {
+	t.Parallel()
+
+	tracker, store := newTestTracker(t, Config{})
+	if _, err := tracker.Rollup(context.Background()); err != nil {
+		t.Fatalf("rollup: %v", err)
+	}
+	want := time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC)
+	if len(store.cutoffs) != 1 || !store.cutoffs[0].Equal(want) {
+		t.Fatalf("expected cutoff %s, got %v", want, store.cutoffs)
+	}
+}
+
+func TestIsBot(t *testing.T) {
+	t.Parallel()
+
+	if IsBot(browserUA) {
+		t.Fatal("expected a browser not to be a bot")
+	}
+	for _, ua := range []string{"", "Mozilla/5.0 (compatible; bingbot/2.0)", "python-requests/2.32", "Mozilla/5.0 HeadlessChrome/120.0"} {
+		if !IsBot(ua) {
+			t.Fatalf("expected %q to be a bot", ua)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package analytics
+
+import "strings"
+
+// botMarkers are lower-cased user agent fragments identifying crawlers,
+// monitors, previews and scripted clients.
+var botMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "archiver", "facebookexternalhit",
+	"embedly", "preview", "monitor", "uptime", "pingdom", "lighthouse",
+	"headless", "phantomjs", "selenium", "puppeteer", "playwright",
+	"curl", "wget", "python", "go-http-client", "java/", "okhttp",
+	"node-fetch", "axios", "httpclient", "libwww", "scrapy",
+}
+
+// IsBot reports whether the user agent looks automated. An empty user agent
+// is treated as a bot; every browser sends one.
+func IsBot(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+	for _, marker := range botMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
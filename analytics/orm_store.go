@@ -0,0 +1,34 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// ORMStore records views through the generated ORM client.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a tracker Store.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// RecordView appends a view of a published post.
+func (s *ORMStore) RecordView(ctx context.Context, postID, visitor string, at time.Time) (bool, error) {
+	return s.client.RecordPostView(ctx, postID, visitor, at)
+}
+
+// Rollup moves views recorded before cutoff into hourly rollups.
+func (s *ORMStore) Rollup(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.client.RollupPostViews(ctx, cutoff)
+}
+
+// DailySalt returns the visitor salt of the day of at, shared through the
+// analytics_salts table.
+func (s *ORMStore) DailySalt(ctx context.Context, at time.Time, candidate []byte) ([]byte, error) {
+	return s.client.VisitorSalt(ctx, at, candidate)
+}
@@ -0,0 +1,60 @@
+package analytics
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// saltSize is the length of a daily salt in bytes.
+const saltSize = 32
+
+// salter yields a random salt per UTC day. The first replica to need a day's
+// salt draws it and the store shares it with the others; the store drops it
+// once a later day's salt is drawn, so hashes cannot be linked across days
+// or reversed after the fact.
+type salter struct {
+	store Store
+
+	mu   sync.Mutex
+	day  string
+	salt []byte
+}
+
+func (s *salter) saltFor(ctx context.Context, at time.Time) ([]byte, error) {
+	at = at.UTC()
+	day := at.Format(time.DateOnly)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.day == day {
+		return s.salt, nil
+	}
+	candidate := make([]byte, saltSize)
+	if _, err := rand.Read(candidate); err != nil {
+		return nil, err
+	}
+	salt, err := s.store.DailySalt(ctx, at, candidate)
+	if err != nil {
+		return nil, err
+	}
+	s.day, s.salt = day, salt
+	return salt, nil
+}
+
+// visitorHash identifies a visitor for one day without storing the address
+// or user agent.
+func (s *salter) visitorHash(ctx context.Context, at time.Time, ip, userAgent string) (string, error) {
+	salt, err := s.saltFor(ctx, at)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(ip))
+	h.Write([]byte{0})
+	h.Write([]byte(userAgent))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,305 @@
+// Package apikeys lets users create personal API keys for integrations that
+// cannot sign in interactively, such as CI jobs and static site generators.
+//
+// A key is sent as a bearer token of the form ermblog_<id>_<secret>. The
+// ermblog_<id> part is the key's public prefix, used to look it up and to
+// tell keys apart in listings; the secret is stored only as a SHA-256
+// digest. Keys verify into oidc.Claims of their owner so directives and
+// resolvers treat them like sessions, but the claims only carry the roles
+// the key is scoped to and the owner still holds.
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/ratelimit"
+)
+
+// TokenPrefix starts every API key, so keys are recognisable in logs and
+// secret scanners and are told apart from JWTs without a lookup.
+const TokenPrefix = "ermblog_"
+
+// maxNameLength bounds key names.
+const maxNameLength = 100
+
+var (
+	// ErrInvalidKey is returned for unknown, expired or malformed keys and
+	// keys whose owner is gone.
+	ErrInvalidKey = errors.New("apikeys: invalid api key")
+	// ErrNotFound is returned when revoking a key that does not exist.
+	ErrNotFound = errors.New("apikeys: api key not found")
+)
+
+// InputError reports an invalid argument to Create.
+type InputError struct {
+	// Field is name, scopes or expiresAt.
+	Field   string
+	Message string
+}
+
+func (e *InputError) Error() string {
+	return "apikeys: " + e.Field + " " + e.Message
+}
+
+// Key is an API key without its secret.
+type Key struct {
+	ID     string
+	UserID string
+	Name   string
+	// Prefix is the public part of the key, e.g. ermblog_1f2e3d4c5b6a7988.
+	Prefix string
+	// Scopes lists the role slugs the key may exercise.
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// Owner is the user a key acts for.
+type Owner struct {
+	ID          string
+	Username    string
+	Email       string
+	DisplayName string
+	// Roles lists the slugs of the user's roles.
+	Roles []string
+}
+
+// Store persists keys and loads their owners.
+type Store interface {
+	// CreateKey stores key with the digest of its secret, setting its ID and
+	// creation time.
+	CreateKey(ctx context.Context, key *Key, secretHash string) error
+	// KeyByPrefix returns the key with the given prefix and its secret
+	// digest, or nil.
+	KeyByPrefix(ctx context.Context, prefix string) (*Key, string, error)
+	// KeyByID returns the key with the given ID, or nil.
+	KeyByID(ctx context.Context, id string) (*Key, error)
+	// KeysForUser lists a user's keys, newest first.
+	KeysForUser(ctx context.Context, userID string) ([]*Key, error)
+	// DeleteKey removes a key.
+	DeleteKey(ctx context.Context, id string) error
+	// TouchKey records that a key was used at.
+	TouchKey(ctx context.Context, id string, at time.Time) error
+	// Owner returns the user with the given ID, or nil.
+	Owner(ctx context.Context, userID string) (*Owner, error)
+}
+
+// Config configures a Service. Zero values use the defaults noted.
+type Config struct {
+	// DefaultRoles are held by every user on top of their assigned roles,
+	// matching what their sessions carry; defaults to ["user"].
+	DefaultRoles []string
+	// TouchInterval limits how often last-used times are written; defaults
+	// to one minute.
+	TouchInterval time.Duration
+}
+
+// Service creates, lists, revokes and verifies API keys.
+type Service struct {
+	cfg   Config
+	store Store
+	now   func() time.Time
+}
+
+// New builds a Service.
+func New(cfg Config, store Store) (*Service, error) {
+	if store == nil {
+		return nil, errors.New("apikeys: store is required")
+	}
+	if cfg.DefaultRoles == nil {
+		cfg.DefaultRoles = []string{"user"}
+	}
+	if cfg.TouchInterval <= 0 {
+		cfg.TouchInterval = time.Minute
+	}
+	return &Service{cfg: cfg, store: store, now: time.Now}, nil
+}
+
+// Create stores a new key for a user and returns it with the full token,
+// which is not retrievable afterwards. Scopes are normalised to trimmed,
+// lower-case, unique role slugs; callers decide which the user may grant.
+func (s *Service) Create(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*Key, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", &InputError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return nil, "", &InputError{Field: "name", Message: "must be at most 100 characters"}
+	}
+	scopes = NormalizeScopes(scopes)
+	if len(scopes) == 0 {
+		return nil, "", &InputError{Field: "scopes", Message: "must name at least one role"}
+	}
+	if expiresAt != nil && !expiresAt.After(s.now()) {
+		return nil, "", &InputError{Field: "expiresAt", Message: "must be in the future"}
+	}
+
+	publicID := make([]byte, 8)
+	if _, err := rand.Read(publicID); err != nil {
+		return nil, "", err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := &Key{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    TokenPrefix + hex.EncodeToString(publicID),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	encodedSecret := base64.RawURLEncoding.EncodeToString(secret)
+	if err := s.store.CreateKey(ctx, key, digest(encodedSecret)); err != nil {
+		return nil, "", err
+	}
+	return key, key.Prefix + "_" + encodedSecret, nil
+}
+
+// List returns a user's keys, newest first.
+func (s *Service) List(ctx context.Context, userID string) ([]*Key, error) {
+	return s.store.KeysForUser(ctx, userID)
+}
+
+// Key returns the key with the given ID, or nil.
+func (s *Service) Key(ctx context.Context, id string) (*Key, error) {
+	return s.store.KeyByID(ctx, id)
+}
+
+// Revoke deletes a key; requests using it fail from then on.
+func (s *Service) Revoke(ctx context.Context, id string) error {
+	key, err := s.store.KeyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return ErrNotFound
+	}
+	return s.store.DeleteKey(ctx, key.ID)
+}
+
+// ValidateToken verifies an API key and returns its owner's claims with
+// the roles the key is scoped to and the owner holds. It implements
+// oidc.TokenValidator.
+func (s *Service) ValidateToken(ctx context.Context, token string) (oidc.Claims, error) {
+	prefix, secret, ok := splitToken(token)
+	if !ok {
+		return oidc.Claims{}, ErrInvalidKey
+	}
+	key, secretHash, err := s.store.KeyByPrefix(ctx, prefix)
+	if err != nil {
+		return oidc.Claims{}, err
+	}
+	if key == nil || subtle.ConstantTimeCompare([]byte(secretHash), []byte(digest(secret))) != 1 {
+		return oidc.Claims{}, ErrInvalidKey
+	}
+	now := s.now()
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+		return oidc.Claims{}, ErrInvalidKey
+	}
+	owner, err := s.store.Owner(ctx, key.UserID)
+	if err != nil {
+		return oidc.Claims{}, err
+	}
+	if owner == nil {
+		return oidc.Claims{}, ErrInvalidKey
+	}
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= s.cfg.TouchInterval {
+		// Best effort: a failed write must not reject a valid key.
+		_ = s.store.TouchKey(ctx, key.ID, now)
+	}
+
+	held := append(append([]string(nil), s.cfg.DefaultRoles...), owner.Roles...)
+	roles := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		if slices.Contains(held, scope) {
+			roles = append(roles, scope)
+		}
+	}
+	claims := oidc.Claims{
+		Subject:  owner.ID,
+		Email:    owner.Email,
+		Name:     owner.DisplayName,
+		Username: owner.Username,
+		Roles:    roles,
+		Raw:      map[string]any{"api_key_id": key.ID, "api_key_prefix": key.Prefix},
+	}
+	return claims, nil
+}
+
+// Middleware serves requests whose bearer token is an API key with next,
+// after attaching the owner's claims and the key for rate limiting, and
+// rejects invalid keys. Other requests go to fallback, typically the OIDC
+// middleware.
+func (s *Service) Middleware(next, fallback http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Fields(r.Header.Get("Authorization"))
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") || !strings.HasPrefix(parts[1], TokenPrefix) {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		claims, err := s.ValidateToken(r.Context(), parts[1])
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		ctx := oidc.ToContext(r.Context(), claims)
+		ctx = ratelimit.WithAPIKey(ctx, KeyID(claims))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// KeyID returns the ID of the API key claims were issued for, or "" for
+// other sessions.
+func KeyID(claims oidc.Claims) string {
+	id, _ := claims.Raw["api_key_id"].(string)
+	return id
+}
+
+// NormalizeScopes trims, lower-cases, sorts and de-duplicates role slugs.
+func NormalizeScopes(scopes []string) []string {
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope != "" {
+			out = append(out, scope)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// splitToken splits ermblog_<id>_<secret> into its prefix and secret.
+func splitToken(token string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(token, TokenPrefix)
+	if !ok {
+		return "", "", false
+	}
+	publicID, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(publicID) != 16 || secret == "" {
+		return "", "", false
+	}
+	if _, err := hex.DecodeString(publicID); err != nil {
+		return "", "", false
+	}
+	return TokenPrefix + publicID, secret, true
+}
+
+func digest(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
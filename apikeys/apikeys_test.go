@@ -0,0 +1,225 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+type memoryStore struct {
+	keys    map[string]*Key
+	hashes  map[string]string
+	owners  map[string]*Owner
+	touches int
+}
+
+func newMemoryStore(owners ...*Owner) *memoryStore {
+	store := &memoryStore{keys: make(map[string]*Key), hashes: make(map[string]string), owners: make(map[string]*Owner)}
+	for _, owner := range owners {
+		store.owners[owner.ID] = owner
+	}
+	return store
+}
+
+func (s *memoryStore) CreateKey(_ context.Context, key *Key, secretHash string) error {
+	key.ID = "key-" + key.Prefix
+	key.CreatedAt = time.Now()
+	s.keys[key.ID] = key
+	s.hashes[key.ID] = secretHash
+	return nil
+}
+
+func (s *memoryStore) KeyByPrefix(_ context.Context, prefix string) (*Key, string, error) {
+	for id, key := range s.keys {
+		if key.Prefix == prefix {
+			return key, s.hashes[id], nil
+		}
+	}
+	return nil, "", nil
+}
+
+func (s *memoryStore) KeyByID(_ context.Context, id string) (*Key, error) {
+	return s.keys[id], nil
+}
+
+func (s *memoryStore) KeysForUser(_ context.Context, userID string) ([]*Key, error) {
+	var keys []*Key
+	for _, key := range s.keys {
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *memoryStore) DeleteKey(_ context.Context, id string) error {
+	delete(s.keys, id)
+	delete(s.hashes, id)
+	return nil
+}
+
+func (s *memoryStore) TouchKey(_ context.Context, id string, at time.Time) error {
+	s.touches++
+	s.keys[id].LastUsedAt = &at
+	return nil
+}
+
+func (s *memoryStore) Owner(_ context.Context, userID string) (*Owner, error) {
+	return s.owners[userID], nil
+}
+
+func newTestService(t *testing.T, store Store) *Service {
+	t.Helper()
+	service, err := New(Config{}, store)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	return service
+}
+
+var alice = &Owner{ID: "user-1", Username: "alice", Email: "alice@example.com", DisplayName: "Alice", Roles: []string{"editor"}}
+
+func TestCreateAndValidateKey(t *testing.T) {
+	store := newMemoryStore(alice)
+	service := newTestService(t, store)
+	ctx := context.Background()
+
+	key, token, err := service.Create(ctx, "user-1", " CI deploy ", []string{"Editor", "user", "admin", "editor"}, nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if key.Name != "CI deploy" || strings.Join(key.Scopes, ",") != "admin,editor,user" {
+		t.Fatalf("unexpected key: %+v", key)
+	}
+	if !strings.HasPrefix(token, key.Prefix+"_") || !strings.HasPrefix(key.Prefix, TokenPrefix) {
+		t.Fatalf("expected the token %q to start with the prefix %q", token, key.Prefix)
+	}
+	if strings.Contains(store.hashes[key.ID], strings.TrimPrefix(token, key.Prefix+"_")) {
+		t.Fatalf("expected only a digest of the secret to be stored")
+	}
+
+	claims, err := service.ValidateToken(ctx, token)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if claims.Subject != "user-1" || claims.Username != "alice" || KeyID(claims) != key.ID {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if strings.Join(claims.Roles, ",") != "editor,user" {
+		t.Fatalf("expected scopes the owner holds, got %v", claims.Roles)
+	}
+	if store.touches != 1 || key.LastUsedAt == nil {
+		t.Fatalf("expected the key to be marked as used")
+	}
+	if _, err := service.ValidateToken(ctx, token); err != nil || store.touches != 1 {
+		t.Fatalf("expected repeated use within the interval not to write, got %d writes, %v", store.touches, err)
+	}
+	if KeyID(oidc.Claims{Subject: "user-1"}) != "" {
+		t.Fatalf("expected no key ID for other sessions")
+	}
+}
+
+func TestValidateRejectsBadKeys(t *testing.T) {
+	store := newMemoryStore(alice)
+	service := newTestService(t, store)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	expires := now.Add(time.Hour)
+	key, token, err := service.Create(ctx, "user-1", "short-lived", []string{"user"}, &expires)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	for _, bad := range []string{"", "eyJhbGciOi.jwt.token", key.Prefix + "_wrong", TokenPrefix + "nothex!!nothex!!_secret", token + "x"} {
+		if _, err := service.ValidateToken(ctx, bad); !errors.Is(err, ErrInvalidKey) {
+			t.Fatalf("expected %q to be rejected, got %v", bad, err)
+		}
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := service.ValidateToken(ctx, token); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected an expired key to be rejected, got %v", err)
+	}
+	now = now.Add(-2 * time.Hour)
+
+	if err := service.Revoke(ctx, key.ID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	if _, err := service.ValidateToken(ctx, token); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected a revoked key to be rejected, got %v", err)
+	}
+	if err := service.Revoke(ctx, key.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected revoking twice to report a missing key, got %v", err)
+	}
+}
+
+func TestCreateValidatesInput(t *testing.T) {
+	service := newTestService(t, newMemoryStore(alice))
+	past := time.Now().Add(-time.Minute)
+	cases := []struct {
+		name    string
+		scopes  []string
+		expires *time.Time
+		field   string
+	}{
+		{name: " ", scopes: []string{"user"}, field: "name"},
+		{name: strings.Repeat("k", 101), scopes: []string{"user"}, field: "name"},
+		{name: "ci", scopes: []string{" "}, field: "scopes"},
+		{name: "ci", scopes: []string{"user"}, expires: &past, field: "expiresAt"},
+	}
+	for _, tc := range cases {
+		_, _, err := service.Create(context.Background(), "user-1", tc.name, tc.scopes, tc.expires)
+		var inputErr *InputError
+		if !errors.As(err, &inputErr) || inputErr.Field != tc.field {
+			t.Errorf("expected an input error on %s, got %v", tc.field, err)
+		}
+	}
+}
+
+func TestMiddlewareRoutesAPIKeys(t *testing.T) {
+	store := newMemoryStore(alice)
+	service := newTestService(t, store)
+	_, token, err := service.Create(context.Background(), "user-1", "ci", []string{"user"}, nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	var served, fellBack bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := oidc.FromContext(r.Context())
+		served = ok && claims.Subject == "user-1"
+	})
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { fellBack = true })
+	handler := service.Middleware(next, fallback)
+
+	request := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	request("Bearer " + token)
+	if !served || fellBack {
+		t.Fatalf("expected the API key request to be served with claims")
+	}
+	served = false
+	request("Bearer eyJhbGciOi.jwt.token")
+	if served || !fellBack {
+		t.Fatalf("expected other bearer tokens to fall back")
+	}
+	fellBack = false
+	if rec := request("Bearer " + TokenPrefix + "0123456789abcdef_wrong"); rec.Code != http.StatusUnauthorized || fellBack {
+		t.Fatalf("expected an invalid API key to be rejected, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,137 @@
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// ORMStore keeps API keys through the generated ORM client.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a Store.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// CreateKey stores key with the digest of its secret.
+func (s *ORMStore) CreateKey(ctx context.Context, key *Key, secretHash string) error {
+	scopes, err := json.Marshal(nonNil(key.Scopes))
+	if err != nil {
+		return err
+	}
+	record, err := s.client.ApiKeys().Create(ctx, &gen.ApiKey{
+		UserID:     key.UserID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		SecretHash: secretHash,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	key.ID = record.ID
+	key.CreatedAt = record.CreatedAt
+	return nil
+}
+
+// KeyByPrefix returns the key with the given prefix and its secret digest.
+func (s *ORMStore) KeyByPrefix(ctx context.Context, prefix string) (*Key, string, error) {
+	record, err := s.client.ApiKeys().Query().WherePrefixEq(prefix).First(ctx)
+	if err != nil || record == nil {
+		return nil, "", err
+	}
+	key, err := toKey(record)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, record.SecretHash, nil
+}
+
+// KeyByID returns the key with the given ID, or nil.
+func (s *ORMStore) KeyByID(ctx context.Context, id string) (*Key, error) {
+	record, err := s.client.ApiKeyByID(ctx, id)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return toKey(record)
+}
+
+// KeysForUser lists a user's keys, newest first.
+func (s *ORMStore) KeysForUser(ctx context.Context, userID string) ([]*Key, error) {
+	records, err := s.client.ApiKeysForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*Key, 0, len(records))
+	for _, record := range records {
+		key, err := toKey(record)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// DeleteKey removes a key.
+func (s *ORMStore) DeleteKey(ctx context.Context, id string) error {
+	return s.client.ApiKeys().Delete(ctx, id)
+}
+
+// TouchKey sets the key's last_used_at.
+func (s *ORMStore) TouchKey(ctx context.Context, id string, at time.Time) error {
+	return s.client.TouchApiKey(ctx, id, at)
+}
+
+// Owner returns the user with the given ID and their role slugs, or nil.
+func (s *ORMStore) Owner(ctx context.Context, userID string) (*Owner, error) {
+	user, err := s.client.Users().ByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	roles, err := s.client.ListRolesForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	owner := &Owner{ID: user.ID, Username: user.Username, Email: user.Email}
+	if user.DisplayName != nil {
+		owner.DisplayName = *user.DisplayName
+	}
+	for _, role := range roles {
+		owner.Roles = append(owner.Roles, role.Slug)
+	}
+	return owner, nil
+}
+
+func toKey(record *gen.ApiKey) (*Key, error) {
+	var scopes []string
+	if len(record.Scopes) > 0 {
+		if err := json.Unmarshal(record.Scopes, &scopes); err != nil {
+			return nil, fmt.Errorf("apikeys: scopes of key %s: %w", record.ID, err)
+		}
+	}
+	return &Key{
+		ID:         record.ID,
+		UserID:     record.UserID,
+		Name:       record.Name,
+		Prefix:     record.Prefix,
+		Scopes:     nonNil(scopes),
+		ExpiresAt:  record.ExpiresAt,
+		LastUsedAt: record.LastUsedAt,
+		CreatedAt:  record.CreatedAt,
+	}, nil
+}
+
+func nonNil(scopes []string) []string {
+	if scopes == nil {
+		return []string{}
+	}
+	return scopes
+}
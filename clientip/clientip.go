@@ -0,0 +1,87 @@
+// Package clientip determines the originating client address of a request,
+// honouring X-Forwarded-For only when it was added by a trusted proxy.
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Resolver extracts client addresses for a fixed set of trusted proxies.
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// New parses trusted proxy addresses or CIDR ranges.
+func New(trusted []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, entry := range trusted {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("clientip: trusted proxy %q: %w", entry, err)
+			}
+			r.trusted = append(r.trusted, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("clientip: trusted proxy %q: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		r.trusted = append(r.trusted, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return r, nil
+}
+
+// Trusted reports whether addr belongs to a trusted proxy.
+func (r *Resolver) Trusted(addr netip.Addr) bool {
+	if r == nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IP returns the peer address, or when the peer is a trusted proxy the
+// right-most X-Forwarded-For entry that is not itself a trusted proxy.
+// Entries further left are client-controlled and ignored.
+func (r *Resolver) IP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+	peer = peer.Unmap()
+	if !r.Trusted(peer) {
+		return peer.String()
+	}
+	hops := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		client = addr.Unmap()
+		if !r.Trusted(client) {
+			break
+		}
+	}
+	return client.String()
+}
@@ -0,0 +1,50 @@
+package clientip
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIPHonoursTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	resolver, err := New([]string{"10.0.0.0/8", "192.0.2.1"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		remote string
+		xff    []string
+		want   string
+	}{
+		{name: "untrusted peer ignores header", remote: "198.51.100.7:1234", xff: []string{"1.1.1.1"}, want: "198.51.100.7"},
+		{name: "trusted peer uses header", remote: "10.1.2.3:1234", xff: []string{"203.0.113.5"}, want: "203.0.113.5"},
+		{name: "skips trusted hops", remote: "10.1.2.3:1234", xff: []string{"1.1.1.1, 203.0.113.5, 192.0.2.1"}, want: "203.0.113.5"},
+		{name: "spoofed left entries ignored", remote: "10.1.2.3:1234", xff: []string{"6.6.6.6", "203.0.113.5"}, want: "203.0.113.5"},
+		{name: "stops at malformed entry", remote: "10.1.2.3:1234", xff: []string{"garbage, 10.0.0.2"}, want: "10.0.0.2"},
+		{name: "no header", remote: "10.1.2.3:1234", want: "10.1.2.3"},
+		{name: "mapped ipv4", remote: "[::ffff:198.51.100.7]:1234", want: "198.51.100.7"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest("GET", "/graphql", nil)
+		req.RemoteAddr = tc.remote
+		for _, v := range tc.xff {
+			req.Header.Add("X-Forwarded-For", v)
+		}
+		if got := resolver.IP(req); got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestNewRejectsInvalidEntries(t *testing.T) {
+	t.Parallel()
+
+	for _, entry := range []string{"10.0.0.0/33", "not-an-ip"} {
+		if _, err := New([]string{entry}); err == nil {
+			t.Errorf("expected error for %q", entry)
+		}
+	}
+}
@@ -1,9 +1,20 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/deicod/ermblog/mail"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/permalink"
+	"github.com/deicod/ermblog/settings"
 )
 
 func TestLoadConfigGraphQLSubscriptionsTransports(t *testing.T) {
@@ -39,3 +50,271 @@ func TestLoadConfigGraphQLSubscriptionsTransports(t *testing.T) {
 		t.Fatal("expected graphql-ws transport to be enabled")
 	}
 }
+
+func TestResolveSiteBaseURLPrefersEnvironment(t *testing.T) {
+	t.Setenv("ERM_SITE_URL", "https://blog.example.com")
+
+	if got := resolveSiteBaseURL(siteConfig{BaseURL: "http://ignored.local"}); got != "https://blog.example.com" {
+		t.Fatalf("expected env base url, got %q", got)
+	}
+}
+
+func TestResolvePermalinksAppliesOverrides(t *testing.T) {
+	links, err := resolvePermalinks(siteConfig{Permalinks: permalinksConfig{Post: "/blog/{slug}"}})
+	if err != nil {
+		t.Fatalf("resolvePermalinks returned error: %v", err)
+	}
+	if links.Post.String() != "/blog/{slug}" {
+		t.Fatalf("expected post pattern override, got %q", links.Post.String())
+	}
+	if links.Page.String() != "/{slug}" {
+		t.Fatalf("expected default page pattern, got %q", links.Page.String())
+	}
+
+	if _, err := resolvePermalinks(siteConfig{Permalinks: permalinksConfig{Tag: "tag/{slug}"}}); err == nil {
+		t.Fatal("expected error for invalid permalink pattern")
+	}
+}
+
+type permalinkSettingStore struct{ value string }
+
+func (s permalinkSettingStore) Autoload(context.Context) ([]settings.Option, error) {
+	if s.value == "" {
+		return nil, nil
+	}
+	return []settings.Option{{ID: "1", Name: settings.PermalinkStructure.Name, Value: json.RawMessage(s.value)}}, nil
+}
+
+func (s permalinkSettingStore) Option(context.Context, string) (*settings.Option, error) {
+	return nil, nil
+}
+
+func TestApplyPermalinkStructure(t *testing.T) {
+	ctx := context.Background()
+	cache := settings.NewCache(permalinkSettingStore{value: `"/posts/{id}"`}, nil)
+	if _, err := cache.Preload(ctx); err != nil {
+		t.Fatalf("preload: %v", err)
+	}
+
+	links, err := applyPermalinkStructure(ctx, permalink.DefaultSet(), siteConfig{}, cache)
+	if err != nil || links.Post.String() != "/posts/{id}" {
+		t.Fatalf("expected stored structure, got %q (%v)", links.Post.String(), err)
+	}
+
+	configured := siteConfig{Permalinks: permalinksConfig{Post: "/blog/{slug}"}}
+	links, err = applyPermalinkStructure(ctx, permalink.Set{Post: permalink.MustParse("/blog/{slug}")}, configured, cache)
+	if err != nil || links.Post.String() != "/blog/{slug}" {
+		t.Fatalf("expected erm.yaml pattern to win, got %q (%v)", links.Post.String(), err)
+	}
+
+	links, err = applyPermalinkStructure(ctx, permalink.DefaultSet(), siteConfig{}, settings.NewCache(permalinkSettingStore{}, nil))
+	if err != nil || links.Post.String() != permalink.DefaultPostPattern {
+		t.Fatalf("expected default pattern without a setting, got %q (%v)", links.Post.String(), err)
+	}
+}
+
+func TestLoadConfigTracing(t *testing.T) {
+	t.Setenv("ERM_TRACING_EXPORTER", "")
+
+	yaml := "observability:\n" +
+		"  tracing:\n" +
+		"    exporter: otlp\n" +
+		"    endpoint: http://collector:4318\n" +
+		"    service_name: blog-api\n" +
+		"    sample_ratio: 0.25\n"
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "erm.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatalf("write temp config: %v", err)
+	}
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig returned error: %v", err)
+	}
+
+	tracingCfg := resolveTracingConfig(cfg.Observability.Tracing)
+	if !tracingCfg.Enabled() {
+		t.Fatal("expected tracing to be enabled")
+	}
+	if tracingCfg.Endpoint != "http://collector:4318" || tracingCfg.ServiceName != "blog-api" || tracingCfg.SampleRatio != 0.25 {
+		t.Fatalf("unexpected tracing config: %+v", tracingCfg)
+	}
+
+	t.Setenv("ERM_TRACING_EXPORTER", "none")
+	if resolveTracingConfig(cfg.Observability.Tracing).Enabled() {
+		t.Fatal("expected environment to disable tracing")
+	}
+}
+
+func TestResolveRateLimiter(t *testing.T) {
+	limiter, err := resolveRateLimiter(rateLimitConfig{}, nil, nil)
+	if err != nil || limiter != nil {
+		t.Fatalf("expected disabled limiter, got %v, %v", limiter, err)
+	}
+
+	limiter, err = resolveRateLimiter(rateLimitConfig{
+		Enabled:        true,
+		TrustedProxies: []string{"10.0.0.0/8"},
+		Query:          rateLimitBudget{Rate: 10, Burst: 20},
+	}, nil, nil)
+	if err != nil || limiter == nil {
+		t.Fatalf("expected memory limiter, got %v, %v", limiter, err)
+	}
+
+	if _, err := resolveRateLimiter(rateLimitConfig{Enabled: true, Store: "redis"}, nil, nil); err == nil {
+		t.Fatal("expected error for unknown store")
+	}
+	if _, err := resolveRateLimiter(rateLimitConfig{Enabled: true, TrustedProxies: []string{"not-an-ip"}}, nil, nil); err == nil {
+		t.Fatal("expected error for invalid trusted proxy")
+	}
+}
+
+func TestResolveTracker(t *testing.T) {
+	tracker, err := resolveTracker(analyticsConfig{}, nil)
+	if err != nil || tracker != nil {
+		t.Fatalf("expected disabled tracker, got %v, %v", tracker, err)
+	}
+	tracker, err = resolveTracker(analyticsConfig{Enabled: true, TrustedProxies: []string{"10.0.0.0/8"}}, nil)
+	if err != nil || tracker == nil {
+		t.Fatalf("expected tracker, got %v, %v", tracker, err)
+	}
+	if _, err := resolveTracker(analyticsConfig{Enabled: true, TrustedProxies: []string{"not-an-ip"}}, nil); err == nil {
+		t.Fatal("expected error for invalid trusted proxy")
+	}
+	if path := resolveAnalyticsPath(analyticsConfig{}); path != "/track" {
+		t.Fatalf("expected default path, got %q", path)
+	}
+}
+
+func TestLoadConfigTrash(t *testing.T) {
+	yaml := "trash:\n" +
+		"  retention: 168h\n" +
+		"  purge_interval: 30m\n"
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "erm.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatalf("write temp config: %v", err)
+	}
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig returned error: %v", err)
+	}
+	if cfg.Trash.Retention != 168*time.Hour || cfg.Trash.PurgeInterval != 30*time.Minute {
+		t.Fatalf("unexpected trash config: %+v", cfg.Trash)
+	}
+	if purger, err := resolvePurger(cfg.Trash, nil); err != nil || purger == nil {
+		t.Fatalf("expected purger, got %v, %v", purger, err)
+	}
+}
+
+func TestLoadConfigResponseCache(t *testing.T) {
+	yaml := "oidc:\n" +
+		"  allow_anonymous: true\n" +
+		"graphql:\n" +
+		"  cache:\n" +
+		"    enabled: true\n" +
+		"    max_entries: 50\n" +
+		"    ttl: 30s\n"
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "erm.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatalf("write temp config: %v", err)
+	}
+
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig returned error: %v", err)
+	}
+	if !cfg.OIDC.AllowAnonymous {
+		t.Fatal("expected anonymous access to be enabled")
+	}
+	if !cfg.GraphQL.Cache.Enabled || cfg.GraphQL.Cache.MaxEntries != 50 || cfg.GraphQL.Cache.TTL != 30*time.Second {
+		t.Fatalf("unexpected cache config: %+v", cfg.GraphQL.Cache)
+	}
+}
+
+func TestResolveLocalAuth(t *testing.T) {
+	service, err := resolveLocalAuth(localAuthConfig{}, nil)
+	if err != nil || service != nil {
+		t.Fatalf("expected local auth to be disabled, got %v, %v", service, err)
+	}
+	if _, err := resolveLocalAuth(localAuthConfig{Enabled: true}, nil); err == nil {
+		t.Fatal("expected an error without signing keys")
+	}
+	if _, err := resolveLocalAuth(localAuthConfig{Enabled: true, Keys: []localAuthKeyConfig{{ID: "k1", Secret: "short"}}}, nil); err == nil {
+		t.Fatal("expected an error for a short secret")
+	}
+
+	t.Setenv("ERM_LOCAL_AUTH_KEYS", "env=0123456789abcdef0123456789abcdef")
+	service, err = resolveLocalAuth(localAuthConfig{Enabled: true, Keys: []localAuthKeyConfig{{ID: "k1", Secret: "short"}}}, nil)
+	if err != nil || service == nil {
+		t.Fatalf("expected keys from the environment to replace the configured ones, got %v, %v", service, err)
+	}
+}
+
+func TestResolveRecovery(t *testing.T) {
+	recovery, err := resolveRecovery(localRecoveryConfig{}, "https://example.com/", mail.LogMailer{}, 0, nil)
+	if err != nil || recovery == nil {
+		t.Fatalf("expected URLs derived from the base URL, got %v, %v", recovery, err)
+	}
+	if _, err := resolveRecovery(localRecoveryConfig{ResetURL: "https://example.com/reset"}, "https://example.com", mail.LogMailer{}, 0, nil); err == nil {
+		t.Fatal("expected an error for a reset URL without {token}")
+	}
+}
+
+func TestResolveMailer(t *testing.T) {
+	if _, ok := resolveMailer(mailConfig{}).(mail.LogMailer); !ok {
+		t.Fatal("expected mails to be logged without an SMTP server")
+	}
+	t.Setenv("ERM_SMTP_PASSWORD", "from-env")
+	mailer, ok := resolveMailer(mailConfig{SMTPAddr: "smtp.example.com:587", From: "blog@example.com", Password: "from-file"}).(mail.SMTPMailer)
+	if !ok || mailer.Password != "from-env" {
+		t.Fatalf("expected an SMTP mailer with the password from the environment, got %+v", mailer)
+	}
+}
+
+func TestResolveAPIKeys(t *testing.T) {
+	service, err := resolveAPIKeys(apiKeysConfig{}, nil)
+	if err != nil || service != nil {
+		t.Fatalf("expected api keys to be disabled, got %v, %v", service, err)
+	}
+	service, err = resolveAPIKeys(apiKeysConfig{Enabled: true}, nil)
+	if err != nil || service == nil {
+		t.Fatalf("expected api keys to be enabled, got %v, %v", service, err)
+	}
+}
+
+func TestAuthenticateKeepsAnonymousCallersOutWithLocalAuth(t *testing.T) {
+	service, err := resolveLocalAuth(localAuthConfig{Enabled: true, Keys: []localAuthKeyConfig{{ID: "k1", Secret: "0123456789abcdef0123456789abcdef"}}}, nil)
+	if err != nil {
+		t.Fatalf("resolveLocalAuth: %v", err)
+	}
+	reached := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	})
+	serve := func(handler http.Handler) int {
+		reached = false
+		body := `{"query":"{ users { edges { node { email } } } }"}`
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := serve(authenticate(false, oidc.Chain{service}, next)); code != http.StatusUnauthorized || reached {
+		t.Fatalf("expected an anonymous users query to be rejected, got %d (reached=%v)", code, reached)
+	}
+	if code := serve(authenticate(true, oidc.Chain{service}, next)); code != http.StatusOK || !reached {
+		t.Fatalf("expected anonymous access when allowed, got %d (reached=%v)", code, reached)
+	}
+	if got := resolveGraphQLAuthPath("/graphql/"); got != "/graphql/auth" {
+		t.Fatalf("unexpected auth path %q", got)
+	}
+}
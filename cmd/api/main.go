@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
@@ -12,11 +13,24 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/deicod/ermblog/analytics"
+	"github.com/deicod/ermblog/apikeys"
+	"github.com/deicod/ermblog/graphql/cache"
+	"github.com/deicod/ermblog/graphql/limits"
+	"github.com/deicod/ermblog/graphql/persisted"
 	"github.com/deicod/ermblog/graphql/server"
+	"github.com/deicod/ermblog/localauth"
+	"github.com/deicod/ermblog/mail"
 	"github.com/deicod/ermblog/observability/metrics"
 	prommetrics "github.com/deicod/ermblog/observability/metrics/prometheus"
+	"github.com/deicod/ermblog/observability/tracing"
 	"github.com/deicod/ermblog/oidc"
 	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/permalink"
+	"github.com/deicod/ermblog/ratelimit"
+	"github.com/deicod/ermblog/settings"
+	"github.com/deicod/ermblog/sitemap"
+	"github.com/deicod/ermblog/trash"
 
 	"github.com/deicod/erm/orm/pg"
 	"gopkg.in/yaml.v3"
@@ -31,6 +45,18 @@ func main() {
 		log.Fatalf("load config: %v", err)
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, resolveTracingConfig(cfg.Observability.Tracing))
+	if err != nil {
+		log.Fatalf("configure tracing: %v", err)
+	}
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			log.Printf("flush traces: %v", err)
+		}
+	}()
+
 	dbURL := resolveDatabaseURL(cfg.Database)
 	if dbURL == "" {
 		log.Fatal("database url is empty; set database.url in erm.yaml or export ERM_DATABASE_URL")
@@ -41,6 +67,7 @@ func main() {
 		log.Fatalf("connect database: %v", err)
 	}
 	defer db.Close()
+	tracing.InstrumentDB(db, nil)
 
 	promCollector, err := prommetrics.New()
 	if err != nil {
@@ -50,9 +77,69 @@ func main() {
 
 	ormClient := gen.NewClient(db)
 
+	siteSettings := settings.NewCache(settings.NewORMStore(ormClient), settings.Defaults())
+	preloaded, err := siteSettings.Preload(ctx)
+	if err != nil {
+		log.Fatalf("preload settings: %v", err)
+	}
+	log.Printf("preloaded %d autoload options", preloaded)
+
+	permalinks, err := resolvePermalinks(cfg.Site)
+	if err != nil {
+		log.Fatalf("configure permalinks: %v", err)
+	}
+	permalinks, err = applyPermalinkStructure(ctx, permalinks, cfg.Site, siteSettings)
+	if err != nil {
+		log.Fatalf("configure permalinks: %v", err)
+	}
+
+	persistedQueries, err := resolvePersistedQueries(ctx, cfg.GraphQL.PersistedQueries, db)
+	if err != nil {
+		log.Fatalf("configure persisted queries: %v", err)
+	}
+
+	localAuth, err := resolveLocalAuth(cfg.LocalAuth, ormClient)
+	if err != nil {
+		log.Fatalf("configure local auth: %v", err)
+	}
+	var recovery *localauth.Recovery
+	if localAuth != nil {
+		recovery, err = resolveRecovery(cfg.LocalAuth.Recovery, resolveSiteBaseURL(cfg.Site), resolveMailer(cfg.Mail), cfg.Mail.Timeout, ormClient)
+		if err != nil {
+			log.Fatalf("configure password recovery: %v", err)
+		}
+	}
+
+	apiKeys, err := resolveAPIKeys(cfg.APIKeys, ormClient)
+	if err != nil {
+		log.Fatalf("configure api keys: %v", err)
+	}
+
+	var responseCache *cache.Cache
+	if cfg.GraphQL.Cache.Enabled {
+		responseCache = cache.New(cache.Config{
+			MaxEntries: cfg.GraphQL.Cache.MaxEntries,
+			TTL:        cfg.GraphQL.Cache.TTL,
+		}, collector)
+		if !cfg.OIDC.AllowAnonymous {
+			log.Print("graphql response cache only stores anonymous queries; set oidc.allow_anonymous to accept them")
+		}
+	}
+
 	gqlOpts := server.Options{
-		ORM:       ormClient,
-		Collector: collector,
+		ORM:        ormClient,
+		Collector:  collector,
+		Permalinks: permalinks,
+		Limits: limits.Config{
+			MaxDepth:      cfg.GraphQL.Limits.MaxDepth,
+			MaxComplexity: cfg.GraphQL.Limits.MaxComplexity,
+		},
+		PersistedQueries: persistedQueries,
+		ResponseCache:    responseCache,
+		Settings:         siteSettings,
+		LocalAuth:        localAuth,
+		Recovery:         recovery,
+		APIKeys:          apiKeys,
 		Subscriptions: server.SubscriptionOptions{
 			Enabled: cfg.GraphQL.Subscriptions.Enabled,
 			Transports: server.SubscriptionTransports{
@@ -69,31 +156,121 @@ func main() {
 		graphqlServer.ServeHTTP(w, r.WithContext(ctx))
 	})
 
+	var validators oidc.Chain
+	if localAuth != nil {
+		validators = append(validators, localAuth)
+	}
 	oidcIssuer, oidcAudience := resolveOIDCConfig(cfg.OIDC)
-	if oidcIssuer == "" {
+	switch {
+	case oidcIssuer == "" && oidcAudience == "" && localAuth != nil:
+		log.Print("oidc is not configured; accepting local sessions only")
+	case oidcIssuer == "":
 		log.Fatal("oidc issuer is empty; set oidc.issuer in erm.yaml or export ERM_OIDC_ISSUER")
-	}
-	if oidcAudience == "" {
+	case oidcAudience == "":
 		log.Fatal("oidc audience is empty; set oidc.audience in erm.yaml or export ERM_OIDC_AUDIENCE")
+	default:
+		validator, err := oidc.NewValidator(ctx, oidcIssuer, oidcAudience)
+		if err != nil {
+			log.Fatalf("configure oidc validator: %v", err)
+		}
+		validators = append(validators, validator)
+	}
+	if responseCache != nil {
+		graphqlHandler = responseCache.Middleware(graphqlHandler)
 	}
-	validator, err := oidc.NewValidator(ctx, oidcIssuer, oidcAudience)
+	limiter, err := resolveRateLimiter(cfg.RateLimit, db, persistedQueries.Store)
 	if err != nil {
-		log.Fatalf("configure oidc validator: %v", err)
+		log.Fatalf("configure rate limiting: %v", err)
+	}
+	if limiter != nil {
+		// Inside the validator so budgets are keyed by the OIDC subject.
+		graphqlHandler = limiter.Middleware(graphqlHandler)
+		go limiter.Run(ctx, cfg.RateLimit.PruneInterval)
+	}
+	authenticated := authenticate(cfg.OIDC.AllowAnonymous, validators, graphqlHandler)
+	if apiKeys != nil {
+		// API keys bypass the token validators but share the rate limiter.
+		graphqlHandler = apiKeys.Middleware(graphqlHandler, authenticated)
+	} else {
+		graphqlHandler = authenticated
 	}
-	graphqlHandler = validator.Middleware(graphqlHandler)
 
 	graphqlPath := resolveGraphQLPath(cfg.GraphQL)
 
+	// Callers without a session log in on a separate endpoint that serves
+	// the auth mutations only, so the main one can keep requiring a token.
+	var authHandler http.Handler
+	if localAuth != nil {
+		authOpts := gqlOpts
+		authOpts.Subscriptions = server.SubscriptionOptions{}
+		authOpts.RootFields = server.AuthFields
+		authServer := server.NewServer(authOpts)
+		authHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := server.WithLoaders(r.Context(), authOpts)
+			authServer.ServeHTTP(w, r.WithContext(ctx))
+		})
+		if limiter != nil {
+			authHandler = limiter.Middleware(authHandler)
+		}
+		authHandler = validators.OptionalMiddleware(authHandler)
+	}
+
+	sitemapHandler, err := sitemap.NewHandler(sitemap.NewORMSource(ormClient), sitemap.Options{
+		BaseURL:    resolveSiteBaseURL(cfg.Site),
+		Permalinks: permalinks,
+	})
+	if err != nil {
+		log.Fatalf("configure sitemap: %v", err)
+	}
+
+	permalinkRouter, err := permalink.NewRouter(permalinks, permalink.NewORMStore(ormClient))
+	if err != nil {
+		log.Fatalf("configure permalinks: %v", err)
+	}
+	permalinkHandler, err := permalink.NewHandler(permalinkRouter, playground.Handler("graphql", graphqlPath))
+	if err != nil {
+		log.Fatalf("configure permalinks: %v", err)
+	}
+
+	// The sitemap and the view beacon are public and hit the database on
+	// every request, so they draw on the query budget as well.
+	limited := func(h http.Handler) http.Handler {
+		if limiter == nil {
+			return h
+		}
+		return limiter.Middleware(h)
+	}
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", healthHandler)
 	mux.Handle("/metrics", promCollector.Handler())
-	mux.Handle("/", playground.Handler("graphql", graphqlPath))
+	mux.Handle(sitemap.IndexPath, limited(sitemapHandler))
+	mux.Handle(sitemap.ChunkPrefix, limited(sitemapHandler))
+	mux.Handle("/", permalinkHandler)
 	mux.Handle(graphqlPath, graphqlHandler)
+	if authHandler != nil {
+		mux.Handle(resolveGraphQLAuthPath(graphqlPath), authHandler)
+	}
+
+	tracker, err := resolveTracker(cfg.Analytics, ormClient)
+	if err != nil {
+		log.Fatalf("configure analytics: %v", err)
+	}
+	if tracker != nil {
+		mux.Handle(resolveAnalyticsPath(cfg.Analytics), limited(tracker))
+		go tracker.Run(ctx, cfg.Analytics.RollupInterval)
+	}
+
+	purger, err := resolvePurger(cfg.Trash, ormClient)
+	if err != nil {
+		log.Fatalf("configure trash: %v", err)
+	}
+	go purger.Run(ctx, cfg.Trash.PurgeInterval)
 
 	addr := resolveHTTPAddr()
 	srv := &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: tracing.Middleware(nil, mux),
 	}
 
 	errCh := make(chan error, 1)
@@ -120,6 +297,10 @@ func main() {
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Printf("graceful shutdown failed: %v", err)
 	}
+	if recovery != nil {
+		// Reset mails are sent in the background; let them finish.
+		recovery.Wait()
+	}
 
 	<-errCh
 }
@@ -133,6 +314,16 @@ type config struct {
 	Database databaseConfig `yaml:"database"`
 	GraphQL  graphQLConfig  `yaml:"graphql"`
 	OIDC     oidcConfig     `yaml:"oidc"`
+	// LocalAuth enables password logins next to (or instead of) OIDC.
+	LocalAuth localAuthConfig `yaml:"local_auth"`
+	Mail      mailConfig      `yaml:"mail"`
+	APIKeys   apiKeysConfig   `yaml:"api_keys"`
+	Site      siteConfig      `yaml:"site"`
+
+	RateLimit     rateLimitConfig     `yaml:"ratelimit"`
+	Analytics     analyticsConfig     `yaml:"analytics"`
+	Trash         trashConfig         `yaml:"trash"`
+	Observability observabilityConfig `yaml:"observability"`
 }
 
 type databaseConfig struct {
@@ -182,11 +373,137 @@ type graphQLConfig struct {
 			GraphQLWS bool `yaml:"graphql_ws"`
 		} `yaml:"transports"`
 	} `yaml:"subscriptions"`
+	Limits struct {
+		MaxDepth      int `yaml:"max_depth"`
+		MaxComplexity int `yaml:"max_complexity"`
+	} `yaml:"limits"`
+	PersistedQueries persistedQueriesConfig `yaml:"persisted_queries"`
+	Cache            responseCacheConfig    `yaml:"cache"`
+}
+
+type responseCacheConfig struct {
+	Enabled    bool          `yaml:"enabled"`
+	MaxEntries int           `yaml:"max_entries"`
+	TTL        time.Duration `yaml:"ttl"`
+}
+
+type persistedQueriesConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	Strict    bool   `yaml:"strict"`
+	Manifest  string `yaml:"manifest"`
+	CacheSize int    `yaml:"cache_size"`
+}
+
+type rateLimitConfig struct {
+	Enabled        bool            `yaml:"enabled"`
+	Store          string          `yaml:"store"`
+	TrustedProxies []string        `yaml:"trusted_proxies"`
+	Query          rateLimitBudget `yaml:"query"`
+	Mutation       rateLimitBudget `yaml:"mutation"`
+	Subscription   rateLimitBudget `yaml:"subscription"`
+	PruneInterval  time.Duration   `yaml:"prune_interval"`
+}
+
+type rateLimitBudget struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+type analyticsConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	Path           string        `yaml:"path"`
+	TrustedProxies []string      `yaml:"trusted_proxies"`
+	RollupInterval time.Duration `yaml:"rollup_interval"`
+}
+
+type trashConfig struct {
+	// Retention is how long deleted posts, comments and media stay
+	// restorable before they are purged; it defaults to 30 days.
+	Retention     time.Duration `yaml:"retention"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
 }
 
 type oidcConfig struct {
 	Issuer   string `yaml:"issuer"`
 	Audience string `yaml:"audience"`
+	// AllowAnonymous serves requests without a bearer token; @auth fields
+	// still require one.
+	AllowAnonymous bool `yaml:"allow_anonymous"`
+}
+
+type localAuthConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// Keys sign access tokens, the first one signing new tokens.
+	// ERM_LOCAL_AUTH_KEYS ("id=secret,...") replaces them.
+	Keys         []localAuthKeyConfig `yaml:"keys"`
+	AccessTTL    time.Duration        `yaml:"access_ttl"`
+	RefreshTTL   time.Duration        `yaml:"refresh_ttl"`
+	MaxFailures  int                  `yaml:"max_failures"`
+	Lockout      time.Duration        `yaml:"lockout"`
+	DefaultRoles []string             `yaml:"default_roles"`
+	// Recovery configures password reset and email verification mails.
+	Recovery localRecoveryConfig `yaml:"recovery"`
+}
+
+type localRecoveryConfig struct {
+	// ResetURL and VerifyURL are mailed with {token} replaced; they default
+	// to /reset-password and /verify-email under site.base_url.
+	ResetURL          string        `yaml:"reset_url"`
+	VerifyURL         string        `yaml:"verify_url"`
+	ResetTTL          time.Duration `yaml:"reset_ttl"`
+	VerifyTTL         time.Duration `yaml:"verify_ttl"`
+	MinPasswordLength int           `yaml:"min_password_length"`
+}
+
+type apiKeysConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// DefaultRoles are held by every key owner on top of their assigned
+	// roles, like local_auth.default_roles.
+	DefaultRoles []string `yaml:"default_roles"`
+}
+
+type mailConfig struct {
+	// SMTPAddr is the host:port of the SMTP server; without it mails are
+	// written to the log.
+	SMTPAddr string `yaml:"smtp_addr"`
+	From     string `yaml:"from"`
+	Username string `yaml:"username"`
+	// Password is replaced by ERM_SMTP_PASSWORD when set.
+	Password string `yaml:"password"`
+	// Timeout bounds sending one mail; defaults to 30 seconds.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type localAuthKeyConfig struct {
+	ID     string `yaml:"id"`
+	Secret string `yaml:"secret"`
+}
+
+type siteConfig struct {
+	BaseURL    string           `yaml:"base_url"`
+	Permalinks permalinksConfig `yaml:"permalinks"`
+}
+
+type permalinksConfig struct {
+	Post     string `yaml:"post"`
+	Page     string `yaml:"page"`
+	Category string `yaml:"category"`
+	Tag      string `yaml:"tag"`
+}
+
+type observabilityConfig struct {
+	Tracing tracingConfig `yaml:"tracing"`
+}
+
+type tracingConfig struct {
+	Exporter    string            `yaml:"exporter"`
+	Endpoint    string            `yaml:"endpoint"`
+	Insecure    bool              `yaml:"insecure"`
+	Headers     map[string]string `yaml:"headers"`
+	ServiceName string            `yaml:"service_name"`
+	SampleRatio float64           `yaml:"sample_ratio"`
 }
 
 func loadConfig(path string) (config, error) {
@@ -241,6 +558,22 @@ func resolveGraphQLPath(cfg graphQLConfig) string {
 	return "/graphql"
 }
 
+// resolveGraphQLAuthPath is where local auth mutations are served without
+// a bearer token, next to the GraphQL endpoint.
+func resolveGraphQLAuthPath(graphqlPath string) string {
+	return strings.TrimSuffix(graphqlPath, "/") + "/auth"
+}
+
+// authenticate requires a bearer token on every request unless anonymous
+// access is allowed. Local auth does not relax this; its login mutations
+// are served on resolveGraphQLAuthPath instead.
+func authenticate(allowAnonymous bool, validators oidc.Chain, next http.Handler) http.Handler {
+	if allowAnonymous {
+		return validators.OptionalMiddleware(next)
+	}
+	return validators.Middleware(next)
+}
+
 func resolveHTTPAddr() string {
 	if addr := os.Getenv("ERM_HTTP_ADDR"); addr != "" {
 		return addr
@@ -266,6 +599,212 @@ func resolveOIDCConfig(cfg oidcConfig) (string, string) {
 	return issuer, audience
 }
 
+func resolveSiteBaseURL(cfg siteConfig) string {
+	if url := os.Getenv("ERM_SITE_URL"); url != "" {
+		return url
+	}
+	if cfg.BaseURL != "" {
+		return cfg.BaseURL
+	}
+	return "http://localhost:8080"
+}
+
+func resolvePermalinks(cfg siteConfig) (permalink.Set, error) {
+	links := permalink.DefaultSet()
+	patterns := []struct {
+		raw    string
+		target *permalink.Pattern
+	}{
+		{cfg.Permalinks.Post, &links.Post},
+		{cfg.Permalinks.Page, &links.Page},
+		{cfg.Permalinks.Category, &links.Category},
+		{cfg.Permalinks.Tag, &links.Tag},
+	}
+	for _, p := range patterns {
+		if p.raw == "" {
+			continue
+		}
+		parsed, err := permalink.Parse(p.raw)
+		if err != nil {
+			return permalink.Set{}, err
+		}
+		*p.target = parsed
+	}
+	return links, nil
+}
+
+// applyPermalinkStructure uses the permalink_structure setting for posts
+// unless erm.yaml configures a post pattern. The setting is read-only at
+// runtime, so reading it once here keeps every replica on the same pattern.
+func applyPermalinkStructure(ctx context.Context, links permalink.Set, cfg siteConfig, cache *settings.Cache) (permalink.Set, error) {
+	if cfg.Permalinks.Post != "" {
+		return links, nil
+	}
+	structure, stored, err := settings.Lookup(ctx, cache, settings.PermalinkStructure)
+	if err != nil || !stored {
+		return links, err
+	}
+	parsed, err := permalink.Parse(structure)
+	if err != nil {
+		return links, fmt.Errorf("permalink_structure setting: %w", err)
+	}
+	links.Post = parsed
+	return links, nil
+}
+
+// resolvePersistedQueries keeps the allow-list in the persisted_queries
+// table so operations registered on one replica run on all of them.
+func resolvePersistedQueries(ctx context.Context, cfg persistedQueriesConfig, db *pg.DB) (server.PersistedQueryOptions, error) {
+	opts := server.PersistedQueryOptions{Enabled: cfg.Enabled || cfg.Strict, Strict: cfg.Strict}
+	if !opts.Enabled {
+		return opts, nil
+	}
+	store, err := persisted.NewSharedStore(ctx, cfg.CacheSize, persisted.NewPostgresBackend(db.Pool))
+	if err != nil {
+		return server.PersistedQueryOptions{}, err
+	}
+	opts.Store = store
+	if cfg.Manifest != "" {
+		count, err := opts.Store.LoadFile(ctx, cfg.Manifest)
+		if err != nil {
+			return server.PersistedQueryOptions{}, err
+		}
+		log.Printf("loaded %d new persisted queries from %s", count, cfg.Manifest)
+	} else if cfg.Strict && opts.Store.Len() == 0 {
+		log.Print("persisted queries are strict but no operations are registered; load a manifest or call registerPersistedQueries")
+	}
+	return opts, nil
+}
+
+// resolveRateLimiter returns nil when rate limiting is disabled. queries,
+// when set, lets hash-only persisted operations be charged by their type.
+func resolveRateLimiter(cfg rateLimitConfig, db *pg.DB, queries *persisted.Store) (*ratelimit.Limiter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	var store ratelimit.Store
+	switch strings.ToLower(strings.TrimSpace(cfg.Store)) {
+	case "", "memory":
+		store = ratelimit.NewMemoryStore()
+	case "postgres":
+		store = ratelimit.NewPostgresStore(db.Pool)
+	default:
+		return nil, fmt.Errorf("unknown ratelimit store %q", cfg.Store)
+	}
+	limits := ratelimit.Config{
+		Query:          ratelimit.Budget{Rate: cfg.Query.Rate, Burst: cfg.Query.Burst},
+		Mutation:       ratelimit.Budget{Rate: cfg.Mutation.Rate, Burst: cfg.Mutation.Burst},
+		Subscription:   ratelimit.Budget{Rate: cfg.Subscription.Rate, Burst: cfg.Subscription.Burst},
+		TrustedProxies: cfg.TrustedProxies,
+	}
+	if queries != nil {
+		limits.PersistedQueries = queries
+	}
+	return ratelimit.New(limits, store)
+}
+
+// resolveLocalAuth returns nil when local auth is disabled.
+func resolveLocalAuth(cfg localAuthConfig, client *gen.Client) (*localauth.Service, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	keys := make([]localauth.Key, 0, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		keys = append(keys, localauth.Key{ID: key.ID, Secret: []byte(key.Secret)})
+	}
+	if env := os.Getenv("ERM_LOCAL_AUTH_KEYS"); env != "" {
+		parsed, err := localauth.ParseKeys(env)
+		if err != nil {
+			return nil, err
+		}
+		keys = parsed
+	}
+	ring, err := localauth.NewKeyRing(keys...)
+	if err != nil {
+		return nil, err
+	}
+	return localauth.New(localauth.Config{
+		Issuer:       cfg.Issuer,
+		Audience:     cfg.Audience,
+		AccessTTL:    cfg.AccessTTL,
+		RefreshTTL:   cfg.RefreshTTL,
+		MaxFailures:  cfg.MaxFailures,
+		Lockout:      cfg.Lockout,
+		DefaultRoles: cfg.DefaultRoles,
+	}, ring, localauth.NewORMStore(client))
+}
+
+// resolveAPIKeys returns nil when API keys are disabled.
+func resolveAPIKeys(cfg apiKeysConfig, client *gen.Client) (*apikeys.Service, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return apikeys.New(apikeys.Config{DefaultRoles: cfg.DefaultRoles}, apikeys.NewORMStore(client))
+}
+
+func resolveRecovery(cfg localRecoveryConfig, baseURL string, mailer mail.Mailer, mailTimeout time.Duration, client *gen.Client) (*localauth.Recovery, error) {
+	base := strings.TrimRight(baseURL, "/")
+	resetURL, verifyURL := cfg.ResetURL, cfg.VerifyURL
+	if resetURL == "" {
+		resetURL = base + "/reset-password?token={token}"
+	}
+	if verifyURL == "" {
+		verifyURL = base + "/verify-email?token={token}"
+	}
+	return localauth.NewRecovery(localauth.RecoveryConfig{
+		ResetURL:    resetURL,
+		VerifyURL:   verifyURL,
+		ResetTTL:    cfg.ResetTTL,
+		VerifyTTL:   cfg.VerifyTTL,
+		Policy:      localauth.PasswordPolicy{MinLength: cfg.MinPasswordLength},
+		MailTimeout: mailTimeout,
+	}, localauth.NewORMStore(client), mailer)
+}
+
+func resolveMailer(cfg mailConfig) mail.Mailer {
+	if cfg.SMTPAddr == "" {
+		return mail.LogMailer{}
+	}
+	password := cfg.Password
+	if env := os.Getenv("ERM_SMTP_PASSWORD"); env != "" {
+		password = env
+	}
+	return mail.SMTPMailer{Addr: cfg.SMTPAddr, From: cfg.From, Username: cfg.Username, Password: password}
+}
+
+func resolveTracker(cfg analyticsConfig, client *gen.Client) (*analytics.Tracker, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return analytics.New(analytics.Config{TrustedProxies: cfg.TrustedProxies}, analytics.NewORMStore(client))
+}
+
+func resolvePurger(cfg trashConfig, client *gen.Client) (*trash.Purger, error) {
+	return trash.New(trash.NewORMStore(client), cfg.Retention)
+}
+
+func resolveAnalyticsPath(cfg analyticsConfig) string {
+	if cfg.Path != "" {
+		return cfg.Path
+	}
+	return analytics.DefaultPath
+}
+
+func resolveTracingConfig(cfg tracingConfig) tracing.Config {
+	exporter := os.Getenv("ERM_TRACING_EXPORTER")
+	if exporter == "" {
+		exporter = cfg.Exporter
+	}
+	return tracing.Config{
+		Exporter:    exporter,
+		Endpoint:    cfg.Endpoint,
+		Insecure:    cfg.Insecure,
+		Headers:     cfg.Headers,
+		ServiceName: cfg.ServiceName,
+		SampleRatio: cfg.SampleRatio,
+	}
+}
+
 func (pc poolConfig) option() pg.Option {
 	if pc.MaxConns == 0 && pc.MinConns == 0 && pc.MaxConnLifetime == 0 && pc.MaxConnIdleTime == 0 && pc.HealthCheckPeriod == 0 {
 		return nil
@@ -0,0 +1,389 @@
+// Package contenttype validates custom post types: their machine names, the
+// editor features they support and the JSON Schema describing the custom
+// fields their posts store as meta.
+//
+// Only the subset of JSON Schema that maps onto flat custom fields is
+// understood: an object with properties of type string, number, integer,
+// boolean or array, the required list and additionalProperties. Properties
+// marked "x-indexed": true get database indexes and can be used in post
+// filters; they must be scalar.
+package contenttype
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Features lists the editor features a content type may declare support for.
+var Features = []string{"title", "editor", "excerpt", "featured_media", "comments", "categories", "tags"}
+
+// MaxNameLength caps content type names.
+const MaxNameLength = 64
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ErrInvalidName is returned for names that are not lower-case identifiers.
+var ErrInvalidName = errors.New("name must start with a letter and contain only lower-case letters, digits and underscores")
+
+// ValidateName reports whether name is usable as a content type name.
+func ValidateName(name string) error {
+	if len(name) > MaxNameLength || !namePattern.MatchString(name) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// ParseSupports decodes a JSON array of feature names, rejecting unknown and
+// duplicate entries. An empty document yields no features.
+func ParseSupports(raw json.RawMessage) ([]string, error) {
+	if isEmpty(raw) {
+		return []string{}, nil
+	}
+	var features []string
+	if err := json.Unmarshal(raw, &features); err != nil {
+		return nil, errors.New("supports must be an array of feature names")
+	}
+	seen := make(map[string]struct{}, len(features))
+	for _, feature := range features {
+		if !slices.Contains(Features, feature) {
+			return nil, fmt.Errorf("unknown feature %q, expected one of %s", feature, strings.Join(Features, ", "))
+		}
+		if _, ok := seen[feature]; ok {
+			return nil, fmt.Errorf("feature %q is listed twice", feature)
+		}
+		seen[feature] = struct{}{}
+	}
+	return features, nil
+}
+
+// Schema describes the custom fields of a content type.
+type Schema struct {
+	Properties           map[string]*Property
+	Required             []string
+	AdditionalProperties bool
+}
+
+// Property describes one custom field.
+type Property struct {
+	Type      string
+	Format    string
+	Enum      []any
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int
+	MaxLength *int
+	Pattern   *regexp.Regexp
+	Items     *Property
+	Indexed   bool
+}
+
+type rawSchema struct {
+	Type                 string                  `json:"type"`
+	Properties           map[string]*rawProperty `json:"properties"`
+	Required             []string                `json:"required"`
+	AdditionalProperties *bool                   `json:"additionalProperties"`
+}
+
+type rawProperty struct {
+	Type      string       `json:"type"`
+	Format    string       `json:"format"`
+	Enum      []any        `json:"enum"`
+	Minimum   *float64     `json:"minimum"`
+	Maximum   *float64     `json:"maximum"`
+	MinLength *int         `json:"minLength"`
+	MaxLength *int         `json:"maxLength"`
+	Pattern   string       `json:"pattern"`
+	Items     *rawProperty `json:"items"`
+	Indexed   bool         `json:"x-indexed"`
+}
+
+var formats = []string{"date", "date-time", "email", "uri"}
+
+// ParseSchema decodes and checks a field schema. An empty document yields a
+// schema without fields. Unknown keys are rejected unless the schema sets
+// additionalProperties to true.
+func ParseSchema(raw json.RawMessage) (*Schema, error) {
+	if isEmpty(raw) {
+		return &Schema{Properties: map[string]*Property{}}, nil
+	}
+	var decoded rawSchema
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("fields must be a JSON Schema object: %w", err)
+	}
+	if decoded.Type != "" && decoded.Type != "object" {
+		return nil, errors.New(`fields schema must have type "object"`)
+	}
+	schema := &Schema{Properties: make(map[string]*Property, len(decoded.Properties))}
+	for key, prop := range decoded.Properties {
+		if !namePattern.MatchString(key) {
+			return nil, fmt.Errorf("field %q: keys must be lower-case identifiers", key)
+		}
+		parsed, err := parseProperty(prop, false)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		schema.Properties[key] = parsed
+	}
+	for _, key := range decoded.Required {
+		if _, ok := schema.Properties[key]; !ok {
+			return nil, fmt.Errorf("required field %q is not defined", key)
+		}
+	}
+	schema.Required = decoded.Required
+	if decoded.AdditionalProperties != nil {
+		schema.AdditionalProperties = *decoded.AdditionalProperties
+	}
+	return schema, nil
+}
+
+func parseProperty(raw *rawProperty, item bool) (*Property, error) {
+	if raw == nil {
+		return nil, errors.New("definition must be an object")
+	}
+	prop := &Property{
+		Type:      raw.Type,
+		Format:    raw.Format,
+		Enum:      raw.Enum,
+		Minimum:   raw.Minimum,
+		Maximum:   raw.Maximum,
+		MinLength: raw.MinLength,
+		MaxLength: raw.MaxLength,
+		Indexed:   raw.Indexed,
+	}
+	switch raw.Type {
+	case "string", "number", "integer", "boolean":
+	case "array":
+		if item {
+			return nil, errors.New("nested arrays are not supported")
+		}
+		if raw.Indexed {
+			return nil, errors.New("only scalar fields can be indexed")
+		}
+		if raw.Items == nil {
+			return nil, errors.New("arrays must define items")
+		}
+		items, err := parseProperty(raw.Items, true)
+		if err != nil {
+			return nil, fmt.Errorf("items: %w", err)
+		}
+		prop.Items = items
+	default:
+		return nil, fmt.Errorf("unsupported type %q", raw.Type)
+	}
+	if raw.Format != "" {
+		if raw.Type != "string" {
+			return nil, errors.New("format only applies to strings")
+		}
+		if !slices.Contains(formats, raw.Format) {
+			return nil, fmt.Errorf("unsupported format %q", raw.Format)
+		}
+	}
+	if raw.Pattern != "" {
+		pattern, err := regexp.Compile(raw.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		prop.Pattern = pattern
+	}
+	return prop, nil
+}
+
+// IndexedKeys returns the fields marked "x-indexed", sorted.
+func (s *Schema) IndexedKeys() []string {
+	keys := make([]string, 0)
+	for key, prop := range s.Properties {
+		if prop.Indexed {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// FieldError reports a meta value that does not match its field definition.
+type FieldError struct {
+	Key     string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+// Validate checks a complete meta document against the schema. It returns a
+// *FieldError for the first offending key in sorted order.
+func (s *Schema) Validate(meta map[string]json.RawMessage) error {
+	for _, key := range s.Required {
+		if _, ok := meta[key]; !ok {
+			return &FieldError{Key: key, Message: "is required"}
+		}
+	}
+	keys := make([]string, 0, len(meta))
+	for key := range meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !namePattern.MatchString(key) {
+			return &FieldError{Key: key, Message: "keys must be lower-case identifiers"}
+		}
+		prop, ok := s.Properties[key]
+		if !ok {
+			if s.AdditionalProperties {
+				continue
+			}
+			return &FieldError{Key: key, Message: "is not a field of this content type"}
+		}
+		value, err := decode(meta[key])
+		if err != nil {
+			return &FieldError{Key: key, Message: "is not valid JSON"}
+		}
+		if msg := prop.check(value); msg != "" {
+			return &FieldError{Key: key, Message: msg}
+		}
+	}
+	return nil
+}
+
+func (p *Property) check(value any) string {
+	switch p.Type {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		if msg := p.checkString(str); msg != "" {
+			return msg
+		}
+	case "number", "integer":
+		num, ok := value.(json.Number)
+		if !ok {
+			return "must be a number"
+		}
+		f, err := num.Float64()
+		if err != nil {
+			return "must be a number"
+		}
+		if p.Type == "integer" && f != math.Trunc(f) {
+			return "must be an integer"
+		}
+		if p.Minimum != nil && f < *p.Minimum {
+			return fmt.Sprintf("must be at least %v", *p.Minimum)
+		}
+		if p.Maximum != nil && f > *p.Maximum {
+			return fmt.Sprintf("must be at most %v", *p.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return "must be an array"
+		}
+		for i, item := range items {
+			if msg := p.Items.check(item); msg != "" {
+				return fmt.Sprintf("item %d %s", i, msg)
+			}
+		}
+	}
+	if len(p.Enum) > 0 && !p.allowed(value) {
+		return "is not one of the allowed values"
+	}
+	return ""
+}
+
+func (p *Property) checkString(str string) string {
+	length := utf8.RuneCountInString(str)
+	if p.MinLength != nil && length < *p.MinLength {
+		return fmt.Sprintf("must be at least %d characters", *p.MinLength)
+	}
+	if p.MaxLength != nil && length > *p.MaxLength {
+		return fmt.Sprintf("must be at most %d characters", *p.MaxLength)
+	}
+	if p.Pattern != nil && !p.Pattern.MatchString(str) {
+		return "does not match the required pattern"
+	}
+	switch p.Format {
+	case "date":
+		if _, err := time.Parse(time.DateOnly, str); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return "must be an RFC 3339 date-time"
+		}
+	case "email":
+		if _, err := mail.ParseAddress(str); err != nil {
+			return "must be an email address"
+		}
+	case "uri":
+		if u, err := url.Parse(str); err != nil || u.Scheme == "" {
+			return "must be an absolute URI"
+		}
+	}
+	return ""
+}
+
+func (p *Property) allowed(value any) bool {
+	for _, candidate := range p.Enum {
+		if equalJSON(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalJSON(a, b any) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+	left, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	right, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(left, right)
+}
+
+func number(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func decode(raw json.RawMessage) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func isEmpty(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
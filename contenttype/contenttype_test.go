@@ -0,0 +1,121 @@
+package contenttype
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const eventFields = `{
+	"type": "object",
+	"properties": {
+		"starts_on": {"type": "string", "format": "date", "x-indexed": true},
+		"price": {"type": "number", "minimum": 0, "x-indexed": true},
+		"seats": {"type": "integer", "maximum": 500},
+		"level": {"type": "string", "enum": ["beginner", "expert"]},
+		"online": {"type": "boolean"},
+		"speakers": {"type": "array", "items": {"type": "string", "maxLength": 10}}
+	},
+	"required": ["starts_on"]
+}`
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"event", "job_offer", "v2"} {
+		if err := ValidateName(name); err != nil {
+			t.Fatalf("expected %q to be valid: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Event", "2fast", "job-offer", "événement"} {
+		if err := ValidateName(name); !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("expected %q to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestParseSupports(t *testing.T) {
+	features, err := ParseSupports(json.RawMessage(`["title", "tags"]`))
+	if err != nil || !reflect.DeepEqual(features, []string{"title", "tags"}) {
+		t.Fatalf("unexpected features %v (%v)", features, err)
+	}
+	if features, err := ParseSupports(nil); err != nil || len(features) != 0 {
+		t.Fatalf("expected no features, got %v (%v)", features, err)
+	}
+	for _, raw := range []string{`["title", "title"]`, `["sidebar"]`, `{"title": true}`} {
+		if _, err := ParseSupports(json.RawMessage(raw)); err == nil {
+			t.Fatalf("expected %s to be rejected", raw)
+		}
+	}
+}
+
+func TestParseSchemaRejectsUnsupportedDefinitions(t *testing.T) {
+	for _, raw := range []string{
+		`{"type": "array"}`,
+		`{"properties": {"when": {"type": "date"}}}`,
+		`{"properties": {"tags": {"type": "array", "items": {"type": "string"}, "x-indexed": true}}}`,
+		`{"properties": {"tags": {"type": "array"}}}`,
+		`{"properties": {"count": {"type": "integer", "format": "date"}}}`,
+		`{"properties": {"code": {"type": "string", "pattern": "("}}}`,
+		`{"properties": {"Price": {"type": "number"}}}`,
+		`{"properties": {}, "required": ["price"]}`,
+	} {
+		if _, err := ParseSchema(json.RawMessage(raw)); err == nil {
+			t.Fatalf("expected %s to be rejected", raw)
+		}
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := ParseSchema(json.RawMessage(eventFields))
+	if err != nil {
+		t.Fatalf("parse schema: %v", err)
+	}
+	if keys := schema.IndexedKeys(); !reflect.DeepEqual(keys, []string{"price", "starts_on"}) {
+		t.Fatalf("unexpected indexed keys %v", keys)
+	}
+
+	valid := map[string]json.RawMessage{
+		"starts_on": json.RawMessage(`"2026-11-02"`),
+		"price":     json.RawMessage(`12.5`),
+		"seats":     json.RawMessage(`40.0`),
+		"level":     json.RawMessage(`"expert"`),
+		"online":    json.RawMessage(`false`),
+		"speakers":  json.RawMessage(`["ada", "grace"]`),
+	}
+	if err := schema.Validate(valid); err != nil {
+		t.Fatalf("expected valid meta, got %v", err)
+	}
+
+	cases := map[string]map[string]json.RawMessage{
+		"starts_on": {"price": json.RawMessage(`1`)},
+		"price":     {"starts_on": json.RawMessage(`"2026-11-02"`), "price": json.RawMessage(`-1`)},
+		"seats":     {"starts_on": json.RawMessage(`"2026-11-02"`), "seats": json.RawMessage(`1.5`)},
+		"level":     {"starts_on": json.RawMessage(`"2026-11-02"`), "level": json.RawMessage(`"novice"`)},
+		"online":    {"starts_on": json.RawMessage(`"2026-11-02"`), "online": json.RawMessage(`"yes"`)},
+		"speakers":  {"starts_on": json.RawMessage(`"2026-11-02"`), "speakers": json.RawMessage(`["a very long name"]`)},
+		"venue":     {"starts_on": json.RawMessage(`"2026-11-02"`), "venue": json.RawMessage(`"hall"`)},
+	}
+	for key, meta := range cases {
+		err := schema.Validate(meta)
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Key != key {
+			t.Fatalf("expected error for %q, got %v", key, err)
+		}
+	}
+	if err := schema.Validate(map[string]json.RawMessage{"starts_on": json.RawMessage(`"02/11/2026"`)}); err == nil {
+		t.Fatal("expected malformed date to be rejected")
+	}
+}
+
+func TestSchemaAdditionalProperties(t *testing.T) {
+	schema, err := ParseSchema(json.RawMessage(`{"additionalProperties": true}`))
+	if err != nil {
+		t.Fatalf("parse schema: %v", err)
+	}
+	if err := schema.Validate(map[string]json.RawMessage{"anything": json.RawMessage(`{"nested": 1}`)}); err != nil {
+		t.Fatalf("expected additional keys to be accepted, got %v", err)
+	}
+	if err := schema.Validate(map[string]json.RawMessage{"Bad Key": json.RawMessage(`1`)}); err == nil {
+		t.Fatal("expected invalid key to be rejected")
+	}
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/deicod/ermblog/graphql/subscriptions"
+)
+
+// ErrNoSubscriptions is returned by Subscribe when the wrapped broker is nil.
+var ErrNoSubscriptions = errors.New("cache: subscriptions are disabled")
+
+// Broker invalidates the cache for every entity event published through it
+// and forwards the event to Next. Next may be nil when subscriptions are
+// disabled; events are then only used for invalidation.
+type Broker struct {
+	Next  subscriptions.Broker
+	Cache *Cache
+}
+
+var _ subscriptions.Broker = (*Broker)(nil)
+
+// Publish implements subscriptions.Broker. Topics have the form
+// "<entity>:<trigger>", e.g. "post:updated".
+func (b *Broker) Publish(ctx context.Context, topic string, payload any) error {
+	if b.Cache != nil {
+		if entity, _, ok := strings.Cut(topic, ":"); ok && entity != "" {
+			b.Cache.Invalidate(entity)
+		}
+	}
+	if b.Next == nil {
+		return nil
+	}
+	return b.Next.Publish(ctx, topic, payload)
+}
+
+// Subscribe implements subscriptions.Broker.
+func (b *Broker) Subscribe(ctx context.Context, topic string) (<-chan any, func(), error) {
+	if b.Next == nil {
+		return nil, nil, ErrNoSubscriptions
+	}
+	return b.Next.Subscribe(ctx, topic)
+}
@@ -0,0 +1,175 @@
+// Package cache stores GraphQL responses to anonymous queries and drops them
+// when an entity they read is created, updated or deleted.
+package cache
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deicod/ermblog/observability/metrics"
+)
+
+// Defaults applied when Config leaves a value unset.
+const (
+	DefaultMaxEntries = 1000
+	DefaultTTL        = time.Minute
+)
+
+// Config bounds the cache.
+type Config struct {
+	// MaxEntries caps the number of stored responses; the least recently used
+	// entry is dropped first.
+	MaxEntries int
+	// TTL bounds how long a response is served and is advertised as the
+	// Cache-Control max-age. It also bounds staleness between replicas, which
+	// only see their own invalidation events.
+	TTL time.Duration
+}
+
+// Cache is an in-memory LRU of GraphQL responses tagged by the entity types
+// each response read.
+type Cache struct {
+	maxEntries int
+	ttl        time.Duration
+	collector  metrics.Collector
+	now        func() time.Time
+
+	mu         sync.Mutex
+	lru        *list.List
+	entries    map[string]*list.Element
+	tags       map[string]map[string]struct{}
+	generation uint64
+}
+
+type entry struct {
+	key         string
+	contentType string
+	body        []byte
+	etag        string
+	tags        []string
+	expires     time.Time
+}
+
+// New returns an empty cache reporting lookups to collector.
+func New(cfg Config, collector metrics.Collector) *Cache {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+	return &Cache{
+		maxEntries: cfg.MaxEntries,
+		ttl:        cfg.TTL,
+		collector:  metrics.WithCollector(collector),
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+		tags:       make(map[string]map[string]struct{}),
+	}
+}
+
+// Len reports the number of stored responses.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Invalidate drops every response that read one of the entity types. Names
+// are matched case-insensitively, so both "Post" and the "post" subscription
+// topic prefix work. It returns the number of responses dropped.
+func (c *Cache) Invalidate(entities ...string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Bump the generation even when nothing is cached so responses computed
+	// before the change are not stored afterwards.
+	c.generation++
+	total := 0
+	for _, entity := range entities {
+		tag := strings.ToLower(entity)
+		keys := c.tags[tag]
+		for key := range keys {
+			if el, ok := c.entries[key]; ok {
+				c.remove(el)
+			}
+		}
+		total += len(keys)
+		c.collector.RecordCacheInvalidation(tag, len(keys))
+	}
+	return total
+}
+
+func (c *Cache) get(key string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e, true
+}
+
+// currentGeneration is captured before executing a query and passed to put.
+func (c *Cache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// put stores body unless an invalidation happened since generation was read.
+func (c *Cache) put(key, contentType string, body []byte, tags []string, generation uint64) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return nil, false
+	}
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	sum := sha256.Sum256(body)
+	e := &entry{
+		key:         key,
+		contentType: contentType,
+		body:        body,
+		etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		tags:        tags,
+		expires:     c.now().Add(c.ttl),
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	for _, tag := range tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+	return e, true
+}
+
+func (c *Cache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*entry)
+	delete(c.entries, e.key)
+	for _, tag := range e.tags {
+		if keys := c.tags[tag]; keys != nil {
+			delete(keys, e.key)
+			if len(keys) == 0 {
+				delete(c.tags, tag)
+			}
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/deicod/ermblog/graphql/subscriptions"
+	"github.com/deicod/ermblog/oidc"
+)
+
+const testSchema = `
+type Query {
+	post(id: ID!): Post
+	health: String!
+	siteSettings: SiteSettings!
+}
+type Mutation {
+	updatePost(id: ID!): Post
+	updateOption(id: ID!): Option
+}
+type Post {
+	id: ID!
+	title: String!
+}
+type Option {
+	id: ID!
+}
+type SiteSettings {
+	title: String!
+}
+`
+
+// newServer runs operations against a mock schema that walks the selection
+// set through the field middleware, like generated code does. Responses embed
+// a version so tests can tell fresh results from cached ones.
+func newServer(c *Cache, version *atomic.Int64) http.Handler {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: testSchema})
+	srv := handler.New(&gql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(context.Context, string, string, int, map[string]any) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) gql.ResponseHandler {
+			ran := false
+			return func(ctx context.Context) *gql.Response {
+				if ran {
+					return nil
+				}
+				ran = true
+				opCtx := gql.GetOperationContext(ctx)
+				root := "Query"
+				if opCtx.Operation.Operation == ast.Mutation {
+					root = "Mutation"
+					version.Add(1)
+				}
+				walk(ctx, opCtx, root, opCtx.Operation.SelectionSet)
+				return &gql.Response{Data: []byte(fmt.Sprintf(`{"post":{"title":"v%d"}}`, version.Load()))}
+			}
+		},
+	})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(Extension{Cache: c})
+	return c.Middleware(srv)
+}
+
+func walk(ctx context.Context, opCtx *gql.OperationContext, object string, set ast.SelectionSet) {
+	for _, sel := range set {
+		field, ok := sel.(*ast.Field)
+		if !ok {
+			continue
+		}
+		fc := &gql.FieldContext{Object: object, Field: gql.CollectedField{Field: field, Selections: field.SelectionSet}}
+		fieldCtx := gql.WithFieldContext(ctx, fc)
+		_, _ = opCtx.ResolverMiddleware(fieldCtx, func(ctx context.Context) (any, error) {
+			walk(ctx, opCtx, field.Definition.Type.Name(), field.SelectionSet)
+			return nil, nil
+		})
+	}
+}
+
+func post(h http.Handler, body string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+const postQuery = `{"query":"query Post($id: ID!) { post(id: $id) { title } }","variables":{"id":"1"}}`
+
+func TestMiddlewareCachesAnonymousQueries(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+
+	first := post(h, postQuery)
+	if first.Code != http.StatusOK || first.Header().Get("X-Cache") != "MISS" {
+		t.Fatalf("expected stored miss, got %d %q: %s", first.Code, first.Header().Get("X-Cache"), first.Body.String())
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+
+	// Formatting and variable order do not split the cache.
+	second := post(h, `{"variables":{"id":"1"},"query":"query Post($id: ID!) {\n  post(id: $id) {\n    title # comment\n  }\n}"}`)
+	if second.Header().Get("X-Cache") != "HIT" || second.Body.String() != first.Body.String() {
+		t.Fatalf("expected cache hit, got %q: %s", second.Header().Get("X-Cache"), second.Body.String())
+	}
+	if second.Header().Get("ETag") != first.Header().Get("ETag") {
+		t.Fatal("expected stable ETag")
+	}
+
+	notModified := post(h, postQuery, "If-None-Match", first.Header().Get("ETag"))
+	if notModified.Code != http.StatusNotModified || notModified.Body.Len() != 0 {
+		t.Fatalf("expected 304, got %d", notModified.Code)
+	}
+
+	other := post(h, `{"query":"query Post($id: ID!) { post(id: $id) { title } }","variables":{"id":"2"}}`)
+	if other.Header().Get("X-Cache") != "MISS" {
+		t.Fatal("expected different variables to miss")
+	}
+}
+
+func TestMiddlewareSkipsAuthenticatedRequests(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+
+	if rec := post(h, postQuery, "Authorization", "Bearer token"); rec.Header().Get("X-Cache") != "" {
+		t.Fatalf("expected authorization header to bypass cache, got %q", rec.Header().Get("X-Cache"))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(postQuery))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(oidc.ToContext(req.Context(), oidc.Claims{Subject: "user-1"}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Header().Get("X-Cache") != "" || c.Len() != 0 {
+		t.Fatal("expected authenticated request to bypass cache")
+	}
+}
+
+func TestMutationsInvalidateTouchedEntities(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+
+	post(h, postQuery)
+	post(h, `{"query":"{ health }"}`)
+	if c.Len() != 2 {
+		t.Fatalf("expected two entries, got %d", c.Len())
+	}
+
+	if rec := post(h, `{"query":"mutation { updatePost(id: \"1\") { id } }"}`); rec.Code != http.StatusOK {
+		t.Fatalf("mutation failed: %d", rec.Code)
+	}
+	if c.Len() != 1 {
+		t.Fatalf("expected only the post entry to be dropped, got %d entries", c.Len())
+	}
+	rec := post(h, postQuery)
+	if rec.Header().Get("X-Cache") != "MISS" || !strings.Contains(rec.Body.String(), "v1") {
+		t.Fatalf("expected fresh result after mutation, got %q: %s", rec.Header().Get("X-Cache"), rec.Body.String())
+	}
+}
+
+func TestOptionUpdatesInvalidateSiteSettings(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+
+	settingsQuery := `{"query":"{ siteSettings { title } }"}`
+	post(h, settingsQuery)
+	if rec := post(h, settingsQuery); rec.Header().Get("X-Cache") != "HIT" {
+		t.Fatalf("expected siteSettings to be cached, got %q", rec.Header().Get("X-Cache"))
+	}
+	if rec := post(h, `{"query":"mutation { updateOption(id: \"1\") { id } }"}`); rec.Code != http.StatusOK {
+		t.Fatalf("mutation failed: %d", rec.Code)
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected the option update to drop siteSettings, got %d entries", c.Len())
+	}
+	if rec := post(h, settingsQuery); rec.Header().Get("X-Cache") != "MISS" {
+		t.Fatalf("expected a fresh siteSettings result, got %q", rec.Header().Get("X-Cache"))
+	}
+}
+
+func TestBrokerEventsInvalidate(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+	post(h, postQuery)
+
+	next := subscriptions.NewInMemoryBroker()
+	broker := &Broker{Next: next, Cache: c}
+	stream, cancel, err := broker.Subscribe(context.Background(), "post:updated")
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	defer cancel()
+
+	if err := broker.Publish(context.Background(), "comment:created", nil); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if c.Len() != 1 {
+		t.Fatal("expected unrelated event to keep the entry")
+	}
+	if err := broker.Publish(context.Background(), "post:updated", "payload"); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if c.Len() != 0 {
+		t.Fatal("expected post event to drop the entry")
+	}
+	if got := <-stream; got != "payload" {
+		t.Fatalf("expected event to be forwarded, got %v", got)
+	}
+
+	if _, _, err := (&Broker{Cache: c}).Subscribe(context.Background(), "post:updated"); err != ErrNoSubscriptions {
+		t.Fatalf("expected ErrNoSubscriptions, got %v", err)
+	}
+}
+
+func TestGetRequestsAndErrors(t *testing.T) {
+	t.Parallel()
+
+	var version atomic.Int64
+	c := New(Config{}, nil)
+	h := newServer(c, &version)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(query), nil)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+	get("{ health }")
+	if rec := get("{ health }"); rec.Header().Get("X-Cache") != "HIT" {
+		t.Fatalf("expected GET hit, got %q", rec.Header().Get("X-Cache"))
+	}
+	if rec := get("{ missing }"); rec.Header().Get("X-Cache") != "" {
+		t.Fatal("expected failed query not to be stored")
+	}
+	if c.Len() != 1 {
+		t.Fatalf("expected one entry, got %d", c.Len())
+	}
+}
+
+func TestCacheExpiryAndEviction(t *testing.T) {
+	t.Parallel()
+
+	c := New(Config{MaxEntries: 2, TTL: time.Second}, nil)
+	now := time.Unix(1700000000, 0)
+	c.now = func() time.Time { return now }
+
+	c.put("a", "application/json", []byte("a"), []string{"post"}, 0)
+	c.put("b", "application/json", []byte("b"), []string{"post"}, 0)
+	c.get("a")
+	c.put("c", "application/json", []byte("c"), []string{"tag"}, 0)
+	if _, ok := c.get("b"); ok {
+		t.Fatal("expected least recently used entry to be evicted")
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("expected recently used entry to survive")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := c.get("a"); ok {
+		t.Fatal("expected entry to expire after TTL")
+	}
+
+	generation := c.currentGeneration()
+	c.Invalidate("Tag")
+	if _, stored := c.put("d", "application/json", []byte("d"), nil, generation); stored {
+		t.Fatal("expected put after invalidation to be skipped")
+	}
+}
+
+func TestEntityTag(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Post":                  "post",
+		"PostConnection":        "post",
+		"SlugHistoryEdge":       "slughistory",
+		"DeleteCategoryPayload": "category",
+		"MoveCategoryPayload":   "category",
+		"SiteSettings":          "option",
+		"Query":                 "",
+		"PageInfo":              "",
+		"__Type":                "",
+	}
+	for in, want := range cases {
+		if got := entityTag(in); got != want {
+			t.Errorf("entityTag(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
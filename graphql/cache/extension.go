@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Extension records the entity types each operation touches. Queries are
+// marked cacheable under those tags; mutations invalidate them, which covers
+// entities that do not publish subscription events.
+type Extension struct {
+	Cache *Cache
+}
+
+var (
+	_ graphql.HandlerExtension    = Extension{}
+	_ graphql.ResponseInterceptor = Extension{}
+	_ graphql.FieldInterceptor    = Extension{}
+)
+
+// ExtensionName implements graphql.HandlerExtension.
+func (Extension) ExtensionName() string {
+	return "ResponseCache"
+}
+
+// Validate implements graphql.HandlerExtension.
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor.
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation == ast.Subscription {
+		return next(ctx)
+	}
+	rec := recorderFromContext(ctx)
+	if rec == nil {
+		rec = &recorder{}
+		ctx = withRecorder(ctx, rec)
+	}
+	resp := next(ctx)
+	switch op.Operation {
+	case ast.Mutation:
+		if e.Cache != nil {
+			e.Cache.Invalidate(rec.entities()...)
+		}
+	case ast.Query:
+		if len(graphql.GetErrors(ctx)) == 0 && resp != nil && len(resp.Errors) == 0 {
+			rec.markCacheable()
+		}
+	}
+	return resp
+}
+
+// InterceptField implements graphql.FieldInterceptor.
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	if rec := recorderFromContext(ctx); rec != nil {
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			rec.add(fc.Object)
+			// Composite fields also tag their return type so a null or empty
+			// result is invalidated when the entity appears.
+			if len(fc.Field.Selections) > 0 && fc.Field.Definition != nil {
+				rec.add(fc.Field.Definition.Type.Name())
+			}
+		}
+	}
+	return next(ctx)
+}
+
+type recorderKey struct{}
+
+// recorder collects entity tags for one operation. Fields resolve
+// concurrently, so it is guarded by a mutex.
+type recorder struct {
+	mu        sync.Mutex
+	tags      map[string]struct{}
+	cacheable bool
+}
+
+func withRecorder(ctx context.Context, rec *recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, rec)
+}
+
+func recorderFromContext(ctx context.Context) *recorder {
+	rec, _ := ctx.Value(recorderKey{}).(*recorder)
+	return rec
+}
+
+func (r *recorder) add(typeName string) {
+	tag := entityTag(typeName)
+	if tag == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tags == nil {
+		r.tags = make(map[string]struct{})
+	}
+	r.tags[tag] = struct{}{}
+}
+
+func (r *recorder) markCacheable() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cacheable = true
+}
+
+func (r *recorder) result() (bool, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cacheable, sortedTags(r.tags)
+}
+
+func (r *recorder) entities() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return sortedTags(r.tags)
+}
+
+func sortedTags(tags map[string]struct{}) []string {
+	out := make([]string, 0, len(tags))
+	for tag := range tags {
+		out = append(out, tag)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// storedAs maps types that are read from another entity's rows onto that
+// entity, so writes to the underlying rows evict them too.
+var storedAs = map[string]string{
+	"SiteSettings": "option",
+}
+
+// entityTag maps a GraphQL type name to the lower-case entity it belongs to,
+// e.g. PostConnection and DeletePostPayload both become "post". This matches
+// the subscription topic prefix used for the entity's events.
+func entityTag(typeName string) string {
+	switch typeName {
+	case "", "Query", "Mutation", "Subscription", "PageInfo":
+		return ""
+	}
+	if tag, ok := storedAs[typeName]; ok {
+		return tag
+	}
+	if strings.HasPrefix(typeName, "__") {
+		return ""
+	}
+	for _, suffix := range []string{"Connection", "Edge", "Payload"} {
+		typeName = strings.TrimSuffix(typeName, suffix)
+	}
+	for _, prefix := range []string{"Create", "Update", "Delete", "Move"} {
+		if trimmed := strings.TrimPrefix(typeName, prefix); trimmed != typeName && trimmed != "" {
+			typeName = trimmed
+			break
+		}
+	}
+	return strings.ToLower(typeName)
+}
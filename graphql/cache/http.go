@@ -0,0 +1,212 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+// maxRequestBody bounds how much of a POST body is read to build a key.
+const maxRequestBody = 1 << 20
+
+// Middleware serves cached responses to anonymous GraphQL queries and stores
+// successful ones. Requests carrying credentials, websocket upgrades and
+// anything that is not a JSON GET or POST pass straight through. Install it
+// inside authentication middleware so verified callers are recognised.
+func (c *Cache) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !anonymous(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		key, ok := requestKey(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if e, hit := c.get(key); hit {
+			c.collector.RecordCacheLookup(true)
+			c.writeHeaders(w, e, "HIT")
+			if etagMatches(r.Header.Get("If-None-Match"), e.etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Content-Type", e.contentType)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(e.body)
+			return
+		}
+		c.collector.RecordCacheLookup(false)
+
+		generation := c.currentGeneration()
+		rec := &recorder{}
+		capture := &captureWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(capture, r.WithContext(withRecorder(r.Context(), rec)))
+
+		if cacheable, tags := rec.result(); cacheable && capture.status == http.StatusOK {
+			body := capture.body.Bytes()
+			if e, stored := c.put(key, w.Header().Get("Content-Type"), body, tags, generation); stored {
+				c.writeHeaders(w, e, "MISS")
+			}
+		}
+		w.WriteHeader(capture.status)
+		_, _ = w.Write(capture.body.Bytes())
+	})
+}
+
+func (c *Cache) writeHeaders(w http.ResponseWriter, e *entry, status string) {
+	maxAge := int(math.Ceil(e.expires.Sub(c.now()).Seconds()))
+	if maxAge < 0 {
+		maxAge = 0
+	}
+	h := w.Header()
+	h.Set("Cache-Control", "public, max-age="+strconv.Itoa(maxAge))
+	h.Set("ETag", e.etag)
+	h.Add("Vary", "Authorization")
+	h.Set("X-Cache", status)
+}
+
+// anonymous reports whether r carries no credentials.
+func anonymous(r *http.Request) bool {
+	if _, ok := oidc.FromContext(r.Context()); ok {
+		return false
+	}
+	if r.Header.Get("Authorization") != "" {
+		return false
+	}
+	return !strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+type requestParams struct {
+	Query         string          `json:"query"`
+	OperationName string          `json:"operationName"`
+	Variables     json.RawMessage `json:"variables"`
+	Extensions    struct {
+		PersistedQuery struct {
+			Sha256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
+}
+
+// requestKey derives the cache key from the normalised document, operation
+// name and variables. Hash-only persisted queries are keyed by their hash;
+// when a document is present it wins so a mismatched hash cannot poison the
+// entry another client would read.
+func requestKey(r *http.Request) (string, bool) {
+	var params requestParams
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		params.Query = q.Get("query")
+		params.OperationName = q.Get("operationName")
+		params.Variables = json.RawMessage(q.Get("variables"))
+		if ext := q.Get("extensions"); ext != "" {
+			if err := json.Unmarshal([]byte(ext), &params.Extensions); err != nil {
+				return "", false
+			}
+		}
+	case http.MethodPost:
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") || r.Body == nil {
+			return "", false
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBody+1))
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		if err != nil || len(body) > maxRequestBody {
+			return "", false
+		}
+		if err := json.Unmarshal(body, &params); err != nil {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	var document string
+	switch {
+	case params.Query != "":
+		document = normaliseQuery(params.Query)
+	case params.Extensions.PersistedQuery.Sha256Hash != "":
+		document = "sha256:" + params.Extensions.PersistedQuery.Sha256Hash
+	default:
+		return "", false
+	}
+	variables, ok := canonicalJSON(params.Variables)
+	if !ok {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(document + "\x00" + params.OperationName + "\x00" + variables))
+	return hex.EncodeToString(sum[:]), true
+}
+
+// normaliseQuery reprints the document so whitespace and comments do not
+// split the cache. Unparseable documents are used as-is; they fail
+// validation and are never stored.
+func normaliseQuery(query string) string {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return query
+	}
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf, formatter.WithCompacted()).FormatQueryDocument(doc)
+	return buf.String()
+}
+
+// canonicalJSON re-encodes raw so key order and spacing do not matter.
+func canonicalJSON(raw json.RawMessage) (string, bool) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return "null", true
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return "", false
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(out), true
+}
+
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// captureWriter buffers the response so it can be stored and decorated with
+// cache headers before it is sent.
+type captureWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *captureWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
@@ -0,0 +1,190 @@
+package dataloaders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/observability/tracing"
+)
+
+const (
+	defaultBatchWait = 2 * time.Millisecond
+	defaultBatchSize = 100
+)
+
+// BatchLoader collects the keys requested within a short window and fetches
+// them with a single call. gqlgen resolves list elements concurrently, so a
+// field resolved for every item of a list costs one query instead of one per
+// item.
+type BatchLoader[K comparable, V any] struct {
+	name      string
+	fetch     func(context.Context, []K) (map[K]V, error)
+	collector metrics.Collector
+	wait      time.Duration
+	maxBatch  int
+
+	mu      sync.Mutex
+	cache   map[K]V
+	pending *pendingBatch[K, V]
+}
+
+type pendingBatch[K comparable, V any] struct {
+	ctx     context.Context
+	keys    []K
+	seen    map[K]struct{}
+	taken   bool
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+func newBatchLoader[K comparable, V any](name string, collector metrics.Collector, fetch func(context.Context, []K) (map[K]V, error)) *BatchLoader[K, V] {
+	if collector == nil {
+		collector = metrics.NoopCollector{}
+	}
+	return &BatchLoader[K, V]{
+		name:      name,
+		fetch:     fetch,
+		collector: collector,
+		wait:      defaultBatchWait,
+		maxBatch:  defaultBatchSize,
+		cache:     make(map[K]V),
+	}
+}
+
+// NewBatchLoader exposes batch loader construction for testing. A zero wait
+// or size keeps the defaults.
+func NewBatchLoader[K comparable, V any](name string, collector metrics.Collector, wait time.Duration, size int, fetch func(context.Context, []K) (map[K]V, error)) *BatchLoader[K, V] {
+	loader := newBatchLoader(name, collector, fetch)
+	if wait > 0 {
+		loader.wait = wait
+	}
+	if size > 0 {
+		loader.maxBatch = size
+	}
+	return loader
+}
+
+// Load resolves a key, joining the batch that is currently collecting keys or
+// starting a new one. Keys missing from the fetch result yield the zero value.
+func (l *BatchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	var zero V
+	l.mu.Lock()
+	if val, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return val, nil
+	}
+	batch := l.pending
+	if batch == nil {
+		batch = &pendingBatch[K, V]{
+			ctx:  context.WithoutCancel(ctx),
+			seen: make(map[K]struct{}),
+			done: make(chan struct{}),
+		}
+		l.pending = batch
+		time.AfterFunc(l.wait, func() { l.dispatch(batch) })
+	}
+	if _, ok := batch.seen[key]; !ok {
+		batch.seen[key] = struct{}{}
+		batch.keys = append(batch.keys, key)
+	}
+	full := len(batch.keys) >= l.maxBatch
+	if full {
+		l.pending = nil
+	}
+	l.mu.Unlock()
+
+	if full {
+		go l.dispatch(batch)
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+	if batch.err != nil {
+		return zero, batch.err
+	}
+	val, ok := batch.results[key]
+	if !ok {
+		return zero, nil
+	}
+	return val, nil
+}
+
+// LoadMany resolves several keys through a single batch and returns their
+// values in the order of keys.
+func (l *BatchLoader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	out := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out[i], errs[i] = l.Load(ctx, key)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Prime seeds the cache with known results to avoid duplicate fetches.
+func (l *BatchLoader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	l.cache[key] = value
+	l.mu.Unlock()
+}
+
+func (l *BatchLoader[K, V]) dispatch(batch *pendingBatch[K, V]) {
+	l.mu.Lock()
+	if l.pending == batch {
+		l.pending = nil
+	}
+	// A timer firing after a size-triggered dispatch must not fetch the
+	// batch twice.
+	if batch.taken {
+		l.mu.Unlock()
+		return
+	}
+	batch.taken = true
+	keys := batch.keys
+	l.mu.Unlock()
+
+	ctx, span := tracing.Tracer(nil).Start(batch.ctx, "dataloader."+l.name,
+		trace.WithAttributes(attribute.String("dataloader.name", l.name), attribute.Int("dataloader.keys", len(keys))))
+	start := time.Now()
+	values, err := l.fetch(ctx, keys)
+	duration := time.Since(start)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.Int("dataloader.results", len(values)))
+		l.collector.RecordDataloaderBatch(l.name, len(values), duration)
+	}
+	span.End()
+
+	l.mu.Lock()
+	if err == nil {
+		for key, val := range values {
+			l.cache[key] = val
+		}
+		batch.results = values
+	}
+	batch.err = err
+	l.mu.Unlock()
+	close(batch.done)
+}
@@ -0,0 +1,122 @@
+package dataloaders
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBatchLoaderCollectsConcurrentKeys(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]string
+	loader := NewBatchLoader[string, int]("test", nil, 20*time.Millisecond, 0, func(_ context.Context, keys []string) (map[string]int, error) {
+		mu.Lock()
+		batches = append(batches, append([]string(nil), keys...))
+		mu.Unlock()
+		out := make(map[string]int, len(keys))
+		for _, key := range keys {
+			if key != "missing" {
+				out[key] = len(key)
+			}
+		}
+		return out, nil
+	})
+
+	keys := []string{"a", "bb", "ccc", "a", "missing"}
+	results := make([]int, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			val, err := loader.Load(context.Background(), key)
+			if err != nil {
+				t.Errorf("load %q: %v", key, err)
+			}
+			results[i] = val
+		}()
+	}
+	wg.Wait()
+
+	if len(batches) != 1 || len(batches[0]) != 4 {
+		t.Fatalf("expected one batch of four distinct keys, got %v", batches)
+	}
+	for i, want := range []int{1, 2, 3, 1, 0} {
+		if results[i] != want {
+			t.Fatalf("key %q: expected %d, got %d", keys[i], want, results[i])
+		}
+	}
+
+	if val, err := loader.Load(context.Background(), "bb"); err != nil || val != 2 || len(batches) != 1 {
+		t.Fatalf("expected cached value, got %d (%v) after %d batches", val, err, len(batches))
+	}
+}
+
+func TestBatchLoaderDispatchesFullBatches(t *testing.T) {
+	var mu sync.Mutex
+	sizes := []int{}
+	loader := NewBatchLoader[int, int]("test", nil, time.Hour, 2, func(_ context.Context, keys []int) (map[int]int, error) {
+		mu.Lock()
+		sizes = append(sizes, len(keys))
+		mu.Unlock()
+		out := make(map[int]int, len(keys))
+		for _, key := range keys {
+			out[key] = key * 10
+		}
+		return out, nil
+	})
+
+	var wg sync.WaitGroup
+	for key := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if val, err := loader.Load(context.Background(), key); err != nil || val != key*10 {
+				t.Errorf("load %d: got %d (%v)", key, val, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(sizes) != 2 || sizes[0] != 2 || sizes[1] != 2 {
+		t.Fatalf("expected two full batches, got %v", sizes)
+	}
+}
+
+func TestBatchLoaderSharesErrors(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	loader := NewBatchLoader[string, int]("test", nil, time.Millisecond, 0, func(context.Context, []string) (map[string]int, error) {
+		calls++
+		return nil, boom
+	})
+
+	if _, err := loader.Load(context.Background(), "a"); !errors.Is(err, boom) {
+		t.Fatalf("expected fetch error, got %v", err)
+	}
+	if _, err := loader.Load(context.Background(), "a"); !errors.Is(err, boom) || calls != 2 {
+		t.Fatalf("expected failed keys to be retried, got %v after %d calls", err, calls)
+	}
+}
+
+func TestBatchLoaderLoadManyUsesOneBatch(t *testing.T) {
+	calls := 0
+	loader := NewBatchLoader[string, int]("test", nil, 5*time.Millisecond, 0, func(_ context.Context, keys []string) (map[string]int, error) {
+		calls++
+		out := make(map[string]int, len(keys))
+		for _, key := range keys {
+			out[key] = len(key)
+		}
+		return out, nil
+	})
+
+	values, err := loader.LoadMany(context.Background(), []string{"ccc", "a", "bb"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 || len(values) != 3 || values[0] != 3 || values[1] != 1 || values[2] != 2 {
+		t.Fatalf("expected ordered values from one fetch, got %v after %d fetches", values, calls)
+	}
+}
@@ -0,0 +1,29 @@
+package dataloaders
+
+import (
+	"context"
+
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+func configureCategoryTreeLoaders(loaders *Loaders, orm *gen.Client, collector metrics.Collector) {
+	if loaders == nil || orm == nil {
+		return
+	}
+	loaders.register("CategoryPostCount", newBatchLoader[string, int]("category_post_counts", collector, func(ctx context.Context, keys []string) (map[string]int, error) {
+		return orm.CategoryPostCounts(ctx, keys)
+	}))
+}
+
+// CategoryPostCount batches post counts, including descendant categories,
+// by category ID.
+func (l *Loaders) CategoryPostCount() *BatchLoader[string, int] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("CategoryPostCount").(*BatchLoader[string, int]); ok {
+		return loader
+	}
+	return nil
+}
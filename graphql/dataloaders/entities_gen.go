@@ -37,6 +37,19 @@ func configureEntityLoaders(loaders *Loaders, orm *gen.Client, collector metrics
 		}
 		return results, nil
 	}))
+	loaders.register("ContentType", newEntityLoader[string, *gen.ContentType]("content_types", collector, func(ctx context.Context, keys []string) (map[string]*gen.ContentType, error) {
+		results := make(map[string]*gen.ContentType, len(keys))
+		for _, key := range keys {
+			record, err := orm.ContentTypes().ByID(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil {
+				results[key] = record
+			}
+		}
+		return results, nil
+	}))
 	loaders.register("Media", newEntityLoader[string, *gen.Media]("medias", collector, func(ctx context.Context, keys []string) (map[string]*gen.Media, error) {
 		results := make(map[string]*gen.Media, len(keys))
 		for _, key := range keys {
@@ -50,6 +63,32 @@ func configureEntityLoaders(loaders *Loaders, orm *gen.Client, collector metrics
 		}
 		return results, nil
 	}))
+	loaders.register("Menu", newEntityLoader[string, *gen.Menu]("menus", collector, func(ctx context.Context, keys []string) (map[string]*gen.Menu, error) {
+		results := make(map[string]*gen.Menu, len(keys))
+		for _, key := range keys {
+			record, err := orm.Menus().ByID(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil {
+				results[key] = record
+			}
+		}
+		return results, nil
+	}))
+	loaders.register("MenuItem", newEntityLoader[string, *gen.MenuItem]("menu_items", collector, func(ctx context.Context, keys []string) (map[string]*gen.MenuItem, error) {
+		results := make(map[string]*gen.MenuItem, len(keys))
+		for _, key := range keys {
+			record, err := orm.MenuItems().ByID(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil {
+				results[key] = record
+			}
+		}
+		return results, nil
+	}))
 	loaders.register("Option", newEntityLoader[string, *gen.Option]("options", collector, func(ctx context.Context, keys []string) (map[string]*gen.Option, error) {
 		results := make(map[string]*gen.Option, len(keys))
 		for _, key := range keys {
@@ -89,6 +128,19 @@ func configureEntityLoaders(loaders *Loaders, orm *gen.Client, collector metrics
 		}
 		return results, nil
 	}))
+	loaders.register("SlugHistory", newEntityLoader[string, *gen.SlugHistory]("slug_histories", collector, func(ctx context.Context, keys []string) (map[string]*gen.SlugHistory, error) {
+		results := make(map[string]*gen.SlugHistory, len(keys))
+		for _, key := range keys {
+			record, err := orm.SlugHistories().ByID(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil {
+				results[key] = record
+			}
+		}
+		return results, nil
+	}))
 	loaders.register("Tag", newEntityLoader[string, *gen.Tag]("tags", collector, func(ctx context.Context, keys []string) (map[string]*gen.Tag, error) {
 		results := make(map[string]*gen.Tag, len(keys))
 		for _, key := range keys {
@@ -115,6 +167,19 @@ func configureEntityLoaders(loaders *Loaders, orm *gen.Client, collector metrics
 		}
 		return results, nil
 	}))
+	loaders.register("UserMeta", newEntityLoader[string, *gen.UserMeta]("user_metas", collector, func(ctx context.Context, keys []string) (map[string]*gen.UserMeta, error) {
+		results := make(map[string]*gen.UserMeta, len(keys))
+		for _, key := range keys {
+			record, err := orm.UserMetas().ByID(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if record != nil {
+				results[key] = record
+			}
+		}
+		return results, nil
+	}))
 }
 
 func (l *Loaders) Category() *EntityLoader[string, *gen.Category] {
@@ -137,6 +202,16 @@ func (l *Loaders) Comment() *EntityLoader[string, *gen.Comment] {
 	return nil
 }
 
+func (l *Loaders) ContentType() *EntityLoader[string, *gen.ContentType] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("ContentType").(*EntityLoader[string, *gen.ContentType]); ok {
+		return loader
+	}
+	return nil
+}
+
 func (l *Loaders) Media() *EntityLoader[string, *gen.Media] {
 	if l == nil {
 		return nil
@@ -147,6 +222,26 @@ func (l *Loaders) Media() *EntityLoader[string, *gen.Media] {
 	return nil
 }
 
+func (l *Loaders) Menu() *EntityLoader[string, *gen.Menu] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("Menu").(*EntityLoader[string, *gen.Menu]); ok {
+		return loader
+	}
+	return nil
+}
+
+func (l *Loaders) MenuItem() *EntityLoader[string, *gen.MenuItem] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("MenuItem").(*EntityLoader[string, *gen.MenuItem]); ok {
+		return loader
+	}
+	return nil
+}
+
 func (l *Loaders) Option() *EntityLoader[string, *gen.Option] {
 	if l == nil {
 		return nil
@@ -177,6 +272,16 @@ func (l *Loaders) Role() *EntityLoader[string, *gen.Role] {
 	return nil
 }
 
+func (l *Loaders) SlugHistory() *EntityLoader[string, *gen.SlugHistory] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("SlugHistory").(*EntityLoader[string, *gen.SlugHistory]); ok {
+		return loader
+	}
+	return nil
+}
+
 func (l *Loaders) Tag() *EntityLoader[string, *gen.Tag] {
 	if l == nil {
 		return nil
@@ -196,3 +301,13 @@ func (l *Loaders) User() *EntityLoader[string, *gen.User] {
 	}
 	return nil
 }
+
+func (l *Loaders) UserMeta() *EntityLoader[string, *gen.UserMeta] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("UserMeta").(*EntityLoader[string, *gen.UserMeta]); ok {
+		return loader
+	}
+	return nil
+}
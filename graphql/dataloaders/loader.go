@@ -5,7 +5,12 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/observability/tracing"
 	"github.com/deicod/ermblog/orm/gen"
 )
 
@@ -25,6 +30,10 @@ func New(orm *gen.Client, collector metrics.Collector) *Loaders {
 	}
 	configureEntityLoaders(loaders, orm, collector)
 	configurePostRelationshipLoaders(loaders, orm, collector)
+	configureCategoryTreeLoaders(loaders, orm, collector)
+	configureTagLoaders(loaders, orm, collector)
+	configurePostMetaLoaders(loaders, orm, collector)
+	configureMenuLoaders(loaders, orm, collector)
 	return loaders
 }
 
@@ -79,13 +88,20 @@ func (l *EntityLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
 	}
 	l.mu.RUnlock()
 
+	ctx, span := tracing.Tracer(nil).Start(ctx, "dataloader."+l.name,
+		trace.WithAttributes(attribute.String("dataloader.name", l.name), attribute.Int("dataloader.keys", 1)))
 	start := time.Now()
 	values, err := l.fetch(ctx, []K{key})
 	duration := time.Since(start)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
 		var zero V
 		return zero, err
 	}
+	span.SetAttributes(attribute.Int("dataloader.results", len(values)))
+	span.End()
 	l.collector.RecordDataloaderBatch(l.name, len(values), duration)
 
 	var zero V
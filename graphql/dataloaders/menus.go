@@ -0,0 +1,71 @@
+package dataloaders
+
+import (
+	"context"
+
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+func configureMenuLoaders(loaders *Loaders, orm *gen.Client, collector metrics.Collector) {
+	if loaders == nil || orm == nil {
+		return
+	}
+	loaders.register("MenuItemsByMenu", newBatchLoader[string, []*gen.MenuItem]("menu_items_by_menu", collector, func(ctx context.Context, keys []string) (map[string][]*gen.MenuItem, error) {
+		return orm.MenuItemsByMenu(ctx, keys)
+	}))
+	loaders.register("PostBatch", newBatchLoader[string, *gen.Post]("posts_by_ids", collector, func(ctx context.Context, keys []string) (map[string]*gen.Post, error) {
+		return orm.PostsByIDs(ctx, keys)
+	}))
+	loaders.register("CategoryBatch", newBatchLoader[string, *gen.Category]("categories_by_ids", collector, func(ctx context.Context, keys []string) (map[string]*gen.Category, error) {
+		return orm.CategoriesByIDs(ctx, keys)
+	}))
+	loaders.register("TagBatch", newBatchLoader[string, *gen.Tag]("tags_by_ids", collector, func(ctx context.Context, keys []string) (map[string]*gen.Tag, error) {
+		return orm.TagsByIDs(ctx, keys)
+	}))
+}
+
+// MenuItemsByMenu batches the items of menus by menu ID.
+func (l *Loaders) MenuItemsByMenu() *BatchLoader[string, []*gen.MenuItem] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("MenuItemsByMenu").(*BatchLoader[string, []*gen.MenuItem]); ok {
+		return loader
+	}
+	return nil
+}
+
+// PostBatch batches post lookups by ID, unlike the Post entity loader which
+// fetches one post per call.
+func (l *Loaders) PostBatch() *BatchLoader[string, *gen.Post] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("PostBatch").(*BatchLoader[string, *gen.Post]); ok {
+		return loader
+	}
+	return nil
+}
+
+// CategoryBatch batches category lookups by ID.
+func (l *Loaders) CategoryBatch() *BatchLoader[string, *gen.Category] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("CategoryBatch").(*BatchLoader[string, *gen.Category]); ok {
+		return loader
+	}
+	return nil
+}
+
+// TagBatch batches tag lookups by ID.
+func (l *Loaders) TagBatch() *BatchLoader[string, *gen.Tag] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("TagBatch").(*BatchLoader[string, *gen.Tag]); ok {
+		return loader
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package dataloaders
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+func configurePostMetaLoaders(loaders *Loaders, orm *gen.Client, collector metrics.Collector) {
+	if loaders == nil || orm == nil {
+		return
+	}
+	loaders.register("PostMeta", newBatchLoader[string, map[string]json.RawMessage]("post_meta", collector, func(ctx context.Context, keys []string) (map[string]map[string]json.RawMessage, error) {
+		return orm.PostMeta(ctx, keys)
+	}))
+}
+
+// PostMeta batches custom field values by post ID.
+func (l *Loaders) PostMeta() *BatchLoader[string, map[string]json.RawMessage] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("PostMeta").(*BatchLoader[string, map[string]json.RawMessage]); ok {
+		return loader
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package dataloaders
+
+import (
+	"context"
+
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+func configureTagLoaders(loaders *Loaders, orm *gen.Client, collector metrics.Collector) {
+	if loaders == nil || orm == nil {
+		return
+	}
+	loaders.register("TagPostCount", newBatchLoader[string, int]("tag_post_counts", collector, func(ctx context.Context, keys []string) (map[string]int, error) {
+		return orm.TagPostCounts(ctx, keys)
+	}))
+}
+
+// TagPostCount batches published post counts by tag ID.
+func (l *Loaders) TagPostCount() *BatchLoader[string, int] {
+	if l == nil {
+		return nil
+	}
+	if loader, ok := l.get("TagPostCount").(*BatchLoader[string, int]); ok {
+		return loader
+	}
+	return nil
+}
@@ -2,10 +2,8 @@ package directives
 
 import (
         "context"
-        "fmt"
-
-        "github.com/vektah/gqlparser/v2/gqlerror"
 
+        "github.com/deicod/ermblog/graphql/gqlerrors"
         "github.com/deicod/ermblog/oidc"
 )
 
@@ -13,7 +11,7 @@ func RequireRoles(roles []string) func(ctx context.Context, obj interface{}, nex
         return func(ctx context.Context, obj interface{}, next func(ctx context.Context) (res interface{}, err error)) (interface{}, error) {
                 claims, ok := oidc.FromContext(ctx)
                 if !ok {
-                        return nil, gqlerror.Errorf("unauthorized")
+                        return nil, gqlerrors.Unauthenticated()
                 }
                 roleSet := make(map[string]struct{}, len(claims.Roles))
                 for _, r := range claims.Roles {
@@ -21,7 +19,7 @@ func RequireRoles(roles []string) func(ctx context.Context, obj interface{}, nex
                 }
                 for _, required := range roles {
                         if _, ok := roleSet[required]; !ok {
-                                return nil, gqlerror.Errorf("forbidden: missing role %s", required)
+                                return nil, gqlerrors.Forbidden("forbidden: missing role %s", required)
                         }
                 }
                 return next(ctx)
@@ -31,7 +29,7 @@ func RequireRoles(roles []string) func(ctx context.Context, obj interface{}, nex
 func RequireAuth() func(ctx context.Context, obj interface{}, next func(ctx context.Context) (res interface{}, err error)) (interface{}, error) {
         return func(ctx context.Context, obj interface{}, next func(ctx context.Context) (res interface{}, err error)) (interface{}, error) {
                 if _, ok := oidc.FromContext(ctx); !ok {
-                        return nil, fmt.Errorf("unauthorized")
+                        return nil, gqlerrors.Unauthenticated()
                 }
                 return next(ctx)
         }
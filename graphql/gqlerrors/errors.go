@@ -0,0 +1,129 @@
+// Package gqlerrors defines the error codes exposed in GraphQL responses and
+// maps domain, validation, auth and database errors onto them. Every error
+// leaving the API carries extensions.code; input errors also name the
+// offending field in extensions.field.
+package gqlerrors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Codes reported in extensions.code.
+const (
+	CodeBadUserInput     = "BAD_USER_INPUT"
+	CodeUnauthenticated  = "UNAUTHENTICATED"
+	CodeForbidden        = "FORBIDDEN"
+	CodeNotFound         = "NOT_FOUND"
+	CodeConflict         = "CONFLICT"
+	CodeSlugTaken        = "SLUG_TAKEN"
+	CodeInvalidReference = "INVALID_REFERENCE"
+	CodeInternal         = "INTERNAL_SERVER_ERROR"
+	CodeQueryTooDeep     = "QUERY_TOO_DEEP"
+	CodeQueryTooComplex  = "QUERY_TOO_COMPLEX"
+
+	CodePersistedQueryNotFound   = "PERSISTED_QUERY_NOT_FOUND"
+	CodePersistedQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+	CodeRateLimited = "RATE_LIMITED"
+)
+
+// Error is a domain error with a stable code.
+type Error struct {
+	Code    string
+	Message string
+	// Field is the input path the error refers to, e.g. "input.email".
+	Field      string
+	Extensions map[string]any
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New returns an error with the given code and message.
+func New(code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Wrap attaches a code and public message to an underlying error.
+func Wrap(code string, err error, message string) *Error {
+	return &Error{Code: code, Message: message, Err: err}
+}
+
+// Unauthenticated reports a request without valid credentials.
+func Unauthenticated() *Error {
+	return New(CodeUnauthenticated, "unauthorized")
+}
+
+// Forbidden reports an authenticated caller lacking permission.
+func Forbidden(format string, args ...any) *Error {
+	return New(CodeForbidden, fmt.Sprintf(format, args...))
+}
+
+// NotFound reports a missing record of the named entity.
+func NotFound(entity string) *Error {
+	return New(CodeNotFound, strings.ToLower(entity)+" not found")
+}
+
+// BadInput reports an invalid argument value.
+func BadInput(field, message string) *Error {
+	return &Error{Code: CodeBadUserInput, Message: message, Field: field}
+}
+
+// Internal reports a server-side failure such as missing configuration.
+func Internal(message string) *Error {
+	return New(CodeInternal, message)
+}
+
+// SlugTaken reports a slug already used by another record, listing free
+// alternatives.
+func SlugTaken(slug string, suggestions []string) *Error {
+	if suggestions == nil {
+		suggestions = []string{}
+	}
+	message := "slug is already taken"
+	extensions := map[string]any{"suggestions": suggestions}
+	if slug != "" {
+		message = fmt.Sprintf("slug %q is already taken", slug)
+		extensions["slug"] = slug
+	}
+	return &Error{Code: CodeSlugTaken, Message: message, Field: "input.slug", Extensions: extensions}
+}
+
+// FieldError describes one invalid input field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError collects every invalid field of an input.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Add records an invalid field.
+func (e *ValidationError) Add(field, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+// Err returns e when fields were recorded and nil otherwise.
+func (e *ValidationError) Err() error {
+	if e == nil || len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		parts = append(parts, field.Field+": "+field.Message)
+	}
+	return "invalid input: " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,178 @@
+package gqlerrors
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Postgres SQLSTATE codes mapped to API errors.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgNotNullViolation    = "23502"
+	pgCheckViolation      = "23514"
+	pgStringTooLong       = "22001"
+)
+
+// Present is a gqlgen error presenter that attaches extensions.code (and
+// extensions.field for input errors) to every error and keeps database
+// details such as constraint names out of responses.
+func Present(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		if _, ok := gqlErr.Extensions["code"]; ok {
+			if gqlErr.Path == nil {
+				gqlErr.Path = graphql.GetPath(ctx)
+			}
+			return gqlErr
+		}
+	}
+	typed := Classify(err)
+	extensions := map[string]any{"code": typed.Code}
+	if typed.Field != "" {
+		extensions["field"] = typed.Field
+	}
+	for key, value := range typed.Extensions {
+		extensions[key] = value
+	}
+	out := &gqlerror.Error{
+		Message:    typed.Message,
+		Path:       graphql.GetPath(ctx),
+		Extensions: extensions,
+		Err:        err,
+	}
+	if gqlErr != nil {
+		out.Path = gqlErr.Path
+		out.Locations = gqlErr.Locations
+		if out.Path == nil {
+			out.Path = graphql.GetPath(ctx)
+		}
+	}
+	return out
+}
+
+// internalMessage replaces the message of unknown errors, which may carry
+// SQL, file paths or other details callers must not see.
+const internalMessage = "internal server error"
+
+// Classify maps any error onto a coded Error. Unknown errors are logged and
+// reported as INTERNAL_SERVER_ERROR with a generic message.
+func Classify(err error) *Error {
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed
+	}
+	var validation *ValidationError
+	if errors.As(err, &validation) {
+		field := ""
+		if len(validation.Fields) > 0 {
+			field = validation.Fields[0].Field
+		}
+		return &Error{
+			Code:       CodeBadUserInput,
+			Message:    validation.Error(),
+			Field:      field,
+			Extensions: map[string]any{"fields": validation.Fields},
+			Err:        err,
+		}
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return classifyPgError(pgErr)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Wrap(CodeNotFound, err, "record not found")
+	}
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return Wrap(CodeInternal, err, "database unavailable")
+	}
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return Wrap(CodeInternal, err, gqlErr.Message)
+	}
+	log.Printf("graphql: internal error: %v", err)
+	return Wrap(CodeInternal, err, internalMessage)
+}
+
+func classifyPgError(pgErr *pgconn.PgError) *Error {
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		field := constraintField(pgErr.TableName, pgErr.ConstraintName, "_key")
+		if field == "slug" {
+			return SlugTaken("", nil)
+		}
+		message := "record already exists"
+		if field != "" {
+			message = field + " is already taken"
+		}
+		return &Error{Code: CodeConflict, Message: message, Field: inputPath(field), Err: pgErr}
+	case pgForeignKeyViolation:
+		field := constraintField(pgErr.TableName, pgErr.ConstraintName, "_fkey")
+		return &Error{Code: CodeInvalidReference, Message: "referenced record does not exist", Field: inputPath(field), Err: pgErr}
+	case pgNotNullViolation:
+		field := camelCase(pgErr.ColumnName)
+		return &Error{Code: CodeBadUserInput, Message: field + " is required", Field: inputPath(field), Err: pgErr}
+	case pgCheckViolation:
+		// The tree triggers raise *_cycle violations with a message meant
+		// for the caller.
+		if strings.HasSuffix(pgErr.ConstraintName, "_cycle") {
+			field := camelCase(pgErr.ColumnName)
+			return &Error{Code: CodeBadUserInput, Message: pgErr.Message, Field: inputPath(field), Err: pgErr}
+		}
+		return Wrap(CodeBadUserInput, pgErr, "invalid value")
+	case pgStringTooLong:
+		return Wrap(CodeBadUserInput, pgErr, "invalid value")
+	default:
+		return Wrap(CodeInternal, pgErr, "database error")
+	}
+}
+
+// constraintField recovers the GraphQL field from erm's constraint naming,
+// e.g. "posts_slug_key" on table "posts" yields "slug".
+func constraintField(table, constraint, suffix string) string {
+	column, ok := strings.CutSuffix(constraint, suffix)
+	if !ok || table == "" {
+		return ""
+	}
+	column, ok = strings.CutPrefix(column, table+"_")
+	if !ok {
+		return ""
+	}
+	return camelCase(column)
+}
+
+func inputPath(field string) string {
+	if field == "" {
+		return ""
+	}
+	return "input." + field
+}
+
+// camelCase converts a column name to its GraphQL field name, keeping the
+// ID and URL initialisms upper-case.
+func camelCase(column string) string {
+	parts := strings.Split(column, "_")
+	var b strings.Builder
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		switch {
+		case i == 0:
+			b.WriteString(part)
+		case part == "id" || part == "url":
+			b.WriteString(strings.ToUpper(part))
+		default:
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
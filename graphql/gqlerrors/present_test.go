@@ -0,0 +1,131 @@
+package gqlerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestPresentMapsDatabaseErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		err   error
+		code  string
+		field string
+	}{
+		{
+			name:  "slug unique violation",
+			err:   &pgconn.PgError{Code: "23505", TableName: "posts", ConstraintName: "posts_slug_key"},
+			code:  CodeSlugTaken,
+			field: "input.slug",
+		},
+		{
+			name:  "unique violation",
+			err:   fmt.Errorf("create user: %w", &pgconn.PgError{Code: "23505", TableName: "users", ConstraintName: "users_email_key"}),
+			code:  CodeConflict,
+			field: "input.email",
+		},
+		{
+			name:  "foreign key violation",
+			err:   &pgconn.PgError{Code: "23503", TableName: "posts", ConstraintName: "posts_author_id_fkey"},
+			code:  CodeInvalidReference,
+			field: "input.authorID",
+		},
+		{
+			name:  "not null violation",
+			err:   &pgconn.PgError{Code: "23502", TableName: "media", ColumnName: "storage_key"},
+			code:  CodeBadUserInput,
+			field: "input.storageKey",
+		},
+		{
+			name:  "tree cycle",
+			err:   &pgconn.PgError{Code: "23514", TableName: "categories", ColumnName: "parent_id", ConstraintName: "categories_parent_id_cycle", Message: "category cannot be moved beneath itself or its descendants"},
+			code:  CodeBadUserInput,
+			field: "input.parentID",
+		},
+		{
+			name: "no rows",
+			err:  pgx.ErrNoRows,
+			code: CodeNotFound,
+		},
+	}
+	for _, tc := range cases {
+		presented := Present(context.Background(), tc.err)
+		if presented.Extensions["code"] != tc.code {
+			t.Fatalf("%s: expected code %s, got %v", tc.name, tc.code, presented.Extensions["code"])
+		}
+		if field, _ := presented.Extensions["field"].(string); field != tc.field {
+			t.Fatalf("%s: expected field %q, got %q", tc.name, tc.field, field)
+		}
+		if strings.Contains(presented.Message, "_key") || strings.Contains(presented.Message, "_fkey") {
+			t.Fatalf("%s: constraint name leaked in %q", tc.name, presented.Message)
+		}
+	}
+}
+
+func TestPresentKeepsTypedErrors(t *testing.T) {
+	t.Parallel()
+
+	presented := Present(context.Background(), Forbidden("forbidden: missing role %s", "admin"))
+	if presented.Message != "forbidden: missing role admin" || presented.Extensions["code"] != CodeForbidden {
+		t.Fatalf("unexpected presentation %#v", presented)
+	}
+
+	slug := Present(context.Background(), SlugTaken("hello", []string{"hello-2"}))
+	if slug.Extensions["slug"] != "hello" {
+		t.Fatalf("expected slug extension, got %#v", slug.Extensions)
+	}
+	suggestions, _ := slug.Extensions["suggestions"].([]string)
+	if len(suggestions) != 1 || suggestions[0] != "hello-2" {
+		t.Fatalf("unexpected suggestions %v", suggestions)
+	}
+
+	coded := &gqlerror.Error{Message: "custom", Extensions: map[string]any{"code": "CUSTOM"}}
+	if got := Present(context.Background(), coded); got != coded {
+		t.Fatalf("expected coded gqlerror to pass through, got %#v", got)
+	}
+}
+
+func TestPresentReportsValidationFields(t *testing.T) {
+	t.Parallel()
+
+	var validation ValidationError
+	validation.Add("input.email", "must be a valid email address")
+	validation.Add("input.websiteURL", "must be an absolute http(s) URL")
+
+	presented := Present(context.Background(), validation.Err())
+	if presented.Extensions["code"] != CodeBadUserInput {
+		t.Fatalf("expected %s, got %v", CodeBadUserInput, presented.Extensions["code"])
+	}
+	if presented.Extensions["field"] != "input.email" {
+		t.Fatalf("expected first field, got %v", presented.Extensions["field"])
+	}
+	fields, _ := presented.Extensions["fields"].([]FieldError)
+	if len(fields) != 2 {
+		t.Fatalf("expected two field errors, got %v", presented.Extensions["fields"])
+	}
+}
+
+func TestPresentDefaultsToInternal(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New(`read /srv/ermblog/uploads: permission denied`)
+	presented := Present(context.Background(), cause)
+	if presented.Extensions["code"] != CodeInternal {
+		t.Fatalf("expected %s, got %v", CodeInternal, presented.Extensions["code"])
+	}
+	if presented.Message != "internal server error" {
+		t.Fatalf("expected a generic message, got %q", presented.Message)
+	}
+	if !errors.Is(presented, cause) {
+		t.Fatal("expected the cause to stay attached for logging")
+	}
+}
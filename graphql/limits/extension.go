@@ -0,0 +1,167 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/observability/metrics"
+)
+
+// Rejection reasons reported to metrics.Collector.RecordRejection.
+const (
+	ReasonDepth      = "depth"
+	ReasonComplexity = "complexity"
+)
+
+// StatsKey is the OperationContext.Stats extension holding Stats.
+const StatsKey = "Limits"
+
+// Stats records the measured size of an accepted or rejected operation.
+type Stats struct {
+	Depth      int
+	Complexity int
+}
+
+// GetStats returns the limits measured for the current operation, if any.
+func GetStats(ctx context.Context) *Stats {
+	if !gql.HasOperationContext(ctx) {
+		return nil
+	}
+	stats, _ := gql.GetOperationContext(ctx).Stats.GetExtension(StatsKey).(*Stats)
+	return stats
+}
+
+// Extension enforces Config on every operation and clamps connection
+// arguments before resolvers run. Install it on a server built from Schema so
+// complexity reflects connection page sizes.
+type Extension struct {
+	cfg       Config
+	collector metrics.Collector
+	schema    gql.ExecutableSchema
+}
+
+var (
+	_ gql.HandlerExtension        = (*Extension)(nil)
+	_ gql.OperationContextMutator = (*Extension)(nil)
+	_ gql.FieldInterceptor        = (*Extension)(nil)
+)
+
+// NewExtension returns an extension enforcing cfg. Rejections are reported to
+// collector, which may be nil.
+func NewExtension(cfg Config, collector metrics.Collector) *Extension {
+	if collector == nil {
+		collector = metrics.NoopCollector{}
+	}
+	return &Extension{cfg: cfg.normalise(), collector: collector}
+}
+
+// ExtensionName implements graphql.HandlerExtension.
+func (e *Extension) ExtensionName() string {
+	return "Limits"
+}
+
+// Validate implements graphql.HandlerExtension.
+func (e *Extension) Validate(schema gql.ExecutableSchema) error {
+	e.schema = schema
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator.
+func (e *Extension) MutateOperationContext(ctx context.Context, opCtx *gql.OperationContext) *gqlerror.Error {
+	op := opCtx.Operation
+	if op == nil {
+		return nil
+	}
+	stats := &Stats{Depth: selectionDepth(op.SelectionSet)}
+	if e.schema != nil {
+		stats.Complexity = complexity.Calculate(ctx, e.schema, op, opCtx.Variables)
+	}
+	opCtx.Stats.SetExtension(StatsKey, stats)
+
+	if stats.Depth > e.cfg.MaxDepth {
+		return e.reject(ctx, opCtx, ReasonDepth, gqlerrors.CodeQueryTooDeep,
+			fmt.Sprintf("query depth %d exceeds the maximum of %d", stats.Depth, e.cfg.MaxDepth),
+			map[string]any{"depth": stats.Depth, "maxDepth": e.cfg.MaxDepth})
+	}
+	if stats.Complexity > e.cfg.MaxComplexity {
+		return e.reject(ctx, opCtx, ReasonComplexity, gqlerrors.CodeQueryTooComplex,
+			fmt.Sprintf("query complexity %d exceeds the maximum of %d; request fewer fields or smaller pages", stats.Complexity, e.cfg.MaxComplexity),
+			map[string]any{"complexity": stats.Complexity, "maxComplexity": e.cfg.MaxComplexity})
+	}
+	return nil
+}
+
+func (e *Extension) reject(ctx context.Context, opCtx *gql.OperationContext, reason, code, message string, extensions map[string]any) *gqlerror.Error {
+	name := opCtx.OperationName
+	if name == "" {
+		name = opCtx.Operation.Name
+	}
+	e.collector.RecordRejection(name, string(opCtx.Operation.Operation), reason)
+	err := gqlerrors.New(code, message)
+	err.Extensions = extensions
+	return gqlerrors.Present(ctx, err)
+}
+
+// InterceptField implements graphql.FieldInterceptor. Connection fields get
+// first/last clamped to the entity's maximum page size, and first defaults to
+// the entity's default page size when neither is given.
+func (e *Extension) InterceptField(ctx context.Context, next gql.Resolver) (any, error) {
+	fc := gql.GetFieldContext(ctx)
+	if fc == nil || fc.Args == nil || fc.Field.Field == nil {
+		return next(ctx)
+	}
+	entity, ok := fieldEntity(fc.Field.Definition)
+	if !ok {
+		return next(ctx)
+	}
+	size := e.cfg.pageSize(entity)
+	first, hasFirst := fc.Args["first"].(*int)
+	last, hasLast := fc.Args["last"].(*int)
+	if hasFirst && first == nil && (!hasLast || last == nil) {
+		fc.Args["first"] = intPtr(size.Default)
+	}
+	if hasFirst && first != nil && *first > size.Max {
+		fc.Args["first"] = intPtr(size.Max)
+	}
+	if hasLast && last != nil && *last > size.Max {
+		fc.Args["last"] = intPtr(size.Max)
+	}
+	return next(ctx)
+}
+
+// selectionDepth returns the deepest field nesting of a selection set,
+// following fragments and ignoring introspection fields.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range set {
+		var d int
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = selectionDepth(sel.Definition.SelectionSet)
+			}
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func intPtr(v int) *int {
+	return &v
+}
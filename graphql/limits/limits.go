@@ -0,0 +1,165 @@
+// Package limits protects the GraphQL endpoint from expensive operations. It
+// rejects operations that nest too deeply or whose estimated cost is too high,
+// and clamps connection page sizes to the limits declared in the schema DSL.
+package limits
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Defaults applied when Config leaves a limit unset.
+const (
+	DefaultMaxDepth      = 12
+	DefaultMaxComplexity = 10000
+)
+
+// PageSize mirrors a query's WithDefaultLimit and WithMaxLimit values.
+type PageSize struct {
+	Default int
+	Max     int
+}
+
+// PageSizes lists the page sizes declared in schema/*.schema.go, keyed by
+// entity name. Keep it in sync when a schema's Query() limits change.
+var PageSizes = map[string]PageSize{
+	"ApiKey":      {Default: 200, Max: 200},
+	"Category":    {Default: 100, Max: 500},
+	"Comment":     {Default: 50, Max: 500},
+	"ContentType": {Default: 50, Max: 200},
+	"Media":       {Default: 50, Max: 200},
+	"Menu":        {Default: 50, Max: 200},
+	"MenuItem":    {Default: 100, Max: 500},
+	"Option":      {Default: 100, Max: 500},
+	"Post":        {Default: 20, Max: 200},
+	"Role":        {Default: 50, Max: 200},
+	"SlugHistory": {Default: 50, Max: 200},
+	"Tag":         {Default: 100, Max: 500},
+	"User":        {Default: 50, Max: 200},
+	"UserMeta":    {Default: 50, Max: 200},
+}
+
+// fallbackPageSize applies to connections without a PageSizes entry.
+var fallbackPageSize = PageSize{Default: 50, Max: 200}
+
+// Config bounds the operations accepted by the server.
+type Config struct {
+	// MaxDepth caps field nesting; introspection fields are not counted.
+	MaxDepth int
+	// MaxComplexity caps the estimated cost: one per field, with connection
+	// fields multiplying their selection by the requested page size.
+	MaxComplexity int
+	// PageSizes overrides the package-level PageSizes table.
+	PageSizes map[string]PageSize
+}
+
+func (cfg Config) normalise() Config {
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = DefaultMaxDepth
+	}
+	if cfg.MaxComplexity <= 0 {
+		cfg.MaxComplexity = DefaultMaxComplexity
+	}
+	if cfg.PageSizes == nil {
+		cfg.PageSizes = PageSizes
+	}
+	return cfg
+}
+
+func (cfg Config) pageSize(entity string) PageSize {
+	if size, ok := cfg.PageSizes[entity]; ok {
+		return size
+	}
+	return fallbackPageSize
+}
+
+// Schema decorates an executable schema so connection fields cost their
+// page size times the cost of their selection.
+func Schema(schema gql.ExecutableSchema, cfg Config) gql.ExecutableSchema {
+	return costedSchema{ExecutableSchema: schema, cfg: cfg.normalise()}
+}
+
+type costedSchema struct {
+	gql.ExecutableSchema
+	cfg Config
+}
+
+func (s costedSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, args map[string]any) (int, bool) {
+	if cost, ok := s.ExecutableSchema.Complexity(ctx, typeName, field, childComplexity, args); ok {
+		return cost, true
+	}
+	entity, ok := connectionEntity(s.Schema(), typeName, field)
+	if !ok {
+		return 0, false
+	}
+	size := s.cfg.pageSize(entity)
+	count := size.Default
+	for _, name := range []string{"first", "last"} {
+		if value, ok := intArg(args[name]); ok {
+			count = value
+			break
+		}
+	}
+	if count > size.Max {
+		count = size.Max
+	}
+	if count < 1 {
+		count = 1
+	}
+	return 1 + childComplexity*count, true
+}
+
+// connectionEntity reports the entity paginated by typeName.field, if the
+// field returns a <Entity>Connection.
+func connectionEntity(schema *ast.Schema, typeName, field string) (string, bool) {
+	if schema == nil {
+		return "", false
+	}
+	def := schema.Types[typeName]
+	if def == nil {
+		return "", false
+	}
+	fieldDef := def.Fields.ForName(field)
+	if fieldDef == nil {
+		return "", false
+	}
+	return fieldEntity(fieldDef)
+}
+
+func fieldEntity(fieldDef *ast.FieldDefinition) (string, bool) {
+	if fieldDef == nil || fieldDef.Type == nil {
+		return "", false
+	}
+	entity, ok := strings.CutSuffix(fieldDef.Type.Name(), "Connection")
+	if !ok || entity == "" {
+		return "", false
+	}
+	return entity, true
+}
+
+func intArg(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil
+	case *int:
+		if v == nil {
+			return 0, false
+		}
+		return *v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,204 @@
+package limits
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/observability/metrics"
+)
+
+type rejection struct {
+	operation string
+	reason    string
+}
+
+type recordingCollector struct {
+	metrics.NoopCollector
+	rejections []rejection
+}
+
+func (c *recordingCollector) RecordRejection(operation, _ string, reason string) {
+	c.rejections = append(c.rejections, rejection{operation: operation, reason: reason})
+}
+
+func newServer(cfg Config, collector metrics.Collector) *handler.Server {
+	srv := handler.New(Schema(graphql.NewExecutableSchema(graphql.Config{}), cfg))
+	srv.AddTransport(transport.POST{})
+	srv.Use(NewExtension(cfg, collector))
+	return srv
+}
+
+type response struct {
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func post(t *testing.T, srv http.Handler, query string) response {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	var resp response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response %q: %v", rec.Body.String(), err)
+	}
+	return resp
+}
+
+func TestExtensionRejectsDeepOperations(t *testing.T) {
+	t.Parallel()
+
+	collector := &recordingCollector{}
+	srv := newServer(Config{MaxDepth: 3}, collector)
+
+	resp := post(t, srv, `query Deep { posts { edges { node { id } } } }`)
+	if len(resp.Errors) != 1 {
+		t.Fatalf("expected one error, got %+v", resp.Errors)
+	}
+	if code := resp.Errors[0].Extensions["code"]; code != "QUERY_TOO_DEEP" {
+		t.Fatalf("unexpected code %v", code)
+	}
+	if resp.Errors[0].Message != "query depth 4 exceeds the maximum of 3" {
+		t.Fatalf("unexpected message %q", resp.Errors[0].Message)
+	}
+	if len(collector.rejections) != 1 || collector.rejections[0] != (rejection{operation: "Deep", reason: ReasonDepth}) {
+		t.Fatalf("unexpected rejections %+v", collector.rejections)
+	}
+}
+
+func TestExtensionRejectsComplexOperations(t *testing.T) {
+	t.Parallel()
+
+	collector := &recordingCollector{}
+	srv := newServer(Config{MaxComplexity: 500}, collector)
+
+	// first is clamped to the Post maximum of 200, so the cost is
+	// 1 + 200 * (edges + node + id) = 601.
+	resp := post(t, srv, `{ posts(first: 10000) { edges { node { id } } } }`)
+	if len(resp.Errors) != 1 {
+		t.Fatalf("expected one error, got %+v", resp.Errors)
+	}
+	if code := resp.Errors[0].Extensions["code"]; code != "QUERY_TOO_COMPLEX" {
+		t.Fatalf("unexpected code %v", code)
+	}
+	if got := resp.Errors[0].Extensions["complexity"]; got != float64(601) {
+		t.Fatalf("unexpected complexity %v", got)
+	}
+	if len(collector.rejections) != 1 || collector.rejections[0].reason != ReasonComplexity {
+		t.Fatalf("unexpected rejections %+v", collector.rejections)
+	}
+}
+
+func TestSelectionDepthFollowsFragmentsAndSkipsIntrospection(t *testing.T) {
+	t.Parallel()
+
+	inner := ast.SelectionSet{&ast.Field{Name: "id"}, &ast.Field{Name: "__typename"}}
+	fragment := &ast.FragmentSpread{Definition: &ast.FragmentDefinition{SelectionSet: ast.SelectionSet{
+		&ast.Field{Name: "node", SelectionSet: inner},
+	}}}
+	set := ast.SelectionSet{
+		&ast.Field{Name: "__schema", SelectionSet: ast.SelectionSet{&ast.Field{Name: "types", SelectionSet: inner}}},
+		&ast.Field{Name: "posts", SelectionSet: ast.SelectionSet{
+			&ast.InlineFragment{SelectionSet: ast.SelectionSet{&ast.Field{Name: "edges", SelectionSet: ast.SelectionSet{fragment}}}},
+		}},
+	}
+	if got := selectionDepth(set); got != 4 {
+		t.Fatalf("expected depth 4, got %d", got)
+	}
+}
+
+func TestInterceptFieldClampsConnectionArguments(t *testing.T) {
+	t.Parallel()
+
+	schema := graphql.NewExecutableSchema(graphql.Config{}).Schema()
+	definition := schema.Query.Fields.ForName("posts")
+	ext := NewExtension(Config{}, nil)
+
+	tests := []struct {
+		name  string
+		args  map[string]any
+		first *int
+		last  *int
+	}{
+		{name: "clamps first", args: map[string]any{"first": intPtr(10000), "last": (*int)(nil)}, first: intPtr(200)},
+		{name: "defaults first", args: map[string]any{"first": (*int)(nil), "last": (*int)(nil)}, first: intPtr(20)},
+		{name: "clamps last", args: map[string]any{"first": (*int)(nil), "last": intPtr(999)}, last: intPtr(200)},
+		{name: "keeps small pages", args: map[string]any{"first": intPtr(5), "last": (*int)(nil)}, first: intPtr(5)},
+	}
+	for _, tc := range tests {
+		fc := &gql.FieldContext{
+			Object: "Query",
+			Args:   tc.args,
+			Field:  gql.CollectedField{Field: &ast.Field{Name: "posts", Definition: definition}},
+		}
+		ctx := gql.WithFieldContext(context.Background(), fc)
+		_, _ = ext.InterceptField(ctx, func(context.Context) (any, error) { return nil, nil })
+		if got := fc.Args["first"].(*int); !equalInt(got, tc.first) {
+			t.Fatalf("%s: unexpected first %v", tc.name, got)
+		}
+		if got := fc.Args["last"].(*int); !equalInt(got, tc.last) {
+			t.Fatalf("%s: unexpected last %v", tc.name, got)
+		}
+	}
+}
+
+func TestPageSizesMatchSchemaDSL(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "schema", "*.schema.go"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("list schema files: %v", err)
+	}
+	typeExpr := regexp.MustCompile(`type (\w+) struct\{ dsl\.Schema \}`)
+	defaultExpr := regexp.MustCompile(`WithDefaultLimit\((\d+)\)`)
+	maxExpr := regexp.MustCompile(`WithMaxLimit\((\d+)\)`)
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		src := string(raw)
+		entity := typeExpr.FindStringSubmatch(src)
+		maxLimit := maxExpr.FindStringSubmatch(src)
+		if entity == nil || maxLimit == nil {
+			continue
+		}
+		size, ok := PageSizes[entity[1]]
+		if !ok {
+			t.Fatalf("PageSizes is missing %s", entity[1])
+		}
+		if want, _ := strconv.Atoi(maxLimit[1]); size.Max != want {
+			t.Fatalf("%s: expected max %d, got %d", entity[1], want, size.Max)
+		}
+		if defaultLimit := defaultExpr.FindStringSubmatch(src); defaultLimit != nil {
+			if want, _ := strconv.Atoi(defaultLimit[1]); size.Default != want {
+				t.Fatalf("%s: expected default %d, got %d", entity[1], want, size.Default)
+			}
+		}
+	}
+}
+
+func equalInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
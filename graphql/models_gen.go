@@ -16,6 +16,25 @@ type Node interface {
 	GetID() string
 }
 
+// A post, comment or media item that was deleted and can still be restored.
+type TrashedNode interface {
+	IsTrashedNode()
+}
+
+// A personal API key. The secret is only returned once, by createApiKey.
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// The public start of the key, e.g. ermblog_1f2e3d4c5b6a7988, to tell keys apart.
+	Prefix string `json:"prefix"`
+	// Role slugs the key may exercise. Requests carry those the owner still holds.
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// When the key was last used, updated at most once a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
 type AssignUserRolesInput struct {
 	ClientMutationID *string  `json:"clientMutationId,omitempty"`
 	UserID           string   `json:"userID"`
@@ -27,14 +46,46 @@ type AssignUserRolesPayload struct {
 	User             *User   `json:"user,omitempty"`
 }
 
+type AuthorLeaderboardEntry struct {
+	AuthorID      string `json:"authorID"`
+	Author        *User  `json:"author,omitempty"`
+	Posts         int    `json:"posts"`
+	PreviousPosts int    `json:"previousPosts"`
+}
+
+type BulkAssignTaxonomiesInput struct {
+	ClientMutationID  *string  `json:"clientMutationId,omitempty"`
+	PostIDs           []string `json:"postIDs"`
+	AddCategoryIDs    []string `json:"addCategoryIDs,omitempty"`
+	RemoveCategoryIDs []string `json:"removeCategoryIDs,omitempty"`
+	AddTagIDs         []string `json:"addTagIDs,omitempty"`
+	RemoveTagIDs      []string `json:"removeTagIDs,omitempty"`
+}
+
+type BulkAssignTaxonomiesPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Posts            []*Post `json:"posts"`
+}
+
 type Category struct {
 	ID          string    `json:"id"`
 	Name        string    `json:"name"`
 	Slug        string    `json:"slug"`
 	Description *string   `json:"description,omitempty"`
 	ParentID    *string   `json:"parentID,omitempty"`
+	Position    int       `json:"position"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
+	// Ancestors from the root down to the parent.
+	Ancestors []*Category `json:"ancestors"`
+	// Direct children ordered by position, then name.
+	Children []*Category `json:"children"`
+	// Descendants in depth-first order, siblings ordered by position. depth limits how many levels are returned; omit it for the whole subtree.
+	Descendants []*Category `json:"descendants"`
+	// Slash-separated slugs from the root to this category, e.g. news/europe.
+	Path string `json:"path"`
+	// Published posts filed under this category or any of its descendants.
+	PostCount int `json:"postCount"`
 }
 
 func (Category) IsNode()            {}
@@ -64,11 +115,14 @@ type Comment struct {
 	SubmittedAt time.Time     `json:"submittedAt"`
 	PublishedAt *time.Time    `json:"publishedAt,omitempty"`
 	UpdatedAt   time.Time     `json:"updatedAt"`
+	DeletedAt   *time.Time    `json:"deletedAt,omitempty"`
 }
 
 func (Comment) IsNode()            {}
 func (this Comment) GetID() string { return this.ID }
 
+func (Comment) IsTrashedNode() {}
+
 type CommentConnection struct {
 	Edges      []*CommentEdge `json:"edges"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -80,6 +134,53 @@ type CommentEdge struct {
 	Node   *Comment `json:"node,omitempty"`
 }
 
+type CommentStatusTrend struct {
+	Status CommentStatus `json:"status"`
+	Series *TrendSeries  `json:"series"`
+}
+
+type ContentType struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Label       string          `json:"label"`
+	PluralLabel string          `json:"pluralLabel"`
+	Description *string         `json:"description,omitempty"`
+	Supports    json.RawMessage `json:"supports,omitempty"`
+	Fields      json.RawMessage `json:"fields,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+func (ContentType) IsNode()            {}
+func (this ContentType) GetID() string { return this.ID }
+
+type ContentTypeConnection struct {
+	Edges      []*ContentTypeEdge `json:"edges"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+type ContentTypeEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *ContentType `json:"node,omitempty"`
+}
+
+type CreateAPIKeyInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Name             string  `json:"name"`
+	// Role slugs to grant the key; each must be one the viewer holds.
+	Scopes []string `json:"scopes"`
+	// When the key stops working; null keeps it valid until revoked.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type CreateAPIKeyPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	APIKey           *APIKey `json:"apiKey"`
+	// The full key to send as a bearer token. It is not shown again.
+	Token string `json:"token"`
+}
+
 type CreateCategoryInput struct {
 	ClientMutationID *string    `json:"clientMutationId,omitempty"`
 	ID               *string    `json:"id,omitempty"`
@@ -87,6 +188,7 @@ type CreateCategoryInput struct {
 	Slug             *string    `json:"slug,omitempty"`
 	Description      *string    `json:"description,omitempty"`
 	ParentID         *string    `json:"parentID,omitempty"`
+	Position         *int       `json:"position,omitempty"`
 	CreatedAt        *time.Time `json:"createdAt,omitempty"`
 	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
 }
@@ -117,6 +219,24 @@ type CreateCommentPayload struct {
 	Comment          *Comment `json:"comment,omitempty"`
 }
 
+type CreateContentTypeInput struct {
+	ClientMutationID *string         `json:"clientMutationId,omitempty"`
+	ID               *string         `json:"id,omitempty"`
+	Name             *string         `json:"name,omitempty"`
+	Label            *string         `json:"label,omitempty"`
+	PluralLabel      *string         `json:"pluralLabel,omitempty"`
+	Description      *string         `json:"description,omitempty"`
+	Supports         json.RawMessage `json:"supports,omitempty"`
+	Fields           json.RawMessage `json:"fields,omitempty"`
+	CreatedAt        *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
+}
+
+type CreateContentTypePayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	ContentType      *ContentType `json:"contentType,omitempty"`
+}
+
 type CreateMediaInput struct {
 	ClientMutationID *string         `json:"clientMutationId,omitempty"`
 	ID               *string         `json:"id,omitempty"`
@@ -140,6 +260,40 @@ type CreateMediaPayload struct {
 	Media            *Media  `json:"media,omitempty"`
 }
 
+type CreateMenuInput struct {
+	ClientMutationID *string    `json:"clientMutationId,omitempty"`
+	ID               *string    `json:"id,omitempty"`
+	Name             *string    `json:"name,omitempty"`
+	Location         *string    `json:"location,omitempty"`
+	Description      *string    `json:"description,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+type CreateMenuItemInput struct {
+	ClientMutationID *string       `json:"clientMutationId,omitempty"`
+	ID               *string       `json:"id,omitempty"`
+	MenuID           *string       `json:"menuID,omitempty"`
+	ParentID         *string       `json:"parentID,omitempty"`
+	Position         *int          `json:"position,omitempty"`
+	Kind             *MenuItemKind `json:"kind,omitempty"`
+	TargetID         *string       `json:"targetID,omitempty"`
+	Label            *string       `json:"label,omitempty"`
+	URL              *string       `json:"url,omitempty"`
+	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time    `json:"updatedAt,omitempty"`
+}
+
+type CreateMenuItemPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	MenuItem         *MenuItem `json:"menuItem,omitempty"`
+}
+
+type CreateMenuPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Menu             *Menu   `json:"menu,omitempty"`
+}
+
 type CreateOptionInput struct {
 	ClientMutationID *string         `json:"clientMutationId,omitempty"`
 	ID               *string         `json:"id,omitempty"`
@@ -160,6 +314,9 @@ type CreatePostInput struct {
 	ID               *string         `json:"id,omitempty"`
 	AuthorID         *string         `json:"authorID,omitempty"`
 	FeaturedMediaID  *string         `json:"featuredMediaID,omitempty"`
+	ContentTypeID    *string         `json:"contentTypeID,omitempty"`
+	ParentID         *string         `json:"parentID,omitempty"`
+	MenuOrder        *int            `json:"menuOrder,omitempty"`
 	Title            *string         `json:"title,omitempty"`
 	Slug             *string         `json:"slug,omitempty"`
 	Status           *PostStatus     `json:"status,omitempty"`
@@ -172,6 +329,10 @@ type CreatePostInput struct {
 	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
 	CategoryIDs      []string        `json:"categoryIDs,omitempty"`
 	TagIDs           []string        `json:"tagIDs,omitempty"`
+	// Tags by name, merged with tagIDs. Names without a matching tag (ignoring case) create one.
+	TagNames []string `json:"tagNames,omitempty"`
+	// Custom field values, validated against the content type's fields schema.
+	Meta json.RawMessage `json:"meta,omitempty"`
 }
 
 type CreatePostPayload struct {
@@ -195,6 +356,21 @@ type CreateRolePayload struct {
 	Role             *Role   `json:"role,omitempty"`
 }
 
+type CreateSlugHistoryInput struct {
+	ClientMutationID *string    `json:"clientMutationId,omitempty"`
+	ID               *string    `json:"id,omitempty"`
+	EntityType       *string    `json:"entityType,omitempty"`
+	EntityID         *string    `json:"entityID,omitempty"`
+	Slug             *string    `json:"slug,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+type CreateSlugHistoryPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	SlugHistory      *SlugHistory `json:"slugHistory,omitempty"`
+}
+
 type CreateTagInput struct {
 	ClientMutationID *string    `json:"clientMutationId,omitempty"`
 	ID               *string    `json:"id,omitempty"`
@@ -225,6 +401,21 @@ type CreateUserInput struct {
 	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
 }
 
+type CreateUserMetaInput struct {
+	ClientMutationID *string         `json:"clientMutationId,omitempty"`
+	ID               *string         `json:"id,omitempty"`
+	UserID           *string         `json:"userID,omitempty"`
+	Key              *string         `json:"key,omitempty"`
+	Value            json.RawMessage `json:"value,omitempty"`
+	CreatedAt        *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
+}
+
+type CreateUserMetaPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	UserMeta         *UserMeta `json:"userMeta,omitempty"`
+}
+
 type CreateUserPayload struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	User             *User   `json:"user,omitempty"`
@@ -250,6 +441,16 @@ type DeleteCommentPayload struct {
 	DeletedCommentID string  `json:"deletedCommentID"`
 }
 
+type DeleteContentTypeInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type DeleteContentTypePayload struct {
+	ClientMutationID     *string `json:"clientMutationId,omitempty"`
+	DeletedContentTypeID string  `json:"deletedContentTypeID"`
+}
+
 type DeleteMediaInput struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	ID               string  `json:"id"`
@@ -260,6 +461,26 @@ type DeleteMediaPayload struct {
 	DeletedMediaID   string  `json:"deletedMediaID"`
 }
 
+type DeleteMenuInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type DeleteMenuItemInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type DeleteMenuItemPayload struct {
+	ClientMutationID  *string `json:"clientMutationId,omitempty"`
+	DeletedMenuItemID string  `json:"deletedMenuItemID"`
+}
+
+type DeleteMenuPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	DeletedMenuID    string  `json:"deletedMenuID"`
+}
+
 type DeleteOptionInput struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	ID               string  `json:"id"`
@@ -290,6 +511,16 @@ type DeleteRolePayload struct {
 	DeletedRoleID    string  `json:"deletedRoleID"`
 }
 
+type DeleteSlugHistoryInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type DeleteSlugHistoryPayload struct {
+	ClientMutationID     *string `json:"clientMutationId,omitempty"`
+	DeletedSlugHistoryID string  `json:"deletedSlugHistoryID"`
+}
+
 type DeleteTagInput struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	ID               string  `json:"id"`
@@ -303,6 +534,20 @@ type DeleteTagPayload struct {
 type DeleteUserInput struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	ID               string  `json:"id"`
+	// User to take over the deleted user's posts and media. Required when they authored posts; otherwise their media are left without an uploader.
+	ReassignPostsTo *string `json:"reassignPostsTo,omitempty"`
+	// Clears the name, email and URL on the user's comments, and on guest comments left with their email, instead of keeping them for attribution.
+	Anonymize *bool `json:"anonymize,omitempty"`
+}
+
+type DeleteUserMetaInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type DeleteUserMetaPayload struct {
+	ClientMutationID  *string `json:"clientMutationId,omitempty"`
+	DeletedUserMetaID string  `json:"deletedUserMetaID"`
 }
 
 type DeleteUserPayload struct {
@@ -310,14 +555,47 @@ type DeleteUserPayload struct {
 	DeletedUserID    string  `json:"deletedUserID"`
 }
 
+type LoginInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// The username or email address.
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type LogoutInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	RefreshToken     string  `json:"refreshToken"`
+}
+
+type LogoutPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Revoked          bool    `json:"revoked"`
+}
+
 type ManagementStats struct {
 	Posts      int `json:"posts"`
 	Comments   int `json:"comments"`
 	MediaItems int `json:"mediaItems"`
 	Taxonomies int `json:"taxonomies"`
+	Categories int `json:"categories"`
+	Tags       int `json:"tags"`
 	Users      int `json:"users"`
 }
 
+type ManagementTrends struct {
+	Range            TrendRange                `json:"range"`
+	Interval         TrendInterval             `json:"interval"`
+	From             time.Time                 `json:"from"`
+	To               time.Time                 `json:"to"`
+	PreviousFrom     time.Time                 `json:"previousFrom"`
+	PostsPublished   *TrendSeries              `json:"postsPublished"`
+	Comments         *TrendSeries              `json:"comments"`
+	CommentsByStatus []*CommentStatusTrend     `json:"commentsByStatus"`
+	MediaUploaded    *TrendSeries              `json:"mediaUploaded"`
+	NewUsers         *TrendSeries              `json:"newUsers"`
+	TopAuthors       []*AuthorLeaderboardEntry `json:"topAuthors"`
+}
+
 type Media struct {
 	ID            string          `json:"id"`
 	UploadedByID  *string         `json:"uploadedByID,omitempty"`
@@ -333,11 +611,14 @@ type Media struct {
 	Metadata      json.RawMessage `json:"metadata,omitempty"`
 	CreatedAt     time.Time       `json:"createdAt"`
 	UpdatedAt     time.Time       `json:"updatedAt"`
+	DeletedAt     *time.Time      `json:"deletedAt,omitempty"`
 }
 
 func (Media) IsNode()            {}
 func (this Media) GetID() string { return this.ID }
 
+func (Media) IsTrashedNode() {}
+
 type MediaConnection struct {
 	Edges      []*MediaEdge `json:"edges"`
 	PageInfo   *PageInfo    `json:"pageInfo"`
@@ -349,6 +630,126 @@ type MediaEdge struct {
 	Node   *Media `json:"node,omitempty"`
 }
 
+type Menu struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Location    *string   `json:"location,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	// Top-level items ordered by position. Items linking unpublished or deleted content are left out together with their children unless includeHidden is set.
+	Items []*MenuItem `json:"items"`
+}
+
+func (Menu) IsNode()            {}
+func (this Menu) GetID() string { return this.ID }
+
+type MenuConnection struct {
+	Edges      []*MenuEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+type MenuEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Menu  `json:"node,omitempty"`
+}
+
+type MenuItem struct {
+	ID        string       `json:"id"`
+	MenuID    string       `json:"menuID"`
+	ParentID  *string      `json:"parentID,omitempty"`
+	Position  int          `json:"position"`
+	Kind      MenuItemKind `json:"kind"`
+	TargetID  *string      `json:"targetID,omitempty"`
+	Label     *string      `json:"label,omitempty"`
+	URL       *string      `json:"url,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+	// Child items ordered by position, filtered like Menu.items.
+	Children []*MenuItem `json:"children"`
+	// The linked post, page, category or tag; null for custom links and missing targets.
+	Target Node `json:"target,omitempty"`
+	// The label, falling back to the target's title or name, or the URL of custom links.
+	Title string `json:"title"`
+	// The custom URL or the permalink of the target.
+	Href *string `json:"href,omitempty"`
+	// Whether the item is shown: custom links always are, other items while their target exists and, for posts and pages, is published.
+	Visible bool `json:"visible"`
+}
+
+func (MenuItem) IsNode()            {}
+func (this MenuItem) GetID() string { return this.ID }
+
+type MenuItemConnection struct {
+	Edges      []*MenuItemEdge `json:"edges"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type MenuItemEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *MenuItem `json:"node,omitempty"`
+}
+
+type MergeCategoriesInput struct {
+	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+	SourceIDs        []string `json:"sourceIDs"`
+	TargetID         string   `json:"targetID"`
+}
+
+type MergeCategoriesPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	Category         *Category `json:"category,omitempty"`
+	// IDs of the source categories that were merged and deleted.
+	MergedIDs []string `json:"mergedIDs"`
+	// Number of posts that were filed under a source category.
+	AffectedPosts int `json:"affectedPosts"`
+}
+
+type MergeTagsInput struct {
+	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+	SourceIDs        []string `json:"sourceIDs"`
+	TargetID         string   `json:"targetID"`
+}
+
+type MergeTagsPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Tag              *Tag    `json:"tag,omitempty"`
+	// IDs of the source tags that were merged and deleted.
+	MergedIDs []string `json:"mergedIDs"`
+	// Number of posts that were tagged with a source tag.
+	AffectedPosts int `json:"affectedPosts"`
+}
+
+type MoveCategoryInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+	// New parent; null moves the category to the root.
+	NewParentID *string `json:"newParentID,omitempty"`
+	// Zero-based index among the new siblings. Defaults to the end.
+	Position *int `json:"position,omitempty"`
+}
+
+type MoveCategoryPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	Category         *Category `json:"category,omitempty"`
+}
+
+type MoveMenuItemInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+	// New parent item of the same menu; null moves the item to the top level.
+	NewParentID *string `json:"newParentID,omitempty"`
+	// Zero-based index among the new siblings. Defaults to the end.
+	Position *int `json:"position,omitempty"`
+}
+
+type MoveMenuItemPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	MenuItem         *MenuItem `json:"menuItem,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -399,10 +800,34 @@ type PageInfo struct {
 	EndCursor       *string `json:"endCursor,omitempty"`
 }
 
+type PageTreeNode struct {
+	Page *Post `json:"page"`
+	// Levels below the starting point; pages directly beneath it have depth 1.
+	Depth int `json:"depth"`
+	// Slash-separated slugs from the root page, e.g. about/team.
+	Path string `json:"path"`
+}
+
+type PathResolution struct {
+	Node          Node    `json:"node,omitempty"`
+	CanonicalPath *string `json:"canonicalPath,omitempty"`
+	Redirect      bool    `json:"redirect"`
+}
+
+type PopularPost struct {
+	PostID   string `json:"postID"`
+	Post     *Post  `json:"post,omitempty"`
+	Views    int    `json:"views"`
+	Visitors int    `json:"visitors"`
+}
+
 type Post struct {
 	ID              string          `json:"id"`
 	AuthorID        string          `json:"authorID"`
 	FeaturedMediaID *string         `json:"featuredMediaID,omitempty"`
+	ContentTypeID   *string         `json:"contentTypeID,omitempty"`
+	ParentID        *string         `json:"parentID,omitempty"`
+	MenuOrder       int             `json:"menuOrder"`
 	Title           string          `json:"title"`
 	Slug            string          `json:"slug"`
 	Status          PostStatus      `json:"status"`
@@ -413,15 +838,29 @@ type Post struct {
 	PublishedAt     *time.Time      `json:"publishedAt,omitempty"`
 	CreatedAt       time.Time       `json:"createdAt"`
 	UpdatedAt       time.Time       `json:"updatedAt"`
+	DeletedAt       *time.Time      `json:"deletedAt,omitempty"`
 	Author          *User           `json:"author,omitempty"`
 	FeaturedMedia   *Media          `json:"featuredMedia,omitempty"`
 	Categories      []*Category     `json:"categories"`
 	Tags            []*Tag          `json:"tags"`
+	ViewStats       *PostViewStats  `json:"viewStats"`
+	// Content type whose fields schema describes the post's meta.
+	ContentType *ContentType `json:"contentType,omitempty"`
+	// Custom field values: the value stored under key, or the whole meta object when key is omitted.
+	Meta json.RawMessage `json:"meta,omitempty"`
+	// Parent pages from the root page down to the direct parent.
+	Ancestors []*Post `json:"ancestors"`
+	// Direct child pages ordered by menuOrder, then title.
+	Children []*Post `json:"children"`
+	// Slash-separated slugs from the root page to this post, e.g. about/team. Posts that are not nested return their slug.
+	Path string `json:"path"`
 }
 
 func (Post) IsNode()            {}
 func (this Post) GetID() string { return this.ID }
 
+func (Post) IsTrashedNode() {}
+
 type PostConnection struct {
 	Edges      []*PostEdge `json:"edges"`
 	PageInfo   *PageInfo   `json:"pageInfo"`
@@ -433,9 +872,95 @@ type PostEdge struct {
 	Node   *Post  `json:"node,omitempty"`
 }
 
+// Matches posts by one custom field. Set exactly one operator. eq, gt, gte, lt, lte and in take strings, numbers or booleans and only apply to keys a content type marks "x-indexed".
+type PostMetaFilter struct {
+	Key string            `json:"key"`
+	Eq  json.RawMessage   `json:"eq,omitempty"`
+	Gt  json.RawMessage   `json:"gt,omitempty"`
+	Gte json.RawMessage   `json:"gte,omitempty"`
+	Lt  json.RawMessage   `json:"lt,omitempty"`
+	Lte json.RawMessage   `json:"lte,omitempty"`
+	In  []json.RawMessage `json:"in,omitempty"`
+	// true matches posts that store the key, false those that do not.
+	Exists *bool `json:"exists,omitempty"`
+}
+
+type PostViewBucket struct {
+	Start time.Time `json:"start"`
+	Views int       `json:"views"`
+	// Unique visitors, counted per hour and summed across the bucket.
+	Visitors int `json:"visitors"`
+}
+
+type PostViewStats struct {
+	Range    TrendRange        `json:"range"`
+	Interval TrendInterval     `json:"interval"`
+	From     time.Time         `json:"from"`
+	To       time.Time         `json:"to"`
+	Views    int               `json:"views"`
+	Visitors int               `json:"visitors"`
+	Buckets  []*PostViewBucket `json:"buckets"`
+}
+
+type PostWhereInput struct {
+	Status        *PostStatus `json:"status,omitempty"`
+	Type          *PostType   `json:"type,omitempty"`
+	ContentTypeID *string     `json:"contentTypeID,omitempty"`
+	AuthorID      *string     `json:"authorID,omitempty"`
+	// Custom field conditions; a post must match all of them.
+	Meta []*PostMetaFilter `json:"meta,omitempty"`
+}
+
+type PurgeCommentInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type PurgeCommentPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	PurgedCommentID  string  `json:"purgedCommentID"`
+}
+
+type PurgeMediaInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type PurgeMediaPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	PurgedMediaID    string  `json:"purgedMediaID"`
+}
+
+type PurgePostInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type PurgePostPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	PurgedPostID     string  `json:"purgedPostID"`
+}
+
 type Query struct {
 }
 
+type RefreshSessionInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	RefreshToken     string  `json:"refreshToken"`
+}
+
+type RegisterPersistedQueriesInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// Relay persisted-queries.json content: a JSON object mapping sha256 ids to query text.
+	Manifest string `json:"manifest"`
+}
+
+type RegisterPersistedQueriesPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Registered       int     `json:"registered"`
+	Total            int     `json:"total"`
+}
+
 type RemoveUserRolesInput struct {
 	ClientMutationID *string  `json:"clientMutationId,omitempty"`
 	UserID           string   `json:"userID"`
@@ -447,6 +972,92 @@ type RemoveUserRolesPayload struct {
 	User             *User   `json:"user,omitempty"`
 }
 
+type ReorderMenuItemsInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	MenuID           string  `json:"menuID"`
+	// Parent whose children are reordered; null reorders the top level.
+	ParentID *string `json:"parentID,omitempty"`
+	// Every child of the parent, in the new order.
+	ItemIDs []string `json:"itemIDs"`
+}
+
+type ReorderMenuItemsPayload struct {
+	ClientMutationID *string     `json:"clientMutationId,omitempty"`
+	Items            []*MenuItem `json:"items"`
+}
+
+type RequestPasswordResetInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Email            string  `json:"email"`
+}
+
+type RequestPasswordResetPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// Always true, whether or not an account uses the email.
+	Requested bool `json:"requested"`
+}
+
+type ResendVerificationEmailInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type ResendVerificationEmailPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Sent             bool    `json:"sent"`
+}
+
+type ResetPasswordInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// The token from the password reset email.
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+type ResetPasswordPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Reset            bool    `json:"reset"`
+}
+
+type RestoreCommentInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type RestoreCommentPayload struct {
+	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+	Comment          *Comment `json:"comment"`
+}
+
+type RestoreMediaInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type RestoreMediaPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Media            *Media  `json:"media"`
+}
+
+type RestorePostInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type RestorePostPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Post             *Post   `json:"post"`
+}
+
+type RevokeAPIKeyInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ID               string  `json:"id"`
+}
+
+type RevokeAPIKeyPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	RevokedAPIKeyID  string  `json:"revokedApiKeyID"`
+}
+
 type Role struct {
 	ID           string          `json:"id"`
 	Name         string          `json:"name"`
@@ -472,6 +1083,55 @@ type RoleEdge struct {
 	Node   *Role  `json:"node,omitempty"`
 }
 
+// A local session. Send accessToken as a bearer token; exchange refreshToken for a new session before expiresAt.
+type SessionPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	AccessToken      string  `json:"accessToken"`
+	TokenType        string  `json:"tokenType"`
+	// When the access token expires.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Single-use token for refreshSession.
+	RefreshToken string  `json:"refreshToken"`
+	Viewer       *Viewer `json:"viewer,omitempty"`
+}
+
+// Site-wide settings stored as options. Settings without a stored option return their defaults.
+type SiteSettings struct {
+	// Site title, stored as the blogname option.
+	Title string `json:"title"`
+	// Short description shown beneath the title, stored as blogdescription.
+	Tagline string `json:"tagline"`
+	// IANA timezone name such as Europe/Berlin, stored as timezone_string.
+	Timezone string `json:"timezone"`
+	// Post permalink pattern such as /{year}/{month}/{slug}, stored as permalink_structure. Defaults to the configured post pattern; a stored value takes effect on the next restart.
+	PermalinkStructure string `json:"permalinkStructure"`
+	// Number of posts listed per page, stored as posts_per_page.
+	PostsPerPage int `json:"postsPerPage"`
+}
+
+type SlugHistory struct {
+	ID         string    `json:"id"`
+	EntityType string    `json:"entityType"`
+	EntityID   string    `json:"entityID"`
+	Slug       string    `json:"slug"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func (SlugHistory) IsNode()            {}
+func (this SlugHistory) GetID() string { return this.ID }
+
+type SlugHistoryConnection struct {
+	Edges      []*SlugHistoryEdge `json:"edges"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+type SlugHistoryEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *SlugHistory `json:"node,omitempty"`
+}
+
 type Subscription struct {
 }
 
@@ -482,6 +1142,8 @@ type Tag struct {
 	Description *string   `json:"description,omitempty"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
+	// Published posts carrying this tag.
+	PostCount int `json:"postCount"`
 }
 
 func (Tag) IsNode()            {}
@@ -498,6 +1160,31 @@ type TagEdge struct {
 	Node   *Tag   `json:"node,omitempty"`
 }
 
+type TrashConnection struct {
+	Edges      []*TrashEdge `json:"edges"`
+	PageInfo   *PageInfo    `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+type TrashEdge struct {
+	Cursor    string      `json:"cursor"`
+	Node      TrashedNode `json:"node"`
+	DeletedAt time.Time   `json:"deletedAt"`
+}
+
+type TrendBucket struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+type TrendSeries struct {
+	Total         int `json:"total"`
+	PreviousTotal int `json:"previousTotal"`
+	// Relative change against the previous period, e.g. 0.25 for +25%. Null when the previous period is empty.
+	Change  *float64       `json:"change,omitempty"`
+	Buckets []*TrendBucket `json:"buckets"`
+}
+
 type UpdateCategoryInput struct {
 	ClientMutationID *string    `json:"clientMutationId,omitempty"`
 	ID               string     `json:"id"`
@@ -505,6 +1192,7 @@ type UpdateCategoryInput struct {
 	Slug             *string    `json:"slug,omitempty"`
 	Description      *string    `json:"description,omitempty"`
 	ParentID         *string    `json:"parentID,omitempty"`
+	Position         *int       `json:"position,omitempty"`
 	CreatedAt        *time.Time `json:"createdAt,omitempty"`
 	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
 }
@@ -535,6 +1223,24 @@ type UpdateCommentPayload struct {
 	Comment          *Comment `json:"comment,omitempty"`
 }
 
+type UpdateContentTypeInput struct {
+	ClientMutationID *string         `json:"clientMutationId,omitempty"`
+	ID               string          `json:"id"`
+	Name             *string         `json:"name,omitempty"`
+	Label            *string         `json:"label,omitempty"`
+	PluralLabel      *string         `json:"pluralLabel,omitempty"`
+	Description      *string         `json:"description,omitempty"`
+	Supports         json.RawMessage `json:"supports,omitempty"`
+	Fields           json.RawMessage `json:"fields,omitempty"`
+	CreatedAt        *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
+}
+
+type UpdateContentTypePayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	ContentType      *ContentType `json:"contentType,omitempty"`
+}
+
 type UpdateMediaInput struct {
 	ClientMutationID *string         `json:"clientMutationId,omitempty"`
 	ID               string          `json:"id"`
@@ -558,6 +1264,40 @@ type UpdateMediaPayload struct {
 	Media            *Media  `json:"media,omitempty"`
 }
 
+type UpdateMenuInput struct {
+	ClientMutationID *string    `json:"clientMutationId,omitempty"`
+	ID               string     `json:"id"`
+	Name             *string    `json:"name,omitempty"`
+	Location         *string    `json:"location,omitempty"`
+	Description      *string    `json:"description,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+type UpdateMenuItemInput struct {
+	ClientMutationID *string       `json:"clientMutationId,omitempty"`
+	ID               string        `json:"id"`
+	MenuID           *string       `json:"menuID,omitempty"`
+	ParentID         *string       `json:"parentID,omitempty"`
+	Position         *int          `json:"position,omitempty"`
+	Kind             *MenuItemKind `json:"kind,omitempty"`
+	TargetID         *string       `json:"targetID,omitempty"`
+	Label            *string       `json:"label,omitempty"`
+	URL              *string       `json:"url,omitempty"`
+	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time    `json:"updatedAt,omitempty"`
+}
+
+type UpdateMenuItemPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	MenuItem         *MenuItem `json:"menuItem,omitempty"`
+}
+
+type UpdateMenuPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Menu             *Menu   `json:"menu,omitempty"`
+}
+
 type UpdateNotificationPreferencesInput struct {
 	ClientMutationID *string                        `json:"clientMutationId,omitempty"`
 	Preferences      []*NotificationPreferenceInput `json:"preferences"`
@@ -588,6 +1328,9 @@ type UpdatePostInput struct {
 	ID               string          `json:"id"`
 	AuthorID         *string         `json:"authorID,omitempty"`
 	FeaturedMediaID  *string         `json:"featuredMediaID,omitempty"`
+	ContentTypeID    *string         `json:"contentTypeID,omitempty"`
+	ParentID         *string         `json:"parentID,omitempty"`
+	MenuOrder        *int            `json:"menuOrder,omitempty"`
 	Title            *string         `json:"title,omitempty"`
 	Slug             *string         `json:"slug,omitempty"`
 	Status           *PostStatus     `json:"status,omitempty"`
@@ -600,6 +1343,10 @@ type UpdatePostInput struct {
 	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
 	CategoryIDs      []string        `json:"categoryIDs,omitempty"`
 	TagIDs           []string        `json:"tagIDs,omitempty"`
+	// Tags by name, merged with tagIDs. Names without a matching tag (ignoring case) create one.
+	TagNames []string `json:"tagNames,omitempty"`
+	// Custom field values merged into the stored meta; null removes a key. The result is validated against the content type's fields schema.
+	Meta json.RawMessage `json:"meta,omitempty"`
 }
 
 type UpdatePostPayload struct {
@@ -623,6 +1370,21 @@ type UpdateRolePayload struct {
 	Role             *Role   `json:"role,omitempty"`
 }
 
+type UpdateSlugHistoryInput struct {
+	ClientMutationID *string    `json:"clientMutationId,omitempty"`
+	ID               string     `json:"id"`
+	EntityType       *string    `json:"entityType,omitempty"`
+	EntityID         *string    `json:"entityID,omitempty"`
+	Slug             *string    `json:"slug,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+type UpdateSlugHistoryPayload struct {
+	ClientMutationID *string      `json:"clientMutationId,omitempty"`
+	SlugHistory      *SlugHistory `json:"slugHistory,omitempty"`
+}
+
 type UpdateTagInput struct {
 	ClientMutationID *string    `json:"clientMutationId,omitempty"`
 	ID               string     `json:"id"`
@@ -653,6 +1415,21 @@ type UpdateUserInput struct {
 	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
 }
 
+type UpdateUserMetaInput struct {
+	ClientMutationID *string         `json:"clientMutationId,omitempty"`
+	ID               string          `json:"id"`
+	UserID           *string         `json:"userID,omitempty"`
+	Key              *string         `json:"key,omitempty"`
+	Value            json.RawMessage `json:"value,omitempty"`
+	CreatedAt        *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time      `json:"updatedAt,omitempty"`
+}
+
+type UpdateUserMetaPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	UserMeta         *UserMeta `json:"userMeta,omitempty"`
+}
+
 type UpdateUserPayload struct {
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
 	User             *User   `json:"user,omitempty"`
@@ -686,11 +1463,51 @@ type UserEdge struct {
 	Node   *User  `json:"node,omitempty"`
 }
 
+type UserMeta struct {
+	ID        string          `json:"id"`
+	UserID    string          `json:"userID"`
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+func (UserMeta) IsNode()            {}
+func (this UserMeta) GetID() string { return this.ID }
+
+type UserMetaConnection struct {
+	Edges      []*UserMetaEdge `json:"edges"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type UserMetaEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *UserMeta `json:"node,omitempty"`
+}
+
+type VerifyEmailInput struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// The token from the verification email.
+	Token string `json:"token"`
+}
+
+type VerifyEmailPayload struct {
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	Verified         bool    `json:"verified"`
+}
+
 type Viewer struct {
 	ID          string  `json:"id"`
 	DisplayName *string `json:"displayName,omitempty"`
 	Email       *string `json:"email,omitempty"`
 	AvatarURL   *string `json:"avatarURL,omitempty"`
+	// The viewer's meta value stored under key, or all of their meta as one object when key is omitted. Viewers without a user record have no meta.
+	Meta json.RawMessage `json:"meta,omitempty"`
+	// Whether the viewer confirmed their current email address.
+	EmailVerified bool `json:"emailVerified"`
+	// The viewer's API keys, newest first.
+	APIKeys []*APIKey `json:"apiKeys"`
 }
 
 type CommentStatus string
@@ -752,6 +1569,67 @@ func (e CommentStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type MenuItemKind string
+
+const (
+	MenuItemKindPost     MenuItemKind = "post"
+	MenuItemKindPage     MenuItemKind = "page"
+	MenuItemKindCategory MenuItemKind = "category"
+	MenuItemKindTag      MenuItemKind = "tag"
+	MenuItemKindCustom   MenuItemKind = "custom"
+)
+
+var AllMenuItemKind = []MenuItemKind{
+	MenuItemKindPost,
+	MenuItemKindPage,
+	MenuItemKindCategory,
+	MenuItemKindTag,
+	MenuItemKindCustom,
+}
+
+func (e MenuItemKind) IsValid() bool {
+	switch e {
+	case MenuItemKindPost, MenuItemKindPage, MenuItemKindCategory, MenuItemKindTag, MenuItemKindCustom:
+		return true
+	}
+	return false
+}
+
+func (e MenuItemKind) String() string {
+	return string(e)
+}
+
+func (e *MenuItemKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MenuItemKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MenuItemKind", str)
+	}
+	return nil
+}
+
+func (e MenuItemKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MenuItemKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MenuItemKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Notification categories supported by the management console.
 type NotificationCategory string
 
@@ -933,3 +1811,176 @@ func (e PostType) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type TrashKind string
+
+const (
+	TrashKindPost    TrashKind = "POST"
+	TrashKindComment TrashKind = "COMMENT"
+	TrashKindMedia   TrashKind = "MEDIA"
+)
+
+var AllTrashKind = []TrashKind{
+	TrashKindPost,
+	TrashKindComment,
+	TrashKindMedia,
+}
+
+func (e TrashKind) IsValid() bool {
+	switch e {
+	case TrashKindPost, TrashKindComment, TrashKindMedia:
+		return true
+	}
+	return false
+}
+
+func (e TrashKind) String() string {
+	return string(e)
+}
+
+func (e *TrashKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrashKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrashKind", str)
+	}
+	return nil
+}
+
+func (e TrashKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TrashKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TrashKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TrendInterval string
+
+const (
+	TrendIntervalDay   TrendInterval = "DAY"
+	TrendIntervalWeek  TrendInterval = "WEEK"
+	TrendIntervalMonth TrendInterval = "MONTH"
+)
+
+var AllTrendInterval = []TrendInterval{
+	TrendIntervalDay,
+	TrendIntervalWeek,
+	TrendIntervalMonth,
+}
+
+func (e TrendInterval) IsValid() bool {
+	switch e {
+	case TrendIntervalDay, TrendIntervalWeek, TrendIntervalMonth:
+		return true
+	}
+	return false
+}
+
+func (e TrendInterval) String() string {
+	return string(e)
+}
+
+func (e *TrendInterval) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrendInterval(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrendInterval", str)
+	}
+	return nil
+}
+
+func (e TrendInterval) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TrendInterval) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TrendInterval) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TrendRange string
+
+const (
+	TrendRangeLast7Days    TrendRange = "LAST_7_DAYS"
+	TrendRangeLast30Days   TrendRange = "LAST_30_DAYS"
+	TrendRangeLast90Days   TrendRange = "LAST_90_DAYS"
+	TrendRangeLast12Months TrendRange = "LAST_12_MONTHS"
+)
+
+var AllTrendRange = []TrendRange{
+	TrendRangeLast7Days,
+	TrendRangeLast30Days,
+	TrendRangeLast90Days,
+	TrendRangeLast12Months,
+}
+
+func (e TrendRange) IsValid() bool {
+	switch e {
+	case TrendRangeLast7Days, TrendRangeLast30Days, TrendRangeLast90Days, TrendRangeLast12Months:
+		return true
+	}
+	return false
+}
+
+func (e TrendRange) String() string {
+	return string(e)
+}
+
+func (e *TrendRange) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrendRange(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrendRange", str)
+	}
+	return nil
+}
+
+func (e TrendRange) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TrendRange) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TrendRange) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,157 @@
+package persisted
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+// errPersistedQueryNotFound is the message APQ clients look for before
+// retrying with the full query text.
+const errPersistedQueryNotFound = "PersistedQueryNotFound"
+
+// Extension resolves persisted query hashes sent in
+// extensions.persistedQuery.sha256Hash, over POST or GET.
+//
+// By default it behaves like Automatic Persisted Queries: unknown hashes ask
+// the client to resend the query, which is then cached. In strict mode only
+// allow-listed operations run; ad-hoc documents and APQ registration are
+// rejected with PERSISTED_QUERY_NOT_ALLOWED. Ad-hoc documents that select
+// only Exempt root fields still run, so the allow-list can be filled through
+// the API when no manifest is loaded at startup.
+type Extension struct {
+	Store  *Store
+	Strict bool
+	Exempt []string
+}
+
+var (
+	_ gql.HandlerExtension          = Extension{}
+	_ gql.OperationParameterMutator = Extension{}
+)
+
+// ExtensionName implements graphql.HandlerExtension.
+func (Extension) ExtensionName() string {
+	return "PersistedQueries"
+}
+
+// Validate implements graphql.HandlerExtension.
+func (e Extension) Validate(gql.ExecutableSchema) error {
+	if e.Store == nil {
+		return fmt.Errorf("persisted: store is required")
+	}
+	return nil
+}
+
+// MutateOperationParameters implements graphql.OperationParameterMutator.
+func (e Extension) MutateOperationParameters(ctx context.Context, params *gql.RawParams) *gqlerror.Error {
+	hash, version, present := persistedQuery(params.Extensions)
+	if !present {
+		if !e.Strict {
+			return nil
+		}
+		if _, ok := e.Store.Allowed(ctx, Hash(params.Query)); ok {
+			return nil
+		}
+		if e.exempt(params.Query, params.OperationName) {
+			return nil
+		}
+		return notAllowed(ctx)
+	}
+	if version != 1 || hash == "" {
+		return presentError(ctx, gqlerrors.BadInput("extensions.persistedQuery", "unsupported persisted query version"))
+	}
+
+	if params.Query == "" {
+		var ok bool
+		if e.Strict {
+			params.Query, ok = e.Store.Allowed(ctx, hash)
+		} else {
+			params.Query, ok = e.Store.Get(ctx, hash)
+		}
+		if !ok {
+			return presentError(ctx, gqlerrors.New(gqlerrors.CodePersistedQueryNotFound, errPersistedQueryNotFound))
+		}
+		return nil
+	}
+
+	if Hash(params.Query) != hash {
+		return presentError(ctx, gqlerrors.BadInput("extensions.persistedQuery.sha256Hash", "persisted query hash does not match query"))
+	}
+	if e.Strict {
+		if _, ok := e.Store.Allowed(ctx, hash); !ok {
+			return notAllowed(ctx)
+		}
+		return nil
+	}
+	e.Store.Add(ctx, hash, params.Query)
+	return nil
+}
+
+// exempt reports whether the selected operation of query only selects root
+// fields listed in Exempt. Fragments are not followed, so documents spreading
+// them at the root are never exempt.
+func (e Extension) exempt(query, operationName string) bool {
+	if len(e.Exempt) == 0 {
+		return false
+	}
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return false
+	}
+	var op *ast.OperationDefinition
+	switch {
+	case operationName != "":
+		op = doc.Operations.ForName(operationName)
+	case len(doc.Operations) == 1:
+		op = doc.Operations[0]
+	}
+	if op == nil || len(op.SelectionSet) == 0 {
+		return false
+	}
+	for _, selection := range op.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok || !slices.Contains(e.Exempt, field.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func notAllowed(ctx context.Context) *gqlerror.Error {
+	return presentError(ctx, gqlerrors.New(gqlerrors.CodePersistedQueryNotAllowed, "operation is not in the persisted query allow-list"))
+}
+
+func presentError(ctx context.Context, err *gqlerrors.Error) *gqlerror.Error {
+	return gqlerrors.Present(ctx, err)
+}
+
+// persistedQuery extracts the APQ extension from request extensions.
+func persistedQuery(extensions map[string]any) (string, int, bool) {
+	raw, ok := extensions["persistedQuery"].(map[string]any)
+	if !ok {
+		return "", 0, false
+	}
+	hash, _ := raw["sha256Hash"].(string)
+	version := 0
+	switch v := raw["version"].(type) {
+	case float64:
+		version = int(v)
+	case int:
+		version = v
+	case int64:
+		version = int(v)
+	case json.Number:
+		n, _ := v.Int64()
+		version = int(n)
+	}
+	return hash, version, true
+}
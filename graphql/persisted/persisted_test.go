@@ -0,0 +1,226 @@
+package persisted
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+const nameQuery = "query Name { name }"
+
+type result struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (r result) code() string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+	code, _ := r.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+func newServer(store *Store, strict bool) http.Handler {
+	srv := testserver.New()
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(Extension{Store: store, Strict: strict})
+	return srv
+}
+
+func persistedExtension(hash string) map[string]any {
+	return map[string]any{"persistedQuery": map[string]any{"version": 1, "sha256Hash": hash}}
+}
+
+func post(t *testing.T, srv http.Handler, body map[string]any) result {
+	t.Helper()
+	raw, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(raw)))
+	req.Header.Set("Content-Type", "application/json")
+	return serve(t, srv, req)
+}
+
+func serve(t *testing.T, srv http.Handler, req *http.Request) result {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	var res result
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+	return res
+}
+
+func TestParseManifestVerifiesHashes(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := ParseManifest(strings.NewReader(`{"` + Hash(nameQuery) + `": "` + nameQuery + `"}`))
+	if err != nil || manifest[Hash(nameQuery)] != nameQuery {
+		t.Fatalf("unexpected manifest %v (%v)", manifest, err)
+	}
+	if _, err := ParseManifest(strings.NewReader(`{"abc": "` + nameQuery + `"}`)); err == nil {
+		t.Fatal("expected mismatched id to be rejected")
+	}
+
+	store := NewStore(0)
+	if added, err := store.Register(context.Background(), manifest); err != nil || added != 1 {
+		t.Fatalf("expected one new operation, got %d (%v)", added, err)
+	}
+	if added, _ := store.Register(context.Background(), manifest); added != 0 || store.Len() != 1 {
+		t.Fatalf("expected re-registration to be idempotent, got added=%d len=%d", added, store.Len())
+	}
+}
+
+func TestAutomaticPersistedQueries(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(NewStore(0), false)
+	hash := Hash(nameQuery)
+
+	if res := post(t, srv, map[string]any{"extensions": persistedExtension(hash)}); res.code() != "PERSISTED_QUERY_NOT_FOUND" || res.Errors[0].Message != "PersistedQueryNotFound" {
+		t.Fatalf("expected not found for unknown hash, got %+v", res)
+	}
+	if res := post(t, srv, map[string]any{"query": nameQuery, "extensions": persistedExtension(hash)}); len(res.Errors) != 0 {
+		t.Fatalf("expected registration to succeed, got %+v", res.Errors)
+	}
+	if res := post(t, srv, map[string]any{"query": "{ name }", "extensions": persistedExtension(hash)}); res.code() != "BAD_USER_INPUT" {
+		t.Fatalf("expected hash mismatch to be rejected, got %+v", res)
+	}
+
+	// Registered hashes are served over GET so CDNs can cache the short URL.
+	values := url.Values{}
+	values.Set("extensions", `{"persistedQuery":{"version":1,"sha256Hash":"`+hash+`"}}`)
+	req := httptest.NewRequest(http.MethodGet, "/graphql?"+values.Encode(), nil)
+	if res := serve(t, srv, req); len(res.Errors) != 0 || res.Data["name"] != "test" {
+		t.Fatalf("expected GET by hash to execute, got %+v", res)
+	}
+
+	if res := post(t, srv, map[string]any{"query": "{ name }"}); len(res.Errors) != 0 {
+		t.Fatalf("expected ad-hoc query outside strict mode, got %+v", res.Errors)
+	}
+}
+
+func TestStrictModeOnlyRunsAllowListedOperations(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(0)
+	if _, err := store.Register(context.Background(), map[string]string{Hash(nameQuery): nameQuery}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	srv := newServer(store, true)
+
+	if res := post(t, srv, map[string]any{"extensions": persistedExtension(Hash(nameQuery))}); len(res.Errors) != 0 {
+		t.Fatalf("expected allow-listed hash to execute, got %+v", res.Errors)
+	}
+	if res := post(t, srv, map[string]any{"query": nameQuery}); len(res.Errors) != 0 {
+		t.Fatalf("expected allow-listed text to execute, got %+v", res.Errors)
+	}
+	if res := post(t, srv, map[string]any{"query": "{ name }"}); res.code() != "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Fatalf("expected ad-hoc query to be rejected, got %+v", res)
+	}
+	adHoc := "query Other { name }"
+	if res := post(t, srv, map[string]any{"query": adHoc, "extensions": persistedExtension(Hash(adHoc))}); res.code() != "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Fatalf("expected APQ registration to be rejected, got %+v", res)
+	}
+	if _, ok := store.Get(t.Context(), Hash(adHoc)); ok {
+		t.Fatal("strict mode must not cache client registrations")
+	}
+}
+
+func TestStrictModeRunsExemptRootFields(t *testing.T) {
+	t.Parallel()
+
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(Extension{Store: NewStore(0), Strict: true, Exempt: []string{"find"}})
+
+	if res := post(t, srv, map[string]any{"query": "query Find { find(id: 1) }"}); res.code() == "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Fatalf("expected exempt field to run, got %+v", res)
+	}
+	if res := post(t, srv, map[string]any{"query": "query Both { find(id: 1) name }"}); res.code() != "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Fatalf("expected mixed selection to be rejected, got %+v", res)
+	}
+	spread := "query Spread { ...F } fragment F on Query { name }"
+	if res := post(t, srv, map[string]any{"query": spread}); res.code() != "PERSISTED_QUERY_NOT_ALLOWED" {
+		t.Fatalf("expected fragment at the root to be rejected, got %+v", res)
+	}
+}
+
+type memoryBackend struct {
+	mu    sync.Mutex
+	ops   map[string]string
+	loads int
+}
+
+func (b *memoryBackend) Save(_ context.Context, manifest map[string]string) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	added := 0
+	for id, query := range manifest {
+		if _, ok := b.ops[id]; !ok {
+			b.ops[id] = query
+			added++
+		}
+	}
+	return added, nil
+}
+
+func (b *memoryBackend) Load(context.Context) (map[string]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.loads++
+	return maps.Clone(b.ops), nil
+}
+
+func TestSharedStoreSeesOperationsRegisteredElsewhere(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	backend := &memoryBackend{ops: make(map[string]string)}
+	first, err := NewSharedStore(ctx, 0, backend)
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	second, err := NewSharedStore(ctx, 0, backend)
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	second.now = func() time.Time { return clock }
+
+	if added, err := first.Register(ctx, map[string]string{strings.ToUpper(Hash(nameQuery)): nameQuery}); err != nil || added != 1 {
+		t.Fatalf("expected one new operation, got %d (%v)", added, err)
+	}
+	if added, _ := first.Register(ctx, map[string]string{Hash(nameQuery): nameQuery}); added != 0 {
+		t.Fatalf("expected the stored operation to be kept, got %d new", added)
+	}
+
+	if query, ok := second.Allowed(ctx, Hash(nameQuery)); !ok || query != nameQuery {
+		t.Fatalf("expected the other replica to load the operation, got %q", query)
+	}
+	loads := backend.loads
+	second.Allowed(ctx, Hash("query Other { name }"))
+	second.Allowed(ctx, Hash("query Another { name }"))
+	if backend.loads != loads {
+		t.Fatalf("expected no reload within the interval, got %d reloads", backend.loads-loads)
+	}
+	clock = clock.Add(RefreshInterval)
+	second.Allowed(ctx, Hash("query Other { name }"))
+	if backend.loads != loads+1 {
+		t.Fatalf("expected a reload after the interval, got %d reloads", backend.loads-loads)
+	}
+}
@@ -0,0 +1,70 @@
+package persisted
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Querier is the subset of pg.DB.Pool used by PostgresBackend.
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+const (
+	// savePersistedQueriesQuery inserts the operations given as parallel
+	// arrays of hash and query and counts those that were new.
+	savePersistedQueriesQuery = `WITH added AS (
+INSERT INTO persisted_queries (hash, query, created_at)
+SELECT m.hash, m.query, now() FROM unnest($1::text[], $2::text[]) AS m(hash, query)
+ON CONFLICT (hash) DO NOTHING
+RETURNING 1
+)
+SELECT count(*) FROM added`
+	loadPersistedQueriesQuery = `SELECT hash, query FROM persisted_queries`
+)
+
+// PostgresBackend shares the allow-list between replicas through the
+// persisted_queries table.
+type PostgresBackend struct {
+	db Querier
+}
+
+// NewPostgresBackend returns a backend that keeps operations in Postgres.
+func NewPostgresBackend(db Querier) *PostgresBackend {
+	return &PostgresBackend{db: db}
+}
+
+// Save implements Backend.
+func (b *PostgresBackend) Save(ctx context.Context, manifest map[string]string) (int, error) {
+	hashes := make([]string, 0, len(manifest))
+	queries := make([]string, 0, len(manifest))
+	for hash, query := range manifest {
+		hashes = append(hashes, hash)
+		queries = append(queries, query)
+	}
+	var added int
+	if err := b.db.QueryRow(ctx, savePersistedQueriesQuery, hashes, queries).Scan(&added); err != nil {
+		return 0, err
+	}
+	return added, nil
+}
+
+// Load implements Backend.
+func (b *PostgresBackend) Load(ctx context.Context) (map[string]string, error) {
+	rows, err := b.db.Query(ctx, loadPersistedQueriesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]string)
+	for rows.Next() {
+		var hash, query string
+		if err := rows.Scan(&hash, &query); err != nil {
+			return nil, err
+		}
+		out[hash] = query
+	}
+	return out, rows.Err()
+}
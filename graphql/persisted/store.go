@@ -0,0 +1,211 @@
+// Package persisted implements Automatic Persisted Queries and an operation
+// allow-list fed by Relay's persisted-queries.json manifest.
+package persisted
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+)
+
+// DefaultCacheSize bounds the queries registered by clients through APQ.
+const DefaultCacheSize = 1000
+
+// RefreshInterval is the least time between reloads of a shared allow-list
+// triggered by unknown hashes.
+const RefreshInterval = 5 * time.Second
+
+// Backend shares allow-listed operations between processes.
+type Backend interface {
+	// Save stores the manifest's operations, keeping those already stored,
+	// and returns how many were new.
+	Save(ctx context.Context, manifest map[string]string) (int, error)
+	// Load returns every stored operation by hash.
+	Load(ctx context.Context) (map[string]string, error)
+}
+
+// Hash returns the SHA-256 hex digest Relay and APQ clients use as query id.
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// Store holds allow-listed operations from manifests plus a bounded cache of
+// queries registered on the fly by APQ clients. It is safe for concurrent use.
+//
+// With a Backend the allow-list is shared: registered operations are saved
+// there and a hash missing locally reloads it, at most once per
+// RefreshInterval, so every replica runs what any replica registered. The
+// APQ cache always stays in process; clients resend unknown queries.
+type Store struct {
+	mu      sync.RWMutex
+	allowed map[string]string
+	cache   *lru.LRU[string]
+
+	backend   Backend
+	now       func() time.Time
+	refreshMu sync.Mutex
+	refreshed time.Time
+}
+
+// NewStore returns an empty store whose APQ cache keeps up to cacheSize
+// queries. A non-positive size uses DefaultCacheSize.
+func NewStore(cacheSize int) *Store {
+	if cacheSize <= 0 {
+		cacheSize = DefaultCacheSize
+	}
+	return &Store{allowed: make(map[string]string), cache: lru.New[string](cacheSize), now: time.Now}
+}
+
+// NewSharedStore returns a store whose allow-list is kept in backend, loading
+// the operations stored so far.
+func NewSharedStore(ctx context.Context, cacheSize int, backend Backend) (*Store, error) {
+	s := NewStore(cacheSize)
+	s.backend = backend
+	if err := s.reload(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ParseManifest decodes a Relay persisted query manifest, a JSON object
+// mapping each operation id to its query text. Ids must be the SHA-256 of the
+// text, i.e. the manifest must be generated with algorithm "SHA256".
+func ParseManifest(r io.Reader) (map[string]string, error) {
+	var manifest map[string]string
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("persisted: decode manifest: %w", err)
+	}
+	for id, query := range manifest {
+		if !strings.EqualFold(id, Hash(query)) {
+			return nil, fmt.Errorf("persisted: manifest id %s does not match the sha256 of its query", id)
+		}
+	}
+	return manifest, nil
+}
+
+// LoadFile registers every operation in the manifest at path.
+func (s *Store) LoadFile(ctx context.Context, path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("persisted: open manifest: %w", err)
+	}
+	defer f.Close()
+	manifest, err := ParseManifest(f)
+	if err != nil {
+		return 0, err
+	}
+	return s.Register(ctx, manifest)
+}
+
+// Register allow-lists the manifest's operations and returns how many were new.
+func (s *Store) Register(ctx context.Context, manifest map[string]string) (int, error) {
+	if s.backend != nil {
+		normalized := make(map[string]string, len(manifest))
+		for id, query := range manifest {
+			normalized[strings.ToLower(id)] = query
+		}
+		added, err := s.backend.Save(ctx, normalized)
+		if err != nil {
+			return 0, fmt.Errorf("persisted: save operations: %w", err)
+		}
+		if err := s.reload(ctx); err != nil {
+			return 0, err
+		}
+		return added, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := 0
+	for id, query := range manifest {
+		id = strings.ToLower(id)
+		if _, ok := s.allowed[id]; !ok {
+			added++
+		}
+		s.allowed[id] = query
+	}
+	return added, nil
+}
+
+// reload replaces the allow-list with the operations stored in the backend.
+func (s *Store) reload(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	return s.reloadLocked(ctx)
+}
+
+func (s *Store) reloadLocked(ctx context.Context) error {
+	stored, err := s.backend.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("persisted: load operations: %w", err)
+	}
+	allowed := make(map[string]string, len(stored))
+	for id, query := range stored {
+		allowed[strings.ToLower(id)] = query
+	}
+	s.mu.Lock()
+	s.allowed = allowed
+	s.mu.Unlock()
+	s.refreshed = s.now()
+	return nil
+}
+
+// refresh reloads the allow-list unless that happened within
+// RefreshInterval, bounding the lookups unknown hashes can cause.
+func (s *Store) refresh(ctx context.Context) {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	if s.now().Sub(s.refreshed) < RefreshInterval {
+		return
+	}
+	if err := s.reloadLocked(ctx); err != nil {
+		log.Printf("%v", err)
+	}
+}
+
+// Len returns the number of allow-listed operations.
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.allowed)
+}
+
+// Allowed returns the allow-listed query for hash.
+func (s *Store) Allowed(ctx context.Context, hash string) (string, bool) {
+	if query, ok := s.lookup(hash); ok || s.backend == nil {
+		return query, ok
+	}
+	s.refresh(ctx)
+	return s.lookup(hash)
+}
+
+func (s *Store) lookup(hash string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	query, ok := s.allowed[strings.ToLower(hash)]
+	return query, ok
+}
+
+// Get implements graphql.Cache, consulting the allow-list before queries
+// registered by clients.
+func (s *Store) Get(ctx context.Context, hash string) (string, bool) {
+	if query, ok := s.Allowed(ctx, hash); ok {
+		return query, true
+	}
+	return s.cache.Get(ctx, strings.ToLower(hash))
+}
+
+// Add implements graphql.Cache by caching a client-registered query.
+func (s *Store) Add(ctx context.Context, hash, query string) {
+	s.cache.Add(ctx, strings.ToLower(hash), query)
+}
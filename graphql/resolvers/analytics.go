@@ -0,0 +1,106 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+const (
+	defaultPopularPosts = 10
+	maxPopularPosts     = 50
+)
+
+type viewSource interface {
+	PostViewBuckets(ctx context.Context, postID, unit string, from, to time.Time) ([]gen.PostViewBucket, error)
+	PopularPosts(ctx context.Context, from, to time.Time, limit int) ([]gen.PostViewCount, error)
+}
+
+func (r *Resolver) viewSource() viewSource {
+	if r == nil {
+		return nil
+	}
+	if r.views != nil {
+		return r.views
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) postViewStats(ctx context.Context, post *graphql1.Post, rangeArg graphql1.TrendRange, interval graphql1.TrendInterval) (*graphql1.PostViewStats, error) {
+	if !rangeArg.IsValid() {
+		rangeArg = graphql1.TrendRangeLast30Days
+	}
+	if !interval.IsValid() {
+		interval = graphql1.TrendIntervalDay
+	}
+	w := newTrendWindow(rangeArg, interval, r.currentTime())
+	result := &graphql1.PostViewStats{Range: rangeArg, Interval: interval, From: w.from, To: w.to}
+
+	var rows []gen.PostViewBucket
+	if source := r.viewSource(); source != nil && post != nil {
+		nativeID, err := decodePostID(post.ID)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		rows, err = source.PostViewBuckets(ctx, nativeID, w.unit(), w.from, w.to)
+		r.recordQuery("post_views", "buckets", start, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byStart := make(map[time.Time]gen.PostViewBucket, len(rows))
+	for _, row := range rows {
+		start := w.truncate(row.Bucket)
+		bucket := byStart[start]
+		bucket.Views += row.Views
+		bucket.Visitors += row.Visitors
+		byStart[start] = bucket
+		result.Views += row.Views
+		result.Visitors += row.Visitors
+	}
+	starts := w.starts()
+	result.Buckets = make([]*graphql1.PostViewBucket, 0, len(starts))
+	for _, start := range starts {
+		bucket := byStart[start]
+		result.Buckets = append(result.Buckets, &graphql1.PostViewBucket{Start: start, Views: bucket.Views, Visitors: bucket.Visitors})
+	}
+	return result, nil
+}
+
+func (r *Resolver) popularPosts(ctx context.Context, rangeArg graphql1.TrendRange, first int) ([]*graphql1.PopularPost, error) {
+	if !rangeArg.IsValid() {
+		rangeArg = graphql1.TrendRangeLast7Days
+	}
+	if first < 1 || first > maxPopularPosts {
+		return nil, gqlerrors.BadInput("first", "must be between 1 and 50")
+	}
+	source := r.viewSource()
+	if source == nil {
+		return []*graphql1.PopularPost{}, nil
+	}
+	w := newTrendWindow(rangeArg, graphql1.TrendIntervalDay, r.currentTime())
+	start := time.Now()
+	rows, err := source.PopularPosts(ctx, w.from, w.to, first)
+	r.recordQuery("post_views", "popular", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.PopularPost, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &graphql1.PopularPost{
+			PostID:   relay.ToGlobalID("Post", row.PostID),
+			Views:    row.Views,
+			Visitors: row.Visitors,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,58 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// Post is the resolver for the post field.
+func (r *popularPostResolver) Post(ctx context.Context, obj *graphql1.PopularPost) (*graphql1.Post, error) {
+	if obj == nil || obj.PostID == "" {
+		return nil, nil
+	}
+	nativeID, err := decodePostID(obj.PostID)
+	if err != nil {
+		return nil, err
+	}
+	post, err := r.loadPost(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	return toGraphQLPost(post), nil
+}
+
+// ViewStats is the resolver for the viewStats field.
+func (r *postResolver) ViewStats(ctx context.Context, obj *graphql1.Post, rangeArg *graphql1.TrendRange, interval *graphql1.TrendInterval) (*graphql1.PostViewStats, error) {
+	selectedRange := graphql1.TrendRangeLast30Days
+	if rangeArg != nil {
+		selectedRange = *rangeArg
+	}
+	selectedInterval := graphql1.TrendIntervalDay
+	if interval != nil {
+		selectedInterval = *interval
+	}
+	return r.postViewStats(ctx, obj, selectedRange, selectedInterval)
+}
+
+// PopularPosts is the resolver for the popularPosts field.
+func (r *queryResolver) PopularPosts(ctx context.Context, rangeArg *graphql1.TrendRange, first *int) ([]*graphql1.PopularPost, error) {
+	selectedRange := graphql1.TrendRangeLast7Days
+	if rangeArg != nil {
+		selectedRange = *rangeArg
+	}
+	limit := defaultPopularPosts
+	if first != nil {
+		limit = *first
+	}
+	return r.popularPosts(ctx, selectedRange, limit)
+}
+
+// PopularPost returns graphql1.PopularPostResolver implementation.
+func (r *Resolver) PopularPost() graphql1.PopularPostResolver { return &popularPostResolver{r} }
+
+type popularPostResolver struct{ *Resolver }
@@ -0,0 +1,87 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubViewSource struct {
+	buckets []gen.PostViewBucket
+	popular []gen.PostViewCount
+	postID  string
+	unit    string
+	limit   int
+}
+
+func (s *stubViewSource) PostViewBuckets(_ context.Context, postID, unit string, _, _ time.Time) ([]gen.PostViewBucket, error) {
+	s.postID, s.unit = postID, unit
+	return s.buckets, nil
+}
+
+func (s *stubViewSource) PopularPosts(_ context.Context, _, _ time.Time, limit int) ([]gen.PostViewCount, error) {
+	s.limit = limit
+	return s.popular, nil
+}
+
+func TestPostViewStatsFillsBuckets(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	source := &stubViewSource{buckets: []gen.PostViewBucket{
+		{Bucket: day(14), Views: 10, Visitors: 4},
+		{Bucket: day(18), Views: 3, Visitors: 2},
+	}}
+	resolver := &Resolver{views: source, now: func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }}
+
+	rangeArg := graphqlpkg.TrendRangeLast7Days
+	post := &graphqlpkg.Post{ID: relay.ToGlobalID("Post", "post-1")}
+	stats, err := resolver.Post().ViewStats(context.Background(), post, &rangeArg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.postID != "post-1" || source.unit != "day" {
+		t.Fatalf("unexpected source call: %q %q", source.postID, source.unit)
+	}
+	if stats.Views != 13 || stats.Visitors != 6 {
+		t.Fatalf("unexpected totals: %d views, %d visitors", stats.Views, stats.Visitors)
+	}
+	if len(stats.Buckets) != 8 {
+		t.Fatalf("expected 8 daily buckets, got %d", len(stats.Buckets))
+	}
+	for _, bucket := range stats.Buckets {
+		want := 0
+		switch {
+		case bucket.Start.Equal(day(14)):
+			want = 10
+		case bucket.Start.Equal(day(18)):
+			want = 3
+		}
+		if bucket.Views != want {
+			t.Fatalf("bucket %s: expected %d views, got %d", bucket.Start, want, bucket.Views)
+		}
+	}
+}
+
+func TestPopularPostsReturnsGlobalIDs(t *testing.T) {
+	source := &stubViewSource{popular: []gen.PostViewCount{{PostID: "post-1", Views: 42, Visitors: 30}}}
+	resolver := &Resolver{views: source}
+
+	posts, err := resolver.Query().PopularPosts(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.limit != defaultPopularPosts {
+		t.Fatalf("expected default limit %d, got %d", defaultPopularPosts, source.limit)
+	}
+	if len(posts) != 1 || posts[0].PostID != relay.ToGlobalID("Post", "post-1") || posts[0].Views != 42 {
+		t.Fatalf("unexpected popular posts: %+v", posts)
+	}
+
+	tooMany := 500
+	if _, err := resolver.Query().PopularPosts(context.Background(), nil, &tooMany); err == nil {
+		t.Fatal("expected error for oversized limit")
+	}
+}
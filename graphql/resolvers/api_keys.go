@@ -0,0 +1,142 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/deicod/ermblog/apikeys"
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/oidc"
+)
+
+type apiKeyManager interface {
+	Create(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*apikeys.Key, string, error)
+	List(ctx context.Context, userID string) ([]*apikeys.Key, error)
+	Key(ctx context.Context, id string) (*apikeys.Key, error)
+	Revoke(ctx context.Context, id string) error
+}
+
+func (r *Resolver) apiKeyManager() (apiKeyManager, error) {
+	if r == nil || r.apiKeys == nil {
+		return nil, gqlerrors.Forbidden("api keys are not enabled")
+	}
+	return r.apiKeys, nil
+}
+
+func (r *Resolver) createAPIKey(ctx context.Context, input graphql1.CreateAPIKeyInput) (*graphql1.CreateAPIKeyPayload, error) {
+	keys, err := r.apiKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := oidc.FromContext(ctx)
+	if !ok || strings.TrimSpace(claims.Subject) == "" {
+		return nil, gqlerrors.Unauthenticated()
+	}
+	// A leaked key must not be able to mint longer-lived ones.
+	if apikeys.KeyID(claims) != "" {
+		return nil, gqlerrors.Forbidden("api keys cannot create api keys")
+	}
+	scopes := apikeys.NormalizeScopes(input.Scopes)
+	for _, scope := range scopes {
+		if !slices.Contains(claims.Roles, scope) {
+			return nil, gqlerrors.BadInput("input.scopes", fmt.Sprintf("cannot grant role %s you do not hold", scope))
+		}
+	}
+	start := time.Now()
+	key, token, err := keys.Create(ctx, strings.TrimSpace(claims.Subject), input.Name, scopes, input.ExpiresAt)
+	r.recordQuery("api_keys", "create", start, err)
+	if err != nil {
+		var inputErr *apikeys.InputError
+		if errors.As(err, &inputErr) {
+			return nil, gqlerrors.BadInput("input."+inputErr.Field, inputErr.Field+" "+inputErr.Message)
+		}
+		return nil, err
+	}
+	return &graphql1.CreateAPIKeyPayload{ClientMutationID: input.ClientMutationID, APIKey: toGraphQLAPIKey(key), Token: token}, nil
+}
+
+func (r *Resolver) revokeAPIKey(ctx context.Context, input graphql1.RevokeAPIKeyInput) (*graphql1.RevokeAPIKeyPayload, error) {
+	keys, err := r.apiKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := oidc.FromContext(ctx)
+	if !ok || strings.TrimSpace(claims.Subject) == "" {
+		return nil, gqlerrors.Unauthenticated()
+	}
+	keyID, err := decodeAPIKeyID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	key, err := keys.Key(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	// Other users' keys look missing so their IDs cannot be probed.
+	if key == nil || (key.UserID != strings.TrimSpace(claims.Subject) && !slices.Contains(claims.Roles, "admin")) {
+		return nil, gqlerrors.NotFound("ApiKey")
+	}
+	start := time.Now()
+	err = keys.Revoke(ctx, key.ID)
+	r.recordQuery("api_keys", "delete", start, err)
+	if errors.Is(err, apikeys.ErrNotFound) {
+		return nil, gqlerrors.NotFound("ApiKey")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.RevokeAPIKeyPayload{ClientMutationID: input.ClientMutationID, RevokedAPIKeyID: relay.ToGlobalID("ApiKey", key.ID)}, nil
+}
+
+func (r *Resolver) viewerAPIKeys(ctx context.Context, obj *graphql1.Viewer) ([]*graphql1.APIKey, error) {
+	if obj == nil || r == nil || r.apiKeys == nil {
+		return []*graphql1.APIKey{}, nil
+	}
+	start := time.Now()
+	keys, err := r.apiKeys.List(ctx, obj.ID)
+	r.recordQuery("api_keys", "list", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.APIKey, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, toGraphQLAPIKey(key))
+	}
+	return out, nil
+}
+
+func toGraphQLAPIKey(key *apikeys.Key) *graphql1.APIKey {
+	scopes := key.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &graphql1.APIKey{
+		ID:         relay.ToGlobalID("ApiKey", key.ID),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func decodeAPIKeyID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "ApiKey" {
+		return "", fmt.Errorf("invalid id for ApiKey: %s", typ)
+	}
+	return nativeID, nil
+}
@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// CreateAPIKey is the resolver for the createApiKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input graphql1.CreateAPIKeyInput) (*graphql1.CreateAPIKeyPayload, error) {
+	return r.createAPIKey(ctx, input)
+}
+
+// RevokeAPIKey is the resolver for the revokeApiKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, input graphql1.RevokeAPIKeyInput) (*graphql1.RevokeAPIKeyPayload, error) {
+	return r.revokeAPIKey(ctx, input)
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *viewerResolver) APIKeys(ctx context.Context, obj *graphql1.Viewer) ([]*graphql1.APIKey, error) {
+	return r.viewerAPIKeys(ctx, obj)
+}
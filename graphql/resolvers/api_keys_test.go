@@ -0,0 +1,123 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deicod/ermblog/apikeys"
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/oidc"
+)
+
+type stubAPIKeys struct {
+	keys    map[string]*apikeys.Key
+	revoked []string
+}
+
+func newStubAPIKeys(keys ...*apikeys.Key) *stubAPIKeys {
+	stub := &stubAPIKeys{keys: make(map[string]*apikeys.Key)}
+	for _, key := range keys {
+		stub.keys[key.ID] = key
+	}
+	return stub
+}
+
+func (s *stubAPIKeys) Create(_ context.Context, userID, name string, scopes []string, expiresAt *time.Time) (*apikeys.Key, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, "", &apikeys.InputError{Field: "name", Message: "is required"}
+	}
+	key := &apikeys.Key{ID: "key-new", UserID: userID, Name: name, Prefix: "ermblog_0123456789abcdef", Scopes: scopes, ExpiresAt: expiresAt}
+	s.keys[key.ID] = key
+	return key, key.Prefix + "_secret", nil
+}
+
+func (s *stubAPIKeys) List(_ context.Context, userID string) ([]*apikeys.Key, error) {
+	var keys []*apikeys.Key
+	for _, key := range s.keys {
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *stubAPIKeys) Key(_ context.Context, id string) (*apikeys.Key, error) {
+	return s.keys[id], nil
+}
+
+func (s *stubAPIKeys) Revoke(_ context.Context, id string) error {
+	s.revoked = append(s.revoked, id)
+	delete(s.keys, id)
+	return nil
+}
+
+func TestCreateAPIKeyLimitsScopesToViewerRoles(t *testing.T) {
+	keys := newStubAPIKeys()
+	resolver := &Resolver{apiKeys: keys}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1", Roles: []string{"user", "editor"}})
+
+	_, err := resolver.Mutation().CreateAPIKey(ctx, graphql1.CreateAPIKeyInput{Name: "ci", Scopes: []string{"editor", "admin"}})
+	expectBadInput(t, err, "input.scopes")
+
+	_, err = resolver.Mutation().CreateAPIKey(ctx, graphql1.CreateAPIKeyInput{Name: " ", Scopes: []string{"user"}})
+	expectBadInput(t, err, "input.name")
+
+	clientMutationID := "mut-1"
+	payload, err := resolver.Mutation().CreateAPIKey(ctx, graphql1.CreateAPIKeyInput{ClientMutationID: &clientMutationID, Name: "ci", Scopes: []string{" Editor "}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Token != "ermblog_0123456789abcdef_secret" || payload.APIKey.ID != relay.ToGlobalID("ApiKey", "key-new") {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if strings.Join(payload.APIKey.Scopes, ",") != "editor" || keys.keys["key-new"].UserID != "user-1" {
+		t.Fatalf("expected a normalised editor key for the viewer, got %+v", payload.APIKey)
+	}
+}
+
+func TestCreateAPIKeyRejectsAPIKeySessions(t *testing.T) {
+	resolver := &Resolver{apiKeys: newStubAPIKeys()}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1", Roles: []string{"user"}, Raw: map[string]any{"api_key_id": "key-1"}})
+
+	_, err := resolver.Mutation().CreateAPIKey(ctx, graphql1.CreateAPIKeyInput{Name: "ci", Scopes: []string{"user"}})
+	expectErrorCode(t, err, gqlerrors.CodeForbidden)
+}
+
+func TestRevokeAPIKeyChecksOwnership(t *testing.T) {
+	keys := newStubAPIKeys(&apikeys.Key{ID: "key-1", UserID: "user-1"}, &apikeys.Key{ID: "key-2", UserID: "user-2"})
+	resolver := &Resolver{apiKeys: keys}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1", Roles: []string{"user"}})
+
+	_, err := resolver.Mutation().RevokeAPIKey(ctx, graphql1.RevokeAPIKeyInput{ID: relay.ToGlobalID("ApiKey", "key-2")})
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+
+	payload, err := resolver.Mutation().RevokeAPIKey(ctx, graphql1.RevokeAPIKeyInput{ID: relay.ToGlobalID("ApiKey", "key-1")})
+	if err != nil || payload.RevokedAPIKeyID != relay.ToGlobalID("ApiKey", "key-1") {
+		t.Fatalf("expected the viewer's key to be revoked, got %+v, %v", payload, err)
+	}
+
+	admin := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-3", Roles: []string{"user", "admin"}})
+	if _, err := resolver.Mutation().RevokeAPIKey(admin, graphql1.RevokeAPIKeyInput{ID: "key-2"}); err != nil {
+		t.Fatalf("expected admins to revoke any key, got %v", err)
+	}
+	if strings.Join(keys.revoked, ",") != "key-1,key-2" {
+		t.Fatalf("unexpected revocations %v", keys.revoked)
+	}
+}
+
+func TestViewerAPIKeys(t *testing.T) {
+	resolver := &Resolver{apiKeys: newStubAPIKeys(&apikeys.Key{ID: "key-1", UserID: "user-1", Name: "ci"}, &apikeys.Key{ID: "key-2", UserID: "user-2"})}
+
+	keys, err := resolver.Viewer().APIKeys(context.Background(), &graphql1.Viewer{ID: "user-1"})
+	if err != nil || len(keys) != 1 || keys[0].Name != "ci" || keys[0].Scopes == nil {
+		t.Fatalf("expected the viewer's key, got %+v, %v", keys, err)
+	}
+	keys, err = (&Resolver{}).Viewer().APIKeys(context.Background(), &graphql1.Viewer{ID: "user-1"})
+	if err != nil || len(keys) != 0 {
+		t.Fatalf("expected no keys when disabled, got %+v, %v", keys, err)
+	}
+}
@@ -0,0 +1,100 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/localauth"
+	"github.com/deicod/ermblog/oidc"
+)
+
+type sessionManager interface {
+	Login(ctx context.Context, username, password string) (*localauth.Session, error)
+	Refresh(ctx context.Context, refreshToken string) (*localauth.Session, error)
+	Logout(ctx context.Context, refreshToken string) error
+}
+
+func (r *Resolver) sessionManager() (sessionManager, error) {
+	if r == nil || r.sessions == nil {
+		return nil, gqlerrors.Forbidden("local login is not enabled")
+	}
+	return r.sessions, nil
+}
+
+func (r *Resolver) login(ctx context.Context, input graphql1.LoginInput) (*graphql1.SessionPayload, error) {
+	sessions, err := r.sessionManager()
+	if err != nil {
+		return nil, err
+	}
+	session, err := sessions.Login(ctx, input.Username, input.Password)
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	return r.sessionPayload(ctx, input.ClientMutationID, session)
+}
+
+func (r *Resolver) refreshSession(ctx context.Context, input graphql1.RefreshSessionInput) (*graphql1.SessionPayload, error) {
+	sessions, err := r.sessionManager()
+	if err != nil {
+		return nil, err
+	}
+	session, err := sessions.Refresh(ctx, input.RefreshToken)
+	if err != nil {
+		return nil, sessionError(err)
+	}
+	return r.sessionPayload(ctx, input.ClientMutationID, session)
+}
+
+func (r *Resolver) logout(ctx context.Context, input graphql1.LogoutInput) (*graphql1.LogoutPayload, error) {
+	sessions, err := r.sessionManager()
+	if err != nil {
+		return nil, err
+	}
+	if err := sessions.Logout(ctx, input.RefreshToken); err != nil {
+		return nil, err
+	}
+	return &graphql1.LogoutPayload{ClientMutationID: input.ClientMutationID, Revoked: true}, nil
+}
+
+// sessionPayload resolves the viewer as the new session sees it.
+func (r *Resolver) sessionPayload(ctx context.Context, clientMutationID *string, session *localauth.Session) (*graphql1.SessionPayload, error) {
+	viewer, err := r.Query().Viewer(oidc.ToContext(ctx, session.Claims))
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.SessionPayload{
+		ClientMutationID: clientMutationID,
+		AccessToken:      session.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresAt:        session.ExpiresAt,
+		RefreshToken:     session.RefreshToken,
+		Viewer:           viewer,
+	}, nil
+}
+
+func sessionError(err error) error {
+	var locked *localauth.LockedError
+	switch {
+	case errors.As(err, &locked):
+		seconds := int(math.Ceil(time.Until(locked.Until).Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		return &gqlerrors.Error{
+			Code:       gqlerrors.CodeRateLimited,
+			Message:    "too many failed logins; try again later",
+			Extensions: map[string]any{"retryAfter": seconds},
+			Err:        err,
+		}
+	case errors.Is(err, localauth.ErrInvalidCredentials):
+		return gqlerrors.Wrap(gqlerrors.CodeUnauthenticated, err, "invalid username or password")
+	case errors.Is(err, localauth.ErrInvalidToken):
+		return gqlerrors.Wrap(gqlerrors.CodeUnauthenticated, err, "invalid or expired refresh token")
+	default:
+		return err
+	}
+}
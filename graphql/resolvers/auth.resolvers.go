@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, input graphql1.LoginInput) (*graphql1.SessionPayload, error) {
+	return r.login(ctx, input)
+}
+
+// RefreshSession is the resolver for the refreshSession field.
+func (r *mutationResolver) RefreshSession(ctx context.Context, input graphql1.RefreshSessionInput) (*graphql1.SessionPayload, error) {
+	return r.refreshSession(ctx, input)
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, input graphql1.LogoutInput) (*graphql1.LogoutPayload, error) {
+	return r.logout(ctx, input)
+}
@@ -0,0 +1,102 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/localauth"
+	"github.com/deicod/ermblog/oidc"
+)
+
+type stubSessions struct {
+	session *localauth.Session
+	err     error
+	revoked []string
+}
+
+func (s *stubSessions) Login(context.Context, string, string) (*localauth.Session, error) {
+	return s.session, s.err
+}
+
+func (s *stubSessions) Refresh(context.Context, string) (*localauth.Session, error) {
+	return s.session, s.err
+}
+
+func (s *stubSessions) Logout(_ context.Context, refreshToken string) error {
+	s.revoked = append(s.revoked, refreshToken)
+	return s.err
+}
+
+func expectErrorCode(t *testing.T, err error, code string) *gqlerrors.Error {
+	t.Helper()
+	var gqlErr *gqlerrors.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Code != code {
+		t.Fatalf("expected %s error, got %v", code, err)
+	}
+	return gqlErr
+}
+
+func TestLoginReturnsSessionAndViewer(t *testing.T) {
+	expires := time.Date(2026, 10, 19, 12, 15, 0, 0, time.UTC)
+	sessions := &stubSessions{session: &localauth.Session{
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		ExpiresAt:    expires,
+		Claims:       oidc.Claims{Subject: "user-1", Name: "Alice", Email: "alice@example.com"},
+	}}
+	resolver := &Resolver{sessions: sessions}
+
+	clientMutationID := "mut-1"
+	payload, err := resolver.Mutation().Login(context.Background(), graphql1.LoginInput{ClientMutationID: &clientMutationID, Username: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.AccessToken != "access" || payload.RefreshToken != "refresh" || payload.TokenType != "Bearer" || !payload.ExpiresAt.Equal(expires) {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if payload.ClientMutationID == nil || *payload.ClientMutationID != clientMutationID {
+		t.Fatalf("expected clientMutationId to be echoed")
+	}
+	if payload.Viewer == nil || payload.Viewer.ID != "user-1" || payload.Viewer.DisplayName == nil || *payload.Viewer.DisplayName != "Alice" {
+		t.Fatalf("unexpected viewer: %+v", payload.Viewer)
+	}
+}
+
+func TestLoginMapsFailures(t *testing.T) {
+	resolver := &Resolver{sessions: &stubSessions{err: localauth.ErrInvalidCredentials}}
+	_, err := resolver.Mutation().Login(context.Background(), graphql1.LoginInput{Username: "alice", Password: "wrong"})
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+
+	resolver = &Resolver{sessions: &stubSessions{err: &localauth.LockedError{Until: time.Now().Add(time.Minute)}}}
+	_, err = resolver.Mutation().Login(context.Background(), graphql1.LoginInput{Username: "alice", Password: "wrong"})
+	locked := expectErrorCode(t, err, gqlerrors.CodeRateLimited)
+	if seconds, _ := locked.Extensions["retryAfter"].(int); seconds < 1 || seconds > 60 {
+		t.Fatalf("unexpected retryAfter: %v", locked.Extensions["retryAfter"])
+	}
+
+	resolver = &Resolver{sessions: &stubSessions{err: localauth.ErrInvalidToken}}
+	_, err = resolver.Mutation().RefreshSession(context.Background(), graphql1.RefreshSessionInput{RefreshToken: "used"})
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+}
+
+func TestLoginIsForbiddenWhenDisabled(t *testing.T) {
+	resolver := &Resolver{}
+	_, err := resolver.Mutation().Login(context.Background(), graphql1.LoginInput{Username: "alice", Password: "secret"})
+	expectErrorCode(t, err, gqlerrors.CodeForbidden)
+}
+
+func TestLogoutRevokesRefreshToken(t *testing.T) {
+	sessions := &stubSessions{}
+	resolver := &Resolver{sessions: sessions}
+	payload, err := resolver.Mutation().Logout(context.Background(), graphql1.LogoutInput{RefreshToken: "refresh"})
+	if err != nil || !payload.Revoked {
+		t.Fatalf("unexpected result: %+v, %v", payload, err)
+	}
+	if len(sessions.revoked) != 1 || sessions.revoked[0] != "refresh" {
+		t.Fatalf("expected the refresh token to be revoked, got %v", sessions.revoked)
+	}
+}
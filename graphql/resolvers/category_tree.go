@@ -0,0 +1,181 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type categoryTree interface {
+	CategoryAncestors(ctx context.Context, id string) ([]*gen.Category, error)
+	CategoryDescendants(ctx context.Context, id string, maxDepth int) ([]gen.CategoryNode, error)
+	CategoryCreatesCycle(ctx context.Context, id, parentID string) (bool, error)
+	CategoryPostCounts(ctx context.Context, ids []string) (map[string]int, error)
+	MoveCategory(ctx context.Context, id string, parentID *string, position int) (*gen.Category, error)
+}
+
+func (r *Resolver) categoryTree() categoryTree {
+	if r == nil {
+		return nil
+	}
+	if r.tree != nil {
+		return r.tree
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) categoryAncestors(ctx context.Context, obj *graphql1.Category) ([]*gen.Category, error) {
+	tree := r.categoryTree()
+	if obj == nil || obj.ParentID == nil || tree == nil {
+		return nil, nil
+	}
+	nativeID, err := decodeCategoryID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	ancestors, err := tree.CategoryAncestors(ctx, nativeID)
+	r.recordQuery("categories", "ancestors", start, err)
+	return ancestors, err
+}
+
+func (r *Resolver) categoryDescendants(ctx context.Context, obj *graphql1.Category, depth int) ([]*graphql1.Category, error) {
+	tree := r.categoryTree()
+	if obj == nil || tree == nil {
+		return []*graphql1.Category{}, nil
+	}
+	nativeID, err := decodeCategoryID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	nodes, err := tree.CategoryDescendants(ctx, nativeID, depth)
+	r.recordQuery("categories", "descendants", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.Category, 0, len(nodes))
+	for _, node := range nodes {
+		r.primeCategory(ctx, node.Category)
+		out = append(out, toGraphQLCategory(node.Category))
+	}
+	return out, nil
+}
+
+// categoryPath joins the slugs of the ancestors and the category itself.
+func (r *Resolver) categoryPath(ctx context.Context, obj *graphql1.Category) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	ancestors, err := r.categoryAncestors(ctx, obj)
+	if err != nil {
+		return "", err
+	}
+	slugs := make([]string, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		slugs = append(slugs, ancestor.Slug)
+	}
+	return strings.Join(append(slugs, obj.Slug), "/"), nil
+}
+
+func (r *Resolver) categoryPostCount(ctx context.Context, obj *graphql1.Category) (int, error) {
+	tree := r.categoryTree()
+	if obj == nil || tree == nil {
+		return 0, nil
+	}
+	nativeID, err := decodeCategoryID(obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.CategoryPostCount(); loader != nil {
+			return loader.Load(ctx, nativeID)
+		}
+	}
+	start := time.Now()
+	counts, err := tree.CategoryPostCounts(ctx, []string{nativeID})
+	r.recordQuery("categories", "post_count", start, err)
+	if err != nil {
+		return 0, err
+	}
+	return counts[nativeID], nil
+}
+
+func (r *Resolver) moveCategory(ctx context.Context, input graphql1.MoveCategoryInput) (*graphql1.MoveCategoryPayload, error) {
+	tree := r.categoryTree()
+	if tree == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	nativeID, err := decodeCategoryID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	var parentID *string
+	if input.NewParentID != nil {
+		decoded, err := decodeCategoryID(*input.NewParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &decoded
+	}
+	position := maxCategoryPosition
+	if input.Position != nil {
+		if *input.Position < 0 {
+			return nil, gqlerrors.BadInput("input.position", "must not be negative")
+		}
+		position = *input.Position
+	}
+
+	start := time.Now()
+	record, err := tree.MoveCategory(ctx, nativeID, parentID, position)
+	r.recordQuery("categories", "move", start, err)
+	if errors.Is(err, gen.ErrCategoryCycle) {
+		return nil, gqlerrors.BadInput("input.newParentID", err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("Category")
+	}
+	if err := r.applyBeforeReturnCategory(ctx, record); err != nil {
+		return nil, err
+	}
+	r.primeCategory(ctx, record)
+	return &graphql1.MoveCategoryPayload{
+		ClientMutationID: input.ClientMutationID,
+		Category:         toGraphQLCategory(record),
+	}, nil
+}
+
+// maxCategoryPosition appends a moved category after its new siblings.
+const maxCategoryPosition = 1<<31 - 1
+
+// preventCategoryCycle rejects updateCategory inputs that would make a
+// category its own ancestor.
+func preventCategoryCycle(ctx context.Context, r *Resolver, input graphql1.UpdateCategoryInput, model *gen.Category) error {
+	if model == nil || model.ParentID == nil || *model.ParentID == "" {
+		return nil
+	}
+	tree := r.categoryTree()
+	if tree == nil {
+		return nil
+	}
+	cycle, err := tree.CategoryCreatesCycle(ctx, model.ID, *model.ParentID)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return gqlerrors.BadInput("input.parentID", gen.ErrCategoryCycle.Error())
+	}
+	return nil
+}
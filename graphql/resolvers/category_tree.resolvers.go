@@ -0,0 +1,63 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+// Ancestors is the resolver for the ancestors field.
+func (r *categoryResolver) Ancestors(ctx context.Context, obj *graphql1.Category) ([]*graphql1.Category, error) {
+	ancestors, err := r.categoryAncestors(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.Category, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		r.primeCategory(ctx, ancestor)
+		out = append(out, toGraphQLCategory(ancestor))
+	}
+	return out, nil
+}
+
+// Children is the resolver for the children field.
+func (r *categoryResolver) Children(ctx context.Context, obj *graphql1.Category) ([]*graphql1.Category, error) {
+	return r.categoryDescendants(ctx, obj, 1)
+}
+
+// Descendants is the resolver for the descendants field.
+func (r *categoryResolver) Descendants(ctx context.Context, obj *graphql1.Category, depth *int) ([]*graphql1.Category, error) {
+	maxDepth := 0
+	if depth != nil {
+		if *depth < 1 {
+			return nil, gqlerrors.BadInput("depth", "must be at least 1")
+		}
+		maxDepth = *depth
+	}
+	return r.categoryDescendants(ctx, obj, maxDepth)
+}
+
+// Path is the resolver for the path field.
+func (r *categoryResolver) Path(ctx context.Context, obj *graphql1.Category) (string, error) {
+	return r.categoryPath(ctx, obj)
+}
+
+// PostCount is the resolver for the postCount field.
+func (r *categoryResolver) PostCount(ctx context.Context, obj *graphql1.Category) (int, error) {
+	return r.categoryPostCount(ctx, obj)
+}
+
+// MoveCategory is the resolver for the moveCategory field.
+func (r *mutationResolver) MoveCategory(ctx context.Context, input graphql1.MoveCategoryInput) (*graphql1.MoveCategoryPayload, error) {
+	return r.moveCategory(ctx, input)
+}
+
+// Category returns graphql1.CategoryResolver implementation.
+func (r *Resolver) Category() graphql1.CategoryResolver { return &categoryResolver{r} }
+
+type categoryResolver struct{ *Resolver }
@@ -0,0 +1,209 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// stubCategoryTree models categories as a parent map: news > world > europe.
+type stubCategoryTree struct {
+	parents  map[string]string
+	slugs    map[string]string
+	counts   map[string]int
+	moved    []string
+	maxDepth int
+}
+
+func newStubCategoryTree() *stubCategoryTree {
+	return &stubCategoryTree{
+		parents: map[string]string{"world": "news", "europe": "world"},
+		slugs:   map[string]string{"news": "news", "world": "world", "europe": "europe"},
+		counts:  map[string]int{"news": 7},
+	}
+}
+
+func (s *stubCategoryTree) category(id string) *gen.Category {
+	record := &gen.Category{ID: id, Slug: s.slugs[id]}
+	if parent, ok := s.parents[id]; ok {
+		record.ParentID = &parent
+	}
+	return record
+}
+
+func (s *stubCategoryTree) CategoryAncestors(_ context.Context, id string) ([]*gen.Category, error) {
+	var out []*gen.Category
+	for parent, ok := s.parents[id]; ok; parent, ok = s.parents[parent] {
+		out = append([]*gen.Category{s.category(parent)}, out...)
+	}
+	return out, nil
+}
+
+func (s *stubCategoryTree) CategoryDescendants(_ context.Context, id string, maxDepth int) ([]gen.CategoryNode, error) {
+	s.maxDepth = maxDepth
+	var out []gen.CategoryNode
+	for child, parent := range s.parents {
+		if parent == id {
+			out = append(out, gen.CategoryNode{Category: s.category(child), Depth: 1})
+		}
+	}
+	return out, nil
+}
+
+func (s *stubCategoryTree) CategoryCreatesCycle(ctx context.Context, id, parentID string) (bool, error) {
+	if id == parentID {
+		return true, nil
+	}
+	ancestors, _ := s.CategoryAncestors(ctx, parentID)
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *stubCategoryTree) CategoryPostCounts(_ context.Context, ids []string) (map[string]int, error) {
+	out := make(map[string]int, len(ids))
+	for _, id := range ids {
+		out[id] = s.counts[id]
+	}
+	return out, nil
+}
+
+func (s *stubCategoryTree) MoveCategory(ctx context.Context, id string, parentID *string, position int) (*gen.Category, error) {
+	if _, ok := s.slugs[id]; !ok {
+		return nil, nil
+	}
+	if parentID != nil {
+		if cycle, _ := s.CategoryCreatesCycle(ctx, id, *parentID); cycle {
+			return nil, gen.ErrCategoryCycle
+		}
+		s.parents[id] = *parentID
+	} else {
+		delete(s.parents, id)
+	}
+	s.moved = append(s.moved, id)
+	record := s.category(id)
+	record.Position = int32(position)
+	return record, nil
+}
+
+func graphQLCategory(id string) *graphqlpkg.Category {
+	tree := newStubCategoryTree()
+	return toGraphQLCategory(tree.category(id))
+}
+
+func TestCategoryAncestorsAndPath(t *testing.T) {
+	resolver := &Resolver{tree: newStubCategoryTree()}
+	europe := graphQLCategory("europe")
+	europe.ID = relay.ToGlobalID("Category", "europe")
+
+	ancestors, err := resolver.Category().Ancestors(context.Background(), europe)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ancestors) != 2 || ancestors[0].Slug != "news" || ancestors[1].Slug != "world" {
+		t.Fatalf("expected root-first ancestors, got %+v", ancestors)
+	}
+
+	path, err := resolver.Category().Path(context.Background(), europe)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "news/world/europe" {
+		t.Fatalf("expected breadcrumb path, got %q", path)
+	}
+
+	root := graphQLCategory("news")
+	if path, _ := resolver.Category().Path(context.Background(), root); path != "news" {
+		t.Fatalf("expected root path, got %q", path)
+	}
+}
+
+func TestCategoryDescendantsDepth(t *testing.T) {
+	tree := newStubCategoryTree()
+	resolver := &Resolver{tree: tree}
+	news := graphQLCategory("news")
+
+	children, err := resolver.Category().Children(context.Background(), news)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(children) != 1 || children[0].Slug != "world" || tree.maxDepth != 1 {
+		t.Fatalf("unexpected children %+v at depth %d", children, tree.maxDepth)
+	}
+
+	if _, err := resolver.Category().Descendants(context.Background(), news, nil); err != nil || tree.maxDepth != 0 {
+		t.Fatalf("expected unlimited depth, got %d (%v)", tree.maxDepth, err)
+	}
+	zero := 0
+	if _, err := resolver.Category().Descendants(context.Background(), news, &zero); err == nil {
+		t.Fatal("expected error for zero depth")
+	}
+}
+
+func TestCategoryPostCount(t *testing.T) {
+	resolver := &Resolver{tree: newStubCategoryTree()}
+	count, err := resolver.Category().PostCount(context.Background(), graphQLCategory("news"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 7 {
+		t.Fatalf("expected 7 posts, got %d", count)
+	}
+}
+
+func TestMoveCategory(t *testing.T) {
+	tree := newStubCategoryTree()
+	resolver := &Resolver{tree: tree}
+
+	news := relay.ToGlobalID("Category", "news")
+	payload, err := resolver.Mutation().MoveCategory(context.Background(), graphqlpkg.MoveCategoryInput{ID: "europe", NewParentID: &news})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Category == nil || payload.Category.ParentID == nil || *payload.Category.ParentID != "news" {
+		t.Fatalf("expected europe under news, got %+v", payload.Category)
+	}
+	if payload.Category.Position != maxCategoryPosition {
+		t.Fatalf("expected move to append by default, got position %d", payload.Category.Position)
+	}
+
+	europe := "europe"
+	_, err = resolver.Mutation().MoveCategory(context.Background(), graphqlpkg.MoveCategoryInput{ID: "news", NewParentID: &europe})
+	var gqlErr *gqlerrors.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Code != gqlerrors.CodeBadUserInput {
+		t.Fatalf("expected cycle to be rejected, got %v", err)
+	}
+
+	if _, err := resolver.Mutation().MoveCategory(context.Background(), graphqlpkg.MoveCategoryInput{ID: "missing"}); err == nil {
+		t.Fatal("expected not found error")
+	}
+
+	negative := -1
+	if _, err := resolver.Mutation().MoveCategory(context.Background(), graphqlpkg.MoveCategoryInput{ID: "world", Position: &negative}); err == nil {
+		t.Fatal("expected error for negative position")
+	}
+}
+
+func TestUpdateCategoryRejectsCycles(t *testing.T) {
+	resolver := &Resolver{tree: newStubCategoryTree()}
+	hooks := newEntityHooks()
+
+	europe := "europe"
+	err := hooks.BeforeUpdateCategory(context.Background(), resolver, graphqlpkg.UpdateCategoryInput{ParentID: &europe}, &gen.Category{ID: "news", ParentID: &europe})
+	if err == nil {
+		t.Fatal("expected cycle to be rejected")
+	}
+
+	world := "world"
+	if err := preventCategoryCycle(context.Background(), resolver, graphqlpkg.UpdateCategoryInput{ParentID: &world}, &gen.Category{ID: "europe", ParentID: &world}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,412 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/deicod/ermblog/contenttype"
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+const (
+	maxMetaFilters  = 10
+	maxMetaInValues = 100
+)
+
+type postMetaStore interface {
+	PostMeta(ctx context.Context, postIDs []string) (map[string]map[string]json.RawMessage, error)
+	ReplacePostMeta(ctx context.Context, postID string, values map[string]json.RawMessage, indexed []string) error
+	ReindexPostMeta(ctx context.Context, contentTypeID string, indexed []string) error
+	IndexedMetaKeys(ctx context.Context) ([]string, error)
+	FilterPosts(ctx context.Context, filter gen.PostFilter, limit, offset int) ([]*gen.Post, int, error)
+}
+
+type contentTypeProvider interface {
+	ByID(ctx context.Context, id string) (*gen.ContentType, error)
+}
+
+func (r *Resolver) postMetaStore() postMetaStore {
+	if r == nil {
+		return nil
+	}
+	if r.meta != nil {
+		return r.meta
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) contentTypeClient() contentTypeProvider {
+	if r == nil {
+		return nil
+	}
+	if r.contentTypes != nil {
+		return r.contentTypes
+	}
+	if r.ORM != nil {
+		return r.ORM.ContentTypes()
+	}
+	return nil
+}
+
+func validateContentTypeOnCreate(_ context.Context, _ *Resolver, _ graphql1.CreateContentTypeInput, model *gen.ContentType) error {
+	return validateContentType(model)
+}
+
+func validateContentTypeOnUpdate(_ context.Context, _ *Resolver, _ graphql1.UpdateContentTypeInput, model *gen.ContentType) error {
+	return validateContentType(model)
+}
+
+func validateContentType(model *gen.ContentType) error {
+	if model == nil {
+		return nil
+	}
+	if err := contenttype.ValidateName(model.Name); err != nil {
+		return gqlerrors.BadInput("name", err.Error())
+	}
+	if _, err := contenttype.ParseSupports(model.Supports); err != nil {
+		return gqlerrors.BadInput("supports", err.Error())
+	}
+	if _, err := contenttype.ParseSchema(model.Fields); err != nil {
+		return gqlerrors.BadInput("fields", err.Error())
+	}
+	return nil
+}
+
+// reindexContentTypeMeta flags the meta rows of the content type's posts
+// for the keys its updated schema marks as indexed. Existing values are not
+// revalidated; they are checked the next time a post is saved.
+func reindexContentTypeMeta(ctx context.Context, r *Resolver, record *gen.ContentType) error {
+	store := r.postMetaStore()
+	if store == nil {
+		return nil
+	}
+	schema, err := contenttype.ParseSchema(record.Fields)
+	if err != nil {
+		return gqlerrors.BadInput("fields", err.Error())
+	}
+	start := time.Now()
+	err = store.ReindexPostMeta(ctx, record.ID, schema.IndexedKeys())
+	r.recordQuery("post_meta", "reindex", start, err)
+	return err
+}
+
+// postMetaWrite is a validated meta document waiting to be stored once the
+// post itself has been saved.
+type postMetaWrite struct {
+	values  map[string]json.RawMessage
+	indexed []string
+}
+
+// preparePostMeta merges a meta patch from a post mutation into the stored
+// meta and validates the result against the post's content type. postID is
+// empty for new posts. Without a patch the stored meta is only revalidated
+// when revalidate is set, e.g. because the content type changed. A nil result
+// means there is nothing to store.
+func (r *Resolver) preparePostMeta(ctx context.Context, postID string, model *gen.Post, patch json.RawMessage, revalidate bool) (*postMetaWrite, error) {
+	if model == nil {
+		return nil, nil
+	}
+	if model.Type == string(graphql1.PostTypeCustom) && model.ContentTypeID == nil {
+		return nil, gqlerrors.BadInput("contentTypeID", "custom posts need a content type")
+	}
+	hasPatch := len(bytes.TrimSpace(patch)) > 0 && !bytes.Equal(bytes.TrimSpace(patch), []byte("null"))
+	if !hasPatch && !revalidate {
+		return nil, nil
+	}
+	var changes map[string]json.RawMessage
+	if hasPatch {
+		if err := json.Unmarshal(patch, &changes); err != nil {
+			return nil, gqlerrors.BadInput("meta", "must be a JSON object")
+		}
+	}
+	if model.ContentTypeID == nil {
+		if len(changes) > 0 {
+			return nil, gqlerrors.BadInput("meta", "posts without a content type cannot store meta")
+		}
+		return nil, nil
+	}
+	schema, err := r.contentTypeSchema(ctx, *model.ContentTypeID)
+	if err != nil {
+		return nil, err
+	}
+	store := r.postMetaStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("post meta store is not configured")
+	}
+	merged := make(map[string]json.RawMessage)
+	if postID != "" {
+		start := time.Now()
+		current, err := store.PostMeta(ctx, []string{postID})
+		r.recordQuery("post_meta", "load", start, err)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range current[postID] {
+			merged[key] = value
+		}
+	}
+	for key, value := range changes {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	if err := schema.Validate(merged); err != nil {
+		var fieldErr *contenttype.FieldError
+		if errors.As(err, &fieldErr) {
+			return nil, gqlerrors.BadInput("meta."+fieldErr.Key, fieldErr.Message)
+		}
+		return nil, err
+	}
+	return &postMetaWrite{values: merged, indexed: schema.IndexedKeys()}, nil
+}
+
+func (r *Resolver) contentTypeSchema(ctx context.Context, id string) (*contenttype.Schema, error) {
+	client := r.contentTypeClient()
+	if client == nil {
+		return nil, gqlerrors.Internal("content type provider is not configured")
+	}
+	nativeID, err := decodeContentTypeID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := client.ByID(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("ContentType")
+	}
+	schema, err := contenttype.ParseSchema(record.Fields)
+	if err != nil {
+		return nil, gqlerrors.Internal("content type has an invalid fields schema")
+	}
+	return schema, nil
+}
+
+func (r *Resolver) storePostMeta(ctx context.Context, postID string, write *postMetaWrite) error {
+	if write == nil {
+		return nil
+	}
+	store := r.postMetaStore()
+	if store == nil {
+		return gqlerrors.Internal("post meta store is not configured")
+	}
+	start := time.Now()
+	err := store.ReplacePostMeta(ctx, postID, write.values, write.indexed)
+	r.recordQuery("post_meta", "replace", start, err)
+	if err != nil {
+		return err
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.PostMeta(); loader != nil {
+			loader.Prime(postID, write.values)
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) postMeta(ctx context.Context, obj *graphql1.Post, key *string) (json.RawMessage, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	nativeID, err := decodePostID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]json.RawMessage
+	if loaders := dataloaders.FromContext(ctx); loaders != nil && loaders.PostMeta() != nil {
+		values, err = loaders.PostMeta().Load(ctx, nativeID)
+	} else if store := r.postMetaStore(); store != nil {
+		start := time.Now()
+		var all map[string]map[string]json.RawMessage
+		all, err = store.PostMeta(ctx, []string{nativeID})
+		r.recordQuery("post_meta", "load", start, err)
+		values = all[nativeID]
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return values[*key], nil
+	}
+	if values == nil {
+		values = map[string]json.RawMessage{}
+	}
+	return json.Marshal(values)
+}
+
+// filterPosts backs the posts connection. Without a where argument it lists
+// all posts like the other connections.
+func (r *Resolver) filterPosts(ctx context.Context, where *graphql1.PostWhereInput, limit, offset int) ([]*gen.Post, int, error) {
+	if where == nil {
+		if r.ORM == nil {
+			return nil, 0, gqlerrors.Internal("orm client is not configured")
+		}
+		total, err := r.ORM.Posts().Count(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		records, err := r.ORM.Posts().List(ctx, limit, offset)
+		return records, total, err
+	}
+	store := r.postMetaStore()
+	if store == nil {
+		return nil, 0, gqlerrors.Internal("post meta store is not configured")
+	}
+	filter, err := r.postFilter(ctx, store, where)
+	if err != nil {
+		return nil, 0, err
+	}
+	start := time.Now()
+	records, total, err := store.FilterPosts(ctx, filter, limit, offset)
+	r.recordQuery("posts", "filter", start, err)
+	return records, total, err
+}
+
+func (r *Resolver) postFilter(ctx context.Context, store postMetaStore, where *graphql1.PostWhereInput) (gen.PostFilter, error) {
+	var filter gen.PostFilter
+	if where.Status != nil {
+		status := string(*where.Status)
+		filter.Status = &status
+	}
+	if where.Type != nil {
+		typ := string(*where.Type)
+		filter.Type = &typ
+	}
+	if where.ContentTypeID != nil {
+		nativeID, err := decodeContentTypeID(*where.ContentTypeID)
+		if err != nil {
+			return filter, gqlerrors.BadInput("where.contentTypeID", err.Error())
+		}
+		filter.ContentTypeID = &nativeID
+	}
+	if where.AuthorID != nil {
+		nativeID, err := decodeUserID(*where.AuthorID)
+		if err != nil {
+			return filter, gqlerrors.BadInput("where.authorID", err.Error())
+		}
+		filter.AuthorID = &nativeID
+	}
+	if len(where.Meta) > maxMetaFilters {
+		return filter, gqlerrors.BadInput("where.meta", fmt.Sprintf("at most %d meta filters are allowed", maxMetaFilters))
+	}
+	var indexed []string
+	for _, condition := range where.Meta {
+		if condition == nil {
+			continue
+		}
+		meta, err := toMetaFilter(condition)
+		if err != nil {
+			return filter, err
+		}
+		if meta.Op != gen.MetaExists && meta.Op != gen.MetaMissing {
+			if indexed == nil {
+				start := time.Now()
+				indexed, err = store.IndexedMetaKeys(ctx)
+				r.recordQuery("content_types", "indexed_keys", start, err)
+				if err != nil {
+					return filter, err
+				}
+			}
+			if !slices.Contains(indexed, meta.Key) {
+				return filter, gqlerrors.BadInput("where.meta", fmt.Sprintf("meta key %q is not indexed by any content type", meta.Key))
+			}
+		}
+		filter.Meta = append(filter.Meta, meta)
+	}
+	return filter, nil
+}
+
+func toMetaFilter(condition *graphql1.PostMetaFilter) (gen.MetaFilter, error) {
+	meta := gen.MetaFilter{Key: condition.Key}
+	if meta.Key == "" {
+		return meta, gqlerrors.BadInput("where.meta.key", "key is required")
+	}
+	set := 0
+	for _, candidate := range []struct {
+		op  gen.MetaOp
+		raw json.RawMessage
+	}{
+		{gen.MetaEq, condition.Eq},
+		{gen.MetaGt, condition.Gt},
+		{gen.MetaGte, condition.Gte},
+		{gen.MetaLt, condition.Lt},
+		{gen.MetaLte, condition.Lte},
+	} {
+		if candidate.raw == nil {
+			continue
+		}
+		set++
+		value, err := decodeMetaValue(candidate.raw)
+		if err != nil {
+			return meta, gqlerrors.BadInput("where.meta."+string(candidate.op), err.Error())
+		}
+		if _, ok := value.(bool); ok && candidate.op != gen.MetaEq {
+			return meta, gqlerrors.BadInput("where.meta."+string(candidate.op), "booleans can only be compared with eq")
+		}
+		meta.Op, meta.Values = candidate.op, []any{value}
+	}
+	if condition.In != nil {
+		set++
+		if len(condition.In) == 0 || len(condition.In) > maxMetaInValues {
+			return meta, gqlerrors.BadInput("where.meta.in", fmt.Sprintf("must list between 1 and %d values", maxMetaInValues))
+		}
+		meta.Op = gen.MetaIn
+		for _, raw := range condition.In {
+			value, err := decodeMetaValue(raw)
+			if err != nil {
+				return meta, gqlerrors.BadInput("where.meta.in", err.Error())
+			}
+			if len(meta.Values) > 0 && fmt.Sprintf("%T", value) != fmt.Sprintf("%T", meta.Values[0]) {
+				return meta, gqlerrors.BadInput("where.meta.in", "values must share one type")
+			}
+			meta.Values = append(meta.Values, value)
+		}
+	}
+	if condition.Exists != nil {
+		set++
+		meta.Op = gen.MetaMissing
+		if *condition.Exists {
+			meta.Op = gen.MetaExists
+		}
+	}
+	if set != 1 {
+		return meta, gqlerrors.BadInput("where.meta", "set exactly one operator per meta filter")
+	}
+	return meta, nil
+}
+
+// decodeMetaValue turns a filter operand into the string, float64 or bool the
+// typed post_meta columns compare against.
+func decodeMetaValue(raw json.RawMessage) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, errors.New("must be valid JSON")
+	}
+	switch v := value.(type) {
+	case string, bool:
+		return v, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, errors.New("must be a finite number")
+		}
+		return f, nil
+	}
+	return nil, errors.New("must be a string, number or boolean")
+}
@@ -0,0 +1,32 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+	"encoding/json"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// ContentType is the resolver for the contentType field.
+func (r *postResolver) ContentType(ctx context.Context, obj *graphql1.Post) (*graphql1.ContentType, error) {
+	if obj == nil || obj.ContentTypeID == nil || *obj.ContentTypeID == "" {
+		return nil, nil
+	}
+	record, err := r.loadContentType(ctx, *obj.ContentTypeID)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLContentType(record), nil
+}
+
+// Meta is the resolver for the meta field.
+func (r *postResolver) Meta(ctx context.Context, obj *graphql1.Post, key *string) (json.RawMessage, error) {
+	return r.postMeta(ctx, obj, key)
+}
@@ -0,0 +1,243 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+const eventFieldsSchema = `{
+	"properties": {
+		"starts_on": {"type": "string", "format": "date", "x-indexed": true},
+		"price": {"type": "number", "minimum": 0, "x-indexed": true},
+		"notes": {"type": "string"}
+	},
+	"required": ["starts_on"]
+}`
+
+type stubContentTypes map[string]*gen.ContentType
+
+func (s stubContentTypes) ByID(_ context.Context, id string) (*gen.ContentType, error) {
+	return s[id], nil
+}
+
+type stubPostMetaStore struct {
+	meta     map[string]map[string]json.RawMessage
+	replaced map[string]json.RawMessage
+	indexed  []string
+	filter   gen.PostFilter
+}
+
+func (s *stubPostMetaStore) PostMeta(_ context.Context, postIDs []string) (map[string]map[string]json.RawMessage, error) {
+	out := make(map[string]map[string]json.RawMessage)
+	for _, id := range postIDs {
+		if values, ok := s.meta[id]; ok {
+			out[id] = values
+		}
+	}
+	return out, nil
+}
+
+func (s *stubPostMetaStore) ReplacePostMeta(_ context.Context, _ string, values map[string]json.RawMessage, indexed []string) error {
+	s.replaced, s.indexed = values, indexed
+	return nil
+}
+
+func (s *stubPostMetaStore) ReindexPostMeta(_ context.Context, _ string, indexed []string) error {
+	s.indexed = indexed
+	return nil
+}
+
+func (s *stubPostMetaStore) IndexedMetaKeys(context.Context) ([]string, error) {
+	return []string{"price", "starts_on"}, nil
+}
+
+func (s *stubPostMetaStore) FilterPosts(_ context.Context, filter gen.PostFilter, _, _ int) ([]*gen.Post, int, error) {
+	s.filter = filter
+	return []*gen.Post{{ID: "post-1"}}, 1, nil
+}
+
+func newContentTypeResolver() (*Resolver, *stubPostMetaStore) {
+	store := &stubPostMetaStore{meta: map[string]map[string]json.RawMessage{
+		"post-1": {"starts_on": json.RawMessage(`"2026-11-02"`), "notes": json.RawMessage(`"bring a laptop"`)},
+	}}
+	resolver := &Resolver{
+		hooks:        newEntityHooks(),
+		meta:         store,
+		contentTypes: stubContentTypes{"event": {ID: "event", Name: "event", Fields: json.RawMessage(eventFieldsSchema)}},
+	}
+	return resolver, store
+}
+
+func expectBadInput(t *testing.T, err error, field string) {
+	t.Helper()
+	var gqlErr *gqlerrors.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Code != gqlerrors.CodeBadUserInput || gqlErr.Field != field {
+		t.Fatalf("expected bad input on %q, got %v", field, err)
+	}
+}
+
+func TestContentTypeHooksValidateDefinitions(t *testing.T) {
+	resolver, _ := newContentTypeResolver()
+	ctx := context.Background()
+
+	valid := &gen.ContentType{Name: "event", Supports: json.RawMessage(`["title", "tags"]`), Fields: json.RawMessage(eventFieldsSchema)}
+	if err := resolver.applyBeforeCreateContentType(ctx, graphqlpkg.CreateContentTypeInput{}, valid); err != nil {
+		t.Fatalf("expected valid content type, got %v", err)
+	}
+
+	err := resolver.applyBeforeCreateContentType(ctx, graphqlpkg.CreateContentTypeInput{}, &gen.ContentType{Name: "Event"})
+	expectBadInput(t, err, "name")
+	err = resolver.applyBeforeUpdateContentType(ctx, graphqlpkg.UpdateContentTypeInput{}, &gen.ContentType{Name: "event", Supports: json.RawMessage(`["sidebar"]`)})
+	expectBadInput(t, err, "supports")
+	err = resolver.applyBeforeUpdateContentType(ctx, graphqlpkg.UpdateContentTypeInput{}, &gen.ContentType{Name: "event", Fields: json.RawMessage(`{"properties": {"when": {"type": "date"}}}`)})
+	expectBadInput(t, err, "fields")
+}
+
+func TestContentTypeUpdateReindexesMeta(t *testing.T) {
+	resolver, store := newContentTypeResolver()
+	record := &gen.ContentType{ID: "event", Name: "event", Fields: json.RawMessage(eventFieldsSchema)}
+	if err := resolver.applyAfterUpdateContentType(context.Background(), record); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.indexed) != 2 || store.indexed[0] != "price" || store.indexed[1] != "starts_on" {
+		t.Fatalf("unexpected indexed keys %v", store.indexed)
+	}
+}
+
+func TestPreparePostMetaValidatesAgainstContentType(t *testing.T) {
+	resolver, _ := newContentTypeResolver()
+	ctx := context.Background()
+	event := "event"
+
+	_, err := resolver.preparePostMeta(ctx, "", &gen.Post{Type: "custom"}, nil, true)
+	expectBadInput(t, err, "contentTypeID")
+
+	_, err = resolver.preparePostMeta(ctx, "", &gen.Post{Type: "post"}, json.RawMessage(`{"price": 3}`), true)
+	expectBadInput(t, err, "meta")
+
+	if write, err := resolver.preparePostMeta(ctx, "", &gen.Post{Type: "post"}, nil, true); err != nil || write != nil {
+		t.Fatalf("expected nothing to store without a content type, got %+v (%v)", write, err)
+	}
+
+	_, err = resolver.preparePostMeta(ctx, "", &gen.Post{Type: "custom", ContentTypeID: &event}, json.RawMessage(`{"price": 3}`), true)
+	expectBadInput(t, err, "meta.starts_on")
+
+	_, err = resolver.preparePostMeta(ctx, "", &gen.Post{Type: "custom", ContentTypeID: &event}, json.RawMessage(`["price"]`), true)
+	expectBadInput(t, err, "meta")
+
+	write, err := resolver.preparePostMeta(ctx, "", &gen.Post{Type: "custom", ContentTypeID: &event}, json.RawMessage(`{"starts_on": "2026-12-01", "price": 3}`), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(write.values) != 2 || len(write.indexed) != 2 {
+		t.Fatalf("unexpected meta write %+v", write)
+	}
+}
+
+func TestPreparePostMetaMergesUpdates(t *testing.T) {
+	resolver, store := newContentTypeResolver()
+	ctx := context.Background()
+	event := "event"
+	model := &gen.Post{ID: "post-1", Type: "custom", ContentTypeID: &event}
+
+	if write, err := resolver.preparePostMeta(ctx, "post-1", model, nil, false); err != nil || write != nil {
+		t.Fatalf("expected untouched meta to be skipped, got %+v (%v)", write, err)
+	}
+
+	write, err := resolver.preparePostMeta(ctx, "post-1", model, json.RawMessage(`{"notes": null, "price": 12.5}`), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := resolver.storePostMeta(ctx, "post-1", write); err != nil {
+		t.Fatalf("store meta: %v", err)
+	}
+	if _, ok := store.replaced["notes"]; ok || string(store.replaced["price"]) != "12.5" || string(store.replaced["starts_on"]) != `"2026-11-02"` {
+		t.Fatalf("unexpected merged meta %v", store.replaced)
+	}
+
+	_, err = resolver.preparePostMeta(ctx, "post-1", model, json.RawMessage(`{"starts_on": null}`), false)
+	expectBadInput(t, err, "meta.starts_on")
+}
+
+func TestPostMetaResolver(t *testing.T) {
+	resolver, _ := newContentTypeResolver()
+	post := &graphqlpkg.Post{ID: relay.ToGlobalID("Post", "post-1")}
+
+	key := "notes"
+	value, err := resolver.Post().Meta(context.Background(), post, &key)
+	if err != nil || string(value) != `"bring a laptop"` {
+		t.Fatalf("unexpected meta value %s (%v)", value, err)
+	}
+	missing := "price"
+	if value, err := resolver.Post().Meta(context.Background(), post, &missing); err != nil || value != nil {
+		t.Fatalf("expected null for unset key, got %s (%v)", value, err)
+	}
+	all, err := resolver.Post().Meta(context.Background(), post, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(all, &decoded); err != nil || len(decoded) != 2 {
+		t.Fatalf("expected whole meta object, got %s (%v)", all, err)
+	}
+}
+
+func TestFilterPostsByMeta(t *testing.T) {
+	resolver, store := newContentTypeResolver()
+	ctx := context.Background()
+	published := graphqlpkg.PostStatusPublished
+	exists := false
+	where := &graphqlpkg.PostWhereInput{
+		Status:        &published,
+		ContentTypeID: ptr(relay.ToGlobalID("ContentType", "event")),
+		Meta: []*graphqlpkg.PostMetaFilter{
+			{Key: "price", Lte: json.RawMessage(`20`)},
+			{Key: "starts_on", In: []json.RawMessage{json.RawMessage(`"2026-11-02"`), json.RawMessage(`"2026-11-03"`)}},
+			{Key: "notes", Exists: &exists},
+		},
+	}
+	records, total, err := resolver.filterPosts(ctx, where, 20, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 || total != 1 {
+		t.Fatalf("unexpected result %v / %d", records, total)
+	}
+	filter := store.filter
+	if *filter.Status != "published" || *filter.ContentTypeID != "event" || len(filter.Meta) != 3 {
+		t.Fatalf("unexpected filter %+v", filter)
+	}
+	if filter.Meta[0].Op != gen.MetaLte || filter.Meta[0].Values[0] != 20.0 {
+		t.Fatalf("unexpected price filter %+v", filter.Meta[0])
+	}
+	if filter.Meta[1].Op != gen.MetaIn || len(filter.Meta[1].Values) != 2 || filter.Meta[2].Op != gen.MetaMissing {
+		t.Fatalf("unexpected meta filters %+v", filter.Meta)
+	}
+}
+
+func TestFilterPostsRejectsInvalidMetaFilters(t *testing.T) {
+	resolver, _ := newContentTypeResolver()
+	ctx := context.Background()
+	yes := true
+	for field, condition := range map[string]*graphqlpkg.PostMetaFilter{
+		"where.meta":     {Key: "notes", Eq: json.RawMessage(`"x"`)},
+		"where.meta.gt":  {Key: "price", Gt: json.RawMessage(`true`)},
+		"where.meta.in":  {Key: "price", In: []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`"2"`)}},
+		"where.meta.eq":  {Key: "price", Eq: json.RawMessage(`{"a": 1}`)},
+		"where.meta.key": {Key: "", Exists: &yes},
+	} {
+		_, _, err := resolver.filterPosts(ctx, &graphqlpkg.PostWhereInput{Meta: []*graphqlpkg.PostMetaFilter{condition}}, 20, 0)
+		expectBadInput(t, err, field)
+	}
+	_, _, err := resolver.filterPosts(ctx, &graphqlpkg.PostWhereInput{Meta: []*graphqlpkg.PostMetaFilter{
+		{Key: "price", Eq: json.RawMessage(`1`), Exists: &yes},
+	}}, 20, 0)
+	expectBadInput(t, err, "where.meta")
+}
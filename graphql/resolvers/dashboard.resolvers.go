@@ -7,38 +7,45 @@ package resolvers
 import (
 	"context"
 
+	"golang.org/x/sync/errgroup"
+
 	graphql1 "github.com/deicod/ermblog/graphql"
 )
 
-// ManagementStats is the resolver for the managementStats field.
-func (r *queryResolver) ManagementStats(ctx context.Context) (*graphql1.ManagementStats, error) {
-	posts, err := r.countThrough(ctx, "posts", r.postCounter())
-	if err != nil {
-		return nil, err
+// Author is the resolver for the author field.
+func (r *authorLeaderboardEntryResolver) Author(ctx context.Context, obj *graphql1.AuthorLeaderboardEntry) (*graphql1.User, error) {
+	if obj == nil || obj.AuthorID == "" {
+		return nil, nil
 	}
-
-	comments, err := r.countThrough(ctx, "comments", r.commentCounter())
-	if err != nil {
-		return nil, err
-	}
-
-	mediaItems, err := r.countThrough(ctx, "medias", r.mediaCounter())
-	if err != nil {
-		return nil, err
-	}
-
-	categories, err := r.countThrough(ctx, "categories", r.categoryCounter())
+	user, err := r.loadUser(ctx, obj.AuthorID)
 	if err != nil {
 		return nil, err
 	}
+	return toGraphQLUser(user), nil
+}
 
-	tags, err := r.countThrough(ctx, "tags", r.tagCounter())
-	if err != nil {
-		return nil, err
+// ManagementStats is the resolver for the managementStats field.
+func (r *queryResolver) ManagementStats(ctx context.Context) (*graphql1.ManagementStats, error) {
+	var posts, comments, mediaItems, categories, tags, users int
+	g, gctx := errgroup.WithContext(ctx)
+	for _, count := range []struct {
+		entity string
+		repo   counter
+		dest   *int
+	}{
+		{"posts", r.postCounter(), &posts},
+		{"comments", r.commentCounter(), &comments},
+		{"medias", r.mediaCounter(), &mediaItems},
+		{"categories", r.categoryCounter(), &categories},
+		{"tags", r.tagCounter(), &tags},
+		{"users", r.userCounter(), &users},
+	} {
+		g.Go(func() (err error) {
+			*count.dest, err = r.countThrough(gctx, count.entity, count.repo)
+			return err
+		})
 	}
-
-	users, err := r.countThrough(ctx, "users", r.userCounter())
-	if err != nil {
+	if err := g.Wait(); err != nil {
 		return nil, err
 	}
 
@@ -47,8 +54,34 @@ func (r *queryResolver) ManagementStats(ctx context.Context) (*graphql1.Manageme
 		Comments:   comments,
 		MediaItems: mediaItems,
 		Taxonomies: categories + tags,
+		Categories: categories,
+		Tags:       tags,
 		Users:      users,
 	}
 
 	return stats, nil
 }
+
+// ManagementTrends is the resolver for the managementTrends field.
+func (r *queryResolver) ManagementTrends(ctx context.Context, rangeArg *graphql1.TrendRange, interval *graphql1.TrendInterval, authors *int) (*graphql1.ManagementTrends, error) {
+	selectedRange := graphql1.TrendRangeLast30Days
+	if rangeArg != nil {
+		selectedRange = *rangeArg
+	}
+	selectedInterval := graphql1.TrendIntervalDay
+	if interval != nil {
+		selectedInterval = *interval
+	}
+	limit := defaultLeaderboardSize
+	if authors != nil {
+		limit = *authors
+	}
+	return r.managementTrends(ctx, selectedRange, selectedInterval, limit)
+}
+
+// AuthorLeaderboardEntry returns graphql1.AuthorLeaderboardEntryResolver implementation.
+func (r *Resolver) AuthorLeaderboardEntry() graphql1.AuthorLeaderboardEntryResolver {
+	return &authorLeaderboardEntryResolver{r}
+}
+
+type authorLeaderboardEntryResolver struct{ *Resolver }
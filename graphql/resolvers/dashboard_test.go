@@ -3,8 +3,12 @@ package resolvers
 import (
 	"context"
 	"errors"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	gqlgraphql "github.com/99designs/gqlgen/graphql"
@@ -13,6 +17,7 @@ import (
 	graphqlpkg "github.com/deicod/ermblog/graphql"
 	"github.com/deicod/ermblog/graphql/directives"
 	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
 )
 
 type stubCounter struct {
@@ -237,3 +242,126 @@ func TestManagementStatsReturnsCountsWhenAuthorized(t *testing.T) {
 		t.Fatalf("unexpected stats: %#v", stats)
 	}
 }
+
+type stubTrendSource struct {
+	mu      sync.Mutex
+	rows    map[gen.TrendMetric][]gen.TrendRow
+	authors []gen.AuthorTrend
+	err     error
+	calls   []string
+}
+
+func (s *stubTrendSource) Trend(_ context.Context, metric gen.TrendMetric, unit string, from, split, to time.Time) ([]gen.TrendRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, string(metric)+":"+unit+":"+from.Format(time.DateOnly)+":"+split.Format(time.DateOnly)+":"+to.Format(time.DateOnly))
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.rows[metric], nil
+}
+
+func (s *stubTrendSource) TopAuthors(_ context.Context, _, _, _ time.Time, limit int) ([]gen.AuthorTrend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, "authors:"+strconv.Itoa(limit))
+	return s.authors, nil
+}
+
+func TestManagementTrendsBucketsAndComparesPeriods(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC) }
+	source := &stubTrendSource{
+		rows: map[gen.TrendMetric][]gen.TrendRow{
+			gen.TrendPostsPublished: {
+				{Bucket: day(6), Current: 0, Previous: 4},
+				{Bucket: day(14), Current: 2},
+				{Bucket: day(18), Current: 3},
+			},
+			gen.TrendComments: {
+				{Bucket: day(15), Key: "approved", Current: 5, Previous: 1},
+				{Bucket: day(15), Key: "spam", Current: 2},
+			},
+		},
+		authors: []gen.AuthorTrend{{AuthorID: "author-1", Current: 4, Previous: 1}},
+	}
+	resolver := &Resolver{trends: source, now: func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }}
+
+	rangeArg := graphqlpkg.TrendRangeLast7Days
+	trends, err := resolver.Query().ManagementTrends(context.Background(), &rangeArg, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !trends.From.Equal(time.Date(2026, 10, 12, 12, 0, 0, 0, time.UTC)) || !trends.PreviousFrom.Equal(time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window: %s – %s", trends.PreviousFrom, trends.From)
+	}
+	posts := trends.PostsPublished
+	if len(posts.Buckets) != 8 || !posts.Buckets[0].Start.Equal(day(12)) {
+		t.Fatalf("expected 8 daily buckets from Oct 12, got %d starting %v", len(posts.Buckets), posts.Buckets[0].Start)
+	}
+	if posts.Buckets[2].Count != 2 || posts.Buckets[6].Count != 3 || posts.Buckets[1].Count != 0 {
+		t.Fatalf("unexpected bucket counts: %+v", posts.Buckets)
+	}
+	if posts.Total != 5 || posts.PreviousTotal != 4 || posts.Change == nil || *posts.Change != 0.25 {
+		t.Fatalf("unexpected totals: %+v", posts)
+	}
+	if trends.MediaUploaded.Total != 0 || trends.MediaUploaded.Change != nil {
+		t.Fatalf("expected empty media series without change, got %+v", trends.MediaUploaded)
+	}
+
+	if trends.Comments.Total != 7 || len(trends.CommentsByStatus) != len(graphqlpkg.AllCommentStatus) {
+		t.Fatalf("unexpected comment trends: %+v", trends.Comments)
+	}
+	for _, entry := range trends.CommentsByStatus {
+		want := map[graphqlpkg.CommentStatus]int{"approved": 5, "spam": 2}[entry.Status]
+		if entry.Series.Total != want {
+			t.Fatalf("expected %d %s comments, got %d", want, entry.Status, entry.Series.Total)
+		}
+	}
+
+	if len(trends.TopAuthors) != 1 || trends.TopAuthors[0].AuthorID != "author-1" || trends.TopAuthors[0].Posts != 4 || trends.TopAuthors[0].PreviousPosts != 1 {
+		t.Fatalf("unexpected leaderboard: %+v", trends.TopAuthors)
+	}
+
+	sort.Strings(source.calls)
+	want := []string{
+		"authors:5",
+		"comments:day:2026-10-05:2026-10-12:2026-10-19",
+		"media_uploaded:day:2026-10-05:2026-10-12:2026-10-19",
+		"new_users:day:2026-10-05:2026-10-12:2026-10-19",
+		"posts_published:day:2026-10-05:2026-10-12:2026-10-19",
+	}
+	if strings.Join(source.calls, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected source calls: %v", source.calls)
+	}
+}
+
+func TestManagementTrendsWeeklyBucketsStartOnMonday(t *testing.T) {
+	resolver := &Resolver{trends: &stubTrendSource{}, now: func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }}
+
+	interval := graphqlpkg.TrendIntervalWeek
+	trends, err := resolver.Query().ManagementTrends(context.Background(), nil, &interval, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, bucket := range trends.NewUsers.Buckets {
+		if bucket.Start.Weekday() != time.Monday {
+			t.Fatalf("expected Monday bucket, got %s", bucket.Start)
+		}
+	}
+	if first := trends.NewUsers.Buckets[0].Start; first.After(trends.From) {
+		t.Fatalf("expected first bucket to cover %s, got %s", trends.From, first)
+	}
+}
+
+func TestManagementTrendsPropagatesErrorsAndValidatesAuthors(t *testing.T) {
+	resolver := &Resolver{trends: &stubTrendSource{err: errors.New("boom")}}
+	if _, err := resolver.Query().ManagementTrends(context.Background(), nil, nil, nil); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected source error, got %v", err)
+	}
+
+	tooMany := 500
+	if _, err := resolver.Query().ManagementTrends(context.Background(), nil, nil, &tooMany); err == nil {
+		t.Fatal("expected error for oversized leaderboard")
+	}
+}
@@ -11,62 +11,97 @@ import (
 )
 
 type entityHooks struct {
-	BeforeCreateCategory func(ctx context.Context, r *Resolver, input graphql.CreateCategoryInput, model *gen.Category) error
-	AfterCreateCategory  func(ctx context.Context, r *Resolver, record *gen.Category) error
-	BeforeUpdateCategory func(ctx context.Context, r *Resolver, input graphql.UpdateCategoryInput, model *gen.Category) error
-	AfterUpdateCategory  func(ctx context.Context, r *Resolver, record *gen.Category) error
-	BeforeDeleteCategory func(ctx context.Context, r *Resolver, input graphql.DeleteCategoryInput, id string) error
-	AfterDeleteCategory  func(ctx context.Context, r *Resolver, input graphql.DeleteCategoryInput, id string) error
-	BeforeReturnCategory func(ctx context.Context, r *Resolver, record *gen.Category) error
-	BeforeCreateComment  func(ctx context.Context, r *Resolver, input graphql.CreateCommentInput, model *gen.Comment) error
-	AfterCreateComment   func(ctx context.Context, r *Resolver, record *gen.Comment) error
-	BeforeUpdateComment  func(ctx context.Context, r *Resolver, input graphql.UpdateCommentInput, model *gen.Comment) error
-	AfterUpdateComment   func(ctx context.Context, r *Resolver, record *gen.Comment) error
-	BeforeDeleteComment  func(ctx context.Context, r *Resolver, input graphql.DeleteCommentInput, id string) error
-	AfterDeleteComment   func(ctx context.Context, r *Resolver, input graphql.DeleteCommentInput, id string) error
-	BeforeReturnComment  func(ctx context.Context, r *Resolver, record *gen.Comment) error
-	BeforeCreateMedia    func(ctx context.Context, r *Resolver, input graphql.CreateMediaInput, model *gen.Media) error
-	AfterCreateMedia     func(ctx context.Context, r *Resolver, record *gen.Media) error
-	BeforeUpdateMedia    func(ctx context.Context, r *Resolver, input graphql.UpdateMediaInput, model *gen.Media) error
-	AfterUpdateMedia     func(ctx context.Context, r *Resolver, record *gen.Media) error
-	BeforeDeleteMedia    func(ctx context.Context, r *Resolver, input graphql.DeleteMediaInput, id string) error
-	AfterDeleteMedia     func(ctx context.Context, r *Resolver, input graphql.DeleteMediaInput, id string) error
-	BeforeReturnMedia    func(ctx context.Context, r *Resolver, record *gen.Media) error
-	BeforeCreateOption   func(ctx context.Context, r *Resolver, input graphql.CreateOptionInput, model *gen.Option) error
-	AfterCreateOption    func(ctx context.Context, r *Resolver, record *gen.Option) error
-	BeforeUpdateOption   func(ctx context.Context, r *Resolver, input graphql.UpdateOptionInput, model *gen.Option) error
-	AfterUpdateOption    func(ctx context.Context, r *Resolver, record *gen.Option) error
-	BeforeDeleteOption   func(ctx context.Context, r *Resolver, input graphql.DeleteOptionInput, id string) error
-	AfterDeleteOption    func(ctx context.Context, r *Resolver, input graphql.DeleteOptionInput, id string) error
-	BeforeReturnOption   func(ctx context.Context, r *Resolver, record *gen.Option) error
-	BeforeCreatePost     func(ctx context.Context, r *Resolver, input graphql.CreatePostInput, model *gen.Post) error
-	AfterCreatePost      func(ctx context.Context, r *Resolver, record *gen.Post) error
-	BeforeUpdatePost     func(ctx context.Context, r *Resolver, input graphql.UpdatePostInput, model *gen.Post) error
-	AfterUpdatePost      func(ctx context.Context, r *Resolver, record *gen.Post) error
-	BeforeDeletePost     func(ctx context.Context, r *Resolver, input graphql.DeletePostInput, id string) error
-	AfterDeletePost      func(ctx context.Context, r *Resolver, input graphql.DeletePostInput, id string) error
-	BeforeReturnPost     func(ctx context.Context, r *Resolver, record *gen.Post) error
-	BeforeCreateRole     func(ctx context.Context, r *Resolver, input graphql.CreateRoleInput, model *gen.Role) error
-	AfterCreateRole      func(ctx context.Context, r *Resolver, record *gen.Role) error
-	BeforeUpdateRole     func(ctx context.Context, r *Resolver, input graphql.UpdateRoleInput, model *gen.Role) error
-	AfterUpdateRole      func(ctx context.Context, r *Resolver, record *gen.Role) error
-	BeforeDeleteRole     func(ctx context.Context, r *Resolver, input graphql.DeleteRoleInput, id string) error
-	AfterDeleteRole      func(ctx context.Context, r *Resolver, input graphql.DeleteRoleInput, id string) error
-	BeforeReturnRole     func(ctx context.Context, r *Resolver, record *gen.Role) error
-	BeforeCreateTag      func(ctx context.Context, r *Resolver, input graphql.CreateTagInput, model *gen.Tag) error
-	AfterCreateTag       func(ctx context.Context, r *Resolver, record *gen.Tag) error
-	BeforeUpdateTag      func(ctx context.Context, r *Resolver, input graphql.UpdateTagInput, model *gen.Tag) error
-	AfterUpdateTag       func(ctx context.Context, r *Resolver, record *gen.Tag) error
-	BeforeDeleteTag      func(ctx context.Context, r *Resolver, input graphql.DeleteTagInput, id string) error
-	AfterDeleteTag       func(ctx context.Context, r *Resolver, input graphql.DeleteTagInput, id string) error
-	BeforeReturnTag      func(ctx context.Context, r *Resolver, record *gen.Tag) error
-	BeforeCreateUser     func(ctx context.Context, r *Resolver, input graphql.CreateUserInput, model *gen.User) error
-	AfterCreateUser      func(ctx context.Context, r *Resolver, record *gen.User) error
-	BeforeUpdateUser     func(ctx context.Context, r *Resolver, input graphql.UpdateUserInput, model *gen.User) error
-	AfterUpdateUser      func(ctx context.Context, r *Resolver, record *gen.User) error
-	BeforeDeleteUser     func(ctx context.Context, r *Resolver, input graphql.DeleteUserInput, id string) error
-	AfterDeleteUser      func(ctx context.Context, r *Resolver, input graphql.DeleteUserInput, id string) error
-	BeforeReturnUser     func(ctx context.Context, r *Resolver, record *gen.User) error
+	BeforeCreateCategory    func(ctx context.Context, r *Resolver, input graphql.CreateCategoryInput, model *gen.Category) error
+	AfterCreateCategory     func(ctx context.Context, r *Resolver, record *gen.Category) error
+	BeforeUpdateCategory    func(ctx context.Context, r *Resolver, input graphql.UpdateCategoryInput, model *gen.Category) error
+	AfterUpdateCategory     func(ctx context.Context, r *Resolver, record *gen.Category) error
+	BeforeDeleteCategory    func(ctx context.Context, r *Resolver, input graphql.DeleteCategoryInput, id string) error
+	AfterDeleteCategory     func(ctx context.Context, r *Resolver, input graphql.DeleteCategoryInput, id string) error
+	BeforeReturnCategory    func(ctx context.Context, r *Resolver, record *gen.Category) error
+	BeforeCreateComment     func(ctx context.Context, r *Resolver, input graphql.CreateCommentInput, model *gen.Comment) error
+	AfterCreateComment      func(ctx context.Context, r *Resolver, record *gen.Comment) error
+	BeforeUpdateComment     func(ctx context.Context, r *Resolver, input graphql.UpdateCommentInput, model *gen.Comment) error
+	AfterUpdateComment      func(ctx context.Context, r *Resolver, record *gen.Comment) error
+	BeforeDeleteComment     func(ctx context.Context, r *Resolver, input graphql.DeleteCommentInput, id string) error
+	AfterDeleteComment      func(ctx context.Context, r *Resolver, input graphql.DeleteCommentInput, id string) error
+	BeforeReturnComment     func(ctx context.Context, r *Resolver, record *gen.Comment) error
+	BeforeCreateContentType func(ctx context.Context, r *Resolver, input graphql.CreateContentTypeInput, model *gen.ContentType) error
+	AfterCreateContentType  func(ctx context.Context, r *Resolver, record *gen.ContentType) error
+	BeforeUpdateContentType func(ctx context.Context, r *Resolver, input graphql.UpdateContentTypeInput, model *gen.ContentType) error
+	AfterUpdateContentType  func(ctx context.Context, r *Resolver, record *gen.ContentType) error
+	BeforeDeleteContentType func(ctx context.Context, r *Resolver, input graphql.DeleteContentTypeInput, id string) error
+	AfterDeleteContentType  func(ctx context.Context, r *Resolver, input graphql.DeleteContentTypeInput, id string) error
+	BeforeReturnContentType func(ctx context.Context, r *Resolver, record *gen.ContentType) error
+	BeforeCreateMedia       func(ctx context.Context, r *Resolver, input graphql.CreateMediaInput, model *gen.Media) error
+	AfterCreateMedia        func(ctx context.Context, r *Resolver, record *gen.Media) error
+	BeforeUpdateMedia       func(ctx context.Context, r *Resolver, input graphql.UpdateMediaInput, model *gen.Media) error
+	AfterUpdateMedia        func(ctx context.Context, r *Resolver, record *gen.Media) error
+	BeforeDeleteMedia       func(ctx context.Context, r *Resolver, input graphql.DeleteMediaInput, id string) error
+	AfterDeleteMedia        func(ctx context.Context, r *Resolver, input graphql.DeleteMediaInput, id string) error
+	BeforeReturnMedia       func(ctx context.Context, r *Resolver, record *gen.Media) error
+	BeforeCreateMenu        func(ctx context.Context, r *Resolver, input graphql.CreateMenuInput, model *gen.Menu) error
+	AfterCreateMenu         func(ctx context.Context, r *Resolver, record *gen.Menu) error
+	BeforeUpdateMenu        func(ctx context.Context, r *Resolver, input graphql.UpdateMenuInput, model *gen.Menu) error
+	AfterUpdateMenu         func(ctx context.Context, r *Resolver, record *gen.Menu) error
+	BeforeDeleteMenu        func(ctx context.Context, r *Resolver, input graphql.DeleteMenuInput, id string) error
+	AfterDeleteMenu         func(ctx context.Context, r *Resolver, input graphql.DeleteMenuInput, id string) error
+	BeforeReturnMenu        func(ctx context.Context, r *Resolver, record *gen.Menu) error
+	BeforeCreateMenuItem    func(ctx context.Context, r *Resolver, input graphql.CreateMenuItemInput, model *gen.MenuItem) error
+	AfterCreateMenuItem     func(ctx context.Context, r *Resolver, record *gen.MenuItem) error
+	BeforeUpdateMenuItem    func(ctx context.Context, r *Resolver, input graphql.UpdateMenuItemInput, model *gen.MenuItem) error
+	AfterUpdateMenuItem     func(ctx context.Context, r *Resolver, record *gen.MenuItem) error
+	BeforeDeleteMenuItem    func(ctx context.Context, r *Resolver, input graphql.DeleteMenuItemInput, id string) error
+	AfterDeleteMenuItem     func(ctx context.Context, r *Resolver, input graphql.DeleteMenuItemInput, id string) error
+	BeforeReturnMenuItem    func(ctx context.Context, r *Resolver, record *gen.MenuItem) error
+	BeforeCreateOption      func(ctx context.Context, r *Resolver, input graphql.CreateOptionInput, model *gen.Option) error
+	AfterCreateOption       func(ctx context.Context, r *Resolver, record *gen.Option) error
+	BeforeUpdateOption      func(ctx context.Context, r *Resolver, input graphql.UpdateOptionInput, model *gen.Option) error
+	AfterUpdateOption       func(ctx context.Context, r *Resolver, record *gen.Option) error
+	BeforeDeleteOption      func(ctx context.Context, r *Resolver, input graphql.DeleteOptionInput, id string) error
+	AfterDeleteOption       func(ctx context.Context, r *Resolver, input graphql.DeleteOptionInput, id string) error
+	BeforeReturnOption      func(ctx context.Context, r *Resolver, record *gen.Option) error
+	BeforeCreatePost        func(ctx context.Context, r *Resolver, input graphql.CreatePostInput, model *gen.Post) error
+	AfterCreatePost         func(ctx context.Context, r *Resolver, record *gen.Post) error
+	BeforeUpdatePost        func(ctx context.Context, r *Resolver, input graphql.UpdatePostInput, model *gen.Post) error
+	AfterUpdatePost         func(ctx context.Context, r *Resolver, record *gen.Post) error
+	BeforeDeletePost        func(ctx context.Context, r *Resolver, input graphql.DeletePostInput, id string) error
+	AfterDeletePost         func(ctx context.Context, r *Resolver, input graphql.DeletePostInput, id string) error
+	BeforeReturnPost        func(ctx context.Context, r *Resolver, record *gen.Post) error
+	BeforeCreateRole        func(ctx context.Context, r *Resolver, input graphql.CreateRoleInput, model *gen.Role) error
+	AfterCreateRole         func(ctx context.Context, r *Resolver, record *gen.Role) error
+	BeforeUpdateRole        func(ctx context.Context, r *Resolver, input graphql.UpdateRoleInput, model *gen.Role) error
+	AfterUpdateRole         func(ctx context.Context, r *Resolver, record *gen.Role) error
+	BeforeDeleteRole        func(ctx context.Context, r *Resolver, input graphql.DeleteRoleInput, id string) error
+	AfterDeleteRole         func(ctx context.Context, r *Resolver, input graphql.DeleteRoleInput, id string) error
+	BeforeReturnRole        func(ctx context.Context, r *Resolver, record *gen.Role) error
+	BeforeCreateSlugHistory func(ctx context.Context, r *Resolver, input graphql.CreateSlugHistoryInput, model *gen.SlugHistory) error
+	AfterCreateSlugHistory  func(ctx context.Context, r *Resolver, record *gen.SlugHistory) error
+	BeforeUpdateSlugHistory func(ctx context.Context, r *Resolver, input graphql.UpdateSlugHistoryInput, model *gen.SlugHistory) error
+	AfterUpdateSlugHistory  func(ctx context.Context, r *Resolver, record *gen.SlugHistory) error
+	BeforeDeleteSlugHistory func(ctx context.Context, r *Resolver, input graphql.DeleteSlugHistoryInput, id string) error
+	AfterDeleteSlugHistory  func(ctx context.Context, r *Resolver, input graphql.DeleteSlugHistoryInput, id string) error
+	BeforeReturnSlugHistory func(ctx context.Context, r *Resolver, record *gen.SlugHistory) error
+	BeforeCreateTag         func(ctx context.Context, r *Resolver, input graphql.CreateTagInput, model *gen.Tag) error
+	AfterCreateTag          func(ctx context.Context, r *Resolver, record *gen.Tag) error
+	BeforeUpdateTag         func(ctx context.Context, r *Resolver, input graphql.UpdateTagInput, model *gen.Tag) error
+	AfterUpdateTag          func(ctx context.Context, r *Resolver, record *gen.Tag) error
+	BeforeDeleteTag         func(ctx context.Context, r *Resolver, input graphql.DeleteTagInput, id string) error
+	AfterDeleteTag          func(ctx context.Context, r *Resolver, input graphql.DeleteTagInput, id string) error
+	BeforeReturnTag         func(ctx context.Context, r *Resolver, record *gen.Tag) error
+	BeforeCreateUser        func(ctx context.Context, r *Resolver, input graphql.CreateUserInput, model *gen.User) error
+	AfterCreateUser         func(ctx context.Context, r *Resolver, record *gen.User) error
+	BeforeUpdateUser        func(ctx context.Context, r *Resolver, input graphql.UpdateUserInput, model *gen.User) error
+	AfterUpdateUser         func(ctx context.Context, r *Resolver, record *gen.User) error
+	BeforeDeleteUser        func(ctx context.Context, r *Resolver, input graphql.DeleteUserInput, id string) error
+	AfterDeleteUser         func(ctx context.Context, r *Resolver, input graphql.DeleteUserInput, id string) error
+	BeforeReturnUser        func(ctx context.Context, r *Resolver, record *gen.User) error
+	BeforeCreateUserMeta    func(ctx context.Context, r *Resolver, input graphql.CreateUserMetaInput, model *gen.UserMeta) error
+	AfterCreateUserMeta     func(ctx context.Context, r *Resolver, record *gen.UserMeta) error
+	BeforeUpdateUserMeta    func(ctx context.Context, r *Resolver, input graphql.UpdateUserMetaInput, model *gen.UserMeta) error
+	AfterUpdateUserMeta     func(ctx context.Context, r *Resolver, record *gen.UserMeta) error
+	BeforeDeleteUserMeta    func(ctx context.Context, r *Resolver, input graphql.DeleteUserMetaInput, id string) error
+	AfterDeleteUserMeta     func(ctx context.Context, r *Resolver, input graphql.DeleteUserMetaInput, id string) error
+	BeforeReturnUserMeta    func(ctx context.Context, r *Resolver, record *gen.UserMeta) error
 }
 
 func (r *Resolver) applyBeforeCreateCategory(ctx context.Context, input graphql.CreateCategoryInput, model *gen.Category) error {
@@ -167,6 +202,55 @@ func (r *Resolver) applyBeforeReturnComment(ctx context.Context, record *gen.Com
 	return r.hooks.BeforeReturnComment(ctx, r, record)
 }
 
+func (r *Resolver) applyBeforeCreateContentType(ctx context.Context, input graphql.CreateContentTypeInput, model *gen.ContentType) error {
+	if r == nil || r.hooks.BeforeCreateContentType == nil {
+		return nil
+	}
+	return r.hooks.BeforeCreateContentType(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterCreateContentType(ctx context.Context, record *gen.ContentType) error {
+	if r == nil || record == nil || r.hooks.AfterCreateContentType == nil {
+		return nil
+	}
+	return r.hooks.AfterCreateContentType(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeUpdateContentType(ctx context.Context, input graphql.UpdateContentTypeInput, model *gen.ContentType) error {
+	if r == nil || r.hooks.BeforeUpdateContentType == nil {
+		return nil
+	}
+	return r.hooks.BeforeUpdateContentType(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterUpdateContentType(ctx context.Context, record *gen.ContentType) error {
+	if r == nil || record == nil || r.hooks.AfterUpdateContentType == nil {
+		return nil
+	}
+	return r.hooks.AfterUpdateContentType(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeDeleteContentType(ctx context.Context, input graphql.DeleteContentTypeInput, id string) error {
+	if r == nil || r.hooks.BeforeDeleteContentType == nil {
+		return nil
+	}
+	return r.hooks.BeforeDeleteContentType(ctx, r, input, id)
+}
+
+func (r *Resolver) applyAfterDeleteContentType(ctx context.Context, input graphql.DeleteContentTypeInput, id string) error {
+	if r == nil || r.hooks.AfterDeleteContentType == nil {
+		return nil
+	}
+	return r.hooks.AfterDeleteContentType(ctx, r, input, id)
+}
+
+func (r *Resolver) applyBeforeReturnContentType(ctx context.Context, record *gen.ContentType) error {
+	if r == nil || record == nil || r.hooks.BeforeReturnContentType == nil {
+		return nil
+	}
+	return r.hooks.BeforeReturnContentType(ctx, r, record)
+}
+
 func (r *Resolver) applyBeforeCreateMedia(ctx context.Context, input graphql.CreateMediaInput, model *gen.Media) error {
 	if r == nil || r.hooks.BeforeCreateMedia == nil {
 		return nil
@@ -216,6 +300,104 @@ func (r *Resolver) applyBeforeReturnMedia(ctx context.Context, record *gen.Media
 	return r.hooks.BeforeReturnMedia(ctx, r, record)
 }
 
+func (r *Resolver) applyBeforeCreateMenu(ctx context.Context, input graphql.CreateMenuInput, model *gen.Menu) error {
+	if r == nil || r.hooks.BeforeCreateMenu == nil {
+		return nil
+	}
+	return r.hooks.BeforeCreateMenu(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterCreateMenu(ctx context.Context, record *gen.Menu) error {
+	if r == nil || record == nil || r.hooks.AfterCreateMenu == nil {
+		return nil
+	}
+	return r.hooks.AfterCreateMenu(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeUpdateMenu(ctx context.Context, input graphql.UpdateMenuInput, model *gen.Menu) error {
+	if r == nil || r.hooks.BeforeUpdateMenu == nil {
+		return nil
+	}
+	return r.hooks.BeforeUpdateMenu(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterUpdateMenu(ctx context.Context, record *gen.Menu) error {
+	if r == nil || record == nil || r.hooks.AfterUpdateMenu == nil {
+		return nil
+	}
+	return r.hooks.AfterUpdateMenu(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeDeleteMenu(ctx context.Context, input graphql.DeleteMenuInput, id string) error {
+	if r == nil || r.hooks.BeforeDeleteMenu == nil {
+		return nil
+	}
+	return r.hooks.BeforeDeleteMenu(ctx, r, input, id)
+}
+
+func (r *Resolver) applyAfterDeleteMenu(ctx context.Context, input graphql.DeleteMenuInput, id string) error {
+	if r == nil || r.hooks.AfterDeleteMenu == nil {
+		return nil
+	}
+	return r.hooks.AfterDeleteMenu(ctx, r, input, id)
+}
+
+func (r *Resolver) applyBeforeReturnMenu(ctx context.Context, record *gen.Menu) error {
+	if r == nil || record == nil || r.hooks.BeforeReturnMenu == nil {
+		return nil
+	}
+	return r.hooks.BeforeReturnMenu(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeCreateMenuItem(ctx context.Context, input graphql.CreateMenuItemInput, model *gen.MenuItem) error {
+	if r == nil || r.hooks.BeforeCreateMenuItem == nil {
+		return nil
+	}
+	return r.hooks.BeforeCreateMenuItem(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterCreateMenuItem(ctx context.Context, record *gen.MenuItem) error {
+	if r == nil || record == nil || r.hooks.AfterCreateMenuItem == nil {
+		return nil
+	}
+	return r.hooks.AfterCreateMenuItem(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeUpdateMenuItem(ctx context.Context, input graphql.UpdateMenuItemInput, model *gen.MenuItem) error {
+	if r == nil || r.hooks.BeforeUpdateMenuItem == nil {
+		return nil
+	}
+	return r.hooks.BeforeUpdateMenuItem(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterUpdateMenuItem(ctx context.Context, record *gen.MenuItem) error {
+	if r == nil || record == nil || r.hooks.AfterUpdateMenuItem == nil {
+		return nil
+	}
+	return r.hooks.AfterUpdateMenuItem(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeDeleteMenuItem(ctx context.Context, input graphql.DeleteMenuItemInput, id string) error {
+	if r == nil || r.hooks.BeforeDeleteMenuItem == nil {
+		return nil
+	}
+	return r.hooks.BeforeDeleteMenuItem(ctx, r, input, id)
+}
+
+func (r *Resolver) applyAfterDeleteMenuItem(ctx context.Context, input graphql.DeleteMenuItemInput, id string) error {
+	if r == nil || r.hooks.AfterDeleteMenuItem == nil {
+		return nil
+	}
+	return r.hooks.AfterDeleteMenuItem(ctx, r, input, id)
+}
+
+func (r *Resolver) applyBeforeReturnMenuItem(ctx context.Context, record *gen.MenuItem) error {
+	if r == nil || record == nil || r.hooks.BeforeReturnMenuItem == nil {
+		return nil
+	}
+	return r.hooks.BeforeReturnMenuItem(ctx, r, record)
+}
+
 func (r *Resolver) applyBeforeCreateOption(ctx context.Context, input graphql.CreateOptionInput, model *gen.Option) error {
 	if r == nil || r.hooks.BeforeCreateOption == nil {
 		return nil
@@ -363,6 +545,55 @@ func (r *Resolver) applyBeforeReturnRole(ctx context.Context, record *gen.Role)
 	return r.hooks.BeforeReturnRole(ctx, r, record)
 }
 
+func (r *Resolver) applyBeforeCreateSlugHistory(ctx context.Context, input graphql.CreateSlugHistoryInput, model *gen.SlugHistory) error {
+	if r == nil || r.hooks.BeforeCreateSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.BeforeCreateSlugHistory(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterCreateSlugHistory(ctx context.Context, record *gen.SlugHistory) error {
+	if r == nil || record == nil || r.hooks.AfterCreateSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.AfterCreateSlugHistory(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeUpdateSlugHistory(ctx context.Context, input graphql.UpdateSlugHistoryInput, model *gen.SlugHistory) error {
+	if r == nil || r.hooks.BeforeUpdateSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.BeforeUpdateSlugHistory(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterUpdateSlugHistory(ctx context.Context, record *gen.SlugHistory) error {
+	if r == nil || record == nil || r.hooks.AfterUpdateSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.AfterUpdateSlugHistory(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeDeleteSlugHistory(ctx context.Context, input graphql.DeleteSlugHistoryInput, id string) error {
+	if r == nil || r.hooks.BeforeDeleteSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.BeforeDeleteSlugHistory(ctx, r, input, id)
+}
+
+func (r *Resolver) applyAfterDeleteSlugHistory(ctx context.Context, input graphql.DeleteSlugHistoryInput, id string) error {
+	if r == nil || r.hooks.AfterDeleteSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.AfterDeleteSlugHistory(ctx, r, input, id)
+}
+
+func (r *Resolver) applyBeforeReturnSlugHistory(ctx context.Context, record *gen.SlugHistory) error {
+	if r == nil || record == nil || r.hooks.BeforeReturnSlugHistory == nil {
+		return nil
+	}
+	return r.hooks.BeforeReturnSlugHistory(ctx, r, record)
+}
+
 func (r *Resolver) applyBeforeCreateTag(ctx context.Context, input graphql.CreateTagInput, model *gen.Tag) error {
 	if r == nil || r.hooks.BeforeCreateTag == nil {
 		return nil
@@ -461,6 +692,55 @@ func (r *Resolver) applyBeforeReturnUser(ctx context.Context, record *gen.User)
 	return r.hooks.BeforeReturnUser(ctx, r, record)
 }
 
+func (r *Resolver) applyBeforeCreateUserMeta(ctx context.Context, input graphql.CreateUserMetaInput, model *gen.UserMeta) error {
+	if r == nil || r.hooks.BeforeCreateUserMeta == nil {
+		return nil
+	}
+	return r.hooks.BeforeCreateUserMeta(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterCreateUserMeta(ctx context.Context, record *gen.UserMeta) error {
+	if r == nil || record == nil || r.hooks.AfterCreateUserMeta == nil {
+		return nil
+	}
+	return r.hooks.AfterCreateUserMeta(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeUpdateUserMeta(ctx context.Context, input graphql.UpdateUserMetaInput, model *gen.UserMeta) error {
+	if r == nil || r.hooks.BeforeUpdateUserMeta == nil {
+		return nil
+	}
+	return r.hooks.BeforeUpdateUserMeta(ctx, r, input, model)
+}
+
+func (r *Resolver) applyAfterUpdateUserMeta(ctx context.Context, record *gen.UserMeta) error {
+	if r == nil || record == nil || r.hooks.AfterUpdateUserMeta == nil {
+		return nil
+	}
+	return r.hooks.AfterUpdateUserMeta(ctx, r, record)
+}
+
+func (r *Resolver) applyBeforeDeleteUserMeta(ctx context.Context, input graphql.DeleteUserMetaInput, id string) error {
+	if r == nil || r.hooks.BeforeDeleteUserMeta == nil {
+		return nil
+	}
+	return r.hooks.BeforeDeleteUserMeta(ctx, r, input, id)
+}
+
+func (r *Resolver) applyAfterDeleteUserMeta(ctx context.Context, input graphql.DeleteUserMetaInput, id string) error {
+	if r == nil || r.hooks.AfterDeleteUserMeta == nil {
+		return nil
+	}
+	return r.hooks.AfterDeleteUserMeta(ctx, r, input, id)
+}
+
+func (r *Resolver) applyBeforeReturnUserMeta(ctx context.Context, record *gen.UserMeta) error {
+	if r == nil || record == nil || r.hooks.BeforeReturnUserMeta == nil {
+		return nil
+	}
+	return r.hooks.BeforeReturnUserMeta(ctx, r, record)
+}
+
 func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, error) {
 	typ, nativeID, err := relay.FromGlobalID(id)
 	if err != nil {
@@ -491,6 +771,18 @@ func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, erro
 			return nil, err
 		}
 		return toGraphQLComment(record), nil
+	case "ContentType":
+		record, err := r.loadContentType(ctx, nativeID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
+			return nil, err
+		}
+		return toGraphQLContentType(record), nil
 	case "Media":
 		record, err := r.loadMedia(ctx, nativeID)
 		if err != nil {
@@ -503,6 +795,30 @@ func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, erro
 			return nil, err
 		}
 		return toGraphQLMedia(record), nil
+	case "Menu":
+		record, err := r.loadMenu(ctx, nativeID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+			return nil, err
+		}
+		return toGraphQLMenu(record), nil
+	case "MenuItem":
+		record, err := r.loadMenuItem(ctx, nativeID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+			return nil, err
+		}
+		return toGraphQLMenuItem(record), nil
 	case "Option":
 		record, err := r.loadOption(ctx, nativeID)
 		if err != nil {
@@ -539,6 +855,18 @@ func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, erro
 			return nil, err
 		}
 		return toGraphQLRole(record), nil
+	case "SlugHistory":
+		record, err := r.loadSlugHistory(ctx, nativeID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		if err := r.applyBeforeReturnSlugHistory(ctx, record); err != nil {
+			return nil, err
+		}
+		return toGraphQLSlugHistory(record), nil
 	case "Tag":
 		record, err := r.loadTag(ctx, nativeID)
 		if err != nil {
@@ -563,6 +891,18 @@ func (r *queryResolver) Node(ctx context.Context, id string) (graphql.Node, erro
 			return nil, err
 		}
 		return toGraphQLUser(record), nil
+	case "UserMeta":
+		record, err := r.loadUserMeta(ctx, nativeID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		if err := r.applyBeforeReturnUserMeta(ctx, record); err != nil {
+			return nil, err
+		}
+		return toGraphQLUserMeta(record), nil
 	default:
 		return nil, fmt.Errorf("unknown node type %s", typ)
 	}
@@ -601,6 +941,7 @@ func toGraphQLCategory(record *gen.Category) *graphql.Category {
 		Slug:        record.Slug,
 		Description: record.Description,
 		ParentID:    record.ParentID,
+		Position:    int(record.Position),
 		CreatedAt:   record.CreatedAt,
 		UpdatedAt:   record.UpdatedAt,
 	}
@@ -712,6 +1053,9 @@ func (r *mutationResolver) CreateCategory(ctx context.Context, input graphql.Cre
 	if input.ParentID != nil {
 		model.ParentID = input.ParentID
 	}
+	if input.Position != nil {
+		model.Position = int32(*input.Position)
+	}
 	if input.CreatedAt != nil {
 		model.CreatedAt = *input.CreatedAt
 	}
@@ -760,6 +1104,9 @@ func (r *mutationResolver) UpdateCategory(ctx context.Context, input graphql.Upd
 	if input.ParentID != nil {
 		model.ParentID = input.ParentID
 	}
+	if input.Position != nil {
+		model.Position = int32(*input.Position)
+	}
 	if input.CreatedAt != nil {
 		model.CreatedAt = *input.CreatedAt
 	}
@@ -851,6 +1198,7 @@ func toGraphQLComment(record *gen.Comment) *graphql.Comment {
 		SubmittedAt: record.SubmittedAt,
 		PublishedAt: record.PublishedAt,
 		UpdatedAt:   record.UpdatedAt,
+		DeletedAt:   record.DeletedAt,
 	}
 }
 
@@ -1207,52 +1555,47 @@ func (r *subscriptionResolver) CommentDeleted(ctx context.Context) (<-chan strin
 	return out, nil
 }
 
-func (r *Resolver) loadMedia(ctx context.Context, id string) (*gen.Media, error) {
+func (r *Resolver) loadContentType(ctx context.Context, id string) (*gen.ContentType, error) {
 	if r == nil || r.ORM == nil {
 		return nil, nil
 	}
 	if loaders := dataloaders.FromContext(ctx); loaders != nil {
-		if loader := loaders.Media(); loader != nil {
+		if loader := loaders.ContentType(); loader != nil {
 			return loader.Load(ctx, id)
 		}
 	}
-	return r.ORM.Medias().ByID(ctx, id)
+	return r.ORM.ContentTypes().ByID(ctx, id)
 }
 
-func (r *Resolver) primeMedia(ctx context.Context, record *gen.Media) {
+func (r *Resolver) primeContentType(ctx context.Context, record *gen.ContentType) {
 	if record == nil {
 		return
 	}
 	if loaders := dataloaders.FromContext(ctx); loaders != nil {
-		if loader := loaders.Media(); loader != nil {
+		if loader := loaders.ContentType(); loader != nil {
 			loader.Prime(record.ID, record)
 		}
 	}
 }
 
-func toGraphQLMedia(record *gen.Media) *graphql.Media {
+func toGraphQLContentType(record *gen.ContentType) *graphql.ContentType {
 	if record == nil {
 		return nil
 	}
-	return &graphql.Media{
-		ID:            relay.ToGlobalID("Media", record.ID),
-		UploadedByID:  record.UploadedByID,
-		FileName:      record.FileName,
-		MimeType:      record.MimeType,
-		StorageKey:    record.StorageKey,
-		URL:           record.URL,
-		Title:         record.Title,
-		AltText:       record.AltText,
-		Caption:       record.Caption,
-		Description:   record.Description,
-		FileSizeBytes: toGraphQLIntPtr(record.FileSizeBytes),
-		Metadata:      record.Metadata,
-		CreatedAt:     record.CreatedAt,
-		UpdatedAt:     record.UpdatedAt,
+	return &graphql.ContentType{
+		ID:          relay.ToGlobalID("ContentType", record.ID),
+		Name:        record.Name,
+		Label:       record.Label,
+		PluralLabel: record.PluralLabel,
+		Description: record.Description,
+		Supports:    record.Supports,
+		Fields:      record.Fields,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
 	}
 }
 
-func decodeMediaID(id string) (string, error) {
+func decodeContentTypeID(id string) (string, error) {
 	if id == "" {
 		return "", fmt.Errorf("id is required")
 	}
@@ -1260,28 +1603,28 @@ func decodeMediaID(id string) (string, error) {
 	if err != nil {
 		return id, nil
 	}
-	if typ != "Media" {
-		return "", fmt.Errorf("invalid id for Media: %s", typ)
+	if typ != "ContentType" {
+		return "", fmt.Errorf("invalid id for ContentType: %s", typ)
 	}
 	return nativeID, nil
 }
 
-func (r *queryResolver) Media(ctx context.Context, id string) (*graphql.Media, error) {
-	nativeID, err := decodeMediaID(id)
+func (r *queryResolver) ContentType(ctx context.Context, id string) (*graphql.ContentType, error) {
+	nativeID, err := decodeContentTypeID(id)
 	if err != nil {
 		return nil, err
 	}
-	record, err := r.loadMedia(ctx, nativeID)
+	record, err := r.loadContentType(ctx, nativeID)
 	if err != nil {
 		return nil, err
 	}
-	if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+	if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
 		return nil, err
 	}
-	return toGraphQLMedia(record), nil
+	return toGraphQLContentType(record), nil
 }
 
-func (r *queryResolver) Medias(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.MediaConnection, error) {
+func (r *queryResolver) ContentTypes(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.ContentTypeConnection, error) {
 	if r.ORM == nil {
 		return nil, fmt.Errorf("orm client is not configured")
 	}
@@ -1298,24 +1641,24 @@ func (r *queryResolver) Medias(ctx context.Context, first *int, after *string, l
 			offset = decoded + 1
 		}
 	}
-	total, err := r.ORM.Medias().Count(ctx)
+	total, err := r.ORM.ContentTypes().Count(ctx)
 	if err != nil {
 		return nil, err
 	}
-	records, err := r.ORM.Medias().List(ctx, limit, offset)
+	records, err := r.ORM.ContentTypes().List(ctx, limit, offset)
 	if err != nil {
 		return nil, err
 	}
-	edges := make([]*graphql.MediaEdge, len(records))
+	edges := make([]*graphql.ContentTypeEdge, len(records))
 	for idx, record := range records {
 		cursor := encodeCursor(offset + idx)
-		if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+		if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
 			return nil, err
 		}
-		r.primeMedia(ctx, record)
-		edges[idx] = &graphql.MediaEdge{
+		r.primeContentType(ctx, record)
+		edges[idx] = &graphql.ContentTypeEdge{
 			Cursor: cursor,
-			Node:   toGraphQLMedia(record),
+			Node:   toGraphQLContentType(record),
 		}
 	}
 	var startCursor, endCursor *string
@@ -1331,54 +1674,38 @@ func (r *queryResolver) Medias(ctx context.Context, first *int, after *string, l
 		StartCursor:     startCursor,
 		EndCursor:       endCursor,
 	}
-	return &graphql.MediaConnection{
+	return &graphql.ContentTypeConnection{
 		Edges:      edges,
 		PageInfo:   pageInfo,
 		TotalCount: total,
 	}, nil
 }
 
-func (r *mutationResolver) CreateMedia(ctx context.Context, input graphql.CreateMediaInput) (*graphql.CreateMediaPayload, error) {
+func (r *mutationResolver) CreateContentType(ctx context.Context, input graphql.CreateContentTypeInput) (*graphql.CreateContentTypePayload, error) {
 	if r.ORM == nil {
 		return nil, fmt.Errorf("orm client is not configured")
 	}
-	model := new(gen.Media)
+	model := new(gen.ContentType)
 	if input.ID != nil {
 		model.ID = *input.ID
 	}
-	if input.UploadedByID != nil {
-		model.UploadedByID = input.UploadedByID
-	}
-	if input.FileName != nil {
-		model.FileName = *input.FileName
-	}
-	if input.MimeType != nil {
-		model.MimeType = *input.MimeType
-	}
-	if input.StorageKey != nil {
-		model.StorageKey = *input.StorageKey
-	}
-	if input.URL != nil {
-		model.URL = *input.URL
-	}
-	if input.Title != nil {
-		model.Title = input.Title
+	if input.Name != nil {
+		model.Name = *input.Name
 	}
-	if input.AltText != nil {
-		model.AltText = input.AltText
+	if input.Label != nil {
+		model.Label = *input.Label
 	}
-	if input.Caption != nil {
-		model.Caption = input.Caption
+	if input.PluralLabel != nil {
+		model.PluralLabel = *input.PluralLabel
 	}
 	if input.Description != nil {
 		model.Description = input.Description
 	}
-	if input.FileSizeBytes != nil {
-		value := int32(*input.FileSizeBytes)
-		model.FileSizeBytes = &value
+	if input.Supports != nil {
+		model.Supports = input.Supports
 	}
-	if input.Metadata != nil {
-		model.Metadata = input.Metadata
+	if input.Fields != nil {
+		model.Fields = input.Fields
 	}
 	if input.CreatedAt != nil {
 		model.CreatedAt = *input.CreatedAt
@@ -1386,10 +1713,289 @@ func (r *mutationResolver) CreateMedia(ctx context.Context, input graphql.Create
 	if input.UpdatedAt != nil {
 		model.UpdatedAt = *input.UpdatedAt
 	}
-	if err := r.applyBeforeCreateMedia(ctx, input, model); err != nil {
+	if err := r.applyBeforeCreateContentType(ctx, input, model); err != nil {
 		return nil, err
 	}
-	record, err := r.ORM.Medias().Create(ctx, model)
+	record, err := r.ORM.ContentTypes().Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterCreateContentType(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLContentType(record)
+	r.primeContentType(ctx, record)
+	return &graphql.CreateContentTypePayload{
+		ClientMutationID: input.ClientMutationID,
+		ContentType:      gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateContentType(ctx context.Context, input graphql.UpdateContentTypeInput) (*graphql.UpdateContentTypePayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeContentTypeID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	model := &gen.ContentType{ID: nativeID}
+	if input.Name != nil {
+		model.Name = *input.Name
+	}
+	if input.Label != nil {
+		model.Label = *input.Label
+	}
+	if input.PluralLabel != nil {
+		model.PluralLabel = *input.PluralLabel
+	}
+	if input.Description != nil {
+		model.Description = input.Description
+	}
+	if input.Supports != nil {
+		model.Supports = input.Supports
+	}
+	if input.Fields != nil {
+		model.Fields = input.Fields
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeUpdateContentType(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.ContentTypes().Update(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterUpdateContentType(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnContentType(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLContentType(record)
+	r.primeContentType(ctx, record)
+	return &graphql.UpdateContentTypePayload{
+		ClientMutationID: input.ClientMutationID,
+		ContentType:      gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteContentType(ctx context.Context, input graphql.DeleteContentTypeInput) (*graphql.DeleteContentTypePayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeContentTypeID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeDeleteContentType(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.ORM.ContentTypes().Delete(ctx, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterDeleteContentType(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	deletedID := relay.ToGlobalID("ContentType", nativeID)
+	return &graphql.DeleteContentTypePayload{
+		ClientMutationID:     input.ClientMutationID,
+		DeletedContentTypeID: deletedID,
+	}, nil
+}
+
+func (r *Resolver) loadMedia(ctx context.Context, id string) (*gen.Media, error) {
+	if r == nil || r.ORM == nil {
+		return nil, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.Media(); loader != nil {
+			return loader.Load(ctx, id)
+		}
+	}
+	return r.ORM.Medias().ByID(ctx, id)
+}
+
+func (r *Resolver) primeMedia(ctx context.Context, record *gen.Media) {
+	if record == nil {
+		return
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.Media(); loader != nil {
+			loader.Prime(record.ID, record)
+		}
+	}
+}
+
+func toGraphQLMedia(record *gen.Media) *graphql.Media {
+	if record == nil {
+		return nil
+	}
+	return &graphql.Media{
+		ID:            relay.ToGlobalID("Media", record.ID),
+		UploadedByID:  record.UploadedByID,
+		FileName:      record.FileName,
+		MimeType:      record.MimeType,
+		StorageKey:    record.StorageKey,
+		URL:           record.URL,
+		Title:         record.Title,
+		AltText:       record.AltText,
+		Caption:       record.Caption,
+		Description:   record.Description,
+		FileSizeBytes: toGraphQLIntPtr(record.FileSizeBytes),
+		Metadata:      record.Metadata,
+		CreatedAt:     record.CreatedAt,
+		UpdatedAt:     record.UpdatedAt,
+		DeletedAt:     record.DeletedAt,
+	}
+}
+
+func decodeMediaID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "Media" {
+		return "", fmt.Errorf("invalid id for Media: %s", typ)
+	}
+	return nativeID, nil
+}
+
+func (r *queryResolver) Media(ctx context.Context, id string) (*graphql.Media, error) {
+	nativeID, err := decodeMediaID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.loadMedia(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLMedia(record), nil
+}
+
+func (r *queryResolver) Medias(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.MediaConnection, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if last != nil || before != nil {
+		return nil, fmt.Errorf("backward pagination is not supported")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	total, err := r.ORM.Medias().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.ORM.Medias().List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql.MediaEdge, len(records))
+	for idx, record := range records {
+		cursor := encodeCursor(offset + idx)
+		if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeMedia(ctx, record)
+		edges[idx] = &graphql.MediaEdge{
+			Cursor: cursor,
+			Node:   toGraphQLMedia(record),
+		}
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	pageInfo := &graphql.PageInfo{
+		HasNextPage:     offset+len(edges) < total,
+		HasPreviousPage: offset > 0,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+	return &graphql.MediaConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: total,
+	}, nil
+}
+
+func (r *mutationResolver) CreateMedia(ctx context.Context, input graphql.CreateMediaInput) (*graphql.CreateMediaPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	model := new(gen.Media)
+	if input.ID != nil {
+		model.ID = *input.ID
+	}
+	if input.UploadedByID != nil {
+		model.UploadedByID = input.UploadedByID
+	}
+	if input.FileName != nil {
+		model.FileName = *input.FileName
+	}
+	if input.MimeType != nil {
+		model.MimeType = *input.MimeType
+	}
+	if input.StorageKey != nil {
+		model.StorageKey = *input.StorageKey
+	}
+	if input.URL != nil {
+		model.URL = *input.URL
+	}
+	if input.Title != nil {
+		model.Title = input.Title
+	}
+	if input.AltText != nil {
+		model.AltText = input.AltText
+	}
+	if input.Caption != nil {
+		model.Caption = input.Caption
+	}
+	if input.Description != nil {
+		model.Description = input.Description
+	}
+	if input.FileSizeBytes != nil {
+		value := int32(*input.FileSizeBytes)
+		model.FileSizeBytes = &value
+	}
+	if input.Metadata != nil {
+		model.Metadata = input.Metadata
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeCreateMedia(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.Medias().Create(ctx, model)
 	if err != nil {
 		return nil, err
 	}
@@ -1440,15 +2046,515 @@ func (r *mutationResolver) UpdateMedia(ctx context.Context, input graphql.Update
 	if input.Caption != nil {
 		model.Caption = input.Caption
 	}
-	if input.Description != nil {
-		model.Description = input.Description
+	if input.Description != nil {
+		model.Description = input.Description
+	}
+	if input.FileSizeBytes != nil {
+		value := int32(*input.FileSizeBytes)
+		model.FileSizeBytes = &value
+	}
+	if input.Metadata != nil {
+		model.Metadata = input.Metadata
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeUpdateMedia(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.Medias().Update(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterUpdateMedia(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLMedia(record)
+	r.primeMedia(ctx, record)
+	return &graphql.UpdateMediaPayload{
+		ClientMutationID: input.ClientMutationID,
+		Media:            gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteMedia(ctx context.Context, input graphql.DeleteMediaInput) (*graphql.DeleteMediaPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeMediaID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeDeleteMedia(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.ORM.Medias().Delete(ctx, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterDeleteMedia(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	deletedID := relay.ToGlobalID("Media", nativeID)
+	return &graphql.DeleteMediaPayload{
+		ClientMutationID: input.ClientMutationID,
+		DeletedMediaID:   deletedID,
+	}, nil
+}
+
+func (r *Resolver) loadMenu(ctx context.Context, id string) (*gen.Menu, error) {
+	if r == nil || r.ORM == nil {
+		return nil, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.Menu(); loader != nil {
+			return loader.Load(ctx, id)
+		}
+	}
+	return r.ORM.Menus().ByID(ctx, id)
+}
+
+func (r *Resolver) primeMenu(ctx context.Context, record *gen.Menu) {
+	if record == nil {
+		return
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.Menu(); loader != nil {
+			loader.Prime(record.ID, record)
+		}
+	}
+}
+
+func toGraphQLMenu(record *gen.Menu) *graphql.Menu {
+	if record == nil {
+		return nil
+	}
+	return &graphql.Menu{
+		ID:          relay.ToGlobalID("Menu", record.ID),
+		Name:        record.Name,
+		Location:    record.Location,
+		Description: record.Description,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+	}
+}
+
+func decodeMenuID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "Menu" {
+		return "", fmt.Errorf("invalid id for Menu: %s", typ)
+	}
+	return nativeID, nil
+}
+
+func (r *queryResolver) Menu(ctx context.Context, id string) (*graphql.Menu, error) {
+	nativeID, err := decodeMenuID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.loadMenu(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLMenu(record), nil
+}
+
+func (r *queryResolver) Menus(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.MenuConnection, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if last != nil || before != nil {
+		return nil, fmt.Errorf("backward pagination is not supported")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	total, err := r.ORM.Menus().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.ORM.Menus().List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql.MenuEdge, len(records))
+	for idx, record := range records {
+		cursor := encodeCursor(offset + idx)
+		if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeMenu(ctx, record)
+		edges[idx] = &graphql.MenuEdge{
+			Cursor: cursor,
+			Node:   toGraphQLMenu(record),
+		}
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	pageInfo := &graphql.PageInfo{
+		HasNextPage:     offset+len(edges) < total,
+		HasPreviousPage: offset > 0,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+	return &graphql.MenuConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: total,
+	}, nil
+}
+
+func (r *mutationResolver) CreateMenu(ctx context.Context, input graphql.CreateMenuInput) (*graphql.CreateMenuPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	model := new(gen.Menu)
+	if input.ID != nil {
+		model.ID = *input.ID
+	}
+	if input.Name != nil {
+		model.Name = *input.Name
+	}
+	if input.Location != nil {
+		model.Location = input.Location
+	}
+	if input.Description != nil {
+		model.Description = input.Description
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeCreateMenu(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.Menus().Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterCreateMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLMenu(record)
+	r.primeMenu(ctx, record)
+	return &graphql.CreateMenuPayload{
+		ClientMutationID: input.ClientMutationID,
+		Menu:             gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateMenu(ctx context.Context, input graphql.UpdateMenuInput) (*graphql.UpdateMenuPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeMenuID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	model := &gen.Menu{ID: nativeID}
+	if input.Name != nil {
+		model.Name = *input.Name
+	}
+	if input.Location != nil {
+		model.Location = input.Location
+	}
+	if input.Description != nil {
+		model.Description = input.Description
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeUpdateMenu(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.Menus().Update(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterUpdateMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLMenu(record)
+	r.primeMenu(ctx, record)
+	return &graphql.UpdateMenuPayload{
+		ClientMutationID: input.ClientMutationID,
+		Menu:             gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteMenu(ctx context.Context, input graphql.DeleteMenuInput) (*graphql.DeleteMenuPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeMenuID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeDeleteMenu(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.ORM.Menus().Delete(ctx, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterDeleteMenu(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	deletedID := relay.ToGlobalID("Menu", nativeID)
+	return &graphql.DeleteMenuPayload{
+		ClientMutationID: input.ClientMutationID,
+		DeletedMenuID:    deletedID,
+	}, nil
+}
+
+func (r *Resolver) loadMenuItem(ctx context.Context, id string) (*gen.MenuItem, error) {
+	if r == nil || r.ORM == nil {
+		return nil, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.MenuItem(); loader != nil {
+			return loader.Load(ctx, id)
+		}
+	}
+	return r.ORM.MenuItems().ByID(ctx, id)
+}
+
+func (r *Resolver) primeMenuItem(ctx context.Context, record *gen.MenuItem) {
+	if record == nil {
+		return
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.MenuItem(); loader != nil {
+			loader.Prime(record.ID, record)
+		}
+	}
+}
+
+func toGraphQLMenuItem(record *gen.MenuItem) *graphql.MenuItem {
+	if record == nil {
+		return nil
+	}
+	return &graphql.MenuItem{
+		ID:        relay.ToGlobalID("MenuItem", record.ID),
+		MenuID:    record.MenuID,
+		ParentID:  record.ParentID,
+		Position:  int(record.Position),
+		Kind:      toGraphQLEnum[graphql.MenuItemKind](record.Kind),
+		TargetID:  record.TargetID,
+		Label:     record.Label,
+		URL:       record.URL,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
+
+func decodeMenuItemID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "MenuItem" {
+		return "", fmt.Errorf("invalid id for MenuItem: %s", typ)
+	}
+	return nativeID, nil
+}
+
+func (r *queryResolver) MenuItem(ctx context.Context, id string) (*graphql.MenuItem, error) {
+	nativeID, err := decodeMenuItemID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.loadMenuItem(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLMenuItem(record), nil
+}
+
+func (r *queryResolver) MenuItems(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.MenuItemConnection, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if last != nil || before != nil {
+		return nil, fmt.Errorf("backward pagination is not supported")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	total, err := r.ORM.MenuItems().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.ORM.MenuItems().List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql.MenuItemEdge, len(records))
+	for idx, record := range records {
+		cursor := encodeCursor(offset + idx)
+		if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeMenuItem(ctx, record)
+		edges[idx] = &graphql.MenuItemEdge{
+			Cursor: cursor,
+			Node:   toGraphQLMenuItem(record),
+		}
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	pageInfo := &graphql.PageInfo{
+		HasNextPage:     offset+len(edges) < total,
+		HasPreviousPage: offset > 0,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+	return &graphql.MenuItemConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: total,
+	}, nil
+}
+
+func (r *mutationResolver) CreateMenuItem(ctx context.Context, input graphql.CreateMenuItemInput) (*graphql.CreateMenuItemPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	model := new(gen.MenuItem)
+	if input.ID != nil {
+		model.ID = *input.ID
+	}
+	if input.MenuID != nil {
+		model.MenuID = *input.MenuID
+	}
+	if input.ParentID != nil {
+		model.ParentID = input.ParentID
+	}
+	if input.Position != nil {
+		model.Position = int32(*input.Position)
+	}
+	if input.Kind != nil {
+		model.Kind = fromGraphQLEnum[graphql.MenuItemKind](*input.Kind)
+	}
+	if input.TargetID != nil {
+		model.TargetID = input.TargetID
+	}
+	if input.Label != nil {
+		model.Label = input.Label
+	}
+	if input.URL != nil {
+		model.URL = input.URL
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeCreateMenuItem(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.MenuItems().Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterCreateMenuItem(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLMenuItem(record)
+	r.primeMenuItem(ctx, record)
+	return &graphql.CreateMenuItemPayload{
+		ClientMutationID: input.ClientMutationID,
+		MenuItem:         gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateMenuItem(ctx context.Context, input graphql.UpdateMenuItemInput) (*graphql.UpdateMenuItemPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeMenuItemID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	model := &gen.MenuItem{ID: nativeID}
+	if input.MenuID != nil {
+		model.MenuID = *input.MenuID
+	}
+	if input.ParentID != nil {
+		model.ParentID = input.ParentID
+	}
+	if input.Position != nil {
+		model.Position = int32(*input.Position)
+	}
+	if input.Kind != nil {
+		model.Kind = fromGraphQLEnum[graphql.MenuItemKind](*input.Kind)
 	}
-	if input.FileSizeBytes != nil {
-		value := int32(*input.FileSizeBytes)
-		model.FileSizeBytes = &value
+	if input.TargetID != nil {
+		model.TargetID = input.TargetID
 	}
-	if input.Metadata != nil {
-		model.Metadata = input.Metadata
+	if input.Label != nil {
+		model.Label = input.Label
+	}
+	if input.URL != nil {
+		model.URL = input.URL
 	}
 	if input.CreatedAt != nil {
 		model.CreatedAt = *input.CreatedAt
@@ -1456,48 +2562,48 @@ func (r *mutationResolver) UpdateMedia(ctx context.Context, input graphql.Update
 	if input.UpdatedAt != nil {
 		model.UpdatedAt = *input.UpdatedAt
 	}
-	if err := r.applyBeforeUpdateMedia(ctx, input, model); err != nil {
+	if err := r.applyBeforeUpdateMenuItem(ctx, input, model); err != nil {
 		return nil, err
 	}
-	record, err := r.ORM.Medias().Update(ctx, model)
+	record, err := r.ORM.MenuItems().Update(ctx, model)
 	if err != nil {
 		return nil, err
 	}
-	if err := r.applyAfterUpdateMedia(ctx, record); err != nil {
+	if err := r.applyAfterUpdateMenuItem(ctx, record); err != nil {
 		return nil, err
 	}
-	if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+	if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
 		return nil, err
 	}
-	gqlRecord := toGraphQLMedia(record)
-	r.primeMedia(ctx, record)
-	return &graphql.UpdateMediaPayload{
+	gqlRecord := toGraphQLMenuItem(record)
+	r.primeMenuItem(ctx, record)
+	return &graphql.UpdateMenuItemPayload{
 		ClientMutationID: input.ClientMutationID,
-		Media:            gqlRecord,
+		MenuItem:         gqlRecord,
 	}, nil
 }
 
-func (r *mutationResolver) DeleteMedia(ctx context.Context, input graphql.DeleteMediaInput) (*graphql.DeleteMediaPayload, error) {
+func (r *mutationResolver) DeleteMenuItem(ctx context.Context, input graphql.DeleteMenuItemInput) (*graphql.DeleteMenuItemPayload, error) {
 	if r.ORM == nil {
 		return nil, fmt.Errorf("orm client is not configured")
 	}
-	nativeID, err := decodeMediaID(input.ID)
+	nativeID, err := decodeMenuItemID(input.ID)
 	if err != nil {
 		return nil, err
 	}
-	if err := r.applyBeforeDeleteMedia(ctx, input, nativeID); err != nil {
+	if err := r.applyBeforeDeleteMenuItem(ctx, input, nativeID); err != nil {
 		return nil, err
 	}
-	if err := r.ORM.Medias().Delete(ctx, nativeID); err != nil {
+	if err := r.ORM.MenuItems().Delete(ctx, nativeID); err != nil {
 		return nil, err
 	}
-	if err := r.applyAfterDeleteMedia(ctx, input, nativeID); err != nil {
+	if err := r.applyAfterDeleteMenuItem(ctx, input, nativeID); err != nil {
 		return nil, err
 	}
-	deletedID := relay.ToGlobalID("Media", nativeID)
-	return &graphql.DeleteMediaPayload{
-		ClientMutationID: input.ClientMutationID,
-		DeletedMediaID:   deletedID,
+	deletedID := relay.ToGlobalID("MenuItem", nativeID)
+	return &graphql.DeleteMenuItemPayload{
+		ClientMutationID:  input.ClientMutationID,
+		DeletedMenuItemID: deletedID,
 	}, nil
 }
 
@@ -1768,6 +2874,9 @@ func toGraphQLPost(record *gen.Post) *graphql.Post {
 		ID:              relay.ToGlobalID("Post", record.ID),
 		AuthorID:        record.AuthorID,
 		FeaturedMediaID: record.FeaturedMediaID,
+		ContentTypeID:   record.ContentTypeID,
+		ParentID:        record.ParentID,
+		MenuOrder:       int(record.MenuOrder),
 		Title:           record.Title,
 		Slug:            record.Slug,
 		Status:          toGraphQLEnum[graphql.PostStatus](record.Status),
@@ -1778,6 +2887,7 @@ func toGraphQLPost(record *gen.Post) *graphql.Post {
 		PublishedAt:     record.PublishedAt,
 		CreatedAt:       record.CreatedAt,
 		UpdatedAt:       record.UpdatedAt,
+		DeletedAt:       record.DeletedAt,
 	}
 }
 
@@ -1810,7 +2920,7 @@ func (r *queryResolver) Post(ctx context.Context, id string) (*graphql.Post, err
 	return toGraphQLPost(record), nil
 }
 
-func (r *queryResolver) Posts(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.PostConnection, error) {
+func (r *queryResolver) Posts(ctx context.Context, first *int, after *string, last *int, before *string, where *graphql.PostWhereInput) (*graphql.PostConnection, error) {
 	if r.ORM == nil {
 		return nil, fmt.Errorf("orm client is not configured")
 	}
@@ -1827,11 +2937,7 @@ func (r *queryResolver) Posts(ctx context.Context, first *int, after *string, la
 			offset = decoded + 1
 		}
 	}
-	total, err := r.ORM.Posts().Count(ctx)
-	if err != nil {
-		return nil, err
-	}
-	records, err := r.ORM.Posts().List(ctx, limit, offset)
+	records, total, err := r.filterPosts(ctx, where, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -1881,6 +2987,15 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreateP
 	if input.FeaturedMediaID != nil {
 		model.FeaturedMediaID = input.FeaturedMediaID
 	}
+	if input.ContentTypeID != nil {
+		model.ContentTypeID = input.ContentTypeID
+	}
+	if input.ParentID != nil {
+		model.ParentID = input.ParentID
+	}
+	if input.MenuOrder != nil {
+		model.MenuOrder = int32(*input.MenuOrder)
+	}
 	if input.Title != nil {
 		model.Title = *input.Title
 	}
@@ -1914,6 +3029,7 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreateP
 	var (
 		categoryIDs []string
 		tagIDs      []string
+		newTags     []*gen.Tag
 		err         error
 	)
 	hasCategoryIDs := input.CategoryIDs != nil
@@ -1923,12 +3039,20 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreateP
 			return nil, err
 		}
 	}
-	hasTagIDs := input.TagIDs != nil
+	hasTagIDs := input.TagIDs != nil || input.TagNames != nil
 	if hasTagIDs {
 		tagIDs, err = r.normalizeTagIDs(ctx, input.TagIDs)
 		if err != nil {
 			return nil, err
 		}
+		tagIDs, newTags, err = r.appendNamedTags(ctx, tagIDs, input.TagNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	postMeta, err := r.preparePostMeta(ctx, "", model, input.Meta, true)
+	if err != nil {
+		return nil, err
 	}
 	if err := r.applyBeforeCreatePost(ctx, input, model); err != nil {
 		return nil, err
@@ -1940,7 +3064,10 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input graphql.CreateP
 	if err := r.applyAfterCreatePost(ctx, record); err != nil {
 		return nil, err
 	}
-	if err := r.assignPostTaxonomies(ctx, record.ID, categoryIDs, hasCategoryIDs, tagIDs, hasTagIDs); err != nil {
+	if err := r.assignPostTaxonomies(ctx, record.ID, categoryIDs, hasCategoryIDs, tagIDs, newTags, hasTagIDs); err != nil {
+		return nil, err
+	}
+	if err := r.storePostMeta(ctx, record.ID, postMeta); err != nil {
 		return nil, err
 	}
 	if err := r.applyBeforeReturnPost(ctx, record); err != nil {
@@ -1970,6 +3097,15 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, input graphql.UpdateP
 	if input.FeaturedMediaID != nil {
 		model.FeaturedMediaID = input.FeaturedMediaID
 	}
+	if input.ContentTypeID != nil {
+		model.ContentTypeID = input.ContentTypeID
+	}
+	if input.ParentID != nil {
+		model.ParentID = input.ParentID
+	}
+	if input.MenuOrder != nil {
+		model.MenuOrder = int32(*input.MenuOrder)
+	}
 	if input.Title != nil {
 		model.Title = *input.Title
 	}
@@ -2003,6 +3139,7 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, input graphql.UpdateP
 	var (
 		categoryIDs []string
 		tagIDs      []string
+		newTags     []*gen.Tag
 	)
 	hasCategoryIDs := input.CategoryIDs != nil
 	if hasCategoryIDs {
@@ -2011,12 +3148,20 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, input graphql.UpdateP
 			return nil, err
 		}
 	}
-	hasTagIDs := input.TagIDs != nil
+	hasTagIDs := input.TagIDs != nil || input.TagNames != nil
 	if hasTagIDs {
 		tagIDs, err = r.normalizeTagIDs(ctx, input.TagIDs)
 		if err != nil {
 			return nil, err
 		}
+		tagIDs, newTags, err = r.appendNamedTags(ctx, tagIDs, input.TagNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	postMeta, err := r.preparePostMeta(ctx, nativeID, model, input.Meta, input.ContentTypeID != nil)
+	if err != nil {
+		return nil, err
 	}
 	if err := r.applyBeforeUpdatePost(ctx, input, model); err != nil {
 		return nil, err
@@ -2028,7 +3173,10 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, input graphql.UpdateP
 	if err := r.applyAfterUpdatePost(ctx, record); err != nil {
 		return nil, err
 	}
-	if err := r.assignPostTaxonomies(ctx, nativeID, categoryIDs, hasCategoryIDs, tagIDs, hasTagIDs); err != nil {
+	if err := r.assignPostTaxonomies(ctx, nativeID, categoryIDs, hasCategoryIDs, tagIDs, newTags, hasTagIDs); err != nil {
+		return nil, err
+	}
+	if err := r.storePostMeta(ctx, nativeID, postMeta); err != nil {
 		return nil, err
 	}
 	if err := r.applyBeforeReturnPost(ctx, record); err != nil {
@@ -2459,69 +3607,305 @@ func (r *subscriptionResolver) RoleUpdated(ctx context.Context) (<-chan *graphql
 	if err != nil {
 		return nil, err
 	}
-	out := make(chan *graphql.Role, 1)
-	go func() {
-		defer close(out)
-		if stop != nil {
-			defer stop()
-		}
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case payload, ok := <-stream:
-				if !ok {
-					return
-				}
-				obj, ok := payload.(*graphql.Role)
-				if !ok || obj == nil {
-					continue
-				}
-				select {
-				case out <- obj:
-					continue
-				case <-ctx.Done():
-					return
-				}
-			}
-		}
-	}()
-	return out, nil
+	out := make(chan *graphql.Role, 1)
+	go func() {
+		defer close(out)
+		if stop != nil {
+			defer stop()
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-stream:
+				if !ok {
+					return
+				}
+				obj, ok := payload.(*graphql.Role)
+				if !ok || obj == nil {
+					continue
+				}
+				select {
+				case out <- obj:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (r *subscriptionResolver) RoleDeleted(ctx context.Context) (<-chan string, error) {
+	stream, stop, err := subscribeToEntity(ctx, r.subscriptionBroker(), "Role", SubscriptionTriggerDeleted)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan string, 1)
+	go func() {
+		defer close(out)
+		if stop != nil {
+			defer stop()
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload, ok := <-stream:
+				if !ok {
+					return
+				}
+				value, ok := payload.(string)
+				if !ok || value == "" {
+					continue
+				}
+				select {
+				case out <- value:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (r *Resolver) loadSlugHistory(ctx context.Context, id string) (*gen.SlugHistory, error) {
+	if r == nil || r.ORM == nil {
+		return nil, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.SlugHistory(); loader != nil {
+			return loader.Load(ctx, id)
+		}
+	}
+	return r.ORM.SlugHistories().ByID(ctx, id)
+}
+
+func (r *Resolver) primeSlugHistory(ctx context.Context, record *gen.SlugHistory) {
+	if record == nil {
+		return
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.SlugHistory(); loader != nil {
+			loader.Prime(record.ID, record)
+		}
+	}
+}
+
+func toGraphQLSlugHistory(record *gen.SlugHistory) *graphql.SlugHistory {
+	if record == nil {
+		return nil
+	}
+	return &graphql.SlugHistory{
+		ID:         relay.ToGlobalID("SlugHistory", record.ID),
+		EntityType: record.EntityType,
+		EntityID:   record.EntityID,
+		Slug:       record.Slug,
+		CreatedAt:  record.CreatedAt,
+		UpdatedAt:  record.UpdatedAt,
+	}
+}
+
+func decodeSlugHistoryID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "SlugHistory" {
+		return "", fmt.Errorf("invalid id for SlugHistory: %s", typ)
+	}
+	return nativeID, nil
+}
+
+func (r *queryResolver) SlugHistory(ctx context.Context, id string) (*graphql.SlugHistory, error) {
+	nativeID, err := decodeSlugHistoryID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.loadSlugHistory(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnSlugHistory(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLSlugHistory(record), nil
+}
+
+func (r *queryResolver) SlugHistories(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.SlugHistoryConnection, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if last != nil || before != nil {
+		return nil, fmt.Errorf("backward pagination is not supported")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	total, err := r.ORM.SlugHistories().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.ORM.SlugHistories().List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql.SlugHistoryEdge, len(records))
+	for idx, record := range records {
+		cursor := encodeCursor(offset + idx)
+		if err := r.applyBeforeReturnSlugHistory(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeSlugHistory(ctx, record)
+		edges[idx] = &graphql.SlugHistoryEdge{
+			Cursor: cursor,
+			Node:   toGraphQLSlugHistory(record),
+		}
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	pageInfo := &graphql.PageInfo{
+		HasNextPage:     offset+len(edges) < total,
+		HasPreviousPage: offset > 0,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+	return &graphql.SlugHistoryConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: total,
+	}, nil
+}
+
+func (r *mutationResolver) CreateSlugHistory(ctx context.Context, input graphql.CreateSlugHistoryInput) (*graphql.CreateSlugHistoryPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	model := new(gen.SlugHistory)
+	if input.ID != nil {
+		model.ID = *input.ID
+	}
+	if input.EntityType != nil {
+		model.EntityType = *input.EntityType
+	}
+	if input.EntityID != nil {
+		model.EntityID = *input.EntityID
+	}
+	if input.Slug != nil {
+		model.Slug = *input.Slug
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeCreateSlugHistory(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.SlugHistories().Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterCreateSlugHistory(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnSlugHistory(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLSlugHistory(record)
+	r.primeSlugHistory(ctx, record)
+	return &graphql.CreateSlugHistoryPayload{
+		ClientMutationID: input.ClientMutationID,
+		SlugHistory:      gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateSlugHistory(ctx context.Context, input graphql.UpdateSlugHistoryInput) (*graphql.UpdateSlugHistoryPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeSlugHistoryID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	model := &gen.SlugHistory{ID: nativeID}
+	if input.EntityType != nil {
+		model.EntityType = *input.EntityType
+	}
+	if input.EntityID != nil {
+		model.EntityID = *input.EntityID
+	}
+	if input.Slug != nil {
+		model.Slug = *input.Slug
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeUpdateSlugHistory(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.SlugHistories().Update(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterUpdateSlugHistory(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnSlugHistory(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLSlugHistory(record)
+	r.primeSlugHistory(ctx, record)
+	return &graphql.UpdateSlugHistoryPayload{
+		ClientMutationID: input.ClientMutationID,
+		SlugHistory:      gqlRecord,
+	}, nil
 }
 
-func (r *subscriptionResolver) RoleDeleted(ctx context.Context) (<-chan string, error) {
-	stream, stop, err := subscribeToEntity(ctx, r.subscriptionBroker(), "Role", SubscriptionTriggerDeleted)
+func (r *mutationResolver) DeleteSlugHistory(ctx context.Context, input graphql.DeleteSlugHistoryInput) (*graphql.DeleteSlugHistoryPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeSlugHistoryID(input.ID)
 	if err != nil {
 		return nil, err
 	}
-	out := make(chan string, 1)
-	go func() {
-		defer close(out)
-		if stop != nil {
-			defer stop()
-		}
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case payload, ok := <-stream:
-				if !ok {
-					return
-				}
-				value, ok := payload.(string)
-				if !ok || value == "" {
-					continue
-				}
-				select {
-				case out <- value:
-					continue
-				case <-ctx.Done():
-					return
-				}
-			}
-		}
-	}()
-	return out, nil
+	if err := r.applyBeforeDeleteSlugHistory(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.ORM.SlugHistories().Delete(ctx, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterDeleteSlugHistory(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	deletedID := relay.ToGlobalID("SlugHistory", nativeID)
+	return &graphql.DeleteSlugHistoryPayload{
+		ClientMutationID:     input.ClientMutationID,
+		DeletedSlugHistoryID: deletedID,
+	}, nil
 }
 
 func (r *Resolver) loadTag(ctx context.Context, id string) (*gen.Tag, error) {
@@ -3138,6 +4522,242 @@ func (r *subscriptionResolver) UserDeleted(ctx context.Context) (<-chan string,
 	return out, nil
 }
 
+func (r *Resolver) loadUserMeta(ctx context.Context, id string) (*gen.UserMeta, error) {
+	if r == nil || r.ORM == nil {
+		return nil, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.UserMeta(); loader != nil {
+			return loader.Load(ctx, id)
+		}
+	}
+	return r.ORM.UserMetas().ByID(ctx, id)
+}
+
+func (r *Resolver) primeUserMeta(ctx context.Context, record *gen.UserMeta) {
+	if record == nil {
+		return
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.UserMeta(); loader != nil {
+			loader.Prime(record.ID, record)
+		}
+	}
+}
+
+func toGraphQLUserMeta(record *gen.UserMeta) *graphql.UserMeta {
+	if record == nil {
+		return nil
+	}
+	return &graphql.UserMeta{
+		ID:        relay.ToGlobalID("UserMeta", record.ID),
+		UserID:    record.UserID,
+		Key:       record.Key,
+		Value:     record.Value,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
+
+func decodeUserMetaID(id string) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("id is required")
+	}
+	typ, nativeID, err := relay.FromGlobalID(id)
+	if err != nil {
+		return id, nil
+	}
+	if typ != "UserMeta" {
+		return "", fmt.Errorf("invalid id for UserMeta: %s", typ)
+	}
+	return nativeID, nil
+}
+
+func (r *queryResolver) UserMeta(ctx context.Context, id string) (*graphql.UserMeta, error) {
+	nativeID, err := decodeUserMetaID(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := r.loadUserMeta(ctx, nativeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnUserMeta(ctx, record); err != nil {
+		return nil, err
+	}
+	return toGraphQLUserMeta(record), nil
+}
+
+func (r *queryResolver) UserMetas(ctx context.Context, first *int, after *string, last *int, before *string) (*graphql.UserMetaConnection, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if last != nil || before != nil {
+		return nil, fmt.Errorf("backward pagination is not supported")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	total, err := r.ORM.UserMetas().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.ORM.UserMetas().List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql.UserMetaEdge, len(records))
+	for idx, record := range records {
+		cursor := encodeCursor(offset + idx)
+		if err := r.applyBeforeReturnUserMeta(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeUserMeta(ctx, record)
+		edges[idx] = &graphql.UserMetaEdge{
+			Cursor: cursor,
+			Node:   toGraphQLUserMeta(record),
+		}
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	pageInfo := &graphql.PageInfo{
+		HasNextPage:     offset+len(edges) < total,
+		HasPreviousPage: offset > 0,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+	return &graphql.UserMetaConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: total,
+	}, nil
+}
+
+func (r *mutationResolver) CreateUserMeta(ctx context.Context, input graphql.CreateUserMetaInput) (*graphql.CreateUserMetaPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	model := new(gen.UserMeta)
+	if input.ID != nil {
+		model.ID = *input.ID
+	}
+	if input.UserID != nil {
+		model.UserID = *input.UserID
+	}
+	if input.Key != nil {
+		model.Key = *input.Key
+	}
+	if input.Value != nil {
+		model.Value = input.Value
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeCreateUserMeta(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.UserMetas().Create(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterCreateUserMeta(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnUserMeta(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLUserMeta(record)
+	r.primeUserMeta(ctx, record)
+	return &graphql.CreateUserMetaPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserMeta:         gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) UpdateUserMeta(ctx context.Context, input graphql.UpdateUserMetaInput) (*graphql.UpdateUserMetaPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeUserMetaID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	model := &gen.UserMeta{ID: nativeID}
+	if input.UserID != nil {
+		model.UserID = *input.UserID
+	}
+	if input.Key != nil {
+		model.Key = *input.Key
+	}
+	if input.Value != nil {
+		model.Value = input.Value
+	}
+	if input.CreatedAt != nil {
+		model.CreatedAt = *input.CreatedAt
+	}
+	if input.UpdatedAt != nil {
+		model.UpdatedAt = *input.UpdatedAt
+	}
+	if err := r.applyBeforeUpdateUserMeta(ctx, input, model); err != nil {
+		return nil, err
+	}
+	record, err := r.ORM.UserMetas().Update(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterUpdateUserMeta(ctx, record); err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnUserMeta(ctx, record); err != nil {
+		return nil, err
+	}
+	gqlRecord := toGraphQLUserMeta(record)
+	r.primeUserMeta(ctx, record)
+	return &graphql.UpdateUserMetaPayload{
+		ClientMutationID: input.ClientMutationID,
+		UserMeta:         gqlRecord,
+	}, nil
+}
+
+func (r *mutationResolver) DeleteUserMeta(ctx context.Context, input graphql.DeleteUserMetaInput) (*graphql.DeleteUserMetaPayload, error) {
+	if r.ORM == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	nativeID, err := decodeUserMetaID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyBeforeDeleteUserMeta(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.ORM.UserMetas().Delete(ctx, nativeID); err != nil {
+		return nil, err
+	}
+	if err := r.applyAfterDeleteUserMeta(ctx, input, nativeID); err != nil {
+		return nil, err
+	}
+	deletedID := relay.ToGlobalID("UserMeta", nativeID)
+	return &graphql.DeleteUserMetaPayload{
+		ClientMutationID:  input.ClientMutationID,
+		DeletedUserMetaID: deletedID,
+	}, nil
+}
+
 func toGraphQLEnum[T ~string](value string) T {
 	return T(value)
 }
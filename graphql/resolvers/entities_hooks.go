@@ -8,6 +8,7 @@ import (
 
 	"github.com/deicod/ermblog/graphql"
 	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/permalink"
 )
 
 const passwordHashCost = bcrypt.DefaultCost
@@ -17,13 +18,49 @@ func newEntityHooks() entityHooks {
 		BeforeCreateUser: hashUserPasswordOnCreate,
 		BeforeUpdateUser: hashUserPasswordOnUpdate,
 		BeforeReturnUser: redactUserPasswordBeforeReturn,
+		AfterCreateUser:  sendVerificationOnCreate,
+		BeforeDeleteUser: deleteUserWithContent,
+
+		BeforeCreatePost:     chainHooks(validatePageParentOnCreate, assignPostSlugOnCreate),
+		BeforeCreateCategory: assignCategorySlugOnCreate,
+		BeforeCreateTag:      assignTagSlugOnCreate,
+		BeforeCreateRole:     assignRoleSlugOnCreate,
+
+		BeforeCreateContentType: validateContentTypeOnCreate,
+		BeforeUpdateContentType: validateContentTypeOnUpdate,
+		AfterUpdateContentType:  reindexContentTypeMeta,
+
+		BeforeCreateOption: validateOptionOnCreate,
+		BeforeUpdateOption: validateOptionOnUpdate,
+		AfterCreateOption:  invalidateSettingOnCreate,
+		AfterUpdateOption:  invalidateSettingOnUpdate,
+		BeforeDeleteOption: rejectReadOnlyOptionOnDelete,
+		AfterDeleteOption:  invalidateSettingOnDelete,
+
+		BeforeCreateUserMeta: requireAdminOnUserMetaCreate,
+		BeforeUpdateUserMeta: requireAdminOnUserMetaUpdate,
+		BeforeDeleteUserMeta: requireAdminOnUserMetaDelete,
+		BeforeReturnUserMeta: requireAdminOnUserMetaReturn,
+
+		BeforeCreateMenu:     normalizeMenuLocationOnCreate,
+		BeforeUpdateMenu:     normalizeMenuLocationOnUpdate,
+		BeforeCreateMenuItem: validateMenuItemOnCreate,
+		BeforeUpdateMenuItem: validateMenuItemOnUpdate,
+
+		BeforeUpdatePost:     chainHooks(validatePageParentOnUpdate, assignPostSlugOnUpdate, recordPostSlugHistory),
+		BeforeUpdateCategory: chainHooks(preventCategoryCycle, assignCategorySlugOnUpdate, recordCategorySlugHistory),
+		BeforeUpdateTag:      chainHooks(assignTagSlugOnUpdate, recordTagSlugHistory),
+		BeforeUpdateRole:     assignRoleSlugOnUpdate,
 	}
 }
 
-func hashUserPasswordOnCreate(_ context.Context, _ *Resolver, input graphql.CreateUserInput, model *gen.User) error {
+func hashUserPasswordOnCreate(_ context.Context, r *Resolver, input graphql.CreateUserInput, model *gen.User) error {
 	if model == nil || input.Password == nil {
 		return nil
 	}
+	if err := r.checkPassword(*input.Password, model); err != nil {
+		return err
+	}
 	hashed, err := generatePasswordHash(*input.Password)
 	if err != nil {
 		return err
@@ -32,10 +69,13 @@ func hashUserPasswordOnCreate(_ context.Context, _ *Resolver, input graphql.Crea
 	return nil
 }
 
-func hashUserPasswordOnUpdate(_ context.Context, _ *Resolver, input graphql.UpdateUserInput, model *gen.User) error {
+func hashUserPasswordOnUpdate(_ context.Context, r *Resolver, input graphql.UpdateUserInput, model *gen.User) error {
 	if model == nil || input.Password == nil {
 		return nil
 	}
+	if err := r.checkPassword(*input.Password, model); err != nil {
+		return err
+	}
 	hashed, err := generatePasswordHash(*input.Password)
 	if err != nil {
 		return err
@@ -51,6 +91,68 @@ func redactUserPasswordBeforeReturn(_ context.Context, _ *Resolver, record *gen.
 	return nil
 }
 
+func recordPostSlugHistory(ctx context.Context, r *Resolver, input graphql.UpdatePostInput, model *gen.Post) error {
+	if model == nil || input.Slug == nil {
+		return nil
+	}
+	client := r.postClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	return r.recordSlugChange(ctx, permalink.EntityPost, current.ID, current.Slug, model.Slug)
+}
+
+func recordCategorySlugHistory(ctx context.Context, r *Resolver, input graphql.UpdateCategoryInput, model *gen.Category) error {
+	if model == nil || input.Slug == nil {
+		return nil
+	}
+	client := r.categoryClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	return r.recordSlugChange(ctx, permalink.EntityCategory, current.ID, current.Slug, model.Slug)
+}
+
+func recordTagSlugHistory(ctx context.Context, r *Resolver, input graphql.UpdateTagInput, model *gen.Tag) error {
+	if model == nil || input.Slug == nil {
+		return nil
+	}
+	client := r.tagClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	return r.recordSlugChange(ctx, permalink.EntityTag, current.ID, current.Slug, model.Slug)
+}
+
+// recordSlugChange keeps the previous slug so old permalinks keep redirecting.
+// Live slugs take precedence during routing, so a row left behind by an update
+// that later fails is harmless.
+func (r *Resolver) recordSlugChange(ctx context.Context, entityType, entityID, previous, next string) error {
+	if previous == "" || previous == next {
+		return nil
+	}
+	recorder := r.slugHistoryClient()
+	if recorder == nil {
+		return nil
+	}
+	if _, err := recorder.Create(ctx, &gen.SlugHistory{EntityType: entityType, EntityID: entityID, Slug: previous}); err != nil {
+		return fmt.Errorf("record slug history: %w", err)
+	}
+	return nil
+}
+
 func generatePasswordHash(plain string) (string, error) {
 	if plain == "" {
 		return "", fmt.Errorf("password cannot be empty")
@@ -88,3 +88,66 @@ func TestRedactUserPasswordBeforeReturn(t *testing.T) {
 		t.Fatalf("expected password to be redacted, got %q", record.Password)
 	}
 }
+
+type stubPostProvider struct {
+	posts map[string]*gen.Post
+}
+
+func (s stubPostProvider) ByID(_ context.Context, id string) (*gen.Post, error) {
+	return s.posts[id], nil
+}
+
+type recordingSlugHistory struct {
+	created []*gen.SlugHistory
+}
+
+func (r *recordingSlugHistory) Create(_ context.Context, input *gen.SlugHistory) (*gen.SlugHistory, error) {
+	r.created = append(r.created, input)
+	return input, nil
+}
+
+func TestRecordPostSlugHistoryStoresPreviousSlug(t *testing.T) {
+	hooks := newEntityHooks()
+	if hooks.BeforeUpdatePost == nil {
+		t.Fatal("expected BeforeUpdatePost hook to be registered")
+	}
+
+	history := &recordingSlugHistory{}
+	resolver := &Resolver{
+		posts:         stubPostProvider{posts: map[string]*gen.Post{"p1": {ID: "p1", Slug: "hello"}}},
+		slugHistories: history,
+	}
+	slug := "hello-world"
+	model := &gen.Post{ID: "p1", Slug: slug}
+
+	if err := hooks.BeforeUpdatePost(context.Background(), resolver, graphql.UpdatePostInput{Slug: &slug}, model); err != nil {
+		t.Fatalf("slug history hook returned error: %v", err)
+	}
+	if len(history.created) != 1 {
+		t.Fatalf("expected one slug history entry, got %d", len(history.created))
+	}
+	entry := history.created[0]
+	if entry.EntityType != "Post" || entry.EntityID != "p1" || entry.Slug != "hello" {
+		t.Fatalf("unexpected slug history entry %+v", entry)
+	}
+}
+
+func TestRecordPostSlugHistorySkipsUnchangedSlug(t *testing.T) {
+	hooks := newEntityHooks()
+	history := &recordingSlugHistory{}
+	resolver := &Resolver{
+		posts:         stubPostProvider{posts: map[string]*gen.Post{"p1": {ID: "p1", Slug: "hello"}}},
+		slugHistories: history,
+	}
+	slug := "hello"
+
+	if err := hooks.BeforeUpdatePost(context.Background(), resolver, graphql.UpdatePostInput{Slug: &slug}, &gen.Post{ID: "p1", Slug: slug}); err != nil {
+		t.Fatalf("slug history hook returned error: %v", err)
+	}
+	if err := hooks.BeforeUpdatePost(context.Background(), resolver, graphql.UpdatePostInput{}, &gen.Post{ID: "p1"}); err != nil {
+		t.Fatalf("slug history hook returned error: %v", err)
+	}
+	if len(history.created) != 0 {
+		t.Fatalf("expected no slug history entries, got %d", len(history.created))
+	}
+}
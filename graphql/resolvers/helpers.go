@@ -50,6 +50,9 @@ func (r *Resolver) createOption(ctx context.Context, repo optionRepository, inpu
 	start := time.Now()
 	record, err := repo.Create(ctx, input)
 	r.recordQuery("options", "create", start, err)
+	if err == nil && record != nil {
+		r.settingsCache().Invalidate(record.Name)
+	}
 	return record, err
 }
 
@@ -60,6 +63,10 @@ func (r *Resolver) updateOption(ctx context.Context, repo optionRepository, inpu
 	start := time.Now()
 	record, err := repo.Update(ctx, input)
 	r.recordQuery("options", "update", start, err)
+	if err == nil && record != nil {
+		r.settingsCache().InvalidateID(record.ID)
+		r.settingsCache().Invalidate(record.Name)
+	}
 	return record, err
 }
 
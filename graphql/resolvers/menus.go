@@ -0,0 +1,668 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/permalink"
+)
+
+const (
+	maxMenuItemLabelLength = 200
+	maxMenuItemURLLength   = 2048
+	maxMenuLocationLength  = 64
+	// maxMenuItemPosition appends a moved item after its new siblings.
+	maxMenuItemPosition = 1<<31 - 1
+)
+
+var menuLocationPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+type menuStore interface {
+	MenuByLocation(ctx context.Context, location string) (*gen.Menu, error)
+	MenuItemsByMenu(ctx context.Context, menuIDs []string) (map[string][]*gen.MenuItem, error)
+	PostsByIDs(ctx context.Context, ids []string) (map[string]*gen.Post, error)
+	CategoriesByIDs(ctx context.Context, ids []string) (map[string]*gen.Category, error)
+	TagsByIDs(ctx context.Context, ids []string) (map[string]*gen.Tag, error)
+	MoveMenuItem(ctx context.Context, id string, parentID *string, position int) (*gen.MenuItem, error)
+	ReorderMenuItems(ctx context.Context, menuID string, parentID *string, ids []string) ([]*gen.MenuItem, error)
+}
+
+func (r *Resolver) menuStore() menuStore {
+	if r == nil {
+		return nil
+	}
+	if r.menus != nil {
+		return r.menus
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+// menuTarget is the content a menu item links, reduced to what menus
+// render.
+type menuTarget struct {
+	node      graphql1.Node
+	title     string
+	path      string
+	published bool
+}
+
+func (r *Resolver) menuByLocation(ctx context.Context, location string) (*graphql1.Menu, error) {
+	store := r.menuStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	start := time.Now()
+	record, err := store.MenuByLocation(ctx, strings.TrimSpace(location))
+	r.recordQuery("menus", "by_location", start, err)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	if err := r.applyBeforeReturnMenu(ctx, record); err != nil {
+		return nil, err
+	}
+	r.primeMenu(ctx, record)
+	return toGraphQLMenu(record), nil
+}
+
+// menuItemsOf returns every item of a menu, siblings ordered by position.
+func (r *Resolver) menuItemsOf(ctx context.Context, menuID string) ([]*gen.MenuItem, error) {
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.MenuItemsByMenu(); loader != nil {
+			return loader.Load(ctx, menuID)
+		}
+	}
+	store := r.menuStore()
+	if store == nil {
+		return nil, nil
+	}
+	start := time.Now()
+	items, err := store.MenuItemsByMenu(ctx, []string{menuID})
+	r.recordQuery("menu_items", "by_menu", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return items[menuID], nil
+}
+
+// menuChildren lists the items of menuID directly beneath parentID (nil for
+// the top level), leaving out hidden items unless includeHidden is set.
+func (r *Resolver) menuChildren(ctx context.Context, menuID string, parentID *string, includeHidden bool) ([]*graphql1.MenuItem, error) {
+	items, err := r.menuItemsOf(ctx, menuID)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]*gen.MenuItem, 0)
+	for _, item := range items {
+		if (parentID == nil && item.ParentID == nil) || (parentID != nil && item.ParentID != nil && *item.ParentID == *parentID) {
+			children = append(children, item)
+		}
+	}
+	var targets map[string]*menuTarget
+	if !includeHidden {
+		targets, err = r.menuTargets(ctx, children)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out := make([]*graphql1.MenuItem, 0, len(children))
+	for _, item := range children {
+		if !includeHidden && !menuItemVisible(item, targets[item.ID]) {
+			continue
+		}
+		if err := r.applyBeforeReturnMenuItem(ctx, item); err != nil {
+			return nil, err
+		}
+		r.primeMenuItem(ctx, item)
+		out = append(out, toGraphQLMenuItem(item))
+	}
+	return out, nil
+}
+
+func (r *Resolver) menuItemsField(ctx context.Context, obj *graphql1.Menu, includeHidden *bool) ([]*graphql1.MenuItem, error) {
+	if obj == nil {
+		return []*graphql1.MenuItem{}, nil
+	}
+	menuID, err := decodeMenuID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.menuChildren(ctx, menuID, nil, includeHidden != nil && *includeHidden)
+}
+
+func (r *Resolver) menuItemChildren(ctx context.Context, obj *graphql1.MenuItem, includeHidden *bool) ([]*graphql1.MenuItem, error) {
+	if obj == nil {
+		return []*graphql1.MenuItem{}, nil
+	}
+	itemID, err := decodeMenuItemID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.menuChildren(ctx, obj.MenuID, &itemID, includeHidden != nil && *includeHidden)
+}
+
+// menuItemTarget resolves the target of a single item; concurrent calls for
+// the items of one list share the batch loaders.
+func (r *Resolver) menuItemTarget(ctx context.Context, obj *graphql1.MenuItem) (*gen.MenuItem, *menuTarget, error) {
+	if obj == nil {
+		return nil, nil, nil
+	}
+	itemID, err := decodeMenuItemID(obj.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	item := &gen.MenuItem{ID: itemID, MenuID: obj.MenuID, Kind: string(obj.Kind), TargetID: obj.TargetID, Label: obj.Label, URL: obj.URL}
+	targets, err := r.menuTargets(ctx, []*gen.MenuItem{item})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, targets[itemID], nil
+}
+
+func (r *Resolver) menuItemTitle(ctx context.Context, obj *graphql1.MenuItem) (string, error) {
+	item, target, err := r.menuItemTarget(ctx, obj)
+	if err != nil || item == nil {
+		return "", err
+	}
+	if item.Label != nil && strings.TrimSpace(*item.Label) != "" {
+		return *item.Label, nil
+	}
+	if target != nil {
+		return target.title, nil
+	}
+	if item.URL != nil {
+		return *item.URL, nil
+	}
+	return "", nil
+}
+
+func (r *Resolver) menuItemHref(ctx context.Context, obj *graphql1.MenuItem) (*string, error) {
+	item, target, err := r.menuItemTarget(ctx, obj)
+	if err != nil || item == nil {
+		return nil, err
+	}
+	if item.Kind == string(graphql1.MenuItemKindCustom) {
+		return item.URL, nil
+	}
+	if target == nil {
+		return nil, nil
+	}
+	return &target.path, nil
+}
+
+// menuTargets loads the content linked by items through the batch loaders
+// and returns it keyed by item ID. Items whose target is missing, or is a
+// post of the wrong type for the item kind, are absent.
+func (r *Resolver) menuTargets(ctx context.Context, items []*gen.MenuItem) (map[string]*menuTarget, error) {
+	var postIDs, categoryIDs, tagIDs []string
+	for _, item := range items {
+		if item.TargetID == nil {
+			continue
+		}
+		switch item.Kind {
+		case string(graphql1.MenuItemKindPost), string(graphql1.MenuItemKindPage):
+			postIDs = append(postIDs, *item.TargetID)
+		case string(graphql1.MenuItemKindCategory):
+			categoryIDs = append(categoryIDs, *item.TargetID)
+		case string(graphql1.MenuItemKindTag):
+			tagIDs = append(tagIDs, *item.TargetID)
+		}
+	}
+	posts, err := r.menuPosts(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := r.menuCategories(ctx, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := r.menuTags(ctx, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	links := r.permalinks.WithDefaults()
+	out := make(map[string]*menuTarget, len(items))
+	for _, item := range items {
+		if item.TargetID == nil {
+			continue
+		}
+		id := *item.TargetID
+		switch item.Kind {
+		case string(graphql1.MenuItemKindPost), string(graphql1.MenuItemKindPage):
+			post := posts[id]
+			if post == nil || !menuKindMatchesPost(item.Kind, post) {
+				continue
+			}
+			kind := permalink.KindPost
+			if post.Type == string(graphql1.PostTypePage) {
+				kind = permalink.KindPage
+			}
+			date := post.CreatedAt
+			if post.PublishedAt != nil {
+				date = *post.PublishedAt
+			}
+			out[item.ID] = &menuTarget{
+				node:      toGraphQLPost(post),
+				title:     post.Title,
+				path:      links.Pattern(kind).Path(permalink.Values{ID: post.ID, Slug: post.Slug, Date: date}),
+				published: post.Status == string(graphql1.PostStatusPublished),
+			}
+		case string(graphql1.MenuItemKindCategory):
+			if category := categories[id]; category != nil {
+				out[item.ID] = &menuTarget{
+					node:      toGraphQLCategory(category),
+					title:     category.Name,
+					path:      links.Category.Path(permalink.Values{ID: category.ID, Slug: category.Slug}),
+					published: true,
+				}
+			}
+		case string(graphql1.MenuItemKindTag):
+			if tag := tags[id]; tag != nil {
+				out[item.ID] = &menuTarget{
+					node:      toGraphQLTag(tag),
+					title:     tag.Name,
+					path:      links.Tag.Path(permalink.Values{ID: tag.ID, Slug: tag.Slug}),
+					published: true,
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *Resolver) menuPosts(ctx context.Context, ids []string) (map[string]*gen.Post, error) {
+	if len(ids) == 0 {
+		return map[string]*gen.Post{}, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.PostBatch(); loader != nil {
+			return loadMany(ctx, loader, ids)
+		}
+	}
+	store := r.menuStore()
+	if store == nil {
+		return map[string]*gen.Post{}, nil
+	}
+	start := time.Now()
+	records, err := store.PostsByIDs(ctx, ids)
+	r.recordQuery("posts", "by_ids", start, err)
+	return records, err
+}
+
+func (r *Resolver) menuCategories(ctx context.Context, ids []string) (map[string]*gen.Category, error) {
+	if len(ids) == 0 {
+		return map[string]*gen.Category{}, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.CategoryBatch(); loader != nil {
+			return loadMany(ctx, loader, ids)
+		}
+	}
+	store := r.menuStore()
+	if store == nil {
+		return map[string]*gen.Category{}, nil
+	}
+	start := time.Now()
+	records, err := store.CategoriesByIDs(ctx, ids)
+	r.recordQuery("categories", "by_ids", start, err)
+	return records, err
+}
+
+func (r *Resolver) menuTags(ctx context.Context, ids []string) (map[string]*gen.Tag, error) {
+	if len(ids) == 0 {
+		return map[string]*gen.Tag{}, nil
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.TagBatch(); loader != nil {
+			return loadMany(ctx, loader, ids)
+		}
+	}
+	store := r.menuStore()
+	if store == nil {
+		return map[string]*gen.Tag{}, nil
+	}
+	start := time.Now()
+	records, err := store.TagsByIDs(ctx, ids)
+	r.recordQuery("tags", "by_ids", start, err)
+	return records, err
+}
+
+// loadMany resolves ids through a batch loader into a map, skipping
+// missing records.
+func loadMany[V any](ctx context.Context, loader *dataloaders.BatchLoader[string, *V], ids []string) (map[string]*V, error) {
+	values, err := loader.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*V, len(ids))
+	for i, value := range values {
+		if value != nil {
+			out[ids[i]] = value
+		}
+	}
+	return out, nil
+}
+
+// menuKindMatchesPost keeps page items on pages and post items off them.
+func menuKindMatchesPost(kind string, post *gen.Post) bool {
+	isPage := post.Type == string(graphql1.PostTypePage)
+	return isPage == (kind == string(graphql1.MenuItemKindPage))
+}
+
+// menuItemVisible reports whether an item is shown in menus: custom links
+// always are, other items while their target exists and is published.
+func menuItemVisible(item *gen.MenuItem, target *menuTarget) bool {
+	if item.Kind == string(graphql1.MenuItemKindCustom) {
+		return true
+	}
+	return target != nil && target.published
+}
+
+func (r *Resolver) moveMenuItem(ctx context.Context, input graphql1.MoveMenuItemInput) (*graphql1.MoveMenuItemPayload, error) {
+	store := r.menuStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	nativeID, err := decodeMenuItemID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	var parentID *string
+	if input.NewParentID != nil {
+		decoded, err := decodeMenuItemID(*input.NewParentID)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &decoded
+	}
+	position := maxMenuItemPosition
+	if input.Position != nil {
+		if *input.Position < 0 {
+			return nil, gqlerrors.BadInput("input.position", "must not be negative")
+		}
+		position = *input.Position
+	}
+
+	start := time.Now()
+	record, err := store.MoveMenuItem(ctx, nativeID, parentID, position)
+	r.recordQuery("menu_items", "move", start, err)
+	if errors.Is(err, gen.ErrMenuItemParent) {
+		return nil, gqlerrors.BadInput("input.newParentID", err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("MenuItem")
+	}
+	if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+		return nil, err
+	}
+	r.primeMenuItem(ctx, record)
+	return &graphql1.MoveMenuItemPayload{
+		ClientMutationID: input.ClientMutationID,
+		MenuItem:         toGraphQLMenuItem(record),
+	}, nil
+}
+
+func (r *Resolver) reorderMenuItems(ctx context.Context, input graphql1.ReorderMenuItemsInput) (*graphql1.ReorderMenuItemsPayload, error) {
+	store := r.menuStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	menuID, err := decodeMenuID(input.MenuID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.menuID", err.Error())
+	}
+	var parentID *string
+	if input.ParentID != nil {
+		decoded, err := decodeMenuItemID(*input.ParentID)
+		if err != nil {
+			return nil, gqlerrors.BadInput("input.parentID", err.Error())
+		}
+		parentID = &decoded
+	}
+	if len(input.ItemIDs) == 0 {
+		return nil, gqlerrors.BadInput("input.itemIDs", "must not be empty")
+	}
+	ids := make([]string, 0, len(input.ItemIDs))
+	seen := make(map[string]struct{}, len(input.ItemIDs))
+	for _, raw := range input.ItemIDs {
+		id, err := decodeMenuItemID(raw)
+		if err != nil {
+			return nil, gqlerrors.BadInput("input.itemIDs", err.Error())
+		}
+		if _, ok := seen[id]; ok {
+			return nil, gqlerrors.BadInput("input.itemIDs", "must not list an item twice")
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	start := time.Now()
+	records, err := store.ReorderMenuItems(ctx, menuID, parentID, ids)
+	r.recordQuery("menu_items", "reorder", start, err)
+	if errors.Is(err, gen.ErrMenuItemOrder) {
+		return nil, gqlerrors.BadInput("input.itemIDs", err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.MenuItem, 0, len(records))
+	for _, record := range records {
+		if err := r.applyBeforeReturnMenuItem(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeMenuItem(ctx, record)
+		out = append(out, toGraphQLMenuItem(record))
+	}
+	return &graphql1.ReorderMenuItemsPayload{ClientMutationID: input.ClientMutationID, Items: out}, nil
+}
+
+func normalizeMenuLocationOnCreate(_ context.Context, _ *Resolver, _ graphql1.CreateMenuInput, model *gen.Menu) error {
+	return normalizeMenuLocation(model)
+}
+
+func normalizeMenuLocationOnUpdate(_ context.Context, _ *Resolver, _ graphql1.UpdateMenuInput, model *gen.Menu) error {
+	return normalizeMenuLocation(model)
+}
+
+// normalizeMenuLocation trims locations, unassigns menus given an empty one
+// and restricts the rest to lower-case identifiers such as "footer-legal".
+func normalizeMenuLocation(model *gen.Menu) error {
+	if model == nil || model.Location == nil {
+		return nil
+	}
+	location := strings.TrimSpace(*model.Location)
+	if location == "" {
+		model.Location = nil
+		return nil
+	}
+	if len(location) > maxMenuLocationLength || !menuLocationPattern.MatchString(location) {
+		return gqlerrors.BadInput("input.location", "must start with a letter and contain only lower-case letters, digits, dashes and underscores")
+	}
+	model.Location = &location
+	return nil
+}
+
+func validateMenuItemOnCreate(ctx context.Context, r *Resolver, input graphql1.CreateMenuItemInput, model *gen.MenuItem) error {
+	return r.validateMenuItem(ctx, model, input.Position == nil)
+}
+
+func validateMenuItemOnUpdate(ctx context.Context, r *Resolver, _ graphql1.UpdateMenuItemInput, model *gen.MenuItem) error {
+	return r.validateMenuItem(ctx, model, false)
+}
+
+// validateMenuItem normalizes IDs and checks that the item links exactly
+// what its kind asks for and nests under an item of the same menu without
+// forming a cycle. New items without a position are appended.
+func (r *Resolver) validateMenuItem(ctx context.Context, model *gen.MenuItem, appendItem bool) error {
+	if model == nil {
+		return nil
+	}
+	if model.MenuID == "" {
+		return gqlerrors.BadInput("input.menuID", "is required")
+	}
+	menuID, err := decodeMenuID(model.MenuID)
+	if err != nil {
+		return gqlerrors.BadInput("input.menuID", err.Error())
+	}
+	model.MenuID = menuID
+	if model.Kind == "" {
+		model.Kind = string(graphql1.MenuItemKindCustom)
+	}
+	if model.Label != nil {
+		label := strings.TrimSpace(*model.Label)
+		if len(label) > maxMenuItemLabelLength {
+			return gqlerrors.BadInput("input.label", "must be at most 200 characters")
+		}
+		model.Label = &label
+		if label == "" {
+			model.Label = nil
+		}
+	}
+	if err := r.validateMenuItemLink(ctx, model); err != nil {
+		return err
+	}
+	if model.ParentID != nil && *model.ParentID == "" {
+		model.ParentID = nil
+	}
+	if model.ParentID == nil && !appendItem {
+		return nil
+	}
+	store := r.menuStore()
+	if store == nil {
+		return nil
+	}
+	start := time.Now()
+	byMenu, err := store.MenuItemsByMenu(ctx, []string{menuID})
+	r.recordQuery("menu_items", "by_menu", start, err)
+	if err != nil {
+		return err
+	}
+	items := make(map[string]*gen.MenuItem, len(byMenu[menuID]))
+	for _, item := range byMenu[menuID] {
+		items[item.ID] = item
+	}
+	if model.ParentID != nil {
+		parentID, err := decodeMenuItemID(*model.ParentID)
+		if err != nil {
+			return gqlerrors.BadInput("input.parentID", err.Error())
+		}
+		model.ParentID = &parentID
+		if _, ok := items[parentID]; !ok {
+			return gqlerrors.BadInput("input.parentID", gen.ErrMenuItemParent.Error())
+		}
+		seen := make(map[string]struct{})
+		for id := &parentID; id != nil; id = items[*id].ParentID {
+			if _, ok := seen[*id]; ok || *id == model.ID {
+				return gqlerrors.BadInput("input.parentID", gen.ErrMenuItemParent.Error())
+			}
+			seen[*id] = struct{}{}
+			if items[*id] == nil {
+				break
+			}
+		}
+	}
+	if appendItem {
+		var siblings int32
+		for _, item := range items {
+			if (model.ParentID == nil && item.ParentID == nil) || (model.ParentID != nil && item.ParentID != nil && *item.ParentID == *model.ParentID) {
+				siblings++
+			}
+		}
+		model.Position = siblings
+	}
+	return nil
+}
+
+// validateMenuItemLink requires a URL for custom links and an existing
+// target of the matching type for every other kind.
+func (r *Resolver) validateMenuItemLink(ctx context.Context, model *gen.MenuItem) error {
+	if model.URL != nil {
+		link := strings.TrimSpace(*model.URL)
+		model.URL = &link
+		if link == "" {
+			model.URL = nil
+		}
+	}
+	if model.TargetID != nil && *model.TargetID == "" {
+		model.TargetID = nil
+	}
+	if model.Kind == string(graphql1.MenuItemKindCustom) {
+		if model.TargetID != nil {
+			return gqlerrors.BadInput("input.targetID", "custom links do not take a target")
+		}
+		if model.URL == nil {
+			return gqlerrors.BadInput("input.url", "is required for custom links")
+		}
+		return validateMenuURL(*model.URL)
+	}
+	if model.URL != nil {
+		return gqlerrors.BadInput("input.url", "only custom links take a url")
+	}
+	if model.TargetID == nil {
+		return gqlerrors.BadInput("input.targetID", "is required for "+model.Kind+" items")
+	}
+	var decode func(string) (string, error)
+	switch model.Kind {
+	case string(graphql1.MenuItemKindPost), string(graphql1.MenuItemKindPage):
+		decode = decodePostID
+	case string(graphql1.MenuItemKindCategory):
+		decode = decodeCategoryID
+	default:
+		decode = decodeTagID
+	}
+	targetID, err := decode(*model.TargetID)
+	if err != nil {
+		return gqlerrors.BadInput("input.targetID", err.Error())
+	}
+	model.TargetID = &targetID
+	if r.menuStore() == nil {
+		return nil
+	}
+	targets, err := r.menuTargets(ctx, []*gen.MenuItem{model})
+	if err != nil {
+		return err
+	}
+	if targets[model.ID] == nil {
+		return gqlerrors.BadInput("input.targetID", "must reference an existing "+model.Kind)
+	}
+	return nil
+}
+
+// validateMenuURL accepts absolute http(s), mailto and tel links as well as
+// site-relative paths and fragments.
+func validateMenuURL(link string) error {
+	if len(link) > maxMenuItemURLLength {
+		return gqlerrors.BadInput("input.url", "must be at most 2048 characters")
+	}
+	if strings.HasPrefix(link, "#") || (strings.HasPrefix(link, "/") && !strings.HasPrefix(link, "//")) {
+		return nil
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return gqlerrors.BadInput("input.url", "must be a valid URL")
+	}
+	switch parsed.Scheme {
+	case "http", "https":
+		if parsed.Host == "" {
+			return gqlerrors.BadInput("input.url", "must include a host")
+		}
+	case "mailto", "tel":
+	default:
+		return gqlerrors.BadInput("input.url", "must be an http(s), mailto or tel URL, a path or a fragment")
+	}
+	return nil
+}
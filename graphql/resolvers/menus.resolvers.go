@@ -0,0 +1,73 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// Items is the resolver for the items field.
+func (r *menuResolver) Items(ctx context.Context, obj *graphql1.Menu, includeHidden *bool) ([]*graphql1.MenuItem, error) {
+	return r.menuItemsField(ctx, obj, includeHidden)
+}
+
+// Children is the resolver for the children field.
+func (r *menuItemResolver) Children(ctx context.Context, obj *graphql1.MenuItem, includeHidden *bool) ([]*graphql1.MenuItem, error) {
+	return r.menuItemChildren(ctx, obj, includeHidden)
+}
+
+// Target is the resolver for the target field.
+func (r *menuItemResolver) Target(ctx context.Context, obj *graphql1.MenuItem) (graphql1.Node, error) {
+	_, target, err := r.menuItemTarget(ctx, obj)
+	if err != nil || target == nil {
+		return nil, err
+	}
+	return target.node, nil
+}
+
+// Title is the resolver for the title field.
+func (r *menuItemResolver) Title(ctx context.Context, obj *graphql1.MenuItem) (string, error) {
+	return r.menuItemTitle(ctx, obj)
+}
+
+// Href is the resolver for the href field.
+func (r *menuItemResolver) Href(ctx context.Context, obj *graphql1.MenuItem) (*string, error) {
+	return r.menuItemHref(ctx, obj)
+}
+
+// Visible is the resolver for the visible field.
+func (r *menuItemResolver) Visible(ctx context.Context, obj *graphql1.MenuItem) (bool, error) {
+	item, target, err := r.menuItemTarget(ctx, obj)
+	if err != nil || item == nil {
+		return false, err
+	}
+	return menuItemVisible(item, target), nil
+}
+
+// MoveMenuItem is the resolver for the moveMenuItem field.
+func (r *mutationResolver) MoveMenuItem(ctx context.Context, input graphql1.MoveMenuItemInput) (*graphql1.MoveMenuItemPayload, error) {
+	return r.moveMenuItem(ctx, input)
+}
+
+// ReorderMenuItems is the resolver for the reorderMenuItems field.
+func (r *mutationResolver) ReorderMenuItems(ctx context.Context, input graphql1.ReorderMenuItemsInput) (*graphql1.ReorderMenuItemsPayload, error) {
+	return r.reorderMenuItems(ctx, input)
+}
+
+// MenuByLocation is the resolver for the menuByLocation field.
+func (r *queryResolver) MenuByLocation(ctx context.Context, location string) (*graphql1.Menu, error) {
+	return r.menuByLocation(ctx, location)
+}
+
+// Menu returns graphql1.MenuResolver implementation.
+func (r *Resolver) Menu() graphql1.MenuResolver { return &menuResolver{r} }
+
+// MenuItem returns graphql1.MenuItemResolver implementation.
+func (r *Resolver) MenuItem() graphql1.MenuItemResolver { return &menuItemResolver{r} }
+
+type menuResolver struct{ *Resolver }
+type menuItemResolver struct{ *Resolver }
@@ -0,0 +1,261 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// stubMenuStore holds one "main" menu: home (custom), about (published
+// page) with a nested draft post, and a tag whose target was deleted.
+type stubMenuStore struct {
+	items      []*gen.MenuItem
+	posts      map[string]*gen.Post
+	categories map[string]*gen.Category
+	tags       map[string]*gen.Tag
+	moved      *string
+	reordered  []string
+}
+
+func newStubMenuStore() *stubMenuStore {
+	str := func(value string) *string { return &value }
+	return &stubMenuStore{
+		items: []*gen.MenuItem{
+			{ID: "home", MenuID: "main", Position: 0, Kind: "custom", Label: str("Home"), URL: str("/")},
+			{ID: "about", MenuID: "main", Position: 1, Kind: "page", TargetID: str("about-page")},
+			{ID: "draft", MenuID: "main", ParentID: str("about"), Position: 0, Kind: "post", TargetID: str("draft-post")},
+			{ID: "gone", MenuID: "main", Position: 2, Kind: "tag", TargetID: str("deleted-tag")},
+		},
+		posts: map[string]*gen.Post{
+			"about-page": {ID: "about-page", Title: "About", Slug: "about", Type: "page", Status: "published"},
+			"draft-post": {ID: "draft-post", Title: "Draft", Slug: "draft", Type: "post", Status: "draft"},
+			"hello":      {ID: "hello", Title: "Hello", Slug: "hello", Type: "post", Status: "published"},
+		},
+		categories: map[string]*gen.Category{"news": {ID: "news", Name: "News", Slug: "news"}},
+		tags:       map[string]*gen.Tag{},
+	}
+}
+
+func (s *stubMenuStore) MenuByLocation(_ context.Context, location string) (*gen.Menu, error) {
+	if location != "header" {
+		return nil, nil
+	}
+	return &gen.Menu{ID: "main", Name: "Main", Location: &location}, nil
+}
+
+func (s *stubMenuStore) MenuItemsByMenu(_ context.Context, menuIDs []string) (map[string][]*gen.MenuItem, error) {
+	out := make(map[string][]*gen.MenuItem)
+	for _, id := range menuIDs {
+		for _, item := range s.items {
+			if item.MenuID == id {
+				out[id] = append(out[id], item)
+			}
+		}
+	}
+	return out, nil
+}
+
+func pick[V any](records map[string]*V, ids []string) map[string]*V {
+	out := make(map[string]*V)
+	for _, id := range ids {
+		if record, ok := records[id]; ok {
+			out[id] = record
+		}
+	}
+	return out
+}
+
+func (s *stubMenuStore) PostsByIDs(_ context.Context, ids []string) (map[string]*gen.Post, error) {
+	return pick(s.posts, ids), nil
+}
+
+func (s *stubMenuStore) CategoriesByIDs(_ context.Context, ids []string) (map[string]*gen.Category, error) {
+	return pick(s.categories, ids), nil
+}
+
+func (s *stubMenuStore) TagsByIDs(_ context.Context, ids []string) (map[string]*gen.Tag, error) {
+	return pick(s.tags, ids), nil
+}
+
+func (s *stubMenuStore) MoveMenuItem(_ context.Context, id string, parentID *string, position int) (*gen.MenuItem, error) {
+	s.moved = parentID
+	if parentID != nil && *parentID == "elsewhere" {
+		return nil, gen.ErrMenuItemParent
+	}
+	return &gen.MenuItem{ID: id, MenuID: "main", ParentID: parentID, Position: int32(position), Kind: "custom"}, nil
+}
+
+func (s *stubMenuStore) ReorderMenuItems(_ context.Context, menuID string, _ *string, ids []string) ([]*gen.MenuItem, error) {
+	s.reordered = ids
+	if len(ids) != 3 {
+		return nil, gen.ErrMenuItemOrder
+	}
+	out := make([]*gen.MenuItem, 0, len(ids))
+	for i, id := range ids {
+		out = append(out, &gen.MenuItem{ID: id, MenuID: menuID, Position: int32(i), Kind: "custom"})
+	}
+	return out, nil
+}
+
+func TestMenuByLocationDropsHiddenItems(t *testing.T) {
+	resolver := &Resolver{menus: newStubMenuStore()}
+	ctx := context.Background()
+
+	menu, err := resolver.Query().MenuByLocation(ctx, " header ")
+	if err != nil || menu == nil {
+		t.Fatalf("expected header menu, got %+v (%v)", menu, err)
+	}
+	items, err := resolver.Menu().Items(ctx, menu, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != relay.ToGlobalID("MenuItem", "home") || items[1].ID != relay.ToGlobalID("MenuItem", "about") {
+		t.Fatalf("expected home and about, got %+v", items)
+	}
+	children, err := resolver.MenuItem().Children(ctx, items[1], nil)
+	if err != nil || len(children) != 0 {
+		t.Fatalf("expected draft child to be hidden, got %+v (%v)", children, err)
+	}
+
+	all := true
+	items, _ = resolver.Menu().Items(ctx, menu, &all)
+	if len(items) != 3 {
+		t.Fatalf("expected hidden items with includeHidden, got %d", len(items))
+	}
+	if visible, _ := resolver.MenuItem().Visible(ctx, items[2]); visible {
+		t.Fatalf("expected item with deleted target to be hidden")
+	}
+
+	if menu, err := resolver.Query().MenuByLocation(ctx, "footer"); err != nil || menu != nil {
+		t.Fatalf("expected no footer menu, got %+v (%v)", menu, err)
+	}
+}
+
+func TestMenuItemTitleHrefAndTarget(t *testing.T) {
+	resolver := &Resolver{menus: newStubMenuStore()}
+	ctx := context.Background()
+	target := "about-page"
+	about := &graphqlpkg.MenuItem{ID: relay.ToGlobalID("MenuItem", "about"), MenuID: "main", Kind: graphqlpkg.MenuItemKindPage, TargetID: &target}
+
+	if title, _ := resolver.MenuItem().Title(ctx, about); title != "About" {
+		t.Fatalf("expected target title, got %q", title)
+	}
+	if href, _ := resolver.MenuItem().Href(ctx, about); href == nil || *href != "/about" {
+		t.Fatalf("expected page permalink, got %v", href)
+	}
+	node, err := resolver.MenuItem().Target(ctx, about)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if post, ok := node.(*graphqlpkg.Post); !ok || post.Slug != "about" {
+		t.Fatalf("expected page target, got %#v", node)
+	}
+
+	post := "hello"
+	mismatched := &graphqlpkg.MenuItem{ID: relay.ToGlobalID("MenuItem", "x"), MenuID: "main", Kind: graphqlpkg.MenuItemKindPage, TargetID: &post}
+	if node, _ := resolver.MenuItem().Target(ctx, mismatched); node != nil {
+		t.Fatalf("expected page item pointing at a post to have no target, got %#v", node)
+	}
+
+	link, label := "https://example.com", "Example"
+	custom := &graphqlpkg.MenuItem{ID: relay.ToGlobalID("MenuItem", "y"), MenuID: "main", Kind: graphqlpkg.MenuItemKindCustom, URL: &link, Label: &label}
+	if title, _ := resolver.MenuItem().Title(ctx, custom); title != "Example" {
+		t.Fatalf("expected label, got %q", title)
+	}
+	if href, _ := resolver.MenuItem().Href(ctx, custom); href == nil || *href != link {
+		t.Fatalf("expected custom url, got %v", href)
+	}
+}
+
+func TestMoveAndReorderMenuItems(t *testing.T) {
+	store := newStubMenuStore()
+	resolver := &Resolver{menus: store}
+	ctx := context.Background()
+
+	parent := relay.ToGlobalID("MenuItem", "about")
+	payload, err := resolver.Mutation().MoveMenuItem(ctx, graphqlpkg.MoveMenuItemInput{ID: relay.ToGlobalID("MenuItem", "home"), NewParentID: &parent})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.moved == nil || *store.moved != "about" || payload.MenuItem.Position != maxMenuItemPosition {
+		t.Fatalf("expected append under about, got parent=%v item=%+v", store.moved, payload.MenuItem)
+	}
+	elsewhere := "elsewhere"
+	_, err = resolver.Mutation().MoveMenuItem(ctx, graphqlpkg.MoveMenuItemInput{ID: "home", NewParentID: &elsewhere})
+	expectBadInput(t, err, "input.newParentID")
+	negative := -1
+	_, err = resolver.Mutation().MoveMenuItem(ctx, graphqlpkg.MoveMenuItemInput{ID: "home", Position: &negative})
+	expectBadInput(t, err, "input.position")
+
+	reordered, err := resolver.Mutation().ReorderMenuItems(ctx, graphqlpkg.ReorderMenuItemsInput{MenuID: "main", ItemIDs: []string{"gone", "about", relay.ToGlobalID("MenuItem", "home")}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reordered.Items) != 3 || store.reordered[2] != "home" {
+		t.Fatalf("unexpected reorder %v -> %+v", store.reordered, reordered.Items)
+	}
+	_, err = resolver.Mutation().ReorderMenuItems(ctx, graphqlpkg.ReorderMenuItemsInput{MenuID: "main", ItemIDs: []string{"home", "about"}})
+	expectBadInput(t, err, "input.itemIDs")
+	_, err = resolver.Mutation().ReorderMenuItems(ctx, graphqlpkg.ReorderMenuItemsInput{MenuID: "main", ItemIDs: []string{"home", "home", "about"}})
+	expectBadInput(t, err, "input.itemIDs")
+}
+
+func TestValidateMenuItem(t *testing.T) {
+	resolver := &Resolver{menus: newStubMenuStore()}
+	ctx := context.Background()
+	str := func(value string) *string { return &value }
+
+	model := &gen.MenuItem{MenuID: relay.ToGlobalID("Menu", "main"), Label: str("  Blog "), URL: str("/blog")}
+	if err := validateMenuItemOnCreate(ctx, resolver, graphqlpkg.CreateMenuItemInput{}, model); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model.MenuID != "main" || model.Kind != "custom" || *model.Label != "Blog" || model.Position != 3 {
+		t.Fatalf("expected normalized appended custom item, got %+v", model)
+	}
+
+	model = &gen.MenuItem{MenuID: "main", Kind: "category", TargetID: str(relay.ToGlobalID("Category", "news")), ParentID: str("about")}
+	if err := validateMenuItemOnCreate(ctx, resolver, graphqlpkg.CreateMenuItemInput{}, model); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *model.TargetID != "news" || model.Position != 1 {
+		t.Fatalf("expected native target appended under about, got %+v", model)
+	}
+
+	cases := []struct {
+		model *gen.MenuItem
+		field string
+	}{
+		{&gen.MenuItem{Kind: "custom", URL: str("/")}, "input.menuID"},
+		{&gen.MenuItem{MenuID: "main", Kind: "custom"}, "input.url"},
+		{&gen.MenuItem{MenuID: "main", Kind: "custom", URL: str("javascript:alert(1)")}, "input.url"},
+		{&gen.MenuItem{MenuID: "main", Kind: "custom", URL: str("/"), TargetID: str("hello")}, "input.targetID"},
+		{&gen.MenuItem{MenuID: "main", Kind: "post", URL: str("/"), TargetID: str("hello")}, "input.url"},
+		{&gen.MenuItem{MenuID: "main", Kind: "page", TargetID: str("hello")}, "input.targetID"},
+		{&gen.MenuItem{MenuID: "main", Kind: "tag", TargetID: str("missing")}, "input.targetID"},
+		{&gen.MenuItem{MenuID: "main", Kind: "custom", URL: str("/"), ParentID: str("nowhere")}, "input.parentID"},
+		{&gen.MenuItem{ID: "about", MenuID: "main", Kind: "page", TargetID: str("about-page"), ParentID: str("draft")}, "input.parentID"},
+	}
+	for _, tc := range cases {
+		err := validateMenuItemOnUpdate(ctx, resolver, graphqlpkg.UpdateMenuItemInput{}, tc.model)
+		expectBadInput(t, err, tc.field)
+	}
+}
+
+func TestNormalizeMenuLocation(t *testing.T) {
+	location := " footer-legal "
+	model := &gen.Menu{Location: &location}
+	if err := normalizeMenuLocationOnCreate(context.Background(), nil, graphqlpkg.CreateMenuInput{}, model); err != nil || *model.Location != "footer-legal" {
+		t.Fatalf("expected trimmed location, got %v (%v)", model.Location, err)
+	}
+	empty := " "
+	model = &gen.Menu{Location: &empty}
+	if err := normalizeMenuLocationOnUpdate(context.Background(), nil, graphqlpkg.UpdateMenuInput{}, model); err != nil || model.Location != nil {
+		t.Fatalf("expected empty location to unassign the menu, got %v (%v)", model.Location, err)
+	}
+	invalid := "Main Menu"
+	err := normalizeMenuLocationOnUpdate(context.Background(), nil, graphqlpkg.UpdateMenuInput{}, &gen.Menu{Location: &invalid})
+	expectBadInput(t, err, "input.location")
+}
@@ -7,16 +7,24 @@ package resolvers
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"strings"
+	"time"
 
 	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
 	"github.com/deicod/ermblog/oidc"
 	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/usermeta"
 )
 
+// notificationPreferenceOptionPrefix names the options that held
+// preferences before they moved to user meta. Subjects without a user row
+// still store theirs there.
 const notificationPreferenceOptionPrefix = "notification_preferences:"
 
+var notificationPreferencesMeta = usermeta.Key[storedNotificationPreferences]{Name: "notification_preferences"}
+
 var notificationCategoryOrder = []graphql1.NotificationCategory{
 	graphql1.NotificationCategoryCommentCreated,
 	graphql1.NotificationCategoryCommentUpdated,
@@ -70,6 +78,10 @@ func decodeStoredPreferences(raw json.RawMessage) map[graphql1.NotificationCateg
 	if err := json.Unmarshal(raw, &stored); err != nil {
 		return nil
 	}
+	return storedPreferenceValues(stored)
+}
+
+func storedPreferenceValues(stored storedNotificationPreferences) map[graphql1.NotificationCategory]bool {
 	if len(stored.Preferences) == 0 {
 		return nil
 	}
@@ -102,7 +114,7 @@ func normalisePreferenceInput(entries []*graphql1.NotificationPreferenceInput) m
 	return preferences
 }
 
-func encodePreferences(preferences map[graphql1.NotificationCategory]bool) (json.RawMessage, error) {
+func toStoredPreferences(preferences map[graphql1.NotificationCategory]bool) storedNotificationPreferences {
 	payload := storedNotificationPreferences{Preferences: make(map[string]bool, len(preferences))}
 	for category, enabled := range preferences {
 		if !isKnownNotificationCategory(category) {
@@ -110,6 +122,11 @@ func encodePreferences(preferences map[graphql1.NotificationCategory]bool) (json
 		}
 		payload.Preferences[string(category)] = enabled
 	}
+	return payload
+}
+
+func encodePreferences(preferences map[graphql1.NotificationCategory]bool) (json.RawMessage, error) {
+	payload := toStoredPreferences(preferences)
 	data, err := json.Marshal(&payload)
 	if err != nil {
 		return nil, err
@@ -117,21 +134,66 @@ func encodePreferences(preferences map[graphql1.NotificationCategory]bool) (json
 	return json.RawMessage(data), nil
 }
 
+// loadMetaPreferences reads the user's preferences from user meta.
+func (r *Resolver) loadMetaPreferences(ctx context.Context, userID string) (map[graphql1.NotificationCategory]bool, bool, error) {
+	store := r.userMetaStore()
+	if store == nil {
+		return nil, false, nil
+	}
+	start := time.Now()
+	stored, found, err := usermeta.Get(ctx, store, userID, notificationPreferencesMeta)
+	r.recordQuery("user_meta", "load", start, err)
+	if err != nil || !found {
+		return nil, false, err
+	}
+	return storedPreferenceValues(stored), true, nil
+}
+
+// saveMetaPreferences stores the user's preferences in user meta. It
+// reports false when the subject has no user row to attach them to.
+func (r *Resolver) saveMetaPreferences(ctx context.Context, userID string, preferences map[graphql1.NotificationCategory]bool) (bool, error) {
+	store := r.userMetaStore()
+	if store == nil {
+		return false, nil
+	}
+	start := time.Now()
+	err := usermeta.Set(ctx, store, userID, notificationPreferencesMeta, toStoredPreferences(preferences))
+	if errors.Is(err, gen.ErrUserNotFound) {
+		r.recordQuery("user_meta", "set", start, nil)
+		return false, nil
+	}
+	r.recordQuery("user_meta", "set", start, err)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // UpdateNotificationPreferences is the resolver for the updateNotificationPreferences field.
 func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, input graphql1.UpdateNotificationPreferencesInput) (*graphql1.UpdateNotificationPreferencesPayload, error) {
 	claims, ok := oidc.FromContext(ctx)
 	if !ok {
-		return nil, fmt.Errorf("unauthorized")
+		return nil, gqlerrors.Unauthenticated()
 	}
 	userID := strings.TrimSpace(claims.Subject)
 	if userID == "" {
-		return nil, fmt.Errorf("unauthorized")
+		return nil, gqlerrors.Unauthenticated()
+	}
+	normalized := normalisePreferenceInput(input.Preferences)
+	saved, err := r.saveMetaPreferences(ctx, userID, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if saved {
+		return &graphql1.UpdateNotificationPreferencesPayload{
+			ClientMutationID: input.ClientMutationID,
+			Preferences:      &graphql1.NotificationPreferences{Entries: mapToPreferenceEntries(normalized)},
+		}, nil
 	}
 	repo := r.optionRepository()
 	if repo == nil {
-		return nil, fmt.Errorf("option repository is not configured")
+		return nil, gqlerrors.Internal("option repository is not configured")
 	}
-	normalized := normalisePreferenceInput(input.Preferences)
 	encoded, err := encodePreferences(normalized)
 	if err != nil {
 		return nil, err
@@ -179,8 +241,14 @@ func (r *queryResolver) NotificationPreferences(ctx context.Context) (*graphql1.
 	if userID == "" {
 		return &graphql1.NotificationPreferences{Entries: mapToPreferenceEntries(nil)}, nil
 	}
+	stored, found, err := r.loadMetaPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return &graphql1.NotificationPreferences{Entries: mapToPreferenceEntries(stored)}, nil
+	}
 	repo := r.optionRepository()
-	var stored map[graphql1.NotificationCategory]bool
 	if repo != nil {
 		record, err := r.findOptionByName(ctx, repo, preferenceOptionName(userID))
 		if err != nil {
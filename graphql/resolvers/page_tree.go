@@ -0,0 +1,249 @@
+package resolvers
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// maxPagePathSegments bounds pageByPath lookups.
+const maxPagePathSegments = 32
+
+type pageHierarchy interface {
+	PageAncestors(ctx context.Context, id string) ([]*gen.Post, error)
+	PageTree(ctx context.Context, rootID *string, maxDepth int, status *string) ([]gen.PageNode, error)
+	PageCreatesCycle(ctx context.Context, id, parentID string) (bool, error)
+	PageByPath(ctx context.Context, slugs []string) (*gen.Post, error)
+}
+
+func (r *Resolver) pageHierarchy() pageHierarchy {
+	if r == nil {
+		return nil
+	}
+	if r.pages != nil {
+		return r.pages
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) postAncestors(ctx context.Context, obj *graphql1.Post) ([]*gen.Post, error) {
+	pages := r.pageHierarchy()
+	if obj == nil || obj.ParentID == nil || pages == nil {
+		return nil, nil
+	}
+	nativeID, err := decodePostID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	ancestors, err := pages.PageAncestors(ctx, nativeID)
+	r.recordQuery("posts", "page_ancestors", start, err)
+	return ancestors, err
+}
+
+func (r *Resolver) postChildren(ctx context.Context, obj *graphql1.Post) ([]*graphql1.Post, error) {
+	pages := r.pageHierarchy()
+	if obj == nil || obj.Type != graphql1.PostTypePage || pages == nil {
+		return []*graphql1.Post{}, nil
+	}
+	nativeID, err := decodePostID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	nodes, err := pages.PageTree(ctx, &nativeID, 1, nil)
+	r.recordQuery("posts", "page_tree", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.Post, 0, len(nodes))
+	for _, node := range nodes {
+		post, err := r.returnPost(ctx, node.Page)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, post)
+	}
+	return out, nil
+}
+
+// postPath joins the slugs of a page's ancestors and the page itself.
+func (r *Resolver) postPath(ctx context.Context, obj *graphql1.Post) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	ancestors, err := r.postAncestors(ctx, obj)
+	if err != nil {
+		return "", err
+	}
+	slugs := make([]string, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		slugs = append(slugs, ancestor.Slug)
+	}
+	return strings.Join(append(slugs, obj.Slug), "/"), nil
+}
+
+func (r *Resolver) pageTree(ctx context.Context, rootID *string, depth *int, status *graphql1.PostStatus) ([]*graphql1.PageTreeNode, error) {
+	pages := r.pageHierarchy()
+	if pages == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	var root *string
+	if rootID != nil {
+		nativeID, err := decodePostID(*rootID)
+		if err != nil {
+			return nil, gqlerrors.BadInput("rootID", err.Error())
+		}
+		root = &nativeID
+	}
+	maxDepth := 0
+	if depth != nil {
+		if *depth < 1 {
+			return nil, gqlerrors.BadInput("depth", "must be at least 1")
+		}
+		maxDepth = *depth
+	}
+	var statusFilter *string
+	if status != nil {
+		value := string(*status)
+		statusFilter = &value
+	}
+	start := time.Now()
+	nodes, err := pages.PageTree(ctx, root, maxDepth, statusFilter)
+	r.recordQuery("posts", "page_tree", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.PageTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		post, err := r.returnPost(ctx, node.Page)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &graphql1.PageTreeNode{Page: post, Depth: node.Depth, Path: node.Path})
+	}
+	return out, nil
+}
+
+func (r *Resolver) pageByPath(ctx context.Context, path string) (*graphql1.Post, error) {
+	pages := r.pageHierarchy()
+	if pages == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	slugs, err := splitPagePath(path)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	record, err := pages.PageByPath(ctx, slugs)
+	r.recordQuery("posts", "page_by_path", start, err)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return r.returnPost(ctx, record)
+}
+
+// splitPagePath turns "/about/team/" into its unescaped slugs, ignoring
+// leading, trailing and doubled slashes.
+func splitPagePath(path string) ([]string, error) {
+	slugs := make([]string, 0)
+	for _, segment := range strings.Split(path, "/") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+		slug, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, gqlerrors.BadInput("path", "contains an invalid escape sequence")
+		}
+		slugs = append(slugs, slug)
+	}
+	if len(slugs) == 0 {
+		return nil, gqlerrors.BadInput("path", "must contain at least one slug")
+	}
+	if len(slugs) > maxPagePathSegments {
+		return nil, gqlerrors.BadInput("path", "must contain at most 32 slugs")
+	}
+	return slugs, nil
+}
+
+func (r *Resolver) returnPost(ctx context.Context, record *gen.Post) (*graphql1.Post, error) {
+	if err := r.applyBeforeReturnPost(ctx, record); err != nil {
+		return nil, err
+	}
+	r.primePost(ctx, record)
+	return toGraphQLPost(record), nil
+}
+
+func validatePageParentOnCreate(ctx context.Context, r *Resolver, _ graphql1.CreatePostInput, model *gen.Post) error {
+	return r.validatePageParent(ctx, model, false)
+}
+
+func validatePageParentOnUpdate(ctx context.Context, r *Resolver, input graphql1.UpdatePostInput, model *gen.Post) error {
+	retyped := input.Type != nil && *input.Type != graphql1.PostTypePage
+	return r.validatePageParent(ctx, model, retyped)
+}
+
+// validatePageParent keeps the page hierarchy sound: only pages nest, their
+// parent must be an existing page and a page cannot move beneath itself. An
+// empty parentID leaves the page at the root. When retyped is set, pages
+// with children must keep their type.
+func (r *Resolver) validatePageParent(ctx context.Context, model *gen.Post, retyped bool) error {
+	if model == nil {
+		return nil
+	}
+	pages := r.pageHierarchy()
+	if retyped && model.ID != "" && pages != nil {
+		children, err := pages.PageTree(ctx, &model.ID, 1, nil)
+		if err != nil {
+			return err
+		}
+		if len(children) > 0 {
+			return gqlerrors.BadInput("input.type", "pages with child pages must stay pages")
+		}
+	}
+	if model.ParentID == nil {
+		return nil
+	}
+	if *model.ParentID == "" {
+		model.ParentID = nil
+		return nil
+	}
+	parentID, err := decodePostID(*model.ParentID)
+	if err != nil {
+		return gqlerrors.BadInput("input.parentID", err.Error())
+	}
+	model.ParentID = &parentID
+	if model.Type != string(graphql1.PostTypePage) {
+		return gqlerrors.BadInput("input.parentID", "only pages can have a parent page")
+	}
+	parent, err := r.loadPost(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	if parent == nil && r.ORM != nil {
+		return gqlerrors.BadInput("input.parentID", "parent page does not exist")
+	}
+	if parent != nil && parent.Type != string(graphql1.PostTypePage) {
+		return gqlerrors.BadInput("input.parentID", "parent must be a page")
+	}
+	if model.ID == "" || pages == nil {
+		return nil
+	}
+	cycle, err := pages.PageCreatesCycle(ctx, model.ID, parentID)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return gqlerrors.BadInput("input.parentID", gen.ErrPageCycle.Error())
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// Ancestors is the resolver for the ancestors field.
+func (r *postResolver) Ancestors(ctx context.Context, obj *graphql1.Post) ([]*graphql1.Post, error) {
+	ancestors, err := r.postAncestors(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.Post, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		post, err := r.returnPost(ctx, ancestor)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, post)
+	}
+	return out, nil
+}
+
+// Children is the resolver for the children field.
+func (r *postResolver) Children(ctx context.Context, obj *graphql1.Post) ([]*graphql1.Post, error) {
+	return r.postChildren(ctx, obj)
+}
+
+// Path is the resolver for the path field.
+func (r *postResolver) Path(ctx context.Context, obj *graphql1.Post) (string, error) {
+	return r.postPath(ctx, obj)
+}
+
+// PageTree is the resolver for the pageTree field.
+func (r *queryResolver) PageTree(ctx context.Context, rootID *string, depth *int, status *graphql1.PostStatus) ([]*graphql1.PageTreeNode, error) {
+	return r.pageTree(ctx, rootID, depth, status)
+}
+
+// PageByPath is the resolver for the pageByPath field.
+func (r *queryResolver) PageByPath(ctx context.Context, path string) (*graphql1.Post, error) {
+	return r.pageByPath(ctx, path)
+}
@@ -0,0 +1,212 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// stubPageHierarchy models pages as a parent map: about > team > alice.
+type stubPageHierarchy struct {
+	parents  map[string]string
+	rootID   *string
+	maxDepth int
+	status   *string
+	slugs    []string
+}
+
+func newStubPageHierarchy() *stubPageHierarchy {
+	return &stubPageHierarchy{parents: map[string]string{"team": "about", "alice": "team"}}
+}
+
+func (s *stubPageHierarchy) page(id string) *gen.Post {
+	record := &gen.Post{ID: id, Slug: id, Type: string(graphqlpkg.PostTypePage)}
+	if parent, ok := s.parents[id]; ok {
+		record.ParentID = &parent
+	}
+	return record
+}
+
+func (s *stubPageHierarchy) PageAncestors(_ context.Context, id string) ([]*gen.Post, error) {
+	var out []*gen.Post
+	for parent, ok := s.parents[id]; ok; parent, ok = s.parents[parent] {
+		out = append([]*gen.Post{s.page(parent)}, out...)
+	}
+	return out, nil
+}
+
+func (s *stubPageHierarchy) PageTree(_ context.Context, rootID *string, maxDepth int, status *string) ([]gen.PageNode, error) {
+	s.rootID, s.maxDepth, s.status = rootID, maxDepth, status
+	var out []gen.PageNode
+	for child, parent := range s.parents {
+		if rootID != nil && parent == *rootID {
+			out = append(out, gen.PageNode{Page: s.page(child), Depth: 1, Path: parent + "/" + child})
+		}
+	}
+	return out, nil
+}
+
+func (s *stubPageHierarchy) PageCreatesCycle(ctx context.Context, id, parentID string) (bool, error) {
+	if id == parentID {
+		return true, nil
+	}
+	ancestors, _ := s.PageAncestors(ctx, parentID)
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *stubPageHierarchy) PageByPath(_ context.Context, slugs []string) (*gen.Post, error) {
+	s.slugs = slugs
+	if strings.Join(slugs, "/") == "about/team" {
+		return s.page("team"), nil
+	}
+	return nil, nil
+}
+
+func TestPostAncestorsAndPath(t *testing.T) {
+	pages := newStubPageHierarchy()
+	resolver := &Resolver{pages: pages}
+	alice := toGraphQLPost(pages.page("alice"))
+	alice.ID = relay.ToGlobalID("Post", "alice")
+
+	ancestors, err := resolver.Post().Ancestors(context.Background(), alice)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ancestors) != 2 || ancestors[0].Slug != "about" || ancestors[1].Slug != "team" {
+		t.Fatalf("expected root-first ancestors, got %+v", ancestors)
+	}
+
+	path, err := resolver.Post().Path(context.Background(), alice)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "about/team/alice" {
+		t.Fatalf("expected composed path, got %q", path)
+	}
+
+	post := &graphqlpkg.Post{ID: "news", Slug: "hello-world", Type: graphqlpkg.PostTypePost}
+	if path, _ := resolver.Post().Path(context.Background(), post); path != "hello-world" {
+		t.Fatalf("expected plain slug for posts, got %q", path)
+	}
+}
+
+func TestPostChildrenOnlyForPages(t *testing.T) {
+	pages := newStubPageHierarchy()
+	resolver := &Resolver{pages: pages}
+
+	children, err := resolver.Post().Children(context.Background(), toGraphQLPost(pages.page("about")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(children) != 1 || children[0].Slug != "team" || pages.maxDepth != 1 {
+		t.Fatalf("unexpected children %+v at depth %d", children, pages.maxDepth)
+	}
+
+	pages.rootID = nil
+	post := &graphqlpkg.Post{ID: "about", Type: graphqlpkg.PostTypePost}
+	if children, err := resolver.Post().Children(context.Background(), post); err != nil || len(children) != 0 || pages.rootID != nil {
+		t.Fatalf("expected no lookup for posts, got %+v (%v)", children, err)
+	}
+}
+
+func TestPageTreeArguments(t *testing.T) {
+	pages := newStubPageHierarchy()
+	resolver := &Resolver{pages: pages}
+	ctx := context.Background()
+
+	root := relay.ToGlobalID("Post", "about")
+	depth := 2
+	status := graphqlpkg.PostStatusPublished
+	nodes, err := resolver.Query().PageTree(ctx, &root, &depth, &status)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages.rootID == nil || *pages.rootID != "about" || pages.maxDepth != 2 || pages.status == nil || *pages.status != "published" {
+		t.Fatalf("unexpected walk root=%v depth=%d status=%v", pages.rootID, pages.maxDepth, pages.status)
+	}
+	if len(nodes) != 1 || nodes[0].Page.Slug != "team" || nodes[0].Depth != 1 || nodes[0].Path != "about/team" {
+		t.Fatalf("unexpected nodes %+v", nodes)
+	}
+
+	if _, err := resolver.Query().PageTree(ctx, nil, nil, nil); err != nil || pages.rootID != nil || pages.maxDepth != 0 || pages.status != nil {
+		t.Fatalf("expected whole tree from the root, got root=%v depth=%d (%v)", pages.rootID, pages.maxDepth, err)
+	}
+
+	zero := 0
+	_, err = resolver.Query().PageTree(ctx, nil, &zero, nil)
+	expectBadInput(t, err, "depth")
+}
+
+func TestPageByPath(t *testing.T) {
+	pages := newStubPageHierarchy()
+	resolver := &Resolver{pages: pages}
+	ctx := context.Background()
+
+	page, err := resolver.Query().PageByPath(ctx, "/about//team/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page == nil || page.Slug != "team" {
+		t.Fatalf("expected team page, got %+v", page)
+	}
+	if strings.Join(pages.slugs, ",") != "about,team" {
+		t.Fatalf("expected cleaned slugs, got %v", pages.slugs)
+	}
+
+	if page, err := resolver.Query().PageByPath(ctx, "team"); err != nil || page != nil {
+		t.Fatalf("expected nested page to need its full path, got %+v (%v)", page, err)
+	}
+
+	_, err = resolver.Query().PageByPath(ctx, " / ")
+	expectBadInput(t, err, "path")
+	_, err = resolver.Query().PageByPath(ctx, "about/%zz")
+	expectBadInput(t, err, "path")
+}
+
+func TestValidatePageParent(t *testing.T) {
+	resolver := &Resolver{pages: newStubPageHierarchy()}
+	ctx := context.Background()
+	page := string(graphqlpkg.PostTypePage)
+
+	about := relay.ToGlobalID("Post", "about")
+	model := &gen.Post{ID: "contact", Type: page, ParentID: &about}
+	if err := validatePageParentOnUpdate(ctx, resolver, graphqlpkg.UpdatePostInput{}, model); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model.ParentID == nil || *model.ParentID != "about" {
+		t.Fatalf("expected parent to be stored as a native id, got %v", model.ParentID)
+	}
+
+	alice := "alice"
+	err := validatePageParentOnUpdate(ctx, resolver, graphqlpkg.UpdatePostInput{}, &gen.Post{ID: "about", Type: page, ParentID: &alice})
+	expectBadInput(t, err, "input.parentID")
+
+	self := "new"
+	err = validatePageParentOnCreate(ctx, resolver, graphqlpkg.CreatePostInput{}, &gen.Post{ID: "new", Type: page, ParentID: &self})
+	expectBadInput(t, err, "input.parentID")
+
+	err = validatePageParentOnCreate(ctx, resolver, graphqlpkg.CreatePostInput{}, &gen.Post{Type: string(graphqlpkg.PostTypePost), ParentID: &alice})
+	expectBadInput(t, err, "input.parentID")
+
+	empty := ""
+	model = &gen.Post{ID: "team", Type: page, ParentID: &empty}
+	if err := validatePageParentOnUpdate(ctx, resolver, graphqlpkg.UpdatePostInput{}, model); err != nil || model.ParentID != nil {
+		t.Fatalf("expected empty parent to be cleared, got %v (%v)", model.ParentID, err)
+	}
+
+	postType := graphqlpkg.PostTypePost
+	err = validatePageParentOnUpdate(ctx, resolver, graphqlpkg.UpdatePostInput{Type: &postType}, &gen.Post{ID: "about", Type: string(postType)})
+	expectBadInput(t, err, "input.type")
+	if err := validatePageParentOnUpdate(ctx, resolver, graphqlpkg.UpdatePostInput{Type: &postType}, &gen.Post{ID: "alice", Type: string(postType)}); err != nil {
+		t.Fatalf("expected leaf page to change type, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/permalink"
+)
+
+// ResolvePath is the resolver for the resolvePath field.
+func (r *queryResolver) ResolvePath(ctx context.Context, path string) (*graphql1.PathResolution, error) {
+	router := r.pathRouter()
+	if router == nil {
+		return nil, gqlerrors.Internal("permalink router is not configured")
+	}
+	resolution, err := router.Resolve(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if resolution == nil {
+		return &graphql1.PathResolution{}, nil
+	}
+	node, err := r.Node(ctx, relay.ToGlobalID(permalink.EntityType(resolution.Kind), resolution.Target.ID))
+	if err != nil {
+		return nil, err
+	}
+	canonical := resolution.Path
+	return &graphql1.PathResolution{Node: node, CanonicalPath: &canonical, Redirect: resolution.Redirect}, nil
+}
@@ -0,0 +1,70 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/deicod/ermblog/permalink"
+)
+
+type stubPathResolver struct {
+	resolution *permalink.Resolution
+	err        error
+	paths      []string
+}
+
+func (s *stubPathResolver) Resolve(_ context.Context, path string) (*permalink.Resolution, error) {
+	s.paths = append(s.paths, path)
+	return s.resolution, s.err
+}
+
+func TestResolvePathReportsRedirect(t *testing.T) {
+	t.Parallel()
+
+	paths := &stubPathResolver{resolution: &permalink.Resolution{
+		Kind:     permalink.KindPost,
+		Target:   permalink.Target{ID: "p1", Slug: "hello-world"},
+		Path:     "/2024/05/hello-world",
+		Redirect: true,
+	}}
+	resolver := &Resolver{paths: paths}
+
+	result, err := (&queryResolver{resolver}).ResolvePath(context.Background(), "/2024/05/hello")
+	if err != nil {
+		t.Fatalf("ResolvePath returned error: %v", err)
+	}
+	if len(paths.paths) != 1 || paths.paths[0] != "/2024/05/hello" {
+		t.Fatalf("unexpected resolved paths %v", paths.paths)
+	}
+	if !result.Redirect {
+		t.Fatal("expected redirect to be reported")
+	}
+	if result.CanonicalPath == nil || *result.CanonicalPath != "/2024/05/hello-world" {
+		t.Fatalf("unexpected canonical path %v", result.CanonicalPath)
+	}
+}
+
+func TestResolvePathReturnsEmptyResultForUnknownPath(t *testing.T) {
+	t.Parallel()
+
+	resolver := &Resolver{paths: &stubPathResolver{}}
+
+	result, err := (&queryResolver{resolver}).ResolvePath(context.Background(), "/missing")
+	if err != nil {
+		t.Fatalf("ResolvePath returned error: %v", err)
+	}
+	if result.Node != nil || result.CanonicalPath != nil || result.Redirect {
+		t.Fatalf("expected empty resolution, got %+v", result)
+	}
+}
+
+func TestResolvePathPropagatesErrors(t *testing.T) {
+	t.Parallel()
+
+	resolver := &Resolver{paths: &stubPathResolver{err: errors.New("boom")}}
+
+	if _, err := (&queryResolver{resolver}).ResolvePath(context.Background(), "/missing"); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,34 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+	"strings"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/persisted"
+)
+
+// RegisterPersistedQueries is the resolver for the registerPersistedQueries field.
+func (r *mutationResolver) RegisterPersistedQueries(ctx context.Context, input graphql1.RegisterPersistedQueriesInput) (*graphql1.RegisterPersistedQueriesPayload, error) {
+	if r.persistedQueries == nil {
+		return nil, gqlerrors.Internal("persisted queries are not configured")
+	}
+	manifest, err := persisted.ParseManifest(strings.NewReader(input.Manifest))
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.manifest", err.Error())
+	}
+	registered, err := r.persistedQueries.Register(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.RegisterPersistedQueriesPayload{
+		ClientMutationID: input.ClientMutationID,
+		Registered:       registered,
+		Total:            r.persistedQueries.Len(),
+	}, nil
+}
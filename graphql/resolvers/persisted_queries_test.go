@@ -0,0 +1,37 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/persisted"
+)
+
+func TestRegisterPersistedQueriesAddsManifest(t *testing.T) {
+	t.Parallel()
+
+	store := persisted.NewStore(0)
+	resolver := NewWithOptions(Options{PersistedQueries: store})
+	query := "query Posts { posts { totalCount } }"
+	manifest := `{"` + persisted.Hash(query) + `": "` + query + `"}`
+
+	payload, err := resolver.Mutation().RegisterPersistedQueries(context.Background(), graphqlpkg.RegisterPersistedQueriesInput{Manifest: manifest})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if payload.Registered != 1 || payload.Total != 1 {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+	if got, ok := store.Allowed(context.Background(), persisted.Hash(query)); !ok || got != query {
+		t.Fatalf("expected query to be allow-listed, got %q", got)
+	}
+
+	_, err = resolver.Mutation().RegisterPersistedQueries(context.Background(), graphqlpkg.RegisterPersistedQueriesInput{Manifest: `{"bad": "` + query + `"}`})
+	var typed *gqlerrors.Error
+	if !errors.As(err, &typed) || typed.Code != gqlerrors.CodeBadUserInput || typed.Field != "input.manifest" {
+		t.Fatalf("expected bad manifest error, got %v", err)
+	}
+}
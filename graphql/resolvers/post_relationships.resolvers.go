@@ -6,10 +6,10 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
 
 	graphql1 "github.com/deicod/ermblog/graphql"
 	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
 	"github.com/deicod/ermblog/orm/gen"
 )
 
@@ -203,7 +203,7 @@ func (r *Resolver) normalizeCategoryIDs(ctx context.Context, ids []string) ([]st
 	}
 	client := r.categoryClient()
 	if client == nil {
-		return nil, fmt.Errorf("category provider is not configured")
+		return nil, gqlerrors.Internal("category provider is not configured")
 	}
 	seen := make(map[string]struct{}, len(ids))
 	normalized := make([]string, 0, len(ids))
@@ -220,7 +220,7 @@ func (r *Resolver) normalizeCategoryIDs(ctx context.Context, ids []string) ([]st
 			return nil, err
 		}
 		if record == nil {
-			return nil, fmt.Errorf("category not found")
+			return nil, gqlerrors.NotFound("Category")
 		}
 		seen[nativeID] = struct{}{}
 		normalized = append(normalized, nativeID)
@@ -235,7 +235,7 @@ func (r *Resolver) normalizeTagIDs(ctx context.Context, ids []string) ([]string,
 	}
 	client := r.tagClient()
 	if client == nil {
-		return nil, fmt.Errorf("tag provider is not configured")
+		return nil, gqlerrors.Internal("tag provider is not configured")
 	}
 	seen := make(map[string]struct{}, len(ids))
 	normalized := make([]string, 0, len(ids))
@@ -252,7 +252,7 @@ func (r *Resolver) normalizeTagIDs(ctx context.Context, ids []string) ([]string,
 			return nil, err
 		}
 		if record == nil {
-			return nil, fmt.Errorf("tag not found")
+			return nil, gqlerrors.NotFound("Tag")
 		}
 		seen[nativeID] = struct{}{}
 		normalized = append(normalized, nativeID)
@@ -261,13 +261,16 @@ func (r *Resolver) normalizeTagIDs(ctx context.Context, ids []string) ([]string,
 	return normalized, nil
 }
 
-func (r *Resolver) assignPostTaxonomies(ctx context.Context, postID string, categoryIDs []string, hasCategoryIDs bool, tagIDs []string, hasTagIDs bool) error {
+// assignPostTaxonomies replaces the post's categories and tags when the
+// mutation set them. newTags are created with the links and announced to
+// Tag subscribers.
+func (r *Resolver) assignPostTaxonomies(ctx context.Context, postID string, categoryIDs []string, hasCategoryIDs bool, tagIDs []string, newTags []*gen.Tag, hasTagIDs bool) error {
 	if !hasCategoryIDs && !hasTagIDs {
 		return nil
 	}
 	service := r.postTaxonomyService()
 	if service == nil {
-		return fmt.Errorf("post taxonomy service is not configured")
+		return gqlerrors.Internal("post taxonomy service is not configured")
 	}
 	if hasCategoryIDs {
 		if err := service.ReplacePostCategories(ctx, postID, categoryIDs); err != nil {
@@ -275,9 +278,14 @@ func (r *Resolver) assignPostTaxonomies(ctx context.Context, postID string, cate
 		}
 	}
 	if hasTagIDs {
-		if err := service.ReplacePostTags(ctx, postID, tagIDs); err != nil {
+		created, err := service.ReplacePostTags(ctx, postID, tagIDs, newTags)
+		if err != nil {
 			return err
 		}
+		for _, record := range created {
+			r.primeTag(ctx, record)
+			publishSubscriptionEvent(ctx, r.subscriptionBroker(), "Tag", SubscriptionTriggerCreated, toGraphQLTag(record))
+		}
 	}
 	return nil
 }
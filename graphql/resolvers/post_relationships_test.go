@@ -155,6 +155,36 @@ func TestCreateAndUpdatePostAssignsTaxonomies(t *testing.T) {
 	}
 }
 
+func TestTaxonomyPostsSkipTrashedPosts(t *testing.T) {
+	pool := newMockPool()
+	now := time.Now().UTC()
+	deletedAt := now.Add(-time.Hour)
+	pool.posts["post-1"] = &gen.Post{ID: "post-1", AuthorID: "author-1", Title: "Live", Slug: "live", Status: "publish", Type: "post", CreatedAt: now, UpdatedAt: now}
+	pool.posts["post-2"] = &gen.Post{ID: "post-2", AuthorID: "author-1", Title: "Gone", Slug: "gone", Status: "publish", Type: "post", CreatedAt: now, UpdatedAt: now, DeletedAt: &deletedAt}
+	pool.postCategories["post-1"] = []string{"cat-1"}
+	pool.postCategories["post-2"] = []string{"cat-1"}
+	pool.postTags["post-1"] = []string{"tag-1"}
+	pool.postTags["post-2"] = []string{"tag-1"}
+	client := gen.NewClient(&pg.DB{Pool: pool})
+	ctx := context.Background()
+
+	category := &gen.Category{ID: "cat-1"}
+	if err := client.Categories().LoadPosts(ctx, category); err != nil {
+		t.Fatalf("unexpected error loading category posts: %v", err)
+	}
+	if len(category.Edges.Posts) != 1 || category.Edges.Posts[0].ID != "post-1" {
+		t.Fatalf("unexpected category posts: %#v", category.Edges.Posts)
+	}
+
+	tag := &gen.Tag{ID: "tag-1"}
+	if err := client.Tags().LoadPosts(ctx, tag); err != nil {
+		t.Fatalf("unexpected error loading tag posts: %v", err)
+	}
+	if len(tag.Edges.Posts) != 1 || tag.Edges.Posts[0].ID != "post-1" {
+		t.Fatalf("unexpected tag posts: %#v", tag.Edges.Posts)
+	}
+}
+
 type mockPool struct {
 	posts          map[string]*gen.Post
 	categories     map[string]*gen.Category
@@ -177,6 +207,24 @@ func newMockPool() *mockPool {
 
 func (m *mockPool) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
 	switch {
+	case strings.Contains(sql, "FROM posts AS t JOIN post_categories"), strings.Contains(sql, "FROM posts AS t JOIN post_tags"):
+		links := m.postCategories
+		if strings.Contains(sql, "post_tags") {
+			links = m.postTags
+		}
+		live := strings.Contains(sql, "t.deleted_at IS NULL")
+		rows := make([][]any, 0)
+		for _, arg := range args {
+			owner := arg.(string)
+			for postID, ids := range links {
+				record, ok := m.posts[postID]
+				if !ok || !contains(ids, owner) || (live && record.DeletedAt != nil) {
+					continue
+				}
+				rows = append(rows, append(postRowValues(record), owner))
+			}
+		}
+		return &mockRows{data: rows}, nil
 	case strings.Contains(sql, "FROM categories AS t JOIN post_categories"):
 		rows := make([][]any, 0)
 		for _, arg := range args {
@@ -184,7 +232,7 @@ func (m *mockPool) Query(_ context.Context, sql string, args ...any) (pgx.Rows,
 			ids := m.postCategories[postID]
 			for _, id := range ids {
 				if record, ok := m.categories[id]; ok {
-					rows = append(rows, []any{record.ID, record.Name, record.Slug, record.Description, record.ParentID, record.CreatedAt, record.UpdatedAt, postID})
+					rows = append(rows, []any{record.ID, record.Name, record.Slug, record.Description, record.ParentID, record.Position, record.CreatedAt, record.UpdatedAt, postID})
 				}
 			}
 		}
@@ -201,75 +249,100 @@ func (m *mockPool) Query(_ context.Context, sql string, args ...any) (pgx.Rows,
 			}
 		}
 		return &mockRows{data: rows}, nil
+	case strings.HasPrefix(sql, "SELECT slug, id::text FROM posts"):
+		base := args[0].(string)
+		rows := make([][]any, 0)
+		for _, record := range m.posts {
+			if record.Slug == base || strings.HasPrefix(record.Slug, base+"-") {
+				rows = append(rows, []any{record.Slug, record.ID})
+			}
+		}
+		return &mockRows{data: rows}, nil
 	default:
 		return nil, fmt.Errorf("unexpected query: %s", sql)
 	}
 }
 
+func postRowValues(record *gen.Post) []any {
+	return []any{record.ID, record.AuthorID, record.FeaturedMediaID, record.ContentTypeID, record.ParentID, record.MenuOrder, record.Title, record.Slug, record.Status, record.Type, record.Excerpt, record.Content, record.Seo, record.PublishedAt, record.CreatedAt, record.UpdatedAt, record.DeletedAt}
+}
+
 func (m *mockPool) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
 	switch {
 	case strings.HasPrefix(sql, "INSERT INTO posts"):
 		post := &gen.Post{
 			ID:        args[0].(string),
 			AuthorID:  args[1].(string),
-			Title:     args[3].(string),
-			Slug:      args[4].(string),
-			Status:    args[5].(string),
-			Type:      args[6].(string),
-			CreatedAt: args[11].(time.Time),
-			UpdatedAt: args[12].(time.Time),
+			MenuOrder: args[5].(int32),
+			Title:     args[6].(string),
+			Slug:      args[7].(string),
+			Status:    args[8].(string),
+			Type:      args[9].(string),
+			CreatedAt: args[14].(time.Time),
+			UpdatedAt: args[15].(time.Time),
 		}
 		if v, ok := args[2].(*string); ok {
 			post.FeaturedMediaID = v
 		}
-		if excerpt, ok := args[7].(*string); ok {
+		if v, ok := args[3].(*string); ok {
+			post.ContentTypeID = v
+		}
+		if v, ok := args[4].(*string); ok {
+			post.ParentID = v
+		}
+		if excerpt, ok := args[10].(*string); ok {
 			post.Excerpt = excerpt
 		}
-		if content, ok := args[8].(*string); ok {
+		if content, ok := args[11].(*string); ok {
 			post.Content = content
 		}
-		if seo, ok := args[9].(json.RawMessage); ok {
+		if seo, ok := args[12].(json.RawMessage); ok {
 			post.Seo = seo
 		}
-		if published, ok := args[10].(*time.Time); ok {
+		if published, ok := args[13].(*time.Time); ok {
 			post.PublishedAt = published
 		}
 		m.posts[post.ID] = post
-		return &mockRow{values: []any{post.ID, post.AuthorID, post.FeaturedMediaID, post.Title, post.Slug, post.Status, post.Type, post.Excerpt, post.Content, post.Seo, post.PublishedAt, post.CreatedAt, post.UpdatedAt}}
+		return &mockRow{values: postRowValues(post)}
 	case strings.HasPrefix(sql, "SELECT id, author_id") && strings.Contains(sql, "FROM posts WHERE id"):
 		id := args[0].(string)
 		if record, ok := m.posts[id]; ok {
-			return &mockRow{values: []any{record.ID, record.AuthorID, record.FeaturedMediaID, record.Title, record.Slug, record.Status, record.Type, record.Excerpt, record.Content, record.Seo, record.PublishedAt, record.CreatedAt, record.UpdatedAt}}
+			return &mockRow{values: postRowValues(record)}
 		}
 		return &mockRow{err: pgx.ErrNoRows}
 	case strings.HasPrefix(sql, "UPDATE posts SET"):
 		featured, _ := args[1].(*string)
-		excerpt, _ := args[6].(*string)
-		content, _ := args[7].(*string)
-		published, _ := args[9].(*time.Time)
-		id := args[11].(string)
+		contentType, _ := args[2].(*string)
+		parent, _ := args[3].(*string)
+		excerpt, _ := args[9].(*string)
+		content, _ := args[10].(*string)
+		published, _ := args[12].(*time.Time)
+		id := args[14].(string)
 		record, ok := m.posts[id]
 		if !ok {
 			return &mockRow{err: pgx.ErrNoRows}
 		}
 		record.AuthorID = args[0].(string)
 		record.FeaturedMediaID = featured
-		record.Title = args[2].(string)
-		record.Slug = args[3].(string)
-		record.Status = args[4].(string)
-		record.Type = args[5].(string)
+		record.ContentTypeID = contentType
+		record.ParentID = parent
+		record.MenuOrder = args[4].(int32)
+		record.Title = args[5].(string)
+		record.Slug = args[6].(string)
+		record.Status = args[7].(string)
+		record.Type = args[8].(string)
 		record.Excerpt = excerpt
 		record.Content = content
-		if seo, ok := args[8].(json.RawMessage); ok {
+		if seo, ok := args[11].(json.RawMessage); ok {
 			record.Seo = seo
 		}
 		record.PublishedAt = published
-		record.UpdatedAt = args[10].(time.Time)
-		return &mockRow{values: []any{record.ID, record.AuthorID, record.FeaturedMediaID, record.Title, record.Slug, record.Status, record.Type, record.Excerpt, record.Content, record.Seo, record.PublishedAt, record.CreatedAt, record.UpdatedAt}}
+		record.UpdatedAt = args[13].(time.Time)
+		return &mockRow{values: postRowValues(record)}
 	case strings.HasPrefix(sql, "SELECT id, name") && strings.Contains(sql, "FROM categories"):
 		id := args[0].(string)
 		if record, ok := m.categories[id]; ok {
-			return &mockRow{values: []any{record.ID, record.Name, record.Slug, record.Description, record.ParentID, record.CreatedAt, record.UpdatedAt}}
+			return &mockRow{values: []any{record.ID, record.Name, record.Slug, record.Description, record.ParentID, record.Position, record.CreatedAt, record.UpdatedAt}}
 		}
 		return &mockRow{err: pgx.ErrNoRows}
 	case strings.HasPrefix(sql, "SELECT id, name") && strings.Contains(sql, "FROM tags"):
@@ -281,7 +354,7 @@ func (m *mockPool) QueryRow(_ context.Context, sql string, args ...any) pgx.Row
 	case strings.HasPrefix(sql, "SELECT id, uploaded_by_id"):
 		id := args[0].(string)
 		if record, ok := m.medias[id]; ok {
-			return &mockRow{values: []any{record.ID, record.UploadedByID, record.FileName, record.MimeType, record.StorageKey, record.URL, record.Title, record.AltText, record.Caption, record.Description, record.FileSizeBytes, record.Metadata, record.CreatedAt, record.UpdatedAt}}
+			return &mockRow{values: []any{record.ID, record.UploadedByID, record.FileName, record.MimeType, record.StorageKey, record.URL, record.Title, record.AltText, record.Caption, record.Description, record.FileSizeBytes, record.Metadata, record.CreatedAt, record.UpdatedAt, record.DeletedAt}}
 		}
 		return &mockRow{err: pgx.ErrNoRows}
 	default:
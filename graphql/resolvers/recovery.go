@@ -0,0 +1,144 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/localauth"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/usermeta"
+)
+
+type accountRecovery interface {
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SendVerification(ctx context.Context, userID string) error
+	VerifyEmail(ctx context.Context, token string) (string, error)
+	PasswordPolicy() localauth.PasswordPolicy
+}
+
+func (r *Resolver) accountRecovery() (accountRecovery, error) {
+	if r == nil || r.recovery == nil {
+		return nil, gqlerrors.Forbidden("password recovery is not enabled")
+	}
+	return r.recovery, nil
+}
+
+// checkPassword applies the password policy to a password set through
+// createUser or updateUser.
+func (r *Resolver) checkPassword(password string, model *gen.User) error {
+	policy := localauth.DefaultPasswordPolicy
+	if r != nil && r.recovery != nil {
+		policy = r.recovery.PasswordPolicy()
+	}
+	if err := policy.Check(password, model.Username, model.Email); err != nil {
+		return gqlerrors.BadInput("input.password", err.Error())
+	}
+	return nil
+}
+
+func (r *Resolver) requestPasswordReset(ctx context.Context, input graphql1.RequestPasswordResetInput) (*graphql1.RequestPasswordResetPayload, error) {
+	recovery, err := r.accountRecovery()
+	if err != nil {
+		return nil, err
+	}
+	// Failures are logged rather than returned: a mail error only happens
+	// for existing accounts and would reveal them.
+	if err := recovery.RequestPasswordReset(ctx, input.Email); err != nil {
+		log.Printf("password reset: %v", err)
+	}
+	return &graphql1.RequestPasswordResetPayload{ClientMutationID: input.ClientMutationID, Requested: true}, nil
+}
+
+func (r *Resolver) resetPassword(ctx context.Context, input graphql1.ResetPasswordInput) (*graphql1.ResetPasswordPayload, error) {
+	recovery, err := r.accountRecovery()
+	if err != nil {
+		return nil, err
+	}
+	if err := recovery.ResetPassword(ctx, input.Token, input.NewPassword); err != nil {
+		return nil, recoveryError(err, "invalid or expired reset token")
+	}
+	return &graphql1.ResetPasswordPayload{ClientMutationID: input.ClientMutationID, Reset: true}, nil
+}
+
+func (r *Resolver) verifyEmail(ctx context.Context, input graphql1.VerifyEmailInput) (*graphql1.VerifyEmailPayload, error) {
+	recovery, err := r.accountRecovery()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := recovery.VerifyEmail(ctx, input.Token); err != nil {
+		return nil, recoveryError(err, "invalid or expired verification token")
+	}
+	return &graphql1.VerifyEmailPayload{ClientMutationID: input.ClientMutationID, Verified: true}, nil
+}
+
+func (r *Resolver) resendVerificationEmail(ctx context.Context, input *graphql1.ResendVerificationEmailInput) (*graphql1.ResendVerificationEmailPayload, error) {
+	recovery, err := r.accountRecovery()
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := oidc.FromContext(ctx)
+	if !ok || strings.TrimSpace(claims.Subject) == "" {
+		return nil, gqlerrors.Unauthenticated()
+	}
+	if err := recovery.SendVerification(ctx, strings.TrimSpace(claims.Subject)); err != nil {
+		return nil, err
+	}
+	payload := &graphql1.ResendVerificationEmailPayload{Sent: true}
+	if input != nil {
+		payload.ClientMutationID = input.ClientMutationID
+	}
+	return payload, nil
+}
+
+// viewerEmailVerified trusts the user's stored verification and, for
+// viewers without one, an email_verified claim for the same address.
+func (r *Resolver) viewerEmailVerified(ctx context.Context, obj *graphql1.Viewer) (bool, error) {
+	if obj == nil || obj.Email == nil || strings.TrimSpace(*obj.Email) == "" {
+		return false, nil
+	}
+	email := strings.TrimSpace(*obj.Email)
+	if store := r.userMetaStore(); store != nil {
+		start := time.Now()
+		verification, _, err := usermeta.Get(ctx, store, obj.ID, localauth.EmailVerification)
+		r.recordQuery("user_meta", "load", start, err)
+		if err != nil {
+			return false, err
+		}
+		if verification.Covers(email) {
+			return true, nil
+		}
+	}
+	claims, ok := oidc.FromContext(ctx)
+	return ok && claims.EmailVerified && strings.EqualFold(strings.TrimSpace(claims.Email), email), nil
+}
+
+// sendVerificationOnCreate mails new users a verification link. The user is
+// already stored, so failures are logged; resendVerificationEmail retries.
+func sendVerificationOnCreate(ctx context.Context, r *Resolver, record *gen.User) error {
+	if r == nil || r.recovery == nil || record == nil || strings.TrimSpace(record.Email) == "" {
+		return nil
+	}
+	if err := r.recovery.SendVerification(ctx, record.ID); err != nil {
+		log.Printf("send verification to user %s: %v", record.ID, err)
+	}
+	return nil
+}
+
+func recoveryError(err error, invalidTokenMessage string) error {
+	var weak *localauth.WeakPasswordError
+	switch {
+	case errors.As(err, &weak):
+		return gqlerrors.BadInput("input.newPassword", weak.Error())
+	case errors.Is(err, localauth.ErrInvalidToken):
+		return gqlerrors.Wrap(gqlerrors.CodeUnauthenticated, err, invalidTokenMessage)
+	default:
+		return err
+	}
+}
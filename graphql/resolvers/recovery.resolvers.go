@@ -0,0 +1,36 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// RequestPasswordReset is the resolver for the requestPasswordReset field.
+func (r *mutationResolver) RequestPasswordReset(ctx context.Context, input graphql1.RequestPasswordResetInput) (*graphql1.RequestPasswordResetPayload, error) {
+	return r.requestPasswordReset(ctx, input)
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, input graphql1.ResetPasswordInput) (*graphql1.ResetPasswordPayload, error) {
+	return r.resetPassword(ctx, input)
+}
+
+// VerifyEmail is the resolver for the verifyEmail field.
+func (r *mutationResolver) VerifyEmail(ctx context.Context, input graphql1.VerifyEmailInput) (*graphql1.VerifyEmailPayload, error) {
+	return r.verifyEmail(ctx, input)
+}
+
+// ResendVerificationEmail is the resolver for the resendVerificationEmail field.
+func (r *mutationResolver) ResendVerificationEmail(ctx context.Context, input *graphql1.ResendVerificationEmailInput) (*graphql1.ResendVerificationEmailPayload, error) {
+	return r.resendVerificationEmail(ctx, input)
+}
+
+// EmailVerified is the resolver for the emailVerified field.
+func (r *viewerResolver) EmailVerified(ctx context.Context, obj *graphql1.Viewer) (bool, error) {
+	return r.viewerEmailVerified(ctx, obj)
+}
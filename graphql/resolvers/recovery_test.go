@@ -0,0 +1,148 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/localauth"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/usermeta"
+)
+
+type stubRecovery struct {
+	err      error
+	requests []string
+	sent     []string
+}
+
+func (s *stubRecovery) RequestPasswordReset(_ context.Context, email string) error {
+	s.requests = append(s.requests, email)
+	return s.err
+}
+
+func (s *stubRecovery) ResetPassword(_ context.Context, _ string, newPassword string) error {
+	if err := s.PasswordPolicy().Check(newPassword); err != nil {
+		return err
+	}
+	return s.err
+}
+
+func (s *stubRecovery) SendVerification(_ context.Context, userID string) error {
+	s.sent = append(s.sent, userID)
+	return s.err
+}
+
+func (s *stubRecovery) VerifyEmail(context.Context, string) (string, error) {
+	return "user-1", s.err
+}
+
+func (s *stubRecovery) PasswordPolicy() localauth.PasswordPolicy {
+	return localauth.PasswordPolicy{MinLength: 12}
+}
+
+func TestRequestPasswordResetAlwaysSucceeds(t *testing.T) {
+	recovery := &stubRecovery{err: errors.New("smtp down")}
+	resolver := &Resolver{recovery: recovery}
+
+	clientMutationID := "mut-1"
+	payload, err := resolver.Mutation().RequestPasswordReset(context.Background(), graphql1.RequestPasswordResetInput{ClientMutationID: &clientMutationID, Email: "alice@example.com"})
+	if err != nil {
+		t.Fatalf("expected mail failures to stay hidden, got %v", err)
+	}
+	if !payload.Requested || payload.ClientMutationID == nil || *payload.ClientMutationID != "mut-1" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if len(recovery.requests) != 1 {
+		t.Fatalf("expected the reset to be requested")
+	}
+}
+
+func TestResetPasswordMapsErrors(t *testing.T) {
+	resolver := &Resolver{recovery: &stubRecovery{}}
+	_, err := resolver.Mutation().ResetPassword(context.Background(), graphql1.ResetPasswordInput{Token: "token", NewPassword: "too-short"})
+	expectBadInput(t, err, "input.newPassword")
+
+	resolver = &Resolver{recovery: &stubRecovery{err: localauth.ErrInvalidToken}}
+	_, err = resolver.Mutation().ResetPassword(context.Background(), graphql1.ResetPasswordInput{Token: "used", NewPassword: "battery staple"})
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+
+	_, err = resolver.Mutation().VerifyEmail(context.Background(), graphql1.VerifyEmailInput{Token: "used"})
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+}
+
+func TestRecoveryRequiresConfiguration(t *testing.T) {
+	_, err := (&Resolver{}).Mutation().RequestPasswordReset(context.Background(), graphql1.RequestPasswordResetInput{Email: "alice@example.com"})
+	expectErrorCode(t, err, gqlerrors.CodeForbidden)
+}
+
+func TestResendVerificationEmailUsesViewer(t *testing.T) {
+	recovery := &stubRecovery{}
+	resolver := &Resolver{recovery: recovery}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1"})
+
+	payload, err := resolver.Mutation().ResendVerificationEmail(ctx, nil)
+	if err != nil || !payload.Sent {
+		t.Fatalf("expected the mail to be sent, got %+v, %v", payload, err)
+	}
+	if len(recovery.sent) != 1 || recovery.sent[0] != "user-1" {
+		t.Fatalf("expected a mail for the viewer, got %v", recovery.sent)
+	}
+}
+
+func TestViewerEmailVerified(t *testing.T) {
+	store := newStubUserMetaStore("user-1")
+	resolver := &Resolver{userMeta: store}
+	ctx := context.Background()
+	email := "alice@example.com"
+	viewer := &graphql1.Viewer{ID: "user-1", Email: &email}
+
+	if verified, err := resolver.Viewer().EmailVerified(ctx, viewer); err != nil || verified {
+		t.Fatalf("expected an unverified email, got %v, %v", verified, err)
+	}
+	if err := usermeta.Set(ctx, store, "user-1", localauth.EmailVerification, localauth.Verification{Email: "Alice@example.com"}); err != nil {
+		t.Fatalf("store verification: %v", err)
+	}
+	if verified, err := resolver.Viewer().EmailVerified(ctx, viewer); err != nil || !verified {
+		t.Fatalf("expected a verified email, got %v, %v", verified, err)
+	}
+
+	changed := "alice@example.org"
+	if verified, _ := resolver.Viewer().EmailVerified(ctx, &graphql1.Viewer{ID: "user-1", Email: &changed}); verified {
+		t.Fatalf("expected a changed email to be unverified")
+	}
+	claimsCtx := oidc.ToContext(ctx, oidc.Claims{Subject: "user-1", Email: changed, EmailVerified: true})
+	if verified, _ := resolver.Viewer().EmailVerified(claimsCtx, &graphql1.Viewer{ID: "user-1", Email: &changed}); !verified {
+		t.Fatalf("expected a verified email claim to count")
+	}
+}
+
+func TestUserPasswordHooksApplyPolicy(t *testing.T) {
+	hooks := newEntityHooks()
+	weak := "alice-2026-pw"
+	input := graphql1.CreateUserInput{Password: &weak}
+	err := hooks.BeforeCreateUser(context.Background(), &Resolver{}, input, &gen.User{Username: "alice"})
+	expectBadInput(t, err, "input.password")
+
+	short := "eleven-char"
+	update := graphql1.UpdateUserInput{Password: &short}
+	err = hooks.BeforeUpdateUser(context.Background(), &Resolver{recovery: &stubRecovery{}}, update, &gen.User{})
+	expectBadInput(t, err, "input.password")
+}
+
+func TestCreateUserSendsVerification(t *testing.T) {
+	recovery := &stubRecovery{err: errors.New("smtp down")}
+	hooks := newEntityHooks()
+	if err := hooks.AfterCreateUser(context.Background(), &Resolver{recovery: recovery}, &gen.User{ID: "user-1", Email: "alice@example.com"}); err != nil {
+		t.Fatalf("expected mail failures not to fail the mutation, got %v", err)
+	}
+	if len(recovery.sent) != 1 {
+		t.Fatalf("expected a verification mail")
+	}
+	if err := hooks.AfterCreateUser(context.Background(), &Resolver{}, &gen.User{ID: "user-2", Email: "bob@example.com"}); err != nil {
+		t.Fatalf("expected no-op without recovery, got %v", err)
+	}
+}
@@ -5,12 +5,20 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/deicod/ermblog/apikeys"
 	"github.com/deicod/ermblog/graphql"
 	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/persisted"
 	"github.com/deicod/ermblog/graphql/subscriptions"
+	"github.com/deicod/ermblog/localauth"
 	"github.com/deicod/ermblog/observability/metrics"
 	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/permalink"
+	"github.com/deicod/ermblog/settings"
+	"github.com/deicod/ermblog/usermeta"
 )
 
 // Options allows configuring resolver behaviour.
@@ -19,6 +27,16 @@ type Options struct {
 	Collector        metrics.Collector
 	Subscriptions    subscriptions.Broker
 	OptionRepository optionRepository
+	Permalinks       permalink.Set
+	PersistedQueries *persisted.Store
+	// Settings caches site settings; without it one is created from ORM.
+	Settings *settings.Cache
+	// LocalAuth enables the login, refreshSession and logout mutations.
+	LocalAuth *localauth.Service
+	// Recovery enables password reset and email verification.
+	Recovery *localauth.Recovery
+	// APIKeys enables the createApiKey and revokeApiKey mutations.
+	APIKeys *apikeys.Service
 }
 
 // Resolver wires GraphQL resolvers into the executable schema.
@@ -41,6 +59,29 @@ type Resolver struct {
 	usersCounter      counter
 	commentRepo       commentRepository
 	options           optionRepository
+	posts             postProvider
+	slugHistories     slugHistoryRecorder
+	permalinks        permalink.Set
+	paths             pathResolver
+	slugs             slugIndex
+	persistedQueries  *persisted.Store
+	trends            trendSource
+	views             viewSource
+	tree              categoryTree
+	merges            taxonomyMerger
+	tagIndex          tagCatalog
+	meta              postMetaStore
+	contentTypes      contentTypeProvider
+	pages             pageHierarchy
+	menus             menuStore
+	settings          *settings.Cache
+	userMeta          usermeta.Store
+	sessions          sessionManager
+	recovery          accountRecovery
+	apiKeys           apiKeyManager
+	deletions         userDeleter
+	trashBin          trashStore
+	now               func() time.Time
 }
 
 type userProvider interface {
@@ -66,9 +107,21 @@ type tagProvider interface {
 	ByID(ctx context.Context, id string) (*gen.Tag, error)
 }
 
+type postProvider interface {
+	ByID(ctx context.Context, id string) (*gen.Post, error)
+}
+
+type slugHistoryRecorder interface {
+	Create(ctx context.Context, input *gen.SlugHistory) (*gen.SlugHistory, error)
+}
+
+type pathResolver interface {
+	Resolve(ctx context.Context, path string) (*permalink.Resolution, error)
+}
+
 type postTaxonomyManager interface {
 	ReplacePostCategories(ctx context.Context, postID string, categoryIDs []string) error
-	ReplacePostTags(ctx context.Context, postID string, tagIDs []string) error
+	ReplacePostTags(ctx context.Context, postID string, tagIDs []string, named []*gen.Tag) ([]*gen.Tag, error)
 }
 
 type counter interface {
@@ -94,14 +147,14 @@ func (r *ormOptionRepository) FindByName(ctx context.Context, name string) (*gen
 
 func (r *ormOptionRepository) Create(ctx context.Context, input *gen.Option) (*gen.Option, error) {
 	if r == nil || r.client == nil {
-		return nil, errors.New("option repository is not configured")
+		return nil, gqlerrors.Internal("option repository is not configured")
 	}
 	return r.client.Create(ctx, input)
 }
 
 func (r *ormOptionRepository) Update(ctx context.Context, input *gen.Option) (*gen.Option, error) {
 	if r == nil || r.client == nil {
-		return nil, errors.New("option repository is not configured")
+		return nil, gqlerrors.Internal("option repository is not configured")
 	}
 	return r.client.Update(ctx, input)
 }
@@ -120,12 +173,26 @@ func NewWithOptions(opts Options) *Resolver {
 	resolver := &Resolver{ORM: opts.ORM, collector: collector, subscriptions: opts.Subscriptions}
 	resolver.hooks = newEntityHooks()
 	resolver.options = opts.OptionRepository
+	resolver.permalinks = opts.Permalinks
+	resolver.persistedQueries = opts.PersistedQueries
+	resolver.settings = opts.Settings
+	if opts.LocalAuth != nil {
+		resolver.sessions = opts.LocalAuth
+	}
+	if opts.Recovery != nil {
+		resolver.recovery = opts.Recovery
+	}
+	if opts.APIKeys != nil {
+		resolver.apiKeys = opts.APIKeys
+	}
 	if resolver.ORM != nil {
 		resolver.users = resolver.ORM.Users()
 		resolver.roles = resolver.ORM.Roles()
 		resolver.userRoles = resolver.ORM
 		resolver.categories = resolver.ORM.Categories()
 		resolver.tags = resolver.ORM.Tags()
+		resolver.posts = resolver.ORM.Posts()
+		resolver.slugHistories = resolver.ORM.SlugHistories()
 		resolver.postTaxonomy = resolver.ORM
 		resolver.postsCounter = resolver.ORM.Posts()
 		resolver.commentsCounter = resolver.ORM.Comments()
@@ -133,6 +200,21 @@ func NewWithOptions(opts Options) *Resolver {
 		resolver.categoriesCounter = resolver.ORM.Categories()
 		resolver.tagsCounter = resolver.ORM.Tags()
 		resolver.usersCounter = resolver.ORM.Users()
+		resolver.trends = resolver.ORM
+		resolver.views = resolver.ORM
+		resolver.tree = resolver.ORM
+		resolver.merges = resolver.ORM
+		resolver.tagIndex = resolver.ORM
+		resolver.meta = resolver.ORM
+		resolver.contentTypes = resolver.ORM.ContentTypes()
+		resolver.pages = resolver.ORM
+		resolver.menus = resolver.ORM
+		resolver.userMeta = resolver.ORM
+		resolver.deletions = resolver.ORM
+		resolver.trashBin = resolver.ORM
+		if resolver.settings == nil {
+			resolver.settings = settings.NewCache(settings.NewORMStore(resolver.ORM), nil)
+		}
 		if resolver.options == nil {
 			resolver.options = &ormOptionRepository{client: resolver.ORM.Options()}
 		}
@@ -214,6 +296,49 @@ func (r *Resolver) tagClient() tagProvider {
 	return nil
 }
 
+func (r *Resolver) postClient() postProvider {
+	if r == nil {
+		return nil
+	}
+	if r.posts != nil {
+		return r.posts
+	}
+	if r.ORM != nil {
+		return r.ORM.Posts()
+	}
+	return nil
+}
+
+func (r *Resolver) slugHistoryClient() slugHistoryRecorder {
+	if r == nil {
+		return nil
+	}
+	if r.slugHistories != nil {
+		return r.slugHistories
+	}
+	if r.ORM != nil {
+		return r.ORM.SlugHistories()
+	}
+	return nil
+}
+
+func (r *Resolver) pathRouter() pathResolver {
+	if r == nil {
+		return nil
+	}
+	if r.paths != nil {
+		return r.paths
+	}
+	if r.ORM != nil {
+		router, err := permalink.NewRouter(r.permalinks, permalink.NewORMStore(r.ORM))
+		if err != nil {
+			return nil
+		}
+		return router
+	}
+	return nil
+}
+
 func (r *Resolver) postTaxonomyService() postTaxonomyManager {
 	if r == nil {
 		return nil
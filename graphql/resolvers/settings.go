@@ -0,0 +1,146 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/settings"
+)
+
+func (r *Resolver) settingsCache() *settings.Cache {
+	if r == nil {
+		return nil
+	}
+	return r.settings
+}
+
+func (r *Resolver) siteSettings(ctx context.Context) (*graphql1.SiteSettings, error) {
+	cache := r.settingsCache()
+	start := time.Now()
+	out, err := readSiteSettings(ctx, cache, r.permalinks.WithDefaults().Post.String())
+	r.recordQuery("options", "site_settings", start, err)
+	return out, err
+}
+
+// readSiteSettings resolves the typed settings. The permalink structure is
+// the running post pattern, which the stored setting only changes on
+// restart.
+func readSiteSettings(ctx context.Context, cache *settings.Cache, postPattern string) (*graphql1.SiteSettings, error) {
+	title, err := settings.Get(ctx, cache, settings.Title)
+	if err != nil {
+		return nil, err
+	}
+	tagline, err := settings.Get(ctx, cache, settings.Tagline)
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := settings.Get(ctx, cache, settings.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	structure := postPattern
+	if structure == "" {
+		structure, err = settings.Get(ctx, cache, settings.PermalinkStructure)
+		if err != nil {
+			return nil, err
+		}
+	}
+	perPage, err := settings.Get(ctx, cache, settings.PostsPerPage)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.SiteSettings{
+		Title:              title,
+		Tagline:            tagline,
+		Timezone:           timezone,
+		PermalinkStructure: structure,
+		PostsPerPage:       perPage,
+	}, nil
+}
+
+func validateOptionOnCreate(_ context.Context, r *Resolver, input graphql1.CreateOptionInput, model *gen.Option) error {
+	if model == nil {
+		return nil
+	}
+	registry := r.settingsCache().Registry()
+	if _, known := registry.Lookup(strings.TrimSpace(model.Name)); known && input.Autoload == nil {
+		model.Autoload = true
+	}
+	if err := rejectReadOnlySetting(registry, model.Name); err != nil {
+		return err
+	}
+	return validateOptionValue(registry, model)
+}
+
+func validateOptionOnUpdate(ctx context.Context, r *Resolver, _ graphql1.UpdateOptionInput, model *gen.Option) error {
+	if model == nil {
+		return nil
+	}
+	registry := r.settingsCache().Registry()
+	if err := rejectReadOnlyOption(ctx, r, registry, model.ID); err != nil {
+		return err
+	}
+	if err := rejectReadOnlySetting(registry, model.Name); err != nil {
+		return err
+	}
+	return validateOptionValue(registry, model)
+}
+
+func rejectReadOnlyOptionOnDelete(ctx context.Context, r *Resolver, _ graphql1.DeleteOptionInput, id string) error {
+	return rejectReadOnlyOption(ctx, r, r.settingsCache().Registry(), id)
+}
+
+// rejectReadOnlyOption refuses writes to the stored option id when it holds
+// a read-only setting, whatever name the write gives it.
+func rejectReadOnlyOption(ctx context.Context, r *Resolver, registry *settings.Registry, id string) error {
+	if id == "" {
+		return nil
+	}
+	existing, err := r.loadOption(ctx, id)
+	if err != nil || existing == nil {
+		return err
+	}
+	return rejectReadOnlySetting(registry, existing.Name)
+}
+
+// rejectReadOnlySetting refuses writes to settings that only take effect on
+// restart, such as permalink_structure: changing them at runtime would leave
+// replicas disagreeing until each restarts.
+func rejectReadOnlySetting(registry *settings.Registry, name string) error {
+	if registry.ReadOnly(strings.TrimSpace(name)) {
+		return gqlerrors.BadInput("input.name", "is read at startup and cannot be changed through the API")
+	}
+	return nil
+}
+
+// validateOptionValue trims the option name and checks the values of
+// registered settings against their schema.
+func validateOptionValue(registry *settings.Registry, model *gen.Option) error {
+	model.Name = strings.TrimSpace(model.Name)
+	if err := registry.Validate(model.Name, model.Value); err != nil {
+		return gqlerrors.BadInput("input.value", err.Error())
+	}
+	return nil
+}
+
+func invalidateSettingOnCreate(_ context.Context, r *Resolver, record *gen.Option) error {
+	r.settingsCache().Invalidate(record.Name)
+	return nil
+}
+
+// invalidateSettingOnUpdate also drops the entry of a renamed option.
+func invalidateSettingOnUpdate(_ context.Context, r *Resolver, record *gen.Option) error {
+	cache := r.settingsCache()
+	cache.InvalidateID(record.ID)
+	cache.Invalidate(record.Name)
+	return nil
+}
+
+func invalidateSettingOnDelete(_ context.Context, r *Resolver, _ graphql1.DeleteOptionInput, id string) error {
+	r.settingsCache().InvalidateID(id)
+	return nil
+}
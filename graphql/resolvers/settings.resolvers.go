@@ -0,0 +1,16 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// SiteSettings is the resolver for the siteSettings field.
+func (r *queryResolver) SiteSettings(ctx context.Context) (*graphql1.SiteSettings, error) {
+	return r.siteSettings(ctx)
+}
@@ -0,0 +1,108 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/permalink"
+	"github.com/deicod/ermblog/settings"
+)
+
+type stubSettingsStore map[string]settings.Option
+
+func (s stubSettingsStore) Autoload(context.Context) ([]settings.Option, error) {
+	out := make([]settings.Option, 0, len(s))
+	for _, option := range s {
+		out = append(out, option)
+	}
+	return out, nil
+}
+
+func (s stubSettingsStore) Option(_ context.Context, name string) (*settings.Option, error) {
+	if option, ok := s[name]; ok {
+		return &option, nil
+	}
+	return nil, nil
+}
+
+func TestSiteSettingsUsesStoredValuesAndDefaults(t *testing.T) {
+	store := stubSettingsStore{
+		"blogname":       {ID: "1", Name: "blogname", Value: json.RawMessage(`"Field Notes"`)},
+		"posts_per_page": {ID: "2", Name: "posts_per_page", Value: json.RawMessage(`5`)},
+	}
+	resolver := &Resolver{
+		settings:   settings.NewCache(store, nil),
+		permalinks: permalink.Set{Post: permalink.MustParse("/blog/{slug}")},
+	}
+	ctx := context.Background()
+
+	site, err := resolver.Query().SiteSettings(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := graphqlpkg.SiteSettings{Title: "Field Notes", Tagline: "", Timezone: "UTC", PermalinkStructure: "/blog/{slug}", PostsPerPage: 5}
+	if *site != want {
+		t.Fatalf("expected %+v, got %+v", want, *site)
+	}
+
+	store["permalink_structure"] = settings.Option{ID: "3", Name: "permalink_structure", Value: json.RawMessage(`"/{id}"`)}
+	if err := invalidateSettingOnCreate(ctx, resolver, &gen.Option{ID: "3", Name: "permalink_structure"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Renaming blogname away leaves the title unset.
+	delete(store, "blogname")
+	if err := invalidateSettingOnUpdate(ctx, resolver, &gen.Option{ID: "1", Name: "site_title"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	site, _ = resolver.Query().SiteSettings(ctx)
+	if site.Title != "ermblog" {
+		t.Fatalf("expected invalidated settings to be reloaded, got %+v", site)
+	}
+	// A stored structure only applies on restart; report the running one.
+	if site.PermalinkStructure != "/blog/{slug}" {
+		t.Fatalf("expected running permalink structure, got %q", site.PermalinkStructure)
+	}
+
+	resolver = &Resolver{}
+	if site, err := resolver.Query().SiteSettings(ctx); err != nil || site.PermalinkStructure != permalink.DefaultPostPattern || site.PostsPerPage != 10 {
+		t.Fatalf("expected defaults without a cache, got %+v (%v)", site, err)
+	}
+}
+
+func TestValidateOptionAgainstRegistry(t *testing.T) {
+	resolver := &Resolver{}
+	ctx := context.Background()
+
+	model := &gen.Option{Name: " posts_per_page ", Value: json.RawMessage(`20`)}
+	if err := validateOptionOnCreate(ctx, resolver, graphqlpkg.CreateOptionInput{}, model); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model.Name != "posts_per_page" || !model.Autoload {
+		t.Fatalf("expected trimmed, autoloaded setting, got %+v", model)
+	}
+
+	off := false
+	model = &gen.Option{Name: "blogname", Value: json.RawMessage(`"Blog"`)}
+	_ = validateOptionOnCreate(ctx, resolver, graphqlpkg.CreateOptionInput{Autoload: &off}, model)
+	if model.Autoload {
+		t.Fatal("expected explicit autoload to be kept")
+	}
+
+	model = &gen.Option{Name: "custom_flag", Value: json.RawMessage(`{"on": true}`)}
+	if err := validateOptionOnCreate(ctx, resolver, graphqlpkg.CreateOptionInput{}, model); err != nil || model.Autoload {
+		t.Fatalf("expected unregistered option to pass untouched, got %+v (%v)", model, err)
+	}
+
+	err := validateOptionOnUpdate(ctx, resolver, graphqlpkg.UpdateOptionInput{}, &gen.Option{Name: "timezone_string", Value: json.RawMessage(`"Nowhere/City"`)})
+	expectBadInput(t, err, "input.value")
+	err = validateOptionOnUpdate(ctx, resolver, graphqlpkg.UpdateOptionInput{}, &gen.Option{Name: "posts_per_page", Value: json.RawMessage(`"ten"`)})
+	expectBadInput(t, err, "input.value")
+
+	err = validateOptionOnCreate(ctx, resolver, graphqlpkg.CreateOptionInput{}, &gen.Option{Name: "permalink_structure", Value: json.RawMessage(`"/{id}"`)})
+	expectBadInput(t, err, "input.name")
+	err = validateOptionOnUpdate(ctx, resolver, graphqlpkg.UpdateOptionInput{}, &gen.Option{Name: " permalink_structure", Value: json.RawMessage(`"/{id}"`)})
+	expectBadInput(t, err, "input.name")
+}
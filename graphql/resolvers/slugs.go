@@ -0,0 +1,230 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/slug"
+)
+
+const slugSuggestionLimit = 3
+
+// slugIndex reports which records of an entity own base or one of its
+// hyphenated variants, keyed by slug.
+type slugIndex interface {
+	SlugOwners(ctx context.Context, entity, base string) (map[string]string, error)
+}
+
+func (r *Resolver) slugIndexer() slugIndex {
+	if r == nil {
+		return nil
+	}
+	if r.slugs != nil {
+		return r.slugs
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+// assignSlug settles the slug a record is saved with. Explicit slugs are
+// normalised and must be free; otherwise a slug is derived from source and
+// suffixed until unique. recordID is empty for new records. The taken slugs
+// are read in one query; a concurrent save of the same slug fails on the
+// unique index instead.
+func (r *Resolver) assignSlug(ctx context.Context, entity, recordID string, requested *string, source string) (string, error) {
+	index := r.slugIndexer()
+	taken := func(ctx context.Context, base string) ([]string, error) {
+		if index == nil {
+			return nil, nil
+		}
+		owners, err := index.SlugOwners(ctx, entity, base)
+		if err != nil {
+			return nil, err
+		}
+		slugs := make([]string, 0, len(owners))
+		for value, owner := range owners {
+			if owner != recordID {
+				slugs = append(slugs, value)
+			}
+		}
+		return slugs, nil
+	}
+	if requested != nil {
+		if value := slug.Make(*requested); value != "" {
+			inUse, suggestions, err := slug.Check(ctx, value, taken, slugSuggestionLimit)
+			if err != nil {
+				return "", err
+			}
+			if !inUse {
+				return value, nil
+			}
+			return "", gqlerrors.SlugTaken(value, suggestions)
+		}
+	}
+	base := slug.Make(source)
+	if base == "" {
+		base = strings.ToLower(entity)
+	}
+	return slug.Unique(ctx, base, taken)
+}
+
+func assignPostSlugOnCreate(ctx context.Context, r *Resolver, input graphql.CreatePostInput, model *gen.Post) error {
+	if model == nil {
+		return nil
+	}
+	value, err := r.assignSlug(ctx, "Post", model.ID, input.Slug, model.Title)
+	if err != nil {
+		return err
+	}
+	model.Slug = value
+	return nil
+}
+
+func assignCategorySlugOnCreate(ctx context.Context, r *Resolver, input graphql.CreateCategoryInput, model *gen.Category) error {
+	if model == nil {
+		return nil
+	}
+	value, err := r.assignSlug(ctx, "Category", model.ID, input.Slug, model.Name)
+	if err != nil {
+		return err
+	}
+	model.Slug = value
+	return nil
+}
+
+func assignTagSlugOnCreate(ctx context.Context, r *Resolver, input graphql.CreateTagInput, model *gen.Tag) error {
+	if model == nil {
+		return nil
+	}
+	value, err := r.assignSlug(ctx, "Tag", model.ID, input.Slug, model.Name)
+	if err != nil {
+		return err
+	}
+	model.Slug = value
+	return nil
+}
+
+func assignRoleSlugOnCreate(ctx context.Context, r *Resolver, input graphql.CreateRoleInput, model *gen.Role) error {
+	if model == nil {
+		return nil
+	}
+	value, err := r.assignSlug(ctx, "Role", model.ID, input.Slug, model.Name)
+	if err != nil {
+		return err
+	}
+	model.Slug = value
+	return nil
+}
+
+// Updates keep the stored slug when none is supplied and re-derive it from the
+// title or name when an empty slug is sent explicitly.
+
+func assignPostSlugOnUpdate(ctx context.Context, r *Resolver, input graphql.UpdatePostInput, model *gen.Post) error {
+	if model == nil {
+		return nil
+	}
+	client := r.postClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	source := current.Title
+	if input.Title != nil {
+		source = *input.Title
+	}
+	return r.updateSlug(ctx, "Post", model.ID, input.Slug, current.Slug, source, &model.Slug)
+}
+
+func assignCategorySlugOnUpdate(ctx context.Context, r *Resolver, input graphql.UpdateCategoryInput, model *gen.Category) error {
+	if model == nil {
+		return nil
+	}
+	client := r.categoryClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	source := current.Name
+	if input.Name != nil {
+		source = *input.Name
+	}
+	return r.updateSlug(ctx, "Category", model.ID, input.Slug, current.Slug, source, &model.Slug)
+}
+
+func assignTagSlugOnUpdate(ctx context.Context, r *Resolver, input graphql.UpdateTagInput, model *gen.Tag) error {
+	if model == nil {
+		return nil
+	}
+	client := r.tagClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	source := current.Name
+	if input.Name != nil {
+		source = *input.Name
+	}
+	return r.updateSlug(ctx, "Tag", model.ID, input.Slug, current.Slug, source, &model.Slug)
+}
+
+func assignRoleSlugOnUpdate(ctx context.Context, r *Resolver, input graphql.UpdateRoleInput, model *gen.Role) error {
+	if model == nil {
+		return nil
+	}
+	client := r.roleClient()
+	if client == nil {
+		return nil
+	}
+	current, err := client.ByID(ctx, model.ID)
+	if err != nil || current == nil {
+		return err
+	}
+	source := current.Name
+	if input.Name != nil {
+		source = *input.Name
+	}
+	return r.updateSlug(ctx, "Role", model.ID, input.Slug, current.Slug, source, &model.Slug)
+}
+
+func (r *Resolver) updateSlug(ctx context.Context, entity, recordID string, requested *string, currentSlug, source string, target *string) error {
+	if requested == nil {
+		*target = currentSlug
+		return nil
+	}
+	if *requested == currentSlug {
+		*target = currentSlug
+		return nil
+	}
+	value, err := r.assignSlug(ctx, entity, recordID, requested, source)
+	if err != nil {
+		return err
+	}
+	*target = value
+	return nil
+}
+
+// chainHooks runs update or create hooks in order, stopping at the first error.
+func chainHooks[I any, M any](hooks ...func(context.Context, *Resolver, I, *M) error) func(context.Context, *Resolver, I, *M) error {
+	return func(ctx context.Context, r *Resolver, input I, model *M) error {
+		for _, hook := range hooks {
+			if err := hook(ctx, r, input, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deicod/erm/orm/pg"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubSlugIndex struct {
+	owners map[string]string
+}
+
+func (s stubSlugIndex) SlugOwners(_ context.Context, entity, base string) (map[string]string, error) {
+	out := make(map[string]string)
+	for key, owner := range s.owners {
+		value, ok := strings.CutPrefix(key, entity+":")
+		if ok && (value == base || strings.HasPrefix(value, base+"-")) {
+			out[value] = owner
+		}
+	}
+	return out, nil
+}
+
+func TestAssignSlugDerivesFromTitleAndAvoidsCollisions(t *testing.T) {
+	t.Parallel()
+
+	resolver := &Resolver{slugs: stubSlugIndex{owners: map[string]string{
+		"Category:cafe-news":   "c1",
+		"Category:cafe-news-2": "c2",
+	}}}
+	hooks := newEntityHooks()
+	model := &gen.Category{Name: "Café News"}
+
+	if err := hooks.BeforeCreateCategory(context.Background(), resolver, graphqlpkg.CreateCategoryInput{}, model); err != nil {
+		t.Fatalf("slug hook returned error: %v", err)
+	}
+	if model.Slug != "cafe-news-3" {
+		t.Fatalf("expected cafe-news-3, got %q", model.Slug)
+	}
+}
+
+func TestAssignSlugRejectsTakenExplicitSlug(t *testing.T) {
+	t.Parallel()
+
+	resolver := &Resolver{slugs: stubSlugIndex{owners: map[string]string{
+		"Tag:go":   "t1",
+		"Tag:go-3": "t3",
+	}}}
+	hooks := newEntityHooks()
+	requested := "Go"
+
+	err := hooks.BeforeCreateTag(context.Background(), resolver, graphqlpkg.CreateTagInput{Slug: &requested}, &gen.Tag{Name: "Go"})
+	var typed *gqlerrors.Error
+	if !errors.As(err, &typed) {
+		t.Fatalf("expected typed error, got %v", err)
+	}
+	if typed.Code != gqlerrors.CodeSlugTaken {
+		t.Fatalf("expected %s code, got %v", gqlerrors.CodeSlugTaken, typed.Code)
+	}
+	suggestions, _ := typed.Extensions["suggestions"].([]string)
+	if len(suggestions) != 3 || suggestions[0] != "go-2" || suggestions[1] != "go-4" || suggestions[2] != "go-5" {
+		t.Fatalf("unexpected suggestions %v", suggestions)
+	}
+}
+
+func TestAssignSlugAllowsRecordToKeepItsSlug(t *testing.T) {
+	t.Parallel()
+
+	slug, err := (&Resolver{slugs: stubSlugIndex{owners: map[string]string{"Role:editor": "r1"}}}).
+		assignSlug(context.Background(), "Role", "r1", ptr("editor"), "Editor")
+	if err != nil {
+		t.Fatalf("assignSlug returned error: %v", err)
+	}
+	if slug != "editor" {
+		t.Fatalf("expected editor, got %q", slug)
+	}
+}
+
+func TestCreatePostGeneratesUniqueSlug(t *testing.T) {
+	pool := newMockPool()
+	now := time.Now().UTC()
+	pool.posts["post-1"] = &gen.Post{ID: "post-1", AuthorID: "author-1", Title: "Hello", Slug: "hello", Status: "draft", Type: "post", CreatedAt: now, UpdatedAt: now}
+	resolver := NewWithOptions(Options{ORM: gen.NewClient(&pg.DB{Pool: pool})})
+
+	payload, err := resolver.Mutation().CreatePost(context.Background(), graphqlpkg.CreatePostInput{
+		ID:       ptr("post-2"),
+		AuthorID: ptr("author-1"),
+		Title:    ptr("Hello!"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating post: %v", err)
+	}
+	if payload.Post == nil || payload.Post.Slug != "hello-2" {
+		t.Fatalf("expected slug hello-2, got %#v", payload.Post)
+	}
+}
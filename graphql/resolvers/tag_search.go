@@ -0,0 +1,161 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/dataloaders"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+const (
+	defaultTagSuggestions = 10
+	maxTagSuggestions     = 50
+	maxTagNameLength      = 200
+)
+
+type tagCatalog interface {
+	SuggestTags(ctx context.Context, prefix string, limit int) ([]*gen.Tag, error)
+	TagsByName(ctx context.Context, names []string) ([]*gen.Tag, error)
+	TagPostCounts(ctx context.Context, ids []string) (map[string]int, error)
+}
+
+func (r *Resolver) tagCatalog() tagCatalog {
+	if r == nil {
+		return nil
+	}
+	if r.tagIndex != nil {
+		return r.tagIndex
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) tagSuggestions(ctx context.Context, prefix string, first int) ([]*graphql1.Tag, error) {
+	if first < 1 || first > maxTagSuggestions {
+		return nil, gqlerrors.BadInput("first", "must be between 1 and 50")
+	}
+	catalog := r.tagCatalog()
+	if catalog == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	if strings.TrimSpace(prefix) == "" {
+		return []*graphql1.Tag{}, nil
+	}
+	start := time.Now()
+	records, err := catalog.SuggestTags(ctx, prefix, first)
+	r.recordQuery("tags", "suggest", start, err)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*graphql1.Tag, 0, len(records))
+	for _, record := range records {
+		if err := r.applyBeforeReturnTag(ctx, record); err != nil {
+			return nil, err
+		}
+		r.primeTag(ctx, record)
+		out = append(out, toGraphQLTag(record))
+	}
+	return out, nil
+}
+
+func (r *Resolver) tagPostCount(ctx context.Context, obj *graphql1.Tag) (int, error) {
+	catalog := r.tagCatalog()
+	if obj == nil || catalog == nil {
+		return 0, nil
+	}
+	nativeID, err := decodeTagID(obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	if loaders := dataloaders.FromContext(ctx); loaders != nil {
+		if loader := loaders.TagPostCount(); loader != nil {
+			return loader.Load(ctx, nativeID)
+		}
+	}
+	start := time.Now()
+	counts, err := catalog.TagPostCounts(ctx, []string{nativeID})
+	r.recordQuery("tags", "post_count", start, err)
+	if err != nil {
+		return 0, err
+	}
+	return counts[nativeID], nil
+}
+
+// appendNamedTags resolves tag names from a post mutation to tag IDs and
+// appends them to ids. Names without a tag come back as unsaved tags with a
+// slug; assignPostTaxonomies creates them together with the post's links.
+func (r *Resolver) appendNamedTags(ctx context.Context, ids []string, names []string) ([]string, []*gen.Tag, error) {
+	normalized, err := normalizeTagNames(names)
+	if err != nil || len(normalized) == 0 {
+		return ids, nil, err
+	}
+	catalog := r.tagCatalog()
+	if catalog == nil {
+		return nil, nil, gqlerrors.Internal("orm client is not configured")
+	}
+	start := time.Now()
+	existing, err := catalog.TagsByName(ctx, normalized)
+	r.recordQuery("tags", "by_name", start, err)
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := make(map[string]*gen.Tag, len(existing))
+	for _, record := range existing {
+		key := strings.ToLower(record.Name)
+		if _, ok := byName[key]; !ok {
+			byName[key] = record
+		}
+	}
+	seen := make(map[string]struct{}, len(ids)+len(normalized))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	var created []*gen.Tag
+	for _, name := range normalized {
+		record, ok := byName[strings.ToLower(name)]
+		if !ok {
+			value, err := r.assignSlug(ctx, "Tag", "", nil, name)
+			if err != nil {
+				return nil, nil, err
+			}
+			created = append(created, &gen.Tag{Name: name, Slug: value})
+			continue
+		}
+		r.primeTag(ctx, record)
+		if _, ok := seen[record.ID]; ok {
+			continue
+		}
+		seen[record.ID] = struct{}{}
+		ids = append(ids, record.ID)
+	}
+	return ids, created, nil
+}
+
+// normalizeTagNames trims names and drops case-insensitive duplicates,
+// keeping the first spelling.
+func normalizeTagNames(names []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(names))
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" {
+			return nil, gqlerrors.BadInput("tagNames", "tag names must not be empty")
+		}
+		if len(name) > maxTagNameLength {
+			return nil, gqlerrors.BadInput("tagNames", "tag names must be at most 200 characters")
+		}
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, name)
+	}
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// PostCount is the resolver for the postCount field.
+func (r *tagResolver) PostCount(ctx context.Context, obj *graphql1.Tag) (int, error) {
+	return r.tagPostCount(ctx, obj)
+}
+
+// TagSuggestions is the resolver for the tagSuggestions field.
+func (r *queryResolver) TagSuggestions(ctx context.Context, prefix string, first *int) ([]*graphql1.Tag, error) {
+	limit := defaultTagSuggestions
+	if first != nil {
+		limit = *first
+	}
+	return r.tagSuggestions(ctx, prefix, limit)
+}
+
+// Tag returns graphql1.TagResolver implementation.
+func (r *Resolver) Tag() graphql1.TagResolver { return &tagResolver{r} }
+
+type tagResolver struct{ *Resolver }
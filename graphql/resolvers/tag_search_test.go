@@ -0,0 +1,145 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubTagCatalog struct {
+	tags   []*gen.Tag
+	counts map[string]int
+	limit  int
+}
+
+func (s *stubTagCatalog) SuggestTags(_ context.Context, prefix string, limit int) ([]*gen.Tag, error) {
+	s.limit = limit
+	var out []*gen.Tag
+	for _, tag := range s.tags {
+		if strings.HasPrefix(strings.ToLower(tag.Name), strings.ToLower(prefix)) {
+			out = append(out, tag)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubTagCatalog) TagsByName(_ context.Context, names []string) ([]*gen.Tag, error) {
+	var out []*gen.Tag
+	for _, tag := range s.tags {
+		for _, name := range names {
+			if strings.EqualFold(tag.Name, name) {
+				out = append(out, tag)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (s *stubTagCatalog) TagPostCounts(_ context.Context, ids []string) (map[string]int, error) {
+	out := make(map[string]int, len(ids))
+	for _, id := range ids {
+		out[id] = s.counts[id]
+	}
+	return out, nil
+}
+
+func newStubTagCatalog() *stubTagCatalog {
+	return &stubTagCatalog{
+		tags: []*gen.Tag{
+			{ID: "go", Name: "Go", Slug: "go"},
+			{ID: "golang", Name: "Golang", Slug: "golang"},
+			{ID: "rust", Name: "Rust", Slug: "rust"},
+		},
+		counts: map[string]int{"go": 4},
+	}
+}
+
+func TestTagSuggestions(t *testing.T) {
+	catalog := newStubTagCatalog()
+	resolver := &Resolver{tagIndex: catalog}
+
+	tags, err := resolver.Query().TagSuggestions(context.Background(), "go", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 2 || tags[0].Slug != "go" || tags[1].Slug != "golang" || catalog.limit != defaultTagSuggestions {
+		t.Fatalf("unexpected suggestions %+v (limit %d)", tags, catalog.limit)
+	}
+	if tags[0].ID != relay.ToGlobalID("Tag", "go") {
+		t.Fatalf("expected global id, got %q", tags[0].ID)
+	}
+
+	if tags, err := resolver.Query().TagSuggestions(context.Background(), "  ", nil); err != nil || len(tags) != 0 {
+		t.Fatalf("expected no suggestions for blank prefix, got %+v (%v)", tags, err)
+	}
+
+	tooMany := maxTagSuggestions + 1
+	_, err = resolver.Query().TagSuggestions(context.Background(), "go", &tooMany)
+	var gqlErr *gqlerrors.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Code != gqlerrors.CodeBadUserInput {
+		t.Fatalf("expected bad input error, got %v", err)
+	}
+}
+
+func TestTagPostCount(t *testing.T) {
+	resolver := &Resolver{tagIndex: newStubTagCatalog()}
+	tag := &graphqlpkg.Tag{ID: relay.ToGlobalID("Tag", "go")}
+	count, err := resolver.Tag().PostCount(context.Background(), tag)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 4 {
+		t.Fatalf("expected 4 posts, got %d", count)
+	}
+}
+
+func TestAppendNamedTagsReusesExistingTags(t *testing.T) {
+	resolver := &Resolver{tagIndex: newStubTagCatalog()}
+	ids, created, err := resolver.appendNamedTags(context.Background(), []string{"go"}, []string{" golang ", "GO", "rust", "Golang"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(ids, ",") != "go,golang,rust" {
+		t.Fatalf("unexpected tag ids %v", ids)
+	}
+	if len(created) != 0 {
+		t.Fatalf("expected no new tags, got %v", created)
+	}
+
+	ids, _, err = resolver.appendNamedTags(context.Background(), []string{"go"}, nil)
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected ids unchanged without names, got %v (%v)", ids, err)
+	}
+}
+
+func TestAppendNamedTagsValidatesNames(t *testing.T) {
+	resolver := &Resolver{tagIndex: newStubTagCatalog()}
+	for _, names := range [][]string{{""}, {"   "}, {strings.Repeat("x", maxTagNameLength+1)}} {
+		_, _, err := resolver.appendNamedTags(context.Background(), nil, names)
+		var gqlErr *gqlerrors.Error
+		if !errors.As(err, &gqlErr) || gqlErr.Code != gqlerrors.CodeBadUserInput {
+			t.Fatalf("expected bad input for %q, got %v", names, err)
+		}
+	}
+
+}
+
+func TestAppendNamedTagsLeavesNewTagsUnsaved(t *testing.T) {
+	resolver := &Resolver{tagIndex: newStubTagCatalog()}
+	ids, created, err := resolver.appendNamedTags(context.Background(), []string{"go"}, []string{"Zig Lang", "rust"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(ids, ",") != "go,rust" {
+		t.Fatalf("unexpected tag ids %v", ids)
+	}
+	if len(created) != 1 || created[0].ID != "" || created[0].Name != "Zig Lang" || created[0].Slug != "zig-lang" {
+		t.Fatalf("expected one unsaved tag for Zig Lang, got %+v", created)
+	}
+}
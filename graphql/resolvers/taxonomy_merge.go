@@ -0,0 +1,232 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type taxonomyMerger interface {
+	MergeTags(ctx context.Context, sourceIDs []string, targetID string) (*gen.TaxonomyMerge, error)
+	MergeCategories(ctx context.Context, sourceIDs []string, targetID string) (*gen.TaxonomyMerge, error)
+	BulkAssignTaxonomies(ctx context.Context, assignment gen.TaxonomyAssignment) ([]string, error)
+}
+
+func (r *Resolver) taxonomyMerger() taxonomyMerger {
+	if r == nil {
+		return nil
+	}
+	if r.merges != nil {
+		return r.merges
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) mergeTags(ctx context.Context, input graphql1.MergeTagsInput) (*graphql1.MergeTagsPayload, error) {
+	merger, tags := r.taxonomyMerger(), r.tagClient()
+	if merger == nil || tags == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	sourceIDs, targetID, err := decodeMergeIDs(input.SourceIDs, input.TargetID, decodeTagID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := tags.ByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, gqlerrors.NotFound("Tag")
+	}
+
+	start := time.Now()
+	merge, err := merger.MergeTags(ctx, sourceIDs, targetID)
+	r.recordQuery("tags", "merge", start, err)
+	if err != nil {
+		return nil, err
+	}
+	mergedIDs := r.publishMerge(ctx, "Tag", merge)
+	r.primeTag(ctx, target)
+	return &graphql1.MergeTagsPayload{
+		ClientMutationID: input.ClientMutationID,
+		Tag:              toGraphQLTag(target),
+		MergedIDs:        mergedIDs,
+		AffectedPosts:    len(merge.PostIDs),
+	}, nil
+}
+
+func (r *Resolver) mergeCategories(ctx context.Context, input graphql1.MergeCategoriesInput) (*graphql1.MergeCategoriesPayload, error) {
+	merger, categories := r.taxonomyMerger(), r.categoryClient()
+	if merger == nil || categories == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	sourceIDs, targetID, err := decodeMergeIDs(input.SourceIDs, input.TargetID, decodeCategoryID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := categories.ByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, gqlerrors.NotFound("Category")
+	}
+
+	start := time.Now()
+	merge, err := merger.MergeCategories(ctx, sourceIDs, targetID)
+	r.recordQuery("categories", "merge", start, err)
+	if errors.Is(err, gen.ErrCategoryCycle) {
+		return nil, gqlerrors.BadInput("input.targetID", "target cannot be a descendant of a merged category")
+	}
+	if err != nil {
+		return nil, err
+	}
+	mergedIDs := r.publishMerge(ctx, "Category", merge)
+	if err := r.applyBeforeReturnCategory(ctx, target); err != nil {
+		return nil, err
+	}
+	r.primeCategory(ctx, target)
+	return &graphql1.MergeCategoriesPayload{
+		ClientMutationID: input.ClientMutationID,
+		Category:         toGraphQLCategory(target),
+		MergedIDs:        mergedIDs,
+		AffectedPosts:    len(merge.PostIDs),
+	}, nil
+}
+
+func (r *Resolver) bulkAssignTaxonomies(ctx context.Context, input graphql1.BulkAssignTaxonomiesInput) (*graphql1.BulkAssignTaxonomiesPayload, error) {
+	merger := r.taxonomyMerger()
+	if merger == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	var assignment gen.TaxonomyAssignment
+	var err error
+	if assignment.PostIDs, err = decodeIDs(input.PostIDs, decodePostID); err != nil {
+		return nil, err
+	}
+	if assignment.AddCategoryIDs, err = decodeIDs(input.AddCategoryIDs, decodeCategoryID); err != nil {
+		return nil, err
+	}
+	if assignment.RemoveCategoryIDs, err = decodeIDs(input.RemoveCategoryIDs, decodeCategoryID); err != nil {
+		return nil, err
+	}
+	if assignment.AddTagIDs, err = decodeIDs(input.AddTagIDs, decodeTagID); err != nil {
+		return nil, err
+	}
+	if assignment.RemoveTagIDs, err = decodeIDs(input.RemoveTagIDs, decodeTagID); err != nil {
+		return nil, err
+	}
+	if overlaps(assignment.AddCategoryIDs, assignment.RemoveCategoryIDs) {
+		return nil, gqlerrors.BadInput("input.removeCategoryIDs", "a category cannot be both added and removed")
+	}
+	if overlaps(assignment.AddTagIDs, assignment.RemoveTagIDs) {
+		return nil, gqlerrors.BadInput("input.removeTagIDs", "a tag cannot be both added and removed")
+	}
+
+	start := time.Now()
+	postIDs, err := merger.BulkAssignTaxonomies(ctx, assignment)
+	r.recordQuery("posts", "bulk_assign_taxonomies", start, err)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := r.publishPostsUpdated(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql1.BulkAssignTaxonomiesPayload{
+		ClientMutationID: input.ClientMutationID,
+		Posts:            posts,
+	}, nil
+}
+
+// publishMerge announces the deleted sources and the re-filed posts, and
+// returns the global IDs of the sources.
+func (r *Resolver) publishMerge(ctx context.Context, entity string, merge *gen.TaxonomyMerge) []string {
+	mergedIDs := make([]string, 0, len(merge.RemovedIDs))
+	for _, id := range merge.RemovedIDs {
+		globalID := relay.ToGlobalID(entity, id)
+		mergedIDs = append(mergedIDs, globalID)
+		publishSubscriptionEvent(ctx, r.subscriptionBroker(), entity, SubscriptionTriggerDeleted, globalID)
+	}
+	// The merge already committed; failing to load a post only skips its event.
+	_, _ = r.publishPostsUpdated(ctx, merge.PostIDs)
+	return mergedIDs
+}
+
+// publishPostsUpdated loads the posts and publishes an update event for each.
+func (r *Resolver) publishPostsUpdated(ctx context.Context, ids []string) ([]*graphql1.Post, error) {
+	posts := make([]*graphql1.Post, 0, len(ids))
+	client := r.postClient()
+	if client == nil {
+		return posts, nil
+	}
+	for _, id := range ids {
+		record, err := client.ByID(ctx, id)
+		if err != nil {
+			return posts, err
+		}
+		if record == nil {
+			continue
+		}
+		if err := r.applyBeforeReturnPost(ctx, record); err != nil {
+			return posts, err
+		}
+		post := toGraphQLPost(record)
+		posts = append(posts, post)
+		publishSubscriptionEvent(ctx, r.subscriptionBroker(), "Post", SubscriptionTriggerUpdated, post)
+	}
+	return posts, nil
+}
+
+func decodeMergeIDs(sources []string, target string, decode func(string) (string, error)) ([]string, string, error) {
+	if len(sources) == 0 {
+		return nil, "", gqlerrors.BadInput("input.sourceIDs", "at least one source is required")
+	}
+	targetID, err := decode(target)
+	if err != nil {
+		return nil, "", gqlerrors.BadInput("input.targetID", err.Error())
+	}
+	sourceIDs, err := decodeIDs(sources, decode)
+	if err != nil {
+		return nil, "", gqlerrors.BadInput("input.sourceIDs", err.Error())
+	}
+	for _, sourceID := range sourceIDs {
+		if sourceID == targetID {
+			return nil, "", gqlerrors.BadInput("input.sourceIDs", "the target cannot also be a source")
+		}
+	}
+	return sourceIDs, targetID, nil
+}
+
+func decodeIDs(ids []string, decode func(string) (string, error)) ([]string, error) {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		nativeID, err := decode(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, nativeID)
+	}
+	return out, nil
+}
+
+func overlaps(a, b []string) bool {
+	set := make(map[string]struct{}, len(a))
+	for _, value := range a {
+		set[value] = struct{}{}
+	}
+	for _, value := range b {
+		if _, ok := set[value]; ok {
+			return true
+		}
+	}
+	return false
+}
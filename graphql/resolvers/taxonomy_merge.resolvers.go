@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// MergeTags is the resolver for the mergeTags field.
+func (r *mutationResolver) MergeTags(ctx context.Context, input graphql1.MergeTagsInput) (*graphql1.MergeTagsPayload, error) {
+	return r.mergeTags(ctx, input)
+}
+
+// MergeCategories is the resolver for the mergeCategories field.
+func (r *mutationResolver) MergeCategories(ctx context.Context, input graphql1.MergeCategoriesInput) (*graphql1.MergeCategoriesPayload, error) {
+	return r.mergeCategories(ctx, input)
+}
+
+// BulkAssignTaxonomies is the resolver for the bulkAssignTaxonomies field.
+func (r *mutationResolver) BulkAssignTaxonomies(ctx context.Context, input graphql1.BulkAssignTaxonomiesInput) (*graphql1.BulkAssignTaxonomiesPayload, error) {
+	return r.bulkAssignTaxonomies(ctx, input)
+}
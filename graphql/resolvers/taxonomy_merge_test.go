@@ -0,0 +1,171 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	graphqlpkg "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubTaxonomyMerger struct {
+	merge      *gen.TaxonomyMerge
+	sources    []string
+	target     string
+	assignment gen.TaxonomyAssignment
+	assigned   []string
+}
+
+func (s *stubTaxonomyMerger) MergeTags(_ context.Context, sourceIDs []string, targetID string) (*gen.TaxonomyMerge, error) {
+	s.sources, s.target = sourceIDs, targetID
+	return s.merge, nil
+}
+
+func (s *stubTaxonomyMerger) MergeCategories(_ context.Context, sourceIDs []string, targetID string) (*gen.TaxonomyMerge, error) {
+	if targetID == "child" {
+		return nil, gen.ErrCategoryCycle
+	}
+	s.sources, s.target = sourceIDs, targetID
+	return s.merge, nil
+}
+
+func (s *stubTaxonomyMerger) BulkAssignTaxonomies(_ context.Context, assignment gen.TaxonomyAssignment) ([]string, error) {
+	s.assignment = assignment
+	return s.assigned, nil
+}
+
+type stubTagProvider map[string]*gen.Tag
+
+func (s stubTagProvider) ByID(_ context.Context, id string) (*gen.Tag, error) {
+	return s[id], nil
+}
+
+type stubCategoryProvider map[string]*gen.Category
+
+func (s stubCategoryProvider) ByID(_ context.Context, id string) (*gen.Category, error) {
+	return s[id], nil
+}
+
+type recordingBroker struct {
+	topics []string
+}
+
+func (b *recordingBroker) Publish(_ context.Context, topic string, _ any) error {
+	b.topics = append(b.topics, topic)
+	return nil
+}
+
+func (b *recordingBroker) Subscribe(context.Context, string) (<-chan any, func(), error) {
+	return nil, func() {}, nil
+}
+
+func TestMergeTagsPublishesEvents(t *testing.T) {
+	merger := &stubTaxonomyMerger{merge: &gen.TaxonomyMerge{RemovedIDs: []string{"go-lang"}, PostIDs: []string{"post-1", "post-2"}}}
+	broker := &recordingBroker{}
+	resolver := &Resolver{
+		merges:        merger,
+		subscriptions: broker,
+		tags:          stubTagProvider{"golang": {ID: "golang", Name: "Go", Slug: "golang"}},
+		posts:         stubPostProvider{posts: map[string]*gen.Post{"post-1": {ID: "post-1"}, "post-2": {ID: "post-2"}}},
+	}
+
+	payload, err := resolver.Mutation().MergeTags(context.Background(), graphqlpkg.MergeTagsInput{
+		SourceIDs: []string{relay.ToGlobalID("Tag", "go-lang")},
+		TargetID:  relay.ToGlobalID("Tag", "golang"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if merger.target != "golang" || len(merger.sources) != 1 || merger.sources[0] != "go-lang" {
+		t.Fatalf("unexpected merge call: %v into %q", merger.sources, merger.target)
+	}
+	if payload.Tag == nil || payload.Tag.Slug != "golang" || payload.AffectedPosts != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if len(payload.MergedIDs) != 1 || payload.MergedIDs[0] != relay.ToGlobalID("Tag", "go-lang") {
+		t.Fatalf("unexpected merged ids: %v", payload.MergedIDs)
+	}
+	sort.Strings(broker.topics)
+	want := []string{"post:updated", "post:updated", "tag:deleted"}
+	if len(broker.topics) != len(want) {
+		t.Fatalf("expected topics %v, got %v", want, broker.topics)
+	}
+	for i := range want {
+		if broker.topics[i] != want[i] {
+			t.Fatalf("expected topics %v, got %v", want, broker.topics)
+		}
+	}
+}
+
+func TestMergeTagsValidatesInput(t *testing.T) {
+	resolver := &Resolver{
+		merges: &stubTaxonomyMerger{merge: &gen.TaxonomyMerge{}},
+		tags:   stubTagProvider{"golang": {ID: "golang"}},
+	}
+	cases := map[string]graphqlpkg.MergeTagsInput{
+		"no sources":       {TargetID: "golang"},
+		"target as source": {SourceIDs: []string{"golang"}, TargetID: "golang"},
+		"missing target":   {SourceIDs: []string{"go-lang"}, TargetID: "missing"},
+		"wrong type":       {SourceIDs: []string{relay.ToGlobalID("Category", "go-lang")}, TargetID: "golang"},
+	}
+	for name, input := range cases {
+		if _, err := resolver.Mutation().MergeTags(context.Background(), input); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestMergeCategoriesRejectsDescendantTarget(t *testing.T) {
+	resolver := &Resolver{
+		merges:     &stubTaxonomyMerger{merge: &gen.TaxonomyMerge{}},
+		categories: stubCategoryProvider{"child": {ID: "child"}, "news": {ID: "news", Slug: "news"}},
+	}
+	if _, err := resolver.Mutation().MergeCategories(context.Background(), graphqlpkg.MergeCategoriesInput{SourceIDs: []string{"parent"}, TargetID: "child"}); err == nil {
+		t.Fatal("expected descendant target to be rejected")
+	}
+	payload, err := resolver.Mutation().MergeCategories(context.Background(), graphqlpkg.MergeCategoriesInput{SourceIDs: []string{"updates"}, TargetID: "news"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Category == nil || payload.Category.Slug != "news" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestBulkAssignTaxonomies(t *testing.T) {
+	merger := &stubTaxonomyMerger{assigned: []string{"post-1"}}
+	broker := &recordingBroker{}
+	resolver := &Resolver{
+		merges:        merger,
+		subscriptions: broker,
+		posts:         stubPostProvider{posts: map[string]*gen.Post{"post-1": {ID: "post-1", Title: "Hello"}}},
+	}
+
+	payload, err := resolver.Mutation().BulkAssignTaxonomies(context.Background(), graphqlpkg.BulkAssignTaxonomiesInput{
+		PostIDs:        []string{relay.ToGlobalID("Post", "post-1"), relay.ToGlobalID("Post", "post-9")},
+		AddCategoryIDs: []string{relay.ToGlobalID("Category", "news")},
+		RemoveTagIDs:   []string{relay.ToGlobalID("Tag", "old")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(merger.assignment.PostIDs) != 2 || merger.assignment.AddCategoryIDs[0] != "news" || merger.assignment.RemoveTagIDs[0] != "old" {
+		t.Fatalf("unexpected assignment: %+v", merger.assignment)
+	}
+	if len(payload.Posts) != 1 || payload.Posts[0].Title != "Hello" {
+		t.Fatalf("unexpected posts: %+v", payload.Posts)
+	}
+	if len(broker.topics) != 1 || broker.topics[0] != "post:updated" {
+		t.Fatalf("expected one post update event, got %v", broker.topics)
+	}
+
+	if _, err := resolver.Mutation().BulkAssignTaxonomies(context.Background(), graphqlpkg.BulkAssignTaxonomiesInput{
+		PostIDs:      []string{"post-1"},
+		AddTagIDs:    []string{"go"},
+		RemoveTagIDs: []string{"go"},
+	}); err == nil {
+		t.Fatal("expected error when a tag is both added and removed")
+	}
+}
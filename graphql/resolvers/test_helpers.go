@@ -37,3 +37,13 @@ func (c *recordingCollector) RecordQuery(table string, operation string, duratio
 	}
 	c.queries = append(c.queries, queryRecord{table: table, operation: operation, duration: duration, err: err})
 }
+
+func (c *recordingCollector) RecordOperation(string, string, int, time.Duration, error) {}
+
+func (c *recordingCollector) RecordResolver(string, string, time.Duration, error) {}
+
+func (c *recordingCollector) RecordRejection(string, string, string) {}
+
+func (c *recordingCollector) RecordCacheLookup(bool) {}
+
+func (c *recordingCollector) RecordCacheInvalidation(string, int) {}
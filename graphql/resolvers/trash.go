@@ -0,0 +1,260 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type trashStore interface {
+	TrashItems(ctx context.Context, kind gen.TrashKind, limit, offset int) ([]gen.TrashItem, int, error)
+	RestorePost(ctx context.Context, id string) (*gen.Post, error)
+	RestoreComment(ctx context.Context, id string) (*gen.Comment, error)
+	RestoreMedia(ctx context.Context, id string) (*gen.Media, error)
+	PurgePosts(ctx context.Context, ids []string) (*gen.TrashPurge, error)
+	PurgeComments(ctx context.Context, ids []string) (*gen.TrashPurge, error)
+	PurgeMedia(ctx context.Context, ids []string) (*gen.TrashPurge, error)
+}
+
+func (r *Resolver) trashStore() trashStore {
+	if r == nil {
+		return nil
+	}
+	if r.trashBin != nil {
+		return r.trashBin
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+var trashKinds = map[graphql1.TrashKind]gen.TrashKind{
+	graphql1.TrashKindPost:    gen.TrashPost,
+	graphql1.TrashKindComment: gen.TrashComment,
+	graphql1.TrashKindMedia:   gen.TrashMedia,
+}
+
+func (r *Resolver) trash(ctx context.Context, kind *graphql1.TrashKind, first *int, after *string) (*graphql1.TrashConnection, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	limit := defaultPageSize
+	if first != nil && *first > 0 {
+		limit = *first
+	}
+	offset := 0
+	if after != nil && *after != "" {
+		if decoded, err := decodeCursor(*after); err == nil {
+			offset = decoded + 1
+		}
+	}
+	var filter gen.TrashKind
+	if kind != nil {
+		filter = trashKinds[*kind]
+	}
+	start := time.Now()
+	items, total, err := store.TrashItems(ctx, filter, limit, offset)
+	r.recordQuery("trash", "list", start, err)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]*graphql1.TrashEdge, 0, len(items))
+	for idx, item := range items {
+		node, err := r.trashedNode(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, &graphql1.TrashEdge{
+			Cursor:    encodeCursor(offset + idx),
+			Node:      node,
+			DeletedAt: item.DeletedAt,
+		})
+	}
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		sc := edges[0].Cursor
+		ec := edges[len(edges)-1].Cursor
+		startCursor = &sc
+		endCursor = &ec
+	}
+	return &graphql1.TrashConnection{
+		Edges: edges,
+		PageInfo: &graphql1.PageInfo{
+			HasNextPage:     offset+len(items) < total,
+			HasPreviousPage: offset > 0,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+		TotalCount: total,
+	}, nil
+}
+
+func (r *Resolver) trashedNode(ctx context.Context, item gen.TrashItem) (graphql1.TrashedNode, error) {
+	switch {
+	case item.Post != nil:
+		if err := r.applyBeforeReturnPost(ctx, item.Post); err != nil {
+			return nil, err
+		}
+		return toGraphQLPost(item.Post), nil
+	case item.Comment != nil:
+		if err := r.applyBeforeReturnComment(ctx, item.Comment); err != nil {
+			return nil, err
+		}
+		return toGraphQLComment(item.Comment), nil
+	case item.Media != nil:
+		if err := r.applyBeforeReturnMedia(ctx, item.Media); err != nil {
+			return nil, err
+		}
+		return toGraphQLMedia(item.Media), nil
+	}
+	return nil, gqlerrors.Internal("trash item has no record")
+}
+
+// restorePost takes a post out of the trash. Restored records are announced
+// as created, since subscribers dropped them when the delete event arrived.
+func (r *Resolver) restorePost(ctx context.Context, input graphql1.RestorePostInput) (*graphql1.RestorePostPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodePostID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	record, err := store.RestorePost(ctx, id)
+	r.recordQuery("posts", "restore", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("Post")
+	}
+	if err := r.applyBeforeReturnPost(ctx, record); err != nil {
+		return nil, err
+	}
+	post := toGraphQLPost(record)
+	publishSubscriptionEvent(ctx, r.subscriptionBroker(), "Post", SubscriptionTriggerCreated, post)
+	return &graphql1.RestorePostPayload{ClientMutationID: input.ClientMutationID, Post: post}, nil
+}
+
+func (r *Resolver) restoreComment(ctx context.Context, input graphql1.RestoreCommentInput) (*graphql1.RestoreCommentPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodeCommentID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	record, err := store.RestoreComment(ctx, id)
+	r.recordQuery("comments", "restore", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("Comment")
+	}
+	if err := r.applyBeforeReturnComment(ctx, record); err != nil {
+		return nil, err
+	}
+	comment := toGraphQLComment(record)
+	publishSubscriptionEvent(ctx, r.subscriptionBroker(), "Comment", SubscriptionTriggerCreated, comment)
+	return &graphql1.RestoreCommentPayload{ClientMutationID: input.ClientMutationID, Comment: comment}, nil
+}
+
+func (r *Resolver) restoreMedia(ctx context.Context, input graphql1.RestoreMediaInput) (*graphql1.RestoreMediaPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodeMediaID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	record, err := store.RestoreMedia(ctx, id)
+	r.recordQuery("medias", "restore", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, gqlerrors.NotFound("Media")
+	}
+	if err := r.applyBeforeReturnMedia(ctx, record); err != nil {
+		return nil, err
+	}
+	media := toGraphQLMedia(record)
+	publishSubscriptionEvent(ctx, r.subscriptionBroker(), "Media", SubscriptionTriggerCreated, media)
+	return &graphql1.RestoreMediaPayload{ClientMutationID: input.ClientMutationID, Media: media}, nil
+}
+
+func (r *Resolver) purgePost(ctx context.Context, input graphql1.PurgePostInput) (*graphql1.PurgePostPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodePostID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	purge, err := store.PurgePosts(ctx, []string{id})
+	r.recordQuery("posts", "purge", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(purge.PostIDs) == 0 {
+		return nil, gqlerrors.NotFound("Post")
+	}
+	return &graphql1.PurgePostPayload{ClientMutationID: input.ClientMutationID, PurgedPostID: relay.ToGlobalID("Post", id)}, nil
+}
+
+func (r *Resolver) purgeComment(ctx context.Context, input graphql1.PurgeCommentInput) (*graphql1.PurgeCommentPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodeCommentID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	purge, err := store.PurgeComments(ctx, []string{id})
+	r.recordQuery("comments", "purge", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(purge.CommentIDs) == 0 {
+		return nil, gqlerrors.NotFound("Comment")
+	}
+	return &graphql1.PurgeCommentPayload{ClientMutationID: input.ClientMutationID, PurgedCommentID: relay.ToGlobalID("Comment", id)}, nil
+}
+
+func (r *Resolver) purgeMedia(ctx context.Context, input graphql1.PurgeMediaInput) (*graphql1.PurgeMediaPayload, error) {
+	store := r.trashStore()
+	if store == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	id, err := decodeMediaID(input.ID)
+	if err != nil {
+		return nil, gqlerrors.BadInput("input.id", err.Error())
+	}
+	start := time.Now()
+	purge, err := store.PurgeMedia(ctx, []string{id})
+	r.recordQuery("medias", "purge", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(purge.MediaIDs) == 0 {
+		return nil, gqlerrors.NotFound("Media")
+	}
+	return &graphql1.PurgeMediaPayload{ClientMutationID: input.ClientMutationID, PurgedMediaID: relay.ToGlobalID("Media", id)}, nil
+}
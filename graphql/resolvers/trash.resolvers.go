@@ -0,0 +1,46 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// RestorePost is the resolver for the restorePost field.
+func (r *mutationResolver) RestorePost(ctx context.Context, input graphql1.RestorePostInput) (*graphql1.RestorePostPayload, error) {
+	return r.restorePost(ctx, input)
+}
+
+// RestoreComment is the resolver for the restoreComment field.
+func (r *mutationResolver) RestoreComment(ctx context.Context, input graphql1.RestoreCommentInput) (*graphql1.RestoreCommentPayload, error) {
+	return r.restoreComment(ctx, input)
+}
+
+// RestoreMedia is the resolver for the restoreMedia field.
+func (r *mutationResolver) RestoreMedia(ctx context.Context, input graphql1.RestoreMediaInput) (*graphql1.RestoreMediaPayload, error) {
+	return r.restoreMedia(ctx, input)
+}
+
+// PurgePost is the resolver for the purgePost field.
+func (r *mutationResolver) PurgePost(ctx context.Context, input graphql1.PurgePostInput) (*graphql1.PurgePostPayload, error) {
+	return r.purgePost(ctx, input)
+}
+
+// PurgeComment is the resolver for the purgeComment field.
+func (r *mutationResolver) PurgeComment(ctx context.Context, input graphql1.PurgeCommentInput) (*graphql1.PurgeCommentPayload, error) {
+	return r.purgeComment(ctx, input)
+}
+
+// PurgeMedia is the resolver for the purgeMedia field.
+func (r *mutationResolver) PurgeMedia(ctx context.Context, input graphql1.PurgeMediaInput) (*graphql1.PurgeMediaPayload, error) {
+	return r.purgeMedia(ctx, input)
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context, kind *graphql1.TrashKind, first *int, after *string) (*graphql1.TrashConnection, error) {
+	return r.trash(ctx, kind, first, after)
+}
@@ -0,0 +1,184 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubTrashStore struct {
+	items    []gen.TrashItem
+	kind     gen.TrashKind
+	trashed  map[string]bool
+	restored []string
+	purged   []string
+}
+
+func (s *stubTrashStore) TrashItems(_ context.Context, kind gen.TrashKind, limit, offset int) ([]gen.TrashItem, int, error) {
+	s.kind = kind
+	end := offset + limit
+	if end > len(s.items) {
+		end = len(s.items)
+	}
+	if offset > end {
+		offset = end
+	}
+	return s.items[offset:end], len(s.items), nil
+}
+
+func (s *stubTrashStore) take(id string, list *[]string) bool {
+	if !s.trashed[id] {
+		return false
+	}
+	delete(s.trashed, id)
+	*list = append(*list, id)
+	return true
+}
+
+func (s *stubTrashStore) RestorePost(_ context.Context, id string) (*gen.Post, error) {
+	if !s.take(id, &s.restored) {
+		return nil, nil
+	}
+	return &gen.Post{ID: id, Title: "Back"}, nil
+}
+
+func (s *stubTrashStore) RestoreComment(_ context.Context, id string) (*gen.Comment, error) {
+	if !s.take(id, &s.restored) {
+		return nil, nil
+	}
+	return &gen.Comment{ID: id}, nil
+}
+
+func (s *stubTrashStore) RestoreMedia(_ context.Context, id string) (*gen.Media, error) {
+	if !s.take(id, &s.restored) {
+		return nil, nil
+	}
+	return &gen.Media{ID: id}, nil
+}
+
+func (s *stubTrashStore) PurgePosts(_ context.Context, ids []string) (*gen.TrashPurge, error) {
+	purge := &gen.TrashPurge{}
+	for _, id := range ids {
+		if s.take(id, &s.purged) {
+			purge.PostIDs = append(purge.PostIDs, id)
+		}
+	}
+	return purge, nil
+}
+
+func (s *stubTrashStore) PurgeComments(_ context.Context, ids []string) (*gen.TrashPurge, error) {
+	purge := &gen.TrashPurge{}
+	for _, id := range ids {
+		if s.take(id, &s.purged) {
+			purge.CommentIDs = append(purge.CommentIDs, id)
+		}
+	}
+	return purge, nil
+}
+
+func (s *stubTrashStore) PurgeMedia(_ context.Context, ids []string) (*gen.TrashPurge, error) {
+	purge := &gen.TrashPurge{}
+	for _, id := range ids {
+		if s.take(id, &s.purged) {
+			purge.MediaIDs = append(purge.MediaIDs, id)
+		}
+	}
+	return purge, nil
+}
+
+func TestTrashListsDeletedRecords(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store := &stubTrashStore{items: []gen.TrashItem{
+		{Kind: gen.TrashPost, ID: "post-1", DeletedAt: deletedAt, Post: &gen.Post{ID: "post-1", DeletedAt: &deletedAt}},
+		{Kind: gen.TrashComment, ID: "comment-1", DeletedAt: deletedAt, Comment: &gen.Comment{ID: "comment-1"}},
+		{Kind: gen.TrashMedia, ID: "media-1", DeletedAt: deletedAt, Media: &gen.Media{ID: "media-1"}},
+	}}
+	resolver := &Resolver{trashBin: store}
+
+	first := 2
+	conn, err := resolver.Query().Trash(context.Background(), nil, &first, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conn.TotalCount != 3 || !conn.PageInfo.HasNextPage || len(conn.Edges) != 2 {
+		t.Fatalf("unexpected page %+v with %d edges", conn.PageInfo, len(conn.Edges))
+	}
+	post, ok := conn.Edges[0].Node.(*graphql1.Post)
+	if !ok || post.ID != relay.ToGlobalID("Post", "post-1") || post.DeletedAt == nil || !conn.Edges[0].DeletedAt.Equal(deletedAt) {
+		t.Fatalf("unexpected first edge %+v", conn.Edges[0])
+	}
+	if _, ok := conn.Edges[1].Node.(*graphql1.Comment); !ok {
+		t.Fatalf("expected a comment, got %T", conn.Edges[1].Node)
+	}
+
+	kind := graphql1.TrashKindMedia
+	conn, err = resolver.Query().Trash(context.Background(), &kind, nil, conn.PageInfo.EndCursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.kind != gen.TrashMedia {
+		t.Fatalf("expected the media filter, got %q", store.kind)
+	}
+	if len(conn.Edges) != 1 || conn.PageInfo.HasNextPage {
+		t.Fatalf("unexpected second page %+v", conn.PageInfo)
+	}
+	if _, ok := conn.Edges[0].Node.(*graphql1.Media); !ok {
+		t.Fatalf("expected media, got %T", conn.Edges[0].Node)
+	}
+}
+
+func TestRestoreTakesRecordsOutOfTheTrash(t *testing.T) {
+	store := &stubTrashStore{trashed: map[string]bool{"post-1": true, "comment-1": true}}
+	resolver := &Resolver{trashBin: store}
+	ctx := context.Background()
+
+	payload, err := resolver.Mutation().RestorePost(ctx, graphql1.RestorePostInput{ID: relay.ToGlobalID("Post", "post-1")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Post.ID != relay.ToGlobalID("Post", "post-1") || payload.Post.Title != "Back" {
+		t.Fatalf("unexpected post %+v", payload.Post)
+	}
+	_, err = resolver.Mutation().RestorePost(ctx, graphql1.RestorePostInput{ID: relay.ToGlobalID("Post", "post-1")})
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+
+	if _, err := resolver.Mutation().RestoreComment(ctx, graphql1.RestoreCommentInput{ID: relay.ToGlobalID("Comment", "comment-1")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = resolver.Mutation().RestoreMedia(ctx, graphql1.RestoreMediaInput{ID: relay.ToGlobalID("Media", "media-1")})
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+
+	_, err = resolver.Mutation().RestoreComment(ctx, graphql1.RestoreCommentInput{ID: relay.ToGlobalID("Post", "post-1")})
+	expectBadInput(t, err, "input.id")
+	if len(store.restored) != 2 {
+		t.Fatalf("unexpected restores %v", store.restored)
+	}
+}
+
+func TestPurgeOnlyRemovesTrashedRecords(t *testing.T) {
+	store := &stubTrashStore{trashed: map[string]bool{"post-1": true, "media-1": true}}
+	resolver := &Resolver{trashBin: store}
+	ctx := context.Background()
+
+	payload, err := resolver.Mutation().PurgePost(ctx, graphql1.PurgePostInput{ID: relay.ToGlobalID("Post", "post-1")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.PurgedPostID != relay.ToGlobalID("Post", "post-1") {
+		t.Fatalf("unexpected purged id %q", payload.PurgedPostID)
+	}
+	media, err := resolver.Mutation().PurgeMedia(ctx, graphql1.PurgeMediaInput{ID: relay.ToGlobalID("Media", "media-1")})
+	if err != nil || media.PurgedMediaID != relay.ToGlobalID("Media", "media-1") {
+		t.Fatalf("unexpected media purge %+v, %v", media, err)
+	}
+	_, err = resolver.Mutation().PurgeComment(ctx, graphql1.PurgeCommentInput{ID: relay.ToGlobalID("Comment", "comment-1")})
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+	if len(store.purged) != 2 {
+		t.Fatalf("unexpected purges %v", store.purged)
+	}
+}
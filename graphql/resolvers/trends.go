@@ -0,0 +1,214 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+const (
+	defaultLeaderboardSize = 5
+	maxLeaderboardSize     = 50
+)
+
+type trendSource interface {
+	Trend(ctx context.Context, metric gen.TrendMetric, unit string, from, split, to time.Time) ([]gen.TrendRow, error)
+	TopAuthors(ctx context.Context, from, split, to time.Time, limit int) ([]gen.AuthorTrend, error)
+}
+
+func (r *Resolver) trendSource() trendSource {
+	if r == nil {
+		return nil
+	}
+	if r.trends != nil {
+		return r.trends
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) currentTime() time.Time {
+	if r != nil && r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// trendWindow is the reporting period [from, to) and the equally long period
+// [previousFrom, from) it is compared against.
+type trendWindow struct {
+	previousFrom time.Time
+	from         time.Time
+	to           time.Time
+	interval     graphql1.TrendInterval
+}
+
+func newTrendWindow(rangeArg graphql1.TrendRange, interval graphql1.TrendInterval, now time.Time) trendWindow {
+	to := now.UTC()
+	var from, previousFrom time.Time
+	switch rangeArg {
+	case graphql1.TrendRangeLast7Days:
+		from, previousFrom = to.AddDate(0, 0, -7), to.AddDate(0, 0, -14)
+	case graphql1.TrendRangeLast90Days:
+		from, previousFrom = to.AddDate(0, 0, -90), to.AddDate(0, 0, -180)
+	case graphql1.TrendRangeLast12Months:
+		from, previousFrom = to.AddDate(0, -12, 0), to.AddDate(0, -24, 0)
+	default:
+		from, previousFrom = to.AddDate(0, 0, -30), to.AddDate(0, 0, -60)
+	}
+	return trendWindow{previousFrom: previousFrom, from: from, to: to, interval: interval}
+}
+
+// unit is the date_trunc field for the interval.
+func (w trendWindow) unit() string {
+	switch w.interval {
+	case graphql1.TrendIntervalWeek:
+		return "week"
+	case graphql1.TrendIntervalMonth:
+		return "month"
+	default:
+		return "day"
+	}
+}
+
+// truncate mirrors date_trunc in UTC; weeks start on Monday.
+func (w trendWindow) truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch w.interval {
+	case graphql1.TrendIntervalWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case graphql1.TrendIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func (w trendWindow) next(t time.Time) time.Time {
+	switch w.interval {
+	case graphql1.TrendIntervalWeek:
+		return t.AddDate(0, 0, 7)
+	case graphql1.TrendIntervalMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// starts lists every bucket of the reporting period so gaps chart as zero.
+func (w trendWindow) starts() []time.Time {
+	var out []time.Time
+	for start := w.truncate(w.from); start.Before(w.to); start = w.next(start) {
+		out = append(out, start)
+	}
+	return out
+}
+
+// series folds rows matching keep into a zero-filled series.
+func (w trendWindow) series(rows []gen.TrendRow, keep func(gen.TrendRow) bool) *graphql1.TrendSeries {
+	counts := make(map[time.Time]int)
+	out := &graphql1.TrendSeries{}
+	for _, row := range rows {
+		if keep != nil && !keep(row) {
+			continue
+		}
+		counts[w.truncate(row.Bucket)] += row.Current
+		out.Total += row.Current
+		out.PreviousTotal += row.Previous
+	}
+	starts := w.starts()
+	out.Buckets = make([]*graphql1.TrendBucket, 0, len(starts))
+	for _, start := range starts {
+		out.Buckets = append(out.Buckets, &graphql1.TrendBucket{Start: start, Count: counts[start]})
+	}
+	if out.PreviousTotal > 0 {
+		change := float64(out.Total-out.PreviousTotal) / float64(out.PreviousTotal)
+		out.Change = &change
+	}
+	return out
+}
+
+func (r *Resolver) trend(ctx context.Context, source trendSource, metric gen.TrendMetric, w trendWindow) ([]gen.TrendRow, error) {
+	start := time.Now()
+	rows, err := source.Trend(ctx, metric, w.unit(), w.previousFrom, w.from, w.to)
+	r.recordQuery(string(metric), "trend", start, err)
+	return rows, err
+}
+
+func (r *Resolver) managementTrends(ctx context.Context, rangeArg graphql1.TrendRange, interval graphql1.TrendInterval, authors int) (*graphql1.ManagementTrends, error) {
+	if !rangeArg.IsValid() {
+		rangeArg = graphql1.TrendRangeLast30Days
+	}
+	if !interval.IsValid() {
+		interval = graphql1.TrendIntervalDay
+	}
+	if authors < 0 || authors > maxLeaderboardSize {
+		return nil, gqlerrors.BadInput("authors", "must be between 0 and 50")
+	}
+	w := newTrendWindow(rangeArg, interval, r.currentTime())
+	result := &graphql1.ManagementTrends{
+		Range:        rangeArg,
+		Interval:     interval,
+		From:         w.from,
+		To:           w.to,
+		PreviousFrom: w.previousFrom,
+	}
+
+	source := r.trendSource()
+	var posts, comments, media, users []gen.TrendRow
+	var leaders []gen.AuthorTrend
+	if source != nil {
+		g, gctx := errgroup.WithContext(ctx)
+		for metric, dest := range map[gen.TrendMetric]*[]gen.TrendRow{
+			gen.TrendPostsPublished: &posts,
+			gen.TrendComments:       &comments,
+			gen.TrendMediaUploaded:  &media,
+			gen.TrendNewUsers:       &users,
+		} {
+			g.Go(func() (err error) {
+				*dest, err = r.trend(gctx, source, metric, w)
+				return err
+			})
+		}
+		if authors > 0 {
+			g.Go(func() (err error) {
+				start := time.Now()
+				leaders, err = source.TopAuthors(gctx, w.previousFrom, w.from, w.to, authors)
+				r.recordQuery("posts", "top_authors", start, err)
+				return err
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+	}
+
+	result.PostsPublished = w.series(posts, nil)
+	result.Comments = w.series(comments, nil)
+	result.MediaUploaded = w.series(media, nil)
+	result.NewUsers = w.series(users, nil)
+	result.CommentsByStatus = make([]*graphql1.CommentStatusTrend, 0, len(graphql1.AllCommentStatus))
+	for _, status := range graphql1.AllCommentStatus {
+		result.CommentsByStatus = append(result.CommentsByStatus, &graphql1.CommentStatusTrend{
+			Status: status,
+			Series: w.series(comments, func(row gen.TrendRow) bool { return row.Key == string(status) }),
+		})
+	}
+	result.TopAuthors = make([]*graphql1.AuthorLeaderboardEntry, 0, len(leaders))
+	for _, leader := range leaders {
+		result.TopAuthors = append(result.TopAuthors, &graphql1.AuthorLeaderboardEntry{
+			AuthorID:      leader.AuthorID,
+			Posts:         leader.Current,
+			PreviousPosts: leader.Previous,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type userDeleter interface {
+	CountUserPosts(ctx context.Context, userID string) (int, error)
+	DeleteUserWithContent(ctx context.Context, userID, reassignTo string, anonymize bool) (*gen.UserDeletion, error)
+	ExportUserData(ctx context.Context, userID string) (json.RawMessage, error)
+}
+
+func (r *Resolver) userDeleter() userDeleter {
+	if r == nil {
+		return nil
+	}
+	if r.deletions != nil {
+		return r.deletions
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+// deleteUserWithContent runs before the generated deleteUser. It checks
+// input.reassignPostsTo, then hands the user's posts and media to it,
+// detaches their comments, drops their roles and deletes the user in one
+// statement, so a failed delete moves nothing. The generated delete that
+// follows finds no row. Authors without a new owner for their posts get an
+// input error rather than the posts' foreign key failing.
+func deleteUserWithContent(ctx context.Context, r *Resolver, input graphql1.DeleteUserInput, id string) error {
+	deleter := r.userDeleter()
+	if deleter == nil {
+		return nil
+	}
+	reassignTo := ""
+	if input.ReassignPostsTo != nil && strings.TrimSpace(*input.ReassignPostsTo) != "" {
+		targetID, err := decodeUserID(strings.TrimSpace(*input.ReassignPostsTo))
+		if err != nil {
+			return gqlerrors.BadInput("input.reassignPostsTo", err.Error())
+		}
+		if targetID == id {
+			return gqlerrors.BadInput("input.reassignPostsTo", "cannot reassign posts to the user being deleted")
+		}
+		users := r.userClient()
+		if users == nil {
+			return gqlerrors.Internal("orm client is not configured")
+		}
+		target, err := users.ByID(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return gqlerrors.BadInput("input.reassignPostsTo", "user not found")
+		}
+		reassignTo = targetID
+	} else {
+		start := time.Now()
+		count, err := deleter.CountUserPosts(ctx, id)
+		r.recordQuery("posts", "count", start, err)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return gqlerrors.BadInput("input.reassignPostsTo", fmt.Sprintf("user authored %d posts; choose a user to reassign them to", count))
+		}
+	}
+
+	anonymize := input.Anonymize != nil && *input.Anonymize
+	start := time.Now()
+	deleted, err := deleter.DeleteUserWithContent(ctx, id, reassignTo, anonymize)
+	r.recordQuery("users", "delete", start, err)
+	if err != nil {
+		return err
+	}
+	if !deleted.Deleted {
+		return gqlerrors.NotFound("User")
+	}
+	// The delete already committed; failing to load a post only skips its event.
+	_, _ = r.publishPostsUpdated(ctx, deleted.PostIDs)
+	return nil
+}
+
+func (r *Resolver) exportMyData(ctx context.Context) (json.RawMessage, error) {
+	claims, ok := oidc.FromContext(ctx)
+	subject := strings.TrimSpace(claims.Subject)
+	if !ok || subject == "" {
+		return nil, gqlerrors.Unauthenticated()
+	}
+	deleter, users := r.userDeleter(), r.userClient()
+	if deleter == nil || users == nil {
+		return nil, gqlerrors.Internal("orm client is not configured")
+	}
+	// Resolve the viewer the way the viewer query does rather than trusting
+	// the subject to be a user ID.
+	user, err := users.ByID(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, gqlerrors.NotFound("User")
+	}
+	start := time.Now()
+	data, err := deleter.ExportUserData(ctx, user.ID)
+	r.recordQuery("users", "export", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, gqlerrors.NotFound("User")
+	}
+	return data, nil
+}
@@ -0,0 +1,247 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/deicod/erm/orm/pg"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/relay"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type reassignCall struct {
+	userID, reassignTo string
+	anonymize          bool
+}
+
+type stubUserDeleter struct {
+	posts   map[string]int
+	moves   []reassignCall
+	exports map[string]json.RawMessage
+}
+
+func (s *stubUserDeleter) CountUserPosts(_ context.Context, userID string) (int, error) {
+	return s.posts[userID], nil
+}
+
+func (s *stubUserDeleter) DeleteUserWithContent(_ context.Context, userID, reassignTo string, anonymize bool) (*gen.UserDeletion, error) {
+	s.moves = append(s.moves, reassignCall{userID: userID, reassignTo: reassignTo, anonymize: anonymize})
+	return &gen.UserDeletion{Deleted: true}, nil
+}
+
+func (s *stubUserDeleter) ExportUserData(_ context.Context, userID string) (json.RawMessage, error) {
+	return s.exports[userID], nil
+}
+
+func TestDeleteUserRequiresReassignmentForAuthors(t *testing.T) {
+	deleter := &stubUserDeleter{posts: map[string]int{"user-1": 3}}
+	resolver := &Resolver{deletions: deleter, users: &testUserProvider{records: map[string]*gen.User{"user-2": {ID: "user-2"}}}}
+	hooks := newEntityHooks()
+	ctx := context.Background()
+
+	err := hooks.BeforeDeleteUser(ctx, resolver, graphql1.DeleteUserInput{}, "user-1")
+	expectBadInput(t, err, "input.reassignPostsTo")
+
+	self := relay.ToGlobalID("User", "user-1")
+	err = hooks.BeforeDeleteUser(ctx, resolver, graphql1.DeleteUserInput{ReassignPostsTo: &self}, "user-1")
+	expectBadInput(t, err, "input.reassignPostsTo")
+
+	missing := relay.ToGlobalID("User", "user-9")
+	err = hooks.BeforeDeleteUser(ctx, resolver, graphql1.DeleteUserInput{ReassignPostsTo: &missing}, "user-1")
+	expectBadInput(t, err, "input.reassignPostsTo")
+	if len(deleter.moves) != 0 {
+		t.Fatalf("expected nothing to be reassigned, got %+v", deleter.moves)
+	}
+
+	target, anonymize := relay.ToGlobalID("User", "user-2"), true
+	if err := hooks.BeforeDeleteUser(ctx, resolver, graphql1.DeleteUserInput{ReassignPostsTo: &target, Anonymize: &anonymize}, "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deleter.moves) != 1 || deleter.moves[0] != (reassignCall{userID: "user-1", reassignTo: "user-2", anonymize: true}) {
+		t.Fatalf("unexpected reassignments %+v", deleter.moves)
+	}
+}
+
+func TestDeleteUserWithoutPostsNeedsNoTarget(t *testing.T) {
+	deleter := &stubUserDeleter{}
+	if err := newEntityHooks().BeforeDeleteUser(context.Background(), &Resolver{deletions: deleter}, graphql1.DeleteUserInput{}, "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deleter.moves) != 1 || deleter.moves[0] != (reassignCall{userID: "user-1"}) {
+		t.Fatalf("unexpected reassignments %+v", deleter.moves)
+	}
+}
+
+// userDeletionPool keeps the rows that copy a user's name or email and
+// applies the parts of the deletion statement it finds in the SQL.
+type userDeletionPool struct {
+	*mockPool
+	users    map[string][2]string // id -> email, display name
+	comments []*userDeletionComment
+	meta     map[string]string // user id -> stored value
+	tokens   map[string]string // user id -> email
+	logins   map[string]int
+	// generated records the generated delete that follows the hook.
+	generated []string
+}
+
+type userDeletionComment struct {
+	authorID                string
+	authorName, authorEmail string
+}
+
+type rowFunc func(dest ...any) error
+
+func (f rowFunc) Scan(dest ...any) error { return f(dest...) }
+
+func (p *userDeletionPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	switch {
+	case strings.HasPrefix(sql, "SELECT count(*) FROM posts"):
+		return rowFunc(func(dest ...any) error { *dest[0].(*int) = 0; return nil })
+	case strings.HasPrefix(sql, "WITH doomed AS"):
+		id, anonymize := args[0].(string), args[2].(bool)
+		user, ok := p.users[id]
+		var comments []string
+		for i, c := range p.comments {
+			if c.authorID != id && !(anonymize && strings.EqualFold(c.authorEmail, user[0])) {
+				continue
+			}
+			c.authorID = ""
+			if anonymize {
+				c.authorName, c.authorEmail = "", ""
+			} else if c.authorEmail == "" {
+				c.authorName, c.authorEmail = user[1], user[0]
+			}
+			comments = append(comments, fmt.Sprint(i))
+		}
+		if anonymize && strings.Contains(sql, "DELETE FROM user_metas WHERE $3") {
+			delete(p.meta, id)
+		}
+		if anonymize && strings.Contains(sql, "DELETE FROM user_tokens WHERE $3") {
+			delete(p.tokens, id)
+		}
+		if anonymize && strings.Contains(sql, "DELETE FROM login_attempts") {
+			delete(p.logins, strings.ToLower(user[0]))
+		}
+		removed := ok && strings.Contains(sql, "DELETE FROM users WHERE id IN (SELECT id FROM doomed)")
+		if removed {
+			delete(p.users, id)
+		}
+		return rowFunc(func(dest ...any) error {
+			*dest[0].(*bool) = removed
+			*dest[3].(*[]string) = comments
+			return nil
+		})
+	}
+	return p.mockPool.QueryRow(ctx, sql, args...)
+}
+
+func (p *userDeletionPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if sql == "DELETE FROM users WHERE id = $1" {
+		p.generated = append(p.generated, args[0].(string))
+		return pgconn.CommandTag{}, nil
+	}
+	return p.mockPool.Exec(ctx, sql, args...)
+}
+
+// stored lists every value the pool still holds.
+func (p *userDeletionPool) stored() []string {
+	var values []string
+	for _, user := range p.users {
+		values = append(values, user[0], user[1])
+	}
+	for _, c := range p.comments {
+		values = append(values, c.authorName, c.authorEmail)
+	}
+	for _, v := range p.meta {
+		values = append(values, v)
+	}
+	for _, v := range p.tokens {
+		values = append(values, v)
+	}
+	for login := range p.logins {
+		values = append(values, login)
+	}
+	return values
+}
+
+func TestDeleteUserAnonymizesEverythingCopiedFromTheUser(t *testing.T) {
+	const (
+		userID = "4f0c2a1e-6a8b-4c1d-9e2f-3a4b5c6d7e8f"
+		email  = "ada@example.com"
+		name   = "Ada Lovelace"
+	)
+	newPool := func() *userDeletionPool {
+		return &userDeletionPool{
+			mockPool: newMockPool(),
+			users:    map[string][2]string{userID: {email, name}},
+			comments: []*userDeletionComment{
+				{authorID: userID},
+				{authorName: name, authorEmail: "ADA@example.com"},
+				{authorName: "Someone", authorEmail: "someone@example.com"},
+			},
+			meta:   map[string]string{userID: `{"displayName":"` + name + `"}`},
+			tokens: map[string]string{userID: email},
+			logins: map[string]int{email: 2},
+		}
+	}
+	input := func(anonymize bool) graphql1.DeleteUserInput {
+		return graphql1.DeleteUserInput{ID: relay.ToGlobalID("User", userID), Anonymize: &anonymize}
+	}
+
+	pool := newPool()
+	resolver := NewWithOptions(Options{ORM: gen.NewClient(&pg.DB{Pool: pool})})
+	if _, err := resolver.Mutation().DeleteUser(context.Background(), input(true)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := pool.users[userID]; ok {
+		t.Fatal("expected the user to be deleted with their content")
+	}
+	for _, value := range pool.stored() {
+		if strings.Contains(strings.ToLower(value), email) || strings.Contains(value, name) {
+			t.Fatalf("expected no copy of the user's email or name to survive, found %q in %v", value, pool.stored())
+		}
+	}
+	if !contains(pool.stored(), "someone@example.com") {
+		t.Fatalf("expected other guests to keep their details, got %v", pool.stored())
+	}
+
+	pool = newPool()
+	resolver = NewWithOptions(Options{ORM: gen.NewClient(&pg.DB{Pool: pool})})
+	if _, err := resolver.Mutation().DeleteUser(context.Background(), input(false)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c := pool.comments[0]; c.authorID != "" || c.authorEmail != email || c.authorName != name {
+		t.Fatalf("expected the detached comment to keep the user's details, got %+v", c)
+	}
+
+	_, err := resolver.Mutation().DeleteUser(context.Background(), input(false))
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+}
+
+func TestExportMyData(t *testing.T) {
+	resolver := &Resolver{
+		deletions: &stubUserDeleter{exports: map[string]json.RawMessage{"user-1": json.RawMessage(`{"user":{"id":"user-1"}}`)}},
+		users:     &testUserProvider{records: map[string]*gen.User{"user-1": {ID: "user-1"}}},
+	}
+
+	_, err := resolver.Query().ExportMyData(context.Background())
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+
+	data, err := resolver.Query().ExportMyData(oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1"}))
+	if err != nil || string(data) != `{"user":{"id":"user-1"}}` {
+		t.Fatalf("unexpected export %s, %v", data, err)
+	}
+
+	_, err = resolver.Query().ExportMyData(oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-2"}))
+	expectErrorCode(t, err, gqlerrors.CodeNotFound)
+}
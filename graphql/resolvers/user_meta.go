@@ -0,0 +1,82 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/usermeta"
+)
+
+func (r *Resolver) userMetaStore() usermeta.Store {
+	if r == nil {
+		return nil
+	}
+	if r.userMeta != nil {
+		return r.userMeta
+	}
+	if r.ORM != nil {
+		return r.ORM
+	}
+	return nil
+}
+
+func (r *Resolver) viewerMeta(ctx context.Context, obj *graphql1.Viewer, key *string) (json.RawMessage, error) {
+	store := r.userMetaStore()
+	if obj == nil || store == nil {
+		return nil, nil
+	}
+	var keys []string
+	if key != nil {
+		keys = []string{*key}
+	}
+	start := time.Now()
+	values, err := store.UserMeta(ctx, obj.ID, keys...)
+	r.recordQuery("user_meta", "load", start, err)
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return values[*key], nil
+	}
+	if values == nil {
+		values = map[string]json.RawMessage{}
+	}
+	return json.Marshal(values)
+}
+
+// requireUserMetaAdmin keeps the generated userMeta queries and mutations to
+// admins. Meta holds other users' preferences and state such as email
+// verification that users must not set themselves; viewers read their own
+// through viewer.meta.
+func requireUserMetaAdmin(ctx context.Context) error {
+	claims, ok := oidc.FromContext(ctx)
+	if !ok {
+		return gqlerrors.Unauthenticated()
+	}
+	if !slices.Contains(claims.Roles, "admin") {
+		return gqlerrors.Forbidden("forbidden: missing role %s", "admin")
+	}
+	return nil
+}
+
+func requireAdminOnUserMetaCreate(ctx context.Context, _ *Resolver, _ graphql1.CreateUserMetaInput, _ *gen.UserMeta) error {
+	return requireUserMetaAdmin(ctx)
+}
+
+func requireAdminOnUserMetaUpdate(ctx context.Context, _ *Resolver, _ graphql1.UpdateUserMetaInput, _ *gen.UserMeta) error {
+	return requireUserMetaAdmin(ctx)
+}
+
+func requireAdminOnUserMetaDelete(ctx context.Context, _ *Resolver, _ graphql1.DeleteUserMetaInput, _ string) error {
+	return requireUserMetaAdmin(ctx)
+}
+
+func requireAdminOnUserMetaReturn(ctx context.Context, _ *Resolver, _ *gen.UserMeta) error {
+	return requireUserMetaAdmin(ctx)
+}
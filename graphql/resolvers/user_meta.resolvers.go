@@ -0,0 +1,22 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.80
+
+import (
+	"context"
+	"encoding/json"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+)
+
+// Meta is the resolver for the meta field.
+func (r *viewerResolver) Meta(ctx context.Context, obj *graphql1.Viewer, key *string) (json.RawMessage, error) {
+	return r.viewerMeta(ctx, obj, key)
+}
+
+// Viewer returns graphql1.ViewerResolver implementation.
+func (r *Resolver) Viewer() graphql1.ViewerResolver { return &viewerResolver{r} }
+
+type viewerResolver struct{ *Resolver }
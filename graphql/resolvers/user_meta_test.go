@@ -0,0 +1,178 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/oidc"
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+type stubUserMetaStore struct {
+	users  map[string]bool
+	values map[string]map[string]json.RawMessage
+}
+
+func newStubUserMetaStore(users ...string) *stubUserMetaStore {
+	store := &stubUserMetaStore{users: make(map[string]bool), values: make(map[string]map[string]json.RawMessage)}
+	for _, user := range users {
+		store.users[user] = true
+	}
+	return store
+}
+
+func (s *stubUserMetaStore) UserMeta(_ context.Context, userID string, keys ...string) (map[string]json.RawMessage, error) {
+	out := make(map[string]json.RawMessage)
+	for key, value := range s.values[userID] {
+		if len(keys) > 0 && !containsString(keys, key) {
+			continue
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+func (s *stubUserMetaStore) SetUserMeta(_ context.Context, userID, key string, value json.RawMessage) error {
+	if !s.users[userID] {
+		return gen.ErrUserNotFound
+	}
+	if s.values[userID] == nil {
+		s.values[userID] = make(map[string]json.RawMessage)
+	}
+	s.values[userID][key] = value
+	return nil
+}
+
+func (s *stubUserMetaStore) DeleteUserMeta(_ context.Context, userID, key string) error {
+	delete(s.values[userID], key)
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestViewerMetaReturnsSingleKeyOrAll(t *testing.T) {
+	store := newStubUserMetaStore("user-1")
+	store.values["user-1"] = map[string]json.RawMessage{
+		"editor": json.RawMessage(`{"theme":"dark"}`),
+		"locale": json.RawMessage(`"de"`),
+	}
+	resolver := &Resolver{userMeta: store}
+	viewer := &graphql1.Viewer{ID: "user-1"}
+
+	key := "locale"
+	value, err := resolver.Viewer().Meta(context.Background(), viewer, &key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(value) != `"de"` {
+		t.Fatalf("expected locale value, got %s", value)
+	}
+
+	missing := "absent"
+	value, err = resolver.Viewer().Meta(context.Background(), viewer, &missing)
+	if err != nil || value != nil {
+		t.Fatalf("expected nil for missing key, got %s (err %v)", value, err)
+	}
+
+	all, err := resolver.Viewer().Meta(context.Background(), viewer, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(all, &decoded); err != nil {
+		t.Fatalf("expected a JSON object, got %s", all)
+	}
+	if len(decoded) != 2 || string(decoded["editor"]) != `{"theme":"dark"}` {
+		t.Fatalf("unexpected meta object: %s", all)
+	}
+}
+
+func TestNotificationPreferencesUseUserMeta(t *testing.T) {
+	options := newStubOptionRepository()
+	store := newStubUserMetaStore("user-meta")
+	resolver := &Resolver{options: options, userMeta: store}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-meta"})
+
+	input := graphql1.UpdateNotificationPreferencesInput{
+		Preferences: []*graphql1.NotificationPreferenceInput{
+			{Category: graphql1.NotificationCategoryPostCreated, Enabled: false},
+		},
+	}
+	if _, err := resolver.Mutation().UpdateNotificationPreferences(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(options.created)+len(options.updated) != 0 {
+		t.Fatalf("expected no option writes, got %d created and %d updated", len(options.created), len(options.updated))
+	}
+	raw := store.values["user-meta"][notificationPreferencesMeta.Name]
+	if stored := decodeStoredPreferences(raw); stored[graphql1.NotificationCategoryPostCreated] != false {
+		t.Fatalf("expected post created to be stored disabled, got %s", raw)
+	}
+
+	prefs, err := resolver.Query().NotificationPreferences(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entries := preferenceEntriesToMap(prefs.Entries); entries[graphql1.NotificationCategoryPostCreated] {
+		t.Fatalf("expected post created to be disabled")
+	}
+}
+
+func TestNotificationPreferencesFallBackToOptionsWithoutUser(t *testing.T) {
+	options := newStubOptionRepository()
+	store := newStubUserMetaStore()
+	resolver := &Resolver{options: options, userMeta: store}
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "external-subject"})
+
+	input := graphql1.UpdateNotificationPreferencesInput{
+		Preferences: []*graphql1.NotificationPreferenceInput{
+			{Category: graphql1.NotificationCategoryCommentDeleted, Enabled: false},
+		},
+	}
+	if _, err := resolver.Mutation().UpdateNotificationPreferences(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(options.created) != 1 || options.created[0].Name != preferenceOptionName("external-subject") {
+		t.Fatalf("expected the legacy option to be created, got %+v", options.created)
+	}
+
+	prefs, err := resolver.Query().NotificationPreferences(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entries := preferenceEntriesToMap(prefs.Entries); entries[graphql1.NotificationCategoryCommentDeleted] {
+		t.Fatalf("expected comment deleted to be read from the option")
+	}
+}
+
+func TestGeneratedUserMetaAPIIsAdminOnly(t *testing.T) {
+	hooks := newEntityHooks()
+	record := &gen.UserMeta{ID: "meta-1", UserID: "user-1", Key: "notification_preferences"}
+
+	err := hooks.BeforeReturnUserMeta(context.Background(), nil, record)
+	expectErrorCode(t, err, gqlerrors.CodeUnauthenticated)
+
+	owner := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1", Roles: []string{"user", "editor"}})
+	expectErrorCode(t, hooks.BeforeReturnUserMeta(owner, nil, record), gqlerrors.CodeForbidden)
+	expectErrorCode(t, hooks.BeforeCreateUserMeta(owner, nil, graphql1.CreateUserMetaInput{}, record), gqlerrors.CodeForbidden)
+	expectErrorCode(t, hooks.BeforeUpdateUserMeta(owner, nil, graphql1.UpdateUserMetaInput{}, record), gqlerrors.CodeForbidden)
+	expectErrorCode(t, hooks.BeforeDeleteUserMeta(owner, nil, graphql1.DeleteUserMetaInput{}, record.ID), gqlerrors.CodeForbidden)
+
+	admin := oidc.ToContext(context.Background(), oidc.Claims{Subject: "admin-1", Roles: []string{"user", "admin"}})
+	if err := hooks.BeforeReturnUserMeta(admin, nil, record); err != nil {
+		t.Fatalf("expected admins to read user meta, got %v", err)
+	}
+	if err := hooks.BeforeUpdateUserMeta(admin, nil, graphql1.UpdateUserMetaInput{}, record); err != nil {
+		t.Fatalf("expected admins to update user meta, got %v", err)
+	}
+}
@@ -6,10 +6,10 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
 	"sort"
 
 	graphql1 "github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
 	"github.com/deicod/ermblog/orm/gen"
 )
 
@@ -22,7 +22,7 @@ func (r *mutationResolver) AssignUserRoles(ctx context.Context, input graphql1.A
 
 	userClient := r.userClient()
 	if userClient == nil {
-		return nil, fmt.Errorf("user provider is not configured")
+		return nil, gqlerrors.Internal("user provider is not configured")
 	}
 
 	user, err := userClient.ByID(ctx, nativeUserID)
@@ -30,14 +30,14 @@ func (r *mutationResolver) AssignUserRoles(ctx context.Context, input graphql1.A
 		return nil, err
 	}
 	if user == nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, gqlerrors.NotFound("User")
 	}
 
 	roleIDs := make([]string, 0, len(input.RoleIDs))
 	if len(input.RoleIDs) > 0 {
 		roleClient := r.roleClient()
 		if roleClient == nil {
-			return nil, fmt.Errorf("role provider is not configured")
+			return nil, gqlerrors.Internal("role provider is not configured")
 		}
 		seen := make(map[string]struct{}, len(input.RoleIDs))
 		for _, encoded := range input.RoleIDs {
@@ -54,7 +54,7 @@ func (r *mutationResolver) AssignUserRoles(ctx context.Context, input graphql1.A
 				return nil, err
 			}
 			if role == nil {
-				return nil, fmt.Errorf("role not found")
+				return nil, gqlerrors.NotFound("Role")
 			}
 			roleIDs = append(roleIDs, nativeRoleID)
 		}
@@ -62,7 +62,7 @@ func (r *mutationResolver) AssignUserRoles(ctx context.Context, input graphql1.A
 
 	service := r.userRoleService()
 	if service == nil {
-		return nil, fmt.Errorf("user role service is not configured")
+		return nil, gqlerrors.Internal("user role service is not configured")
 	}
 	if err := service.AssignUserRoles(ctx, nativeUserID, roleIDs); err != nil {
 		return nil, err
@@ -96,7 +96,7 @@ func (r *mutationResolver) RemoveUserRoles(ctx context.Context, input graphql1.R
 
 	userClient := r.userClient()
 	if userClient == nil {
-		return nil, fmt.Errorf("user provider is not configured")
+		return nil, gqlerrors.Internal("user provider is not configured")
 	}
 
 	user, err := userClient.ByID(ctx, nativeUserID)
@@ -104,14 +104,14 @@ func (r *mutationResolver) RemoveUserRoles(ctx context.Context, input graphql1.R
 		return nil, err
 	}
 	if user == nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, gqlerrors.NotFound("User")
 	}
 
 	roleIDs := make([]string, 0, len(input.RoleIDs))
 	if len(input.RoleIDs) > 0 {
 		roleClient := r.roleClient()
 		if roleClient == nil {
-			return nil, fmt.Errorf("role provider is not configured")
+			return nil, gqlerrors.Internal("role provider is not configured")
 		}
 		seen := make(map[string]struct{}, len(input.RoleIDs))
 		for _, encoded := range input.RoleIDs {
@@ -128,7 +128,7 @@ func (r *mutationResolver) RemoveUserRoles(ctx context.Context, input graphql1.R
 				return nil, err
 			}
 			if role == nil {
-				return nil, fmt.Errorf("role not found")
+				return nil, gqlerrors.NotFound("Role")
 			}
 			roleIDs = append(roleIDs, nativeRoleID)
 		}
@@ -136,7 +136,7 @@ func (r *mutationResolver) RemoveUserRoles(ctx context.Context, input graphql1.R
 
 	service := r.userRoleService()
 	if service == nil {
-		return nil, fmt.Errorf("user role service is not configured")
+		return nil, gqlerrors.Internal("user role service is not configured")
 	}
 	if err := service.RemoveUserRoles(ctx, nativeUserID, roleIDs); err != nil {
 		return nil, err
@@ -171,7 +171,7 @@ func (r *Resolver) Role_users(ctx context.Context, obj *graphql1.Role, first *in
 		}, nil
 	}
 	if last != nil || before != nil {
-		return nil, fmt.Errorf("backward pagination is not supported")
+		return nil, gqlerrors.BadInput("last", "backward pagination is not supported")
 	}
 	nativeRoleID, err := decodeRoleID(obj.ID)
 	if err != nil {
@@ -179,7 +179,7 @@ func (r *Resolver) Role_users(ctx context.Context, obj *graphql1.Role, first *in
 	}
 	service := r.userRoleService()
 	if service == nil {
-		return nil, fmt.Errorf("user role service is not configured")
+		return nil, gqlerrors.Internal("user role service is not configured")
 	}
 	users, err := service.ListUsersForRole(ctx, nativeRoleID)
 	if err != nil {
@@ -264,7 +264,7 @@ func (r *Resolver) User_roles(ctx context.Context, obj *graphql1.User, first *in
 		}, nil
 	}
 	if last != nil || before != nil {
-		return nil, fmt.Errorf("backward pagination is not supported")
+		return nil, gqlerrors.BadInput("last", "backward pagination is not supported")
 	}
 	nativeUserID, err := decodeUserID(obj.ID)
 	if err != nil {
@@ -272,7 +272,7 @@ func (r *Resolver) User_roles(ctx context.Context, obj *graphql1.User, first *in
 	}
 	service := r.userRoleService()
 	if service == nil {
-		return nil, fmt.Errorf("user role service is not configured")
+		return nil, gqlerrors.Internal("user role service is not configured")
 	}
 	roles, err := service.ListRolesForUser(ctx, nativeUserID)
 	if err != nil {
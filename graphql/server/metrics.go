@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/complexity"
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/deicod/ermblog/graphql/limits"
+	"github.com/deicod/ermblog/observability/metrics"
+)
+
+// metricsExtension reports operation latency, complexity and errors plus
+// resolver timings to the configured collector. Trivial field reads are
+// skipped so only fields backed by resolver code are timed.
+type metricsExtension struct {
+	collector metrics.Collector
+	schema    gql.ExecutableSchema
+}
+
+var (
+	_ gql.HandlerExtension    = metricsExtension{}
+	_ gql.ResponseInterceptor = metricsExtension{}
+	_ gql.FieldInterceptor    = metricsExtension{}
+)
+
+func (metricsExtension) ExtensionName() string {
+	return "Metrics"
+}
+
+func (metricsExtension) Validate(gql.ExecutableSchema) error {
+	return nil
+}
+
+func (e metricsExtension) InterceptResponse(ctx context.Context, next gql.ResponseHandler) *gql.Response {
+	if !gql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	opCtx := gql.GetOperationContext(ctx)
+	start := opCtx.Stats.OperationStart
+	if start.IsZero() || (opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription) {
+		// Subscriptions stay open; time each event rather than the stream.
+		start = time.Now()
+	}
+	resp := next(ctx)
+	if resp == nil {
+		return resp
+	}
+
+	var err error
+	if errs := gql.GetErrors(ctx); len(errs) > 0 {
+		err = errs
+	} else if len(resp.Errors) > 0 {
+		err = resp.Errors
+	}
+	name, operationType, cost := opCtx.OperationName, "unknown", 0
+	if op := opCtx.Operation; op != nil {
+		operationType = string(op.Operation)
+		if name == "" {
+			name = op.Name
+		}
+		if stats := limits.GetStats(ctx); stats != nil {
+			cost = stats.Complexity
+		} else if e.schema != nil {
+			cost = complexity.Calculate(ctx, e.schema, op, opCtx.Variables)
+		}
+	}
+	e.collector.RecordOperation(name, operationType, cost, time.Since(start), err)
+	return resp
+}
+
+func (e metricsExtension) InterceptField(ctx context.Context, next gql.Resolver) (any, error) {
+	fc := gql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	e.collector.RecordResolver(fc.Object, fc.Field.Name, time.Since(start), err)
+	return res, err
+}
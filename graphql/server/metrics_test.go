@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/deicod/ermblog/observability/metrics"
+)
+
+type operationRecord struct {
+	name          string
+	operationType string
+	err           error
+}
+
+type resolverRecord struct {
+	object string
+	field  string
+	err    error
+}
+
+type recordingCollector struct {
+	metrics.NoopCollector
+	operations []operationRecord
+	resolvers  []resolverRecord
+}
+
+func (c *recordingCollector) RecordOperation(name string, operationType string, _ int, _ time.Duration, err error) {
+	c.operations = append(c.operations, operationRecord{name: name, operationType: operationType, err: err})
+}
+
+func (c *recordingCollector) RecordResolver(object string, field string, _ time.Duration, err error) {
+	c.resolvers = append(c.resolvers, resolverRecord{object: object, field: field, err: err})
+}
+
+func TestMetricsExtensionRecordsOperations(t *testing.T) {
+	t.Parallel()
+
+	collector := &recordingCollector{}
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(metricsExtension{collector: collector})
+
+	for _, body := range []string{`{"query":"query Greeting { name }"}`, `{"query":"mutation { name }"}`} {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		srv.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if len(collector.operations) != 2 {
+		t.Fatalf("expected two operations, got %d", len(collector.operations))
+	}
+	if got := collector.operations[0]; got.name != "Greeting" || got.operationType != "query" || got.err != nil {
+		t.Fatalf("unexpected query record %+v", got)
+	}
+	if got := collector.operations[1]; got.name != "" || got.operationType != "mutation" || got.err == nil {
+		t.Fatalf("expected failed anonymous mutation, got %+v", got)
+	}
+}
+
+func TestMetricsExtensionTimesResolverFieldsOnly(t *testing.T) {
+	t.Parallel()
+
+	collector := &recordingCollector{}
+	ext := metricsExtension{collector: collector}
+	failure := errors.New("boom")
+
+	trivial := gql.WithFieldContext(context.Background(), &gql.FieldContext{
+		Object: "Post",
+		Field:  gql.CollectedField{Field: &ast.Field{Name: "title"}},
+	})
+	if _, err := ext.InterceptField(trivial, func(context.Context) (any, error) { return "x", nil }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resolver := gql.WithFieldContext(context.Background(), &gql.FieldContext{
+		Object:     "Query",
+		IsResolver: true,
+		Field:      gql.CollectedField{Field: &ast.Field{Name: "posts"}},
+	})
+	if _, err := ext.InterceptField(resolver, func(context.Context) (any, error) { return nil, failure }); !errors.Is(err, failure) {
+		t.Fatalf("expected resolver error, got %v", err)
+	}
+
+	if len(collector.resolvers) != 1 {
+		t.Fatalf("expected one resolver record, got %d", len(collector.resolvers))
+	}
+	if got := collector.resolvers[0]; got.object != "Query" || got.field != "posts" || !errors.Is(got.err, failure) {
+		t.Fatalf("unexpected resolver record %+v", got)
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+// AuthFields are the root fields a caller without a session needs to get
+// one: password login, session refresh and logout, and account recovery.
+var AuthFields = []string{
+	"login",
+	"refreshSession",
+	"logout",
+	"requestPasswordReset",
+	"resetPassword",
+	"verifyEmail",
+}
+
+// rootFieldsExtension rejects operations selecting a root field outside
+// its allow-list, so an endpoint served without authentication cannot reach
+// the rest of the schema.
+type rootFieldsExtension struct {
+	fields map[string]bool
+}
+
+var (
+	_ gql.HandlerExtension        = rootFieldsExtension{}
+	_ gql.OperationContextMutator = rootFieldsExtension{}
+)
+
+func newRootFieldsExtension(fields []string) rootFieldsExtension {
+	allowed := make(map[string]bool, len(fields)+1)
+	allowed["__typename"] = true
+	for _, field := range fields {
+		allowed[field] = true
+	}
+	return rootFieldsExtension{fields: allowed}
+}
+
+// ExtensionName implements graphql.HandlerExtension.
+func (rootFieldsExtension) ExtensionName() string {
+	return "RootFields"
+}
+
+// Validate implements graphql.HandlerExtension.
+func (rootFieldsExtension) Validate(gql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator.
+func (e rootFieldsExtension) MutateOperationContext(ctx context.Context, rc *gql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil {
+		return nil
+	}
+	if field, ok := e.disallowed(rc.Operation.SelectionSet); ok {
+		return gqlerrors.Present(ctx, gqlerrors.New(gqlerrors.CodeForbidden, fmt.Sprintf("field %q is not served on this endpoint", field)))
+	}
+	return nil
+}
+
+func (e rootFieldsExtension) disallowed(set ast.SelectionSet) (string, bool) {
+	for _, selection := range set {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if !e.fields[sel.Name] {
+				return sel.Name, true
+			}
+		case *ast.InlineFragment:
+			if field, ok := e.disallowed(sel.SelectionSet); ok {
+				return field, true
+			}
+		case *ast.FragmentSpread:
+			if sel.Definition == nil {
+				continue
+			}
+			if field, ok := e.disallowed(sel.Definition.SelectionSet); ok {
+				return field, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRootFieldsOnlyServesAllowListedFields(t *testing.T) {
+	t.Parallel()
+
+	srv := NewServer(Options{RootFields: AuthFields})
+	post := func(query string) map[string]any {
+		raw, _ := json.Marshal(map[string]string{"query": query})
+		req := httptest.NewRequest(http.MethodPost, "/graphql/auth", strings.NewReader(string(raw)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		var res map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("decode %q: %v", rec.Body.String(), err)
+		}
+		return res
+	}
+	rejected := func(res map[string]any) bool {
+		errs, _ := res["errors"].([]any)
+		if len(errs) == 0 {
+			return false
+		}
+		first, _ := errs[0].(map[string]any)
+		ext, _ := first["extensions"].(map[string]any)
+		message, _ := first["message"].(string)
+		return ext["code"] == "FORBIDDEN" && strings.Contains(message, "not served on this endpoint")
+	}
+
+	if res := post("{ users { totalCount } }"); !rejected(res) || res["data"] != nil {
+		t.Fatalf("expected users to be rejected, got %v", res)
+	}
+	if res := post("query Sneaky { ... on Query { options { totalCount } } }"); !rejected(res) {
+		t.Fatalf("expected fields behind fragments to be rejected, got %v", res)
+	}
+	// Without local auth configured the resolver refuses, but the field is served.
+	if res := post("mutation { logout(input: {refreshToken: \"x\"}) { __typename } }"); rejected(res) {
+		t.Fatalf("expected logout to be served, got %v", res)
+	}
+}
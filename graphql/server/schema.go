@@ -4,14 +4,22 @@ import (
         "context"
 
         gql "github.com/99designs/gqlgen/graphql"
+        "go.opentelemetry.io/otel/trace"
 
+        "github.com/deicod/ermblog/apikeys"
         "github.com/deicod/ermblog/graphql"
+        "github.com/deicod/ermblog/graphql/cache"
         "github.com/deicod/ermblog/graphql/dataloaders"
         "github.com/deicod/ermblog/graphql/directives"
+        "github.com/deicod/ermblog/graphql/limits"
+        "github.com/deicod/ermblog/graphql/persisted"
         "github.com/deicod/ermblog/graphql/resolvers"
         "github.com/deicod/ermblog/graphql/subscriptions"
+        "github.com/deicod/ermblog/localauth"
         "github.com/deicod/ermblog/observability/metrics"
         "github.com/deicod/ermblog/orm/gen"
+        "github.com/deicod/ermblog/permalink"
+        "github.com/deicod/ermblog/settings"
 )
 
 // Options configures the executable schema and request scaffolding.
@@ -19,6 +27,35 @@ type Options struct {
         ORM           *gen.Client
         Collector     metrics.Collector
         Subscriptions SubscriptionOptions
+        Permalinks    permalink.Set
+        // Limits bounds operation depth, complexity and page sizes.
+        Limits limits.Config
+        // PersistedQueries enables APQ and, when Strict, the operation allow-list.
+        PersistedQueries PersistedQueryOptions
+        // TracerProvider records GraphQL spans; nil uses the global provider.
+        TracerProvider trace.TracerProvider
+        // ResponseCache, when set, is invalidated by entity events and
+        // mutations. Serve it with ResponseCache.Middleware.
+        ResponseCache *cache.Cache
+        // Settings is the process-wide site settings cache, preloaded with
+        // the autoloaded options; nil creates an empty one.
+        Settings *settings.Cache
+        // LocalAuth enables password logins; nil leaves them disabled.
+        LocalAuth *localauth.Service
+        // Recovery enables password reset and email verification; nil leaves them disabled.
+        Recovery *localauth.Recovery
+        // APIKeys enables personal API key management; nil leaves it disabled.
+        APIKeys *apikeys.Service
+        // RootFields, when set, rejects operations selecting any other root
+        // field. Endpoints served without authentication use AuthFields.
+        RootFields []string
+}
+
+type PersistedQueryOptions struct {
+        Enabled bool
+        // Strict only executes operations registered in Store.
+        Strict bool
+        Store  *persisted.Store
 }
 
 type SubscriptionOptions struct {
@@ -36,7 +73,7 @@ type SubscriptionTransports struct {
 func NewExecutableSchema(opts Options) gql.ExecutableSchema {
         opts = normaliseOptions(opts)
         collector := metrics.WithCollector(opts.Collector)
-        resolver := resolvers.NewWithOptions(resolvers.Options{ORM: opts.ORM, Collector: collector, Subscriptions: opts.Subscriptions.Broker})
+        resolver := resolvers.NewWithOptions(resolvers.Options{ORM: opts.ORM, Collector: collector, Subscriptions: opts.Subscriptions.Broker, Permalinks: opts.Permalinks, PersistedQueries: opts.PersistedQueries.Store, Settings: opts.Settings, LocalAuth: opts.LocalAuth, Recovery: opts.Recovery, APIKeys: opts.APIKeys})
         cfg := graphql.Config{
                 Resolvers: resolver,
                 Directives: graphql.DirectiveRoot{
@@ -72,6 +109,14 @@ func normaliseOptions(opts Options) Options {
                         subs.Transports.Websocket = true
                 }
         }
+        if opts.ResponseCache != nil {
+                if _, wrapped := subs.Broker.(*cache.Broker); !wrapped {
+                        subs.Broker = &cache.Broker{Next: subs.Broker, Cache: opts.ResponseCache}
+                }
+        }
         opts.Subscriptions = subs
+        if opts.PersistedQueries.Enabled && opts.PersistedQueries.Store == nil {
+                opts.PersistedQueries.Store = persisted.NewStore(0)
+        }
         return opts
 }
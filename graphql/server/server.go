@@ -6,13 +6,42 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+
+	"github.com/deicod/ermblog/graphql/cache"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+	"github.com/deicod/ermblog/graphql/limits"
+	"github.com/deicod/ermblog/graphql/persisted"
+	"github.com/deicod/ermblog/graphql/validate"
+	"github.com/deicod/ermblog/observability/metrics"
+	"github.com/deicod/ermblog/observability/tracing"
 )
 
 // NewServer configures a gqlgen handler with HTTP and subscription transports.
 func NewServer(opts Options) *handler.Server {
 	opts = normaliseOptions(opts)
-	srv := handler.New(NewExecutableSchema(opts))
+	collector := metrics.WithCollector(opts.Collector)
+	schema := limits.Schema(NewExecutableSchema(opts), opts.Limits)
+	srv := handler.New(schema)
+	srv.SetErrorPresenter(gqlerrors.Present)
+	srv.Use(tracing.GraphQL{Provider: opts.TracerProvider})
+	srv.Use(metricsExtension{collector: collector, schema: schema})
+	srv.Use(limits.NewExtension(opts.Limits, collector))
+	if len(opts.RootFields) > 0 {
+		srv.Use(newRootFieldsExtension(opts.RootFields))
+	}
+	if opts.ResponseCache != nil {
+		srv.Use(cache.Extension{Cache: opts.ResponseCache})
+	}
+	srv.AroundFields(validate.Default.Middleware())
 	srv.Use(extension.Introspection{})
+	if opts.PersistedQueries.Enabled {
+		srv.Use(persisted.Extension{
+			Store:  opts.PersistedQueries.Store,
+			Strict: opts.PersistedQueries.Strict,
+			// Lets an admin fill the allow-list when no manifest is loaded.
+			Exempt: []string{"registerPersistedQueries"},
+		})
+	}
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
@@ -0,0 +1,84 @@
+package validate
+
+// Length limits shared across entities.
+const (
+	maxName        = 200
+	maxSlug        = 200
+	maxEmail       = 254
+	maxURL         = 2048
+	maxShortText   = 500
+	maxDescription = 5000
+	maxComment     = 20000
+)
+
+// Default holds the rules applied to the generated CRUD inputs.
+var Default = Rules{
+	"Category": {
+		"name":        {MaxLength(maxName)},
+		"slug":        {MaxLength(maxSlug)},
+		"description": {MaxLength(maxDescription)},
+	},
+	"Comment": {
+		"authorName":  {MaxLength(maxName)},
+		"authorEmail": {MaxLength(maxEmail), Email()},
+		"authorURL":   {MaxLength(maxURL), URL()},
+		"content":     {MaxLength(maxComment)},
+	},
+	"ContentType": {
+		"name":        {MaxLength(64)},
+		"label":       {MaxLength(maxName)},
+		"pluralLabel": {MaxLength(maxName)},
+		"description": {MaxLength(maxDescription)},
+	},
+	"Media": {
+		"fileName":    {MaxLength(255)},
+		"mimeType":    {MaxLength(255)},
+		"storageKey":  {MaxLength(1024)},
+		"url":         {MaxLength(maxURL), URL()},
+		"title":       {MaxLength(maxName)},
+		"altText":     {MaxLength(maxShortText)},
+		"caption":     {MaxLength(maxDescription)},
+		"description": {MaxLength(maxDescription)},
+	},
+	"Menu": {
+		"name":        {MaxLength(maxName)},
+		"location":    {MaxLength(64)},
+		"description": {MaxLength(maxDescription)},
+	},
+	// Menu item URLs may be relative, so only their length is checked here.
+	"MenuItem": {
+		"label": {MaxLength(maxName)},
+		"url":   {MaxLength(maxURL)},
+	},
+	"Option": {
+		"name": {MaxLength(191)},
+	},
+	"Post": {
+		"title":   {MaxLength(maxName)},
+		"slug":    {MaxLength(maxSlug)},
+		"excerpt": {MaxLength(maxDescription)},
+	},
+	"Role": {
+		"name":        {MaxLength(maxName)},
+		"slug":        {MaxLength(maxSlug)},
+		"description": {MaxLength(maxDescription)},
+	},
+	"SlugHistory": {
+		"entityType": {MaxLength(50)},
+		"slug":       {MaxLength(maxSlug)},
+	},
+	"Tag": {
+		"name":        {MaxLength(maxName)},
+		"slug":        {MaxLength(maxSlug)},
+		"description": {MaxLength(maxDescription)},
+	},
+	"User": {
+		"username":    {MaxLength(60)},
+		"email":       {MaxLength(maxEmail), Email()},
+		"password":    {MaxLength(72)},
+		"displayName": {MaxLength(maxName)},
+		"bio":         {MaxLength(maxDescription)},
+		"avatarURL":   {MaxLength(maxURL), URL()},
+		"websiteURL":  {MaxLength(maxURL), URL()},
+	},
+}
@@ -0,0 +1,150 @@
+// Package validate checks mutation inputs against declarative per-entity
+// rules before resolvers run. Rules are keyed by entity name and apply to both
+// Create<Entity>Input and Update<Entity>Input, matched by GraphQL field name.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+// Rule checks a non-empty string value and returns a message when it is
+// invalid. Empty values are left to the schema's required-field handling.
+type Rule func(value string) string
+
+// Fields maps GraphQL input field names to their rules.
+type Fields map[string][]Rule
+
+// Rules maps entity names to field rules.
+type Rules map[string]Fields
+
+// MaxLength limits a value to n characters.
+func MaxLength(n int) Rule {
+	return func(value string) string {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}
+}
+
+// Email requires a bare address such as "jane@example.com".
+func Email() Rule {
+	return func(value string) string {
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Address != value || addr.Name != "" {
+			return "must be a valid email address"
+		}
+		return ""
+	}
+}
+
+// URL requires an absolute http or https URL.
+func URL() Rule {
+	return func(value string) string {
+		parsed, err := url.Parse(value)
+		if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return "must be an absolute http(s) URL"
+		}
+		return ""
+	}
+}
+
+// Check validates a mutation argument. Values that are not Create*/Update*
+// inputs of a known entity pass unchanged. Failures are reported as a
+// *gqlerrors.ValidationError naming each field as "<argument>.<field>".
+func (r Rules) Check(argument string, value any) error {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	fields, ok := r[entityOf(v.Type().Name())]
+	if !ok {
+		return nil
+	}
+	var errs gqlerrors.ValidationError
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := jsonName(t.Field(i))
+		rules := fields[name]
+		if len(rules) == 0 {
+			continue
+		}
+		text, ok := stringValue(v.Field(i))
+		if !ok || text == "" {
+			continue
+		}
+		for _, rule := range rules {
+			if message := rule(text); message != "" {
+				errs.Add(argument+"."+name, message)
+				break
+			}
+		}
+	}
+	return errs.Err()
+}
+
+// Middleware validates the arguments of every mutation field.
+func (r Rules) Middleware() graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if fc != nil && fc.Object == "Mutation" {
+			for name, arg := range fc.Args {
+				if err := r.Check(name, arg); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return next(ctx)
+	}
+}
+
+func entityOf(typeName string) string {
+	name, ok := strings.CutSuffix(typeName, "Input")
+	if !ok {
+		return ""
+	}
+	if entity, ok := strings.CutPrefix(name, "Create"); ok {
+		return entity
+	}
+	if entity, ok := strings.CutPrefix(name, "Update"); ok {
+		return entity
+	}
+	return ""
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func stringValue(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+	return v.String(), true
+}
@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/deicod/ermblog/graphql"
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+func TestCheckReportsEveryInvalidField(t *testing.T) {
+	t.Parallel()
+
+	email := "not-an-email"
+	website := "ftp://example.com"
+	username := strings.Repeat("a", 61)
+	err := Default.Check("input", graphql.CreateUserInput{Email: &email, WebsiteURL: &website, Username: &username})
+
+	var validation *gqlerrors.ValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	got := map[string]bool{}
+	for _, field := range validation.Fields {
+		got[field.Field] = true
+	}
+	for _, want := range []string{"input.email", "input.websiteURL", "input.username"} {
+		if !got[want] {
+			t.Fatalf("expected %s to be reported, got %+v", want, validation.Fields)
+		}
+	}
+}
+
+func TestCheckAcceptsValidAndOmittedFields(t *testing.T) {
+	t.Parallel()
+
+	email := "jane@example.com"
+	url := "https://example.com/jane"
+	if err := Default.Check("input", &graphql.UpdateUserInput{ID: "1", Email: &email, AvatarURL: &url}); err != nil {
+		t.Fatalf("expected valid input, got %v", err)
+	}
+	if err := Default.Check("input", graphql.UpdateCommentInput{ID: "1"}); err != nil {
+		t.Fatalf("expected omitted fields to pass, got %v", err)
+	}
+	if err := Default.Check("id", "not-an-input"); err != nil {
+		t.Fatalf("expected non-input arguments to pass, got %v", err)
+	}
+}
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		rule  Rule
+		value string
+		ok    bool
+	}{
+		{Email(), "jane@example.com", true},
+		{Email(), "Jane <jane@example.com>", false},
+		{Email(), "jane@", false},
+		{URL(), "http://example.com", true},
+		{URL(), "example.com", false},
+		{URL(), "javascript:alert(1)", false},
+		{MaxLength(3), "äöü", true},
+		{MaxLength(3), "abcd", false},
+	}
+	for _, tc := range cases {
+		if got := tc.rule(tc.value) == ""; got != tc.ok {
+			t.Fatalf("rule on %q: expected ok=%v", tc.value, tc.ok)
+		}
+	}
+}
+
+func TestDefaultCoversContentTypesAndMenus(t *testing.T) {
+	t.Parallel()
+
+	long := func(n int) *string {
+		value := strings.Repeat("a", n)
+		return &value
+	}
+	cases := []struct {
+		name  string
+		input any
+		field string
+	}{
+		{"content type name", graphql.CreateContentTypeInput{Name: long(65)}, "input.name"},
+		{"content type label", graphql.UpdateContentTypeInput{ID: "1", Label: long(201)}, "input.label"},
+		{"content type plural label", graphql.CreateContentTypeInput{PluralLabel: long(201)}, "input.pluralLabel"},
+		{"content type description", graphql.UpdateContentTypeInput{ID: "1", Description: long(5001)}, "input.description"},
+		{"menu name", graphql.CreateMenuInput{Name: long(201)}, "input.name"},
+		{"menu location", graphql.UpdateMenuInput{ID: "1", Location: long(65)}, "input.location"},
+		{"menu description", graphql.CreateMenuInput{Description: long(5001)}, "input.description"},
+		{"menu item label", graphql.CreateMenuItemInput{Label: long(201)}, "input.label"},
+		{"menu item url", graphql.UpdateMenuItemInput{ID: "1", URL: long(2049)}, "input.url"},
+		{"content type at limit", graphql.CreateContentTypeInput{Name: long(64), Label: long(200)}, ""},
+		{"menu at limit", graphql.UpdateMenuInput{ID: "1", Name: long(200), Location: long(64)}, ""},
+		{"relative menu item url", graphql.CreateMenuItemInput{URL: ptr("/about"), Label: long(200)}, ""},
+	}
+	for _, tc := range cases {
+		err := Default.Check("input", tc.input)
+		if tc.field == "" {
+			if err != nil {
+				t.Errorf("%s: expected valid input, got %v", tc.name, err)
+			}
+			continue
+		}
+		var validation *gqlerrors.ValidationError
+		if !errors.As(err, &validation) || len(validation.Fields) != 1 || validation.Fields[0].Field != tc.field {
+			t.Errorf("%s: expected %s to be reported, got %v", tc.name, tc.field, err)
+		}
+	}
+}
+
+func ptr(value string) *string {
+	return &value
+}
@@ -0,0 +1,70 @@
+package localauth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// minSecretLength is the shortest accepted HMAC secret, the output size of
+// SHA-256.
+const minSecretLength = 32
+
+// Key is a named HMAC secret. The ID is sent as the token's kid header.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// KeyRing signs tokens with its first key and verifies them with any of its
+// keys. Rotate by putting a new key first and removing the old one once the
+// tokens it signed have expired.
+type KeyRing struct {
+	signing Key
+	secrets map[string][]byte
+}
+
+// NewKeyRing validates keys: at least one, with unique non-empty IDs and
+// secrets of at least 32 bytes.
+func NewKeyRing(keys ...Key) (*KeyRing, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("localauth: at least one signing key is required")
+	}
+	ring := &KeyRing{signing: keys[0], secrets: make(map[string][]byte, len(keys))}
+	for _, key := range keys {
+		if strings.TrimSpace(key.ID) == "" {
+			return nil, errors.New("localauth: key id is required")
+		}
+		if _, ok := ring.secrets[key.ID]; ok {
+			return nil, fmt.Errorf("localauth: key %q is declared twice", key.ID)
+		}
+		if len(key.Secret) < minSecretLength {
+			return nil, fmt.Errorf("localauth: key %q must be at least %d bytes", key.ID, minSecretLength)
+		}
+		ring.secrets[key.ID] = key.Secret
+	}
+	return ring, nil
+}
+
+// ParseKeys reads keys written as "id=secret" pairs separated by commas,
+// signing key first.
+func ParseKeys(spec string) ([]Key, error) {
+	var keys []Key
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("localauth: key %q is not written as id=secret", pair)
+		}
+		keys = append(keys, Key{ID: strings.TrimSpace(id), Secret: []byte(strings.TrimSpace(secret))})
+	}
+	return keys, nil
+}
+
+func (r *KeyRing) secret(id string) ([]byte, bool) {
+	secret, ok := r.secrets[id]
+	return secret, ok
+}
@@ -0,0 +1,358 @@
+// Package localauth lets users sign in with the username (or email) and
+// password stored on their user record, for installs without an identity
+// provider.
+//
+// A successful login issues a short-lived access token, an HS256 JWT
+// signed with a KeyRing, and a long-lived refresh token. Refresh tokens are
+// random strings stored only as SHA-256 digests and are single use: each
+// refresh returns a new pair. Access tokens verify into oidc.Claims so
+// directives and resolvers treat local and OIDC sessions alike; combine a
+// Service with the OIDC validator in an oidc.Chain to accept both.
+//
+// Every failed login takes as long as a successful one and reports the same
+// error whether the user exists or not. Repeated failures lock the login
+// name for a while; names without a user are counted too so a lockout does
+// not reveal which accounts exist.
+package localauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+var (
+	// ErrInvalidCredentials is returned for unknown users and wrong
+	// passwords alike.
+	ErrInvalidCredentials = errors.New("localauth: invalid username or password")
+	// ErrInvalidToken is returned for unknown, expired or malformed tokens.
+	ErrInvalidToken = errors.New("localauth: invalid token")
+)
+
+// LockedError reports a login name locked after too many failures.
+type LockedError struct {
+	Until time.Time
+}
+
+func (e *LockedError) Error() string {
+	return "localauth: too many failed logins"
+}
+
+// Account is a user that can sign in.
+type Account struct {
+	ID           string
+	Username     string
+	Email        string
+	DisplayName  string
+	PasswordHash string
+	// EmailVerified reports whether the user confirmed Email.
+	EmailVerified bool
+	// Roles lists the slugs of the user's roles.
+	Roles []string
+}
+
+// Store loads accounts and persists lockouts and refresh tokens.
+type Store interface {
+	// Account returns the account whose username or email is login, or nil.
+	Account(ctx context.Context, login string) (*Account, error)
+	// AccountByID returns the account with the given user ID, or nil.
+	AccountByID(ctx context.Context, id string) (*Account, error)
+	// LockedUntil returns the end of login's lockout, or the zero time.
+	LockedUntil(ctx context.Context, login string, now time.Time) (time.Time, error)
+	// RecordFailure counts a failed login and locks login for lockout
+	// once limit failures were counted within lockout of each other.
+	RecordFailure(ctx context.Context, login string, at time.Time, limit int, lockout time.Duration) error
+	// ClearFailures forgets login's failures after a successful login.
+	ClearFailures(ctx context.Context, login string) error
+	// RecordLogin notes a successful login of the user.
+	RecordLogin(ctx context.Context, userID string, at time.Time) error
+	// SaveRefreshToken stores the digest of a refresh token.
+	SaveRefreshToken(ctx context.Context, digest, userID string, expiresAt time.Time) error
+	// ConsumeRefreshToken deletes a refresh token and returns its user, or
+	// "" when it is unknown or expired at now.
+	ConsumeRefreshToken(ctx context.Context, digest string, now time.Time) (string, error)
+	// RevokeRefreshToken deletes a refresh token.
+	RevokeRefreshToken(ctx context.Context, digest string) error
+}
+
+// Config configures a Service. Zero values use the defaults noted.
+type Config struct {
+	// Issuer and Audience are the iss and aud of issued tokens; both
+	// default to "ermblog".
+	Issuer   string
+	Audience string
+	// AccessTTL bounds access tokens; defaults to 15 minutes.
+	AccessTTL time.Duration
+	// RefreshTTL bounds refresh tokens; defaults to 30 days.
+	RefreshTTL time.Duration
+	// MaxFailures failed logins lock the login name; defaults to 5.
+	MaxFailures int
+	// Lockout is how long a locked login name stays locked and how long
+	// failures are remembered; defaults to 15 minutes.
+	Lockout time.Duration
+	// DefaultRoles are granted to every local session on top of the
+	// user's roles; defaults to "user", the role mutations require.
+	DefaultRoles []string
+}
+
+func (cfg Config) withDefaults() Config {
+	if strings.TrimSpace(cfg.Issuer) == "" {
+		cfg.Issuer = "ermblog"
+	}
+	if strings.TrimSpace(cfg.Audience) == "" {
+		cfg.Audience = "ermblog"
+	}
+	if cfg.AccessTTL <= 0 {
+		cfg.AccessTTL = 15 * time.Minute
+	}
+	if cfg.RefreshTTL <= 0 {
+		cfg.RefreshTTL = 30 * 24 * time.Hour
+	}
+	if cfg.MaxFailures <= 0 {
+		cfg.MaxFailures = 5
+	}
+	if cfg.Lockout <= 0 {
+		cfg.Lockout = 15 * time.Minute
+	}
+	if cfg.DefaultRoles == nil {
+		cfg.DefaultRoles = []string{"user"}
+	}
+	return cfg
+}
+
+// Session is the result of a login or refresh.
+type Session struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt is when the access token expires.
+	ExpiresAt time.Time
+	// Claims are what the access token verifies into.
+	Claims oidc.Claims
+}
+
+// Service issues and verifies local sessions.
+type Service struct {
+	cfg   Config
+	keys  *KeyRing
+	store Store
+	now   func() time.Time
+}
+
+// New builds a Service.
+func New(cfg Config, keys *KeyRing, store Store) (*Service, error) {
+	if keys == nil {
+		return nil, errors.New("localauth: key ring is required")
+	}
+	if store == nil {
+		return nil, errors.New("localauth: store is required")
+	}
+	return &Service{cfg: cfg.withDefaults(), keys: keys, store: store, now: time.Now}, nil
+}
+
+// Login verifies username, which may also be the user's email, and password
+// and starts a session. It returns ErrInvalidCredentials or a *LockedError
+// on failure.
+func (s *Service) Login(ctx context.Context, username, password string) (*Session, error) {
+	login := normaliseLogin(username)
+	now := s.now()
+	lockedUntil, err := s.store.LockedUntil(ctx, login, now)
+	if err != nil {
+		return nil, err
+	}
+	var account *Account
+	if login != "" && lockedUntil.IsZero() {
+		if account, err = s.store.Account(ctx, login); err != nil {
+			return nil, err
+		}
+	}
+	// Compare against a dummy hash when there is nothing to compare with
+	// so every failure costs one bcrypt comparison.
+	hash := dummyHash()
+	if account != nil && account.PasswordHash != "" {
+		hash = []byte(account.PasswordHash)
+	}
+	matched := bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+	if !lockedUntil.IsZero() {
+		return nil, &LockedError{Until: lockedUntil}
+	}
+	if account == nil || account.PasswordHash == "" || !matched {
+		if login != "" {
+			if err := s.store.RecordFailure(ctx, login, now, s.cfg.MaxFailures, s.cfg.Lockout); err != nil {
+				return nil, err
+			}
+		}
+		return nil, ErrInvalidCredentials
+	}
+	if err := s.store.ClearFailures(ctx, login); err != nil {
+		return nil, err
+	}
+	if err := s.store.RecordLogin(ctx, account.ID, now); err != nil {
+		return nil, err
+	}
+	return s.issue(ctx, account, now)
+}
+
+// Refresh exchanges a refresh token for a new session. The token cannot be
+// used again.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (*Session, error) {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return nil, ErrInvalidToken
+	}
+	now := s.now()
+	userID, err := s.store.ConsumeRefreshToken(ctx, digest(refreshToken), now)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "" {
+		return nil, ErrInvalidToken
+	}
+	account, err := s.store.AccountByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, ErrInvalidToken
+	}
+	return s.issue(ctx, account, now)
+}
+
+// Logout revokes a refresh token. Access tokens stay valid until they
+// expire.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return nil
+	}
+	return s.store.RevokeRefreshToken(ctx, digest(refreshToken))
+}
+
+// ValidateToken verifies an access token issued by the service. Together
+// with oidc.Validator it satisfies oidc.TokenValidator.
+func (s *Service) ValidateToken(_ context.Context, token string) (oidc.Claims, error) {
+	if strings.TrimSpace(token) == "" {
+		return oidc.Claims{}, ErrInvalidToken
+	}
+	claims := jwt.MapClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(tok *jwt.Token) (any, error) {
+		kid, _ := tok.Header["kid"].(string)
+		secret, ok := s.keys.secret(kid)
+		if !ok {
+			return nil, fmt.Errorf("localauth: unknown key %q", kid)
+		}
+		return secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.cfg.Issuer),
+		jwt.WithAudience(s.cfg.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil || !parsed.Valid {
+		return oidc.Claims{}, ErrInvalidToken
+	}
+	return oidc.ClaimsFromJWT(claims), nil
+}
+
+func (s *Service) issue(ctx context.Context, account *Account, now time.Time) (*Session, error) {
+	expiresAt := now.Add(s.cfg.AccessTTL)
+	claims := jwt.MapClaims{
+		"iss":                s.cfg.Issuer,
+		"aud":                s.cfg.Audience,
+		"sub":                account.ID,
+		"iat":                now.Unix(),
+		"nbf":                now.Unix(),
+		"exp":                expiresAt.Unix(),
+		"preferred_username": account.Username,
+		"roles":              mergeRoles(s.cfg.DefaultRoles, account.Roles),
+	}
+	if account.Email != "" {
+		claims["email"] = account.Email
+		claims["email_verified"] = account.EmailVerified
+	}
+	if account.DisplayName != "" {
+		claims["name"] = account.DisplayName
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = s.keys.signing.ID
+	accessToken, err := token.SignedString(s.keys.signing.Secret)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.SaveRefreshToken(ctx, digest(refreshToken), account.ID, now.Add(s.cfg.RefreshTTL)); err != nil {
+		return nil, err
+	}
+	// Round-trip the claims so they match what ValidateToken returns.
+	verified, err := s.ValidateToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Unix(expiresAt.Unix(), 0).UTC(),
+		Claims:       verified,
+	}, nil
+}
+
+func normaliseLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+func mergeRoles(groups ...[]string) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	for _, group := range groups {
+		for _, role := range group {
+			if _, ok := seen[role]; ok || role == "" {
+				continue
+			}
+			seen[role] = struct{}{}
+			out = append(out, role)
+		}
+	}
+	return out
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+var (
+	dummyOnce sync.Once
+	dummy     []byte
+)
+
+// dummyHash is compared against when there is no password hash, at the
+// cost passwords are stored with.
+func dummyHash() []byte {
+	dummyOnce.Do(func() {
+		dummy, _ = bcrypt.GenerateFromPassword([]byte("localauth dummy password"), bcrypt.DefaultCost)
+	})
+	return dummy
+}
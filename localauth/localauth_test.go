@@ -0,0 +1,302 @@
+package localauth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+type memoryStore struct {
+	accounts map[string]*Account
+	failures map[string]int
+	locked   map[string]time.Time
+	refresh  map[string]refreshRecord
+	logins   []string
+	tokens   map[string]tokenRecord
+	verified map[string]string
+}
+
+type refreshRecord struct {
+	userID    string
+	expiresAt time.Time
+}
+
+func newMemoryStore(accounts ...*Account) *memoryStore {
+	store := &memoryStore{
+		accounts: make(map[string]*Account),
+		failures: make(map[string]int),
+		locked:   make(map[string]time.Time),
+		refresh:  make(map[string]refreshRecord),
+		tokens:   make(map[string]tokenRecord),
+		verified: make(map[string]string),
+	}
+	for _, account := range accounts {
+		store.accounts[account.ID] = account
+	}
+	return store
+}
+
+func (s *memoryStore) Account(_ context.Context, login string) (*Account, error) {
+	for _, account := range s.accounts {
+		if strings.EqualFold(account.Username, login) || strings.EqualFold(account.Email, login) {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryStore) AccountByID(_ context.Context, id string) (*Account, error) {
+	return s.accounts[id], nil
+}
+
+func (s *memoryStore) LockedUntil(_ context.Context, login string, now time.Time) (time.Time, error) {
+	if until, ok := s.locked[login]; ok && until.After(now) {
+		return until, nil
+	}
+	return time.Time{}, nil
+}
+
+func (s *memoryStore) RecordFailure(_ context.Context, login string, at time.Time, limit int, lockout time.Duration) error {
+	s.failures[login]++
+	if s.failures[login] >= limit {
+		s.failures[login] = 0
+		s.locked[login] = at.Add(lockout)
+	}
+	return nil
+}
+
+func (s *memoryStore) ClearFailures(_ context.Context, login string) error {
+	delete(s.failures, login)
+	return nil
+}
+
+func (s *memoryStore) RecordLogin(_ context.Context, userID string, _ time.Time) error {
+	s.logins = append(s.logins, userID)
+	return nil
+}
+
+func (s *memoryStore) SaveRefreshToken(_ context.Context, digest, userID string, expiresAt time.Time) error {
+	s.refresh[digest] = refreshRecord{userID: userID, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *memoryStore) ConsumeRefreshToken(_ context.Context, digest string, now time.Time) (string, error) {
+	record, ok := s.refresh[digest]
+	delete(s.refresh, digest)
+	if !ok || !record.expiresAt.After(now) {
+		return "", nil
+	}
+	return record.userID, nil
+}
+
+func (s *memoryStore) RevokeRefreshToken(_ context.Context, digest string) error {
+	delete(s.refresh, digest)
+	return nil
+}
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestService(t *testing.T, store Store, cfg Config) *Service {
+	t.Helper()
+	ring, err := NewKeyRing(Key{ID: "k1", Secret: testSecret})
+	if err != nil {
+		t.Fatalf("key ring: %v", err)
+	}
+	service, err := New(cfg, ring, store)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	return service
+}
+
+func testAccount(t *testing.T, password string) *Account {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	return &Account{
+		ID:           "018f1e2a-0000-7000-8000-000000000001",
+		Username:     "alice",
+		Email:        "alice@example.com",
+		DisplayName:  "Alice",
+		PasswordHash: string(hash),
+		Roles:        []string{"editor"},
+	}
+}
+
+func TestLoginIssuesVerifiableSession(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+
+	session, err := service.Login(context.Background(), " Alice@Example.com ", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if session.AccessToken == "" || session.RefreshToken == "" {
+		t.Fatalf("expected tokens, got %+v", session)
+	}
+	claims, err := service.ValidateToken(context.Background(), session.AccessToken)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if claims.Subject != "018f1e2a-0000-7000-8000-000000000001" || claims.Username != "alice" || claims.Name != "Alice" {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if strings.Join(claims.Roles, ",") != "user,editor" {
+		t.Fatalf("expected default and assigned roles, got %v", claims.Roles)
+	}
+	if session.Claims.Subject != claims.Subject {
+		t.Fatalf("session claims differ from token claims")
+	}
+	if len(store.logins) != 1 {
+		t.Fatalf("expected the login to be recorded")
+	}
+}
+
+func TestLoginFailuresLookTheSame(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+
+	if _, err := service.Login(context.Background(), "alice", "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected invalid credentials for a wrong password, got %v", err)
+	}
+	if _, err := service.Login(context.Background(), "nobody", "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected invalid credentials for an unknown user, got %v", err)
+	}
+	if store.failures["alice"] != 1 || store.failures["nobody"] != 1 {
+		t.Fatalf("expected failures to be counted for both names, got %v", store.failures)
+	}
+}
+
+func TestLoginLocksAfterRepeatedFailures(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{MaxFailures: 3, Lockout: time.Minute})
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if _, err := service.Login(context.Background(), "alice", "wrong"); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected invalid credentials, got %v", i+1, err)
+		}
+	}
+	_, err := service.Login(context.Background(), "alice", "correct horse")
+	var locked *LockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("expected a lockout, got %v", err)
+	}
+	if !locked.Until.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected lockout end %v", locked.Until)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := service.Login(context.Background(), "alice", "correct horse"); err != nil {
+		t.Fatalf("expected login after the lockout, got %v", err)
+	}
+}
+
+func TestRefreshRotatesTokens(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+
+	session, err := service.Login(context.Background(), "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	refreshed, err := service.Refresh(context.Background(), session.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if refreshed.RefreshToken == session.RefreshToken {
+		t.Fatalf("expected a new refresh token")
+	}
+	if _, err := service.Refresh(context.Background(), session.RefreshToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a used refresh token to be rejected, got %v", err)
+	}
+	if err := service.Logout(context.Background(), refreshed.RefreshToken); err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if _, err := service.Refresh(context.Background(), refreshed.RefreshToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a revoked refresh token to be rejected, got %v", err)
+	}
+}
+
+func TestValidateTokenRejectsForeignTokens(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+	session, err := service.Login(context.Background(), "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	otherRing, _ := NewKeyRing(Key{ID: "k1", Secret: []byte("fedcba9876543210fedcba9876543210")})
+	other, _ := New(Config{}, otherRing, store)
+	if _, err := other.ValidateToken(context.Background(), session.AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a token signed with another secret to be rejected, got %v", err)
+	}
+
+	otherIssuer := newTestService(t, store, Config{Issuer: "elsewhere"})
+	if _, err := otherIssuer.ValidateToken(context.Background(), session.AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a token of another issuer to be rejected, got %v", err)
+	}
+
+	service.now = func() time.Time { return time.Now().Add(time.Hour) }
+	if _, err := service.ValidateToken(context.Background(), session.AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected an expired token to be rejected, got %v", err)
+	}
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	old := newTestService(t, store, Config{})
+	session, err := old.Login(context.Background(), "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	keys, err := ParseKeys("k2=fedcba9876543210fedcba9876543210, k1=" + string(testSecret))
+	if err != nil {
+		t.Fatalf("parse keys: %v", err)
+	}
+	ring, err := NewKeyRing(keys...)
+	if err != nil {
+		t.Fatalf("key ring: %v", err)
+	}
+	rotated, _ := New(Config{}, ring, store)
+	if _, err := rotated.ValidateToken(context.Background(), session.AccessToken); err != nil {
+		t.Fatalf("expected tokens of the previous key to verify, got %v", err)
+	}
+
+	if _, err := NewKeyRing(Key{ID: "short", Secret: []byte("too short")}); err == nil {
+		t.Fatalf("expected short secrets to be rejected")
+	}
+}
+
+func TestChainAcceptsLocalTokens(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+	session, err := service.Login(context.Background(), "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	chain := oidc.Chain{rejectAll{}, service}
+	claims, err := chain.ValidateToken(context.Background(), session.AccessToken)
+	if err != nil || claims.Subject != session.Claims.Subject {
+		t.Fatalf("expected the chain to accept the local token, got %+v, %v", claims, err)
+	}
+	if _, err := chain.ValidateToken(context.Background(), "garbage"); err == nil {
+		t.Fatalf("expected the chain to reject unknown tokens")
+	}
+}
+
+type rejectAll struct{}
+
+func (rejectAll) ValidateToken(context.Context, string) (oidc.Claims, error) {
+	return oidc.Claims{}, errors.New("rejected")
+}
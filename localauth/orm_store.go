@@ -0,0 +1,133 @@
+package localauth
+
+import (
+	"context"
+	"time"
+
+	"github.com/deicod/ermblog/orm/gen"
+	"github.com/deicod/ermblog/usermeta"
+)
+
+// ORMStore keeps accounts, lockouts, refresh tokens and mailed tokens
+// through the generated ORM client.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a Service Store and RecoveryStore.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// Account returns the user whose username or email is login, or nil.
+func (s *ORMStore) Account(ctx context.Context, login string) (*Account, error) {
+	user, err := s.client.UserByLogin(ctx, login)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return s.account(ctx, user)
+}
+
+// AccountByID returns the user with the given ID, or nil.
+func (s *ORMStore) AccountByID(ctx context.Context, id string) (*Account, error) {
+	user, err := s.client.Users().ByID(ctx, id)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return s.account(ctx, user)
+}
+
+func (s *ORMStore) account(ctx context.Context, user *gen.User) (*Account, error) {
+	roles, err := s.client.ListRolesForUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	account := &Account{
+		ID:           user.ID,
+		Username:     user.Username,
+		Email:        user.Email,
+		PasswordHash: user.Password,
+	}
+	if user.DisplayName != nil {
+		account.DisplayName = *user.DisplayName
+	}
+	for _, role := range roles {
+		account.Roles = append(account.Roles, role.Slug)
+	}
+	verification, _, err := usermeta.Get(ctx, s.client, user.ID, EmailVerification)
+	if err != nil {
+		return nil, err
+	}
+	account.EmailVerified = verification.Covers(user.Email)
+	return account, nil
+}
+
+// AccountByEmail returns the user with the given email, or nil.
+func (s *ORMStore) AccountByEmail(ctx context.Context, email string) (*Account, error) {
+	user, err := s.client.UserByEmail(ctx, email)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return s.account(ctx, user)
+}
+
+// LockedUntil returns the end of login's lockout, or the zero time.
+func (s *ORMStore) LockedUntil(ctx context.Context, login string, now time.Time) (time.Time, error) {
+	return s.client.LoginLockedUntil(ctx, login, now)
+}
+
+// RecordFailure counts a failed login.
+func (s *ORMStore) RecordFailure(ctx context.Context, login string, at time.Time, limit int, lockout time.Duration) error {
+	return s.client.RecordLoginFailure(ctx, login, at, limit, lockout)
+}
+
+// ClearFailures forgets login's failures.
+func (s *ORMStore) ClearFailures(ctx context.Context, login string) error {
+	return s.client.ClearLoginFailures(ctx, login)
+}
+
+// RecordLogin sets the user's last_login_at.
+func (s *ORMStore) RecordLogin(ctx context.Context, userID string, at time.Time) error {
+	return s.client.RecordUserLogin(ctx, userID, at)
+}
+
+// SaveRefreshToken stores the digest of a refresh token.
+func (s *ORMStore) SaveRefreshToken(ctx context.Context, digest, userID string, expiresAt time.Time) error {
+	return s.client.CreateRefreshToken(ctx, digest, userID, expiresAt)
+}
+
+// ConsumeRefreshToken deletes a refresh token and returns its user.
+func (s *ORMStore) ConsumeRefreshToken(ctx context.Context, digest string, now time.Time) (string, error) {
+	return s.client.ConsumeRefreshToken(ctx, digest, now)
+}
+
+// RevokeRefreshToken deletes a refresh token.
+func (s *ORMStore) RevokeRefreshToken(ctx context.Context, digest string) error {
+	return s.client.RevokeRefreshToken(ctx, digest)
+}
+
+// CreateUserToken stores the digest of a mailed token.
+func (s *ORMStore) CreateUserToken(ctx context.Context, digest, userID, purpose, email string, expiresAt time.Time) error {
+	return s.client.CreateUserToken(ctx, digest, userID, purpose, email, expiresAt)
+}
+
+// ConsumeUserToken deletes a mailed token and returns its user and email.
+func (s *ORMStore) ConsumeUserToken(ctx context.Context, digest, purpose string, now time.Time) (string, string, error) {
+	return s.client.ConsumeUserToken(ctx, digest, purpose, now)
+}
+
+// UserToken returns the user and email of a mailed token without using it.
+func (s *ORMStore) UserToken(ctx context.Context, digest, purpose string, now time.Time) (string, string, error) {
+	return s.client.UserToken(ctx, digest, purpose, now)
+}
+
+// ResetPassword uses up a password reset token, replaces the user's
+// password hash and revokes their sessions in one statement.
+func (s *ORMStore) ResetPassword(ctx context.Context, digest, userID, passwordHash string, now time.Time) (bool, error) {
+	return s.client.ResetUserPassword(ctx, digest, PurposePasswordReset, userID, passwordHash, now)
+}
+
+// MarkEmailVerified stores the user's Verification meta value.
+func (s *ORMStore) MarkEmailVerified(ctx context.Context, userID, email string, at time.Time) error {
+	return usermeta.Set(ctx, s.client, userID, EmailVerification, Verification{Email: email, VerifiedAt: at.UTC()})
+}
@@ -0,0 +1,84 @@
+package localauth
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxPasswordBytes is the most bcrypt hashes; longer passwords are rejected
+// rather than silently truncated.
+const maxPasswordBytes = 72
+
+// PasswordPolicy decides which new passwords are accepted.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters; defaults to 10.
+	MinLength int
+}
+
+// DefaultPasswordPolicy is used when none is configured.
+var DefaultPasswordPolicy = PasswordPolicy{MinLength: 10}
+
+// WeakPasswordError explains why a password was rejected.
+type WeakPasswordError struct {
+	Reason string
+}
+
+func (e *WeakPasswordError) Error() string {
+	return "password " + e.Reason
+}
+
+// commonPasswords lists passwords that meet the length rule but are among
+// the first tried by guessing attacks.
+var commonPasswords = map[string]struct{}{
+	"1234567890":    {},
+	"12345678910":   {},
+	"123456789012":  {},
+	"0987654321":    {},
+	"1q2w3e4r5t":    {},
+	"iloveyou12":    {},
+	"password12":    {},
+	"password123":   {},
+	"password1234":  {},
+	"passw0rd123":   {},
+	"qwertyuiop":    {},
+	"qwerty1234":    {},
+	"qwerty12345":   {},
+	"letmein123":    {},
+	"welcome123":    {},
+	"administrator": {},
+	"changeme123":   {},
+}
+
+// Check returns a *WeakPasswordError when password is too short, too long
+// for bcrypt, a single repeated character, a common password, or contains
+// one of identifiers such as the username or the local part of the email.
+func (p PasswordPolicy) Check(password string, identifiers ...string) error {
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = DefaultPasswordPolicy.MinLength
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		return &WeakPasswordError{Reason: fmt.Sprintf("must be at least %d characters", minLength)}
+	}
+	if len(password) > maxPasswordBytes {
+		return &WeakPasswordError{Reason: fmt.Sprintf("must be at most %d bytes", maxPasswordBytes)}
+	}
+	lower := strings.ToLower(password)
+	if strings.Count(lower, lower[:1]) == len(lower) {
+		return &WeakPasswordError{Reason: "must not repeat a single character"}
+	}
+	if _, ok := commonPasswords[lower]; ok {
+		return &WeakPasswordError{Reason: "is too common"}
+	}
+	for _, identifier := range identifiers {
+		identifier = strings.ToLower(strings.TrimSpace(identifier))
+		if local, _, ok := strings.Cut(identifier, "@"); ok {
+			identifier = local
+		}
+		if len(identifier) >= 3 && strings.Contains(lower, identifier) {
+			return &WeakPasswordError{Reason: "must not contain your username or email"}
+		}
+	}
+	return nil
+}
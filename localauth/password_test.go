@@ -0,0 +1,35 @@
+package localauth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	policy := PasswordPolicy{}
+	cases := []struct {
+		password    string
+		identifiers []string
+		reason      string
+	}{
+		{password: "short", reason: "at least 10"},
+		{password: strings.Repeat("a", 12), reason: "repeat"},
+		{password: "Password123", reason: "common"},
+		{password: "alice-rocks-2026", identifiers: []string{"alice", "alice@example.com"}, reason: "username"},
+		{password: "see-wonderland", identifiers: []string{"bob", "wonderland@example.com"}, reason: "email"},
+		{password: strings.Repeat("ab", 40), reason: "at most 72"},
+	}
+	for _, tc := range cases {
+		var weak *WeakPasswordError
+		if err := policy.Check(tc.password, tc.identifiers...); !errors.As(err, &weak) || !strings.Contains(weak.Error(), tc.reason) {
+			t.Errorf("Check(%q) = %v, want a weak password error mentioning %q", tc.password, err, tc.reason)
+		}
+	}
+	if err := policy.Check("battery staple", "al", "alice@example.com"); err != nil {
+		t.Fatalf("expected a reasonable password to pass, got %v", err)
+	}
+	if err := (PasswordPolicy{MinLength: 16}).Check("battery staple"); err == nil {
+		t.Fatalf("expected a configured minimum length to apply")
+	}
+}
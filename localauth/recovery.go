@@ -0,0 +1,292 @@
+package localauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/deicod/ermblog/mail"
+	"github.com/deicod/ermblog/usermeta"
+)
+
+// Token purposes stored with mailed tokens.
+const (
+	PurposePasswordReset     = "password_reset"
+	PurposeEmailVerification = "email_verification"
+)
+
+// Verification records which address a user verified and when.
+type Verification struct {
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verifiedAt"`
+}
+
+// Covers reports whether the verification applies to email.
+func (v Verification) Covers(email string) bool {
+	return v.Email != "" && strings.EqualFold(v.Email, strings.TrimSpace(email))
+}
+
+// EmailVerification is the user meta key holding a user's Verification.
+// A user's email counts as verified while the stored address matches it.
+var EmailVerification = usermeta.Key[Verification]{Name: "email_verification"}
+
+// RecoveryStore persists mailed tokens and the changes they authorise.
+type RecoveryStore interface {
+	// AccountByEmail returns the account with the given email, or nil.
+	AccountByEmail(ctx context.Context, email string) (*Account, error)
+	// AccountByID returns the account with the given user ID, or nil.
+	AccountByID(ctx context.Context, id string) (*Account, error)
+	// CreateUserToken stores a token digest, replacing the user's earlier
+	// tokens for the same purpose.
+	CreateUserToken(ctx context.Context, digest, userID, purpose, email string, expiresAt time.Time) error
+	// UserToken returns a token's user and email without using it up, or
+	// empty strings when it is unknown or expired at now.
+	UserToken(ctx context.Context, digest, purpose string, now time.Time) (string, string, error)
+	// ConsumeUserToken deletes a token and returns its user and email, or
+	// empty strings when it is unknown or expired at now.
+	ConsumeUserToken(ctx context.Context, digest, purpose string, now time.Time) (string, string, error)
+	// ResetPassword uses up the user's password reset token, replaces
+	// their password hash and deletes their refresh tokens in one
+	// transaction. It reports false, changing nothing, when the token is
+	// no longer valid at now.
+	ResetPassword(ctx context.Context, digest, userID, passwordHash string, now time.Time) (bool, error)
+	// MarkEmailVerified records that the user verified email.
+	MarkEmailVerified(ctx context.Context, userID, email string, at time.Time) error
+}
+
+// RecoveryConfig configures a Recovery. Zero values use the defaults noted.
+type RecoveryConfig struct {
+	// SiteName names the site in mails; defaults to "ermblog".
+	SiteName string
+	// ResetURL and VerifyURL are the links mailed to users, with {token}
+	// replaced by the token. Both are required.
+	ResetURL  string
+	VerifyURL string
+	// ResetTTL bounds password reset tokens; defaults to 1 hour.
+	ResetTTL time.Duration
+	// VerifyTTL bounds email verification tokens; defaults to 48 hours.
+	VerifyTTL time.Duration
+	// Policy checks new passwords; defaults to DefaultPasswordPolicy.
+	Policy PasswordPolicy
+	// MailTimeout bounds sending a reset mail in the background; defaults
+	// to 30 seconds.
+	MailTimeout time.Duration
+}
+
+// Recovery runs the password reset and email verification flows.
+type Recovery struct {
+	cfg    RecoveryConfig
+	store  RecoveryStore
+	mailer mail.Mailer
+	now    func() time.Time
+	// pending tracks reset mails still being sent.
+	pending sync.WaitGroup
+}
+
+// NewRecovery builds a Recovery.
+func NewRecovery(cfg RecoveryConfig, store RecoveryStore, mailer mail.Mailer) (*Recovery, error) {
+	if store == nil {
+		return nil, errors.New("localauth: recovery store is required")
+	}
+	if mailer == nil {
+		return nil, errors.New("localauth: mailer is required")
+	}
+	if !strings.Contains(cfg.ResetURL, "{token}") || !strings.Contains(cfg.VerifyURL, "{token}") {
+		return nil, errors.New("localauth: reset and verify URLs must contain {token}")
+	}
+	if strings.TrimSpace(cfg.SiteName) == "" {
+		cfg.SiteName = "ermblog"
+	}
+	if cfg.ResetTTL <= 0 {
+		cfg.ResetTTL = time.Hour
+	}
+	if cfg.VerifyTTL <= 0 {
+		cfg.VerifyTTL = 48 * time.Hour
+	}
+	if cfg.Policy.MinLength <= 0 {
+		cfg.Policy = DefaultPasswordPolicy
+	}
+	if cfg.MailTimeout <= 0 {
+		cfg.MailTimeout = 30 * time.Second
+	}
+	return &Recovery{cfg: cfg, store: store, mailer: mailer, now: time.Now}, nil
+}
+
+// PasswordPolicy returns the policy new passwords are checked against.
+func (r *Recovery) PasswordPolicy() PasswordPolicy {
+	return r.cfg.Policy
+}
+
+// RequestPasswordReset mails a reset link to the user with the given email.
+// The lookup and the mail happen in the background, so the call takes as
+// long for unknown addresses as for accounts and callers cannot probe for
+// them. Failures are logged.
+func (r *Recovery) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil
+	}
+	r.pending.Add(1)
+	go func() {
+		defer r.pending.Done()
+		// The request may end before the mail is sent.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.cfg.MailTimeout)
+		defer cancel()
+		if err := r.sendPasswordReset(ctx, email); err != nil {
+			log.Printf("send password reset: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Wait blocks until the reset mails requested so far were sent or gave up.
+func (r *Recovery) Wait() {
+	r.pending.Wait()
+}
+
+func (r *Recovery) sendPasswordReset(ctx context.Context, email string) error {
+	account, err := r.store.AccountByEmail(ctx, email)
+	if err != nil || account == nil {
+		return err
+	}
+	token, err := r.createToken(ctx, account, PurposePasswordReset, r.cfg.ResetTTL)
+	if err != nil {
+		return err
+	}
+	return r.mailer.Send(ctx, mail.Message{
+		To:      account.Email,
+		Subject: "Reset your " + r.cfg.SiteName + " password",
+		Text: "Someone asked to reset the password of your " + r.cfg.SiteName + " account " + account.Username + ".\n\n" +
+			"Open this link within " + formatTTL(r.cfg.ResetTTL) + " to choose a new one:\n" +
+			link(r.cfg.ResetURL, token) + "\n\n" +
+			"If you did not ask for this, ignore this email; your password stays unchanged.\n",
+	})
+}
+
+// ResetPassword sets a new password with a token from RequestPasswordReset
+// and signs the user out everywhere. It returns a *WeakPasswordError for
+// passwords the policy rejects, leaving the token usable, and
+// ErrInvalidToken for unknown, used or expired tokens.
+func (r *Recovery) ResetPassword(ctx context.Context, token, newPassword string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return ErrInvalidToken
+	}
+	userID, email, err := r.store.UserToken(ctx, digest(token), PurposePasswordReset, r.now())
+	if err != nil {
+		return err
+	}
+	if userID == "" {
+		return ErrInvalidToken
+	}
+	account, err := r.store.AccountByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return ErrInvalidToken
+	}
+	if err := r.cfg.Policy.Check(newPassword, account.Username, account.Email); err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	reset, err := r.store.ResetPassword(ctx, digest(token), userID, string(hash), r.now())
+	if err != nil {
+		return err
+	}
+	if !reset {
+		// Used by a concurrent reset or expired while hashing.
+		return ErrInvalidToken
+	}
+	// Following the mailed link proves the address belongs to the user.
+	if strings.EqualFold(email, account.Email) {
+		return r.store.MarkEmailVerified(ctx, userID, account.Email, r.now())
+	}
+	return nil
+}
+
+// SendVerification mails an email verification link to the user.
+func (r *Recovery) SendVerification(ctx context.Context, userID string) error {
+	account, err := r.store.AccountByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if account == nil || strings.TrimSpace(account.Email) == "" {
+		return nil
+	}
+	token, err := r.createToken(ctx, account, PurposeEmailVerification, r.cfg.VerifyTTL)
+	if err != nil {
+		return err
+	}
+	return r.mailer.Send(ctx, mail.Message{
+		To:      account.Email,
+		Subject: "Confirm your " + r.cfg.SiteName + " email address",
+		Text: "Confirm that " + account.Email + " belongs to your " + r.cfg.SiteName + " account " + account.Username + " by opening this link within " + formatTTL(r.cfg.VerifyTTL) + ":\n" +
+			link(r.cfg.VerifyURL, token) + "\n",
+	})
+}
+
+// VerifyEmail marks the address a verification token was sent to as
+// verified and returns the user's ID. It returns ErrInvalidToken for
+// unknown, used or expired tokens and for tokens sent to an address the
+// user no longer has.
+func (r *Recovery) VerifyEmail(ctx context.Context, token string) (string, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", ErrInvalidToken
+	}
+	userID, email, err := r.store.ConsumeUserToken(ctx, digest(token), PurposeEmailVerification, r.now())
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", ErrInvalidToken
+	}
+	account, err := r.store.AccountByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if account == nil || !strings.EqualFold(account.Email, email) {
+		return "", ErrInvalidToken
+	}
+	if err := r.store.MarkEmailVerified(ctx, userID, account.Email, r.now()); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (r *Recovery) createToken(ctx context.Context, account *Account, purpose string, ttl time.Duration) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	if err := r.store.CreateUserToken(ctx, digest(token), account.ID, purpose, account.Email, r.now().Add(ttl)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func link(template, token string) string {
+	return strings.ReplaceAll(template, "{token}", token)
+}
+
+func formatTTL(ttl time.Duration) string {
+	switch {
+	case ttl == time.Hour:
+		return "1 hour"
+	case ttl%time.Hour == 0:
+		return fmt.Sprintf("%d hours", ttl/time.Hour)
+	case ttl%time.Minute == 0:
+		return fmt.Sprintf("%d minutes", ttl/time.Minute)
+	}
+	return ttl.String()
+}
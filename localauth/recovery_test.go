@@ -0,0 +1,297 @@
+package localauth
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/deicod/ermblog/mail"
+)
+
+type tokenRecord struct {
+	userID    string
+	purpose   string
+	email     string
+	expiresAt time.Time
+}
+
+func (s *memoryStore) AccountByEmail(_ context.Context, email string) (*Account, error) {
+	for _, account := range s.accounts {
+		if strings.EqualFold(account.Email, email) {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryStore) CreateUserToken(_ context.Context, digest, userID, purpose, email string, expiresAt time.Time) error {
+	for key, record := range s.tokens {
+		if record.userID == userID && record.purpose == purpose {
+			delete(s.tokens, key)
+		}
+	}
+	s.tokens[digest] = tokenRecord{userID: userID, purpose: purpose, email: email, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *memoryStore) ConsumeUserToken(_ context.Context, digest, purpose string, now time.Time) (string, string, error) {
+	record, ok := s.tokens[digest]
+	if !ok || record.purpose != purpose {
+		return "", "", nil
+	}
+	delete(s.tokens, digest)
+	if !record.expiresAt.After(now) {
+		return "", "", nil
+	}
+	return record.userID, record.email, nil
+}
+
+func (s *memoryStore) UserToken(_ context.Context, digest, purpose string, now time.Time) (string, string, error) {
+	record, ok := s.tokens[digest]
+	if !ok || record.purpose != purpose || !record.expiresAt.After(now) {
+		return "", "", nil
+	}
+	return record.userID, record.email, nil
+}
+
+func (s *memoryStore) ResetPassword(_ context.Context, digest, userID, passwordHash string, now time.Time) (bool, error) {
+	record, ok := s.tokens[digest]
+	if !ok || record.purpose != PurposePasswordReset || record.userID != userID || !record.expiresAt.After(now) {
+		return false, nil
+	}
+	delete(s.tokens, digest)
+	s.accounts[userID].PasswordHash = passwordHash
+	for key, refresh := range s.refresh {
+		if refresh.userID == userID {
+			delete(s.refresh, key)
+		}
+	}
+	return true, nil
+}
+
+func (s *memoryStore) MarkEmailVerified(_ context.Context, userID, email string, _ time.Time) error {
+	s.verified[userID] = email
+	return nil
+}
+
+var tokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+func newTestRecovery(t *testing.T, store RecoveryStore) (*Recovery, *mail.Memory) {
+	t.Helper()
+	mailer := &mail.Memory{}
+	recovery, err := NewRecovery(RecoveryConfig{
+		SiteName:  "Example",
+		ResetURL:  "https://example.com/reset?token={token}",
+		VerifyURL: "https://example.com/verify?token={token}",
+	}, store, mailer)
+	if err != nil {
+		t.Fatalf("new recovery: %v", err)
+	}
+	return recovery, mailer
+}
+
+func mailedToken(t *testing.T, mailer *mail.Memory) string {
+	t.Helper()
+	messages := mailer.Messages()
+	if len(messages) == 0 {
+		t.Fatalf("expected a mail")
+	}
+	match := tokenPattern.FindStringSubmatch(messages[len(messages)-1].Text)
+	if match == nil {
+		t.Fatalf("expected a token link in %q", messages[len(messages)-1].Text)
+	}
+	return match[1]
+}
+
+func TestPasswordResetIsSingleUse(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	service := newTestService(t, store, Config{})
+	recovery, mailer := newTestRecovery(t, store)
+	ctx := context.Background()
+
+	session, err := service.Login(ctx, "alice", "correct horse")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if err := recovery.RequestPasswordReset(ctx, "ALICE@example.com"); err != nil {
+		t.Fatalf("request reset: %v", err)
+	}
+	recovery.Wait()
+	if messages := mailer.Messages(); len(messages) != 1 || messages[0].To != "alice@example.com" {
+		t.Fatalf("expected a reset mail to alice, got %+v", messages)
+	}
+	token := mailedToken(t, mailer)
+	for digest := range store.tokens {
+		if digest == token {
+			t.Fatalf("expected only the token digest to be stored")
+		}
+	}
+
+	var weak *WeakPasswordError
+	if err := recovery.ResetPassword(ctx, token, "short"); !errors.As(err, &weak) {
+		t.Fatalf("expected a weak password error, got %v", err)
+	}
+	if err := recovery.ResetPassword(ctx, token, "alice in wonderland"); !errors.As(err, &weak) {
+		t.Fatalf("expected a password containing the username to be rejected, got %v", err)
+	}
+	if err := recovery.ResetPassword(ctx, token, "battery staple"); err != nil {
+		t.Fatalf("reset password: %v", err)
+	}
+	if err := recovery.ResetPassword(ctx, token, "battery staple"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a used token to be rejected, got %v", err)
+	}
+	if _, err := service.Login(ctx, "alice", "battery staple"); err != nil {
+		t.Fatalf("expected login with the new password, got %v", err)
+	}
+	if _, err := service.Refresh(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected earlier sessions to be revoked, got %v", err)
+	}
+	if store.verified["018f1e2a-0000-7000-8000-000000000001"] != "alice@example.com" {
+		t.Fatalf("expected the reset to verify the email")
+	}
+}
+
+func TestPasswordResetDoesNotRevealAccounts(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	recovery, mailer := newTestRecovery(t, store)
+
+	if err := recovery.RequestPasswordReset(context.Background(), "nobody@example.com"); err != nil {
+		t.Fatalf("expected unknown emails to succeed silently, got %v", err)
+	}
+	recovery.Wait()
+	if len(mailer.Messages()) != 0 || len(store.tokens) != 0 {
+		t.Fatalf("expected no mail or token for an unknown email")
+	}
+}
+
+// blockingMailer holds every mail until release is closed.
+type blockingMailer struct {
+	release chan struct{}
+	mail.Memory
+}
+
+func (m *blockingMailer) Send(ctx context.Context, msg mail.Message) error {
+	select {
+	case <-m.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return m.Memory.Send(ctx, msg)
+}
+
+func TestPasswordResetMailsInTheBackground(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	mailer := &blockingMailer{release: make(chan struct{})}
+	recovery, err := NewRecovery(RecoveryConfig{
+		ResetURL:  "https://example.com/reset?token={token}",
+		VerifyURL: "https://example.com/verify?token={token}",
+	}, store, mailer)
+	if err != nil {
+		t.Fatalf("new recovery: %v", err)
+	}
+
+	// Both calls return while the mail to alice is still held, so an
+	// existing account does not take longer to answer than an unknown one.
+	for _, email := range []string{"alice@example.com", "nobody@example.com"} {
+		if err := recovery.RequestPasswordReset(context.Background(), email); err != nil {
+			t.Fatalf("request reset for %s: %v", email, err)
+		}
+	}
+	close(mailer.release)
+	recovery.Wait()
+	if messages := mailer.Messages(); len(messages) != 1 || messages[0].To != "alice@example.com" {
+		t.Fatalf("expected one reset mail to alice, got %+v", messages)
+	}
+}
+
+func TestPasswordResetTokensExpire(t *testing.T) {
+	store := newMemoryStore(testAccount(t, "correct horse"))
+	recovery, mailer := newTestRecovery(t, store)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	recovery.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := recovery.RequestPasswordReset(ctx, "alice@example.com"); err != nil {
+		t.Fatalf("request reset: %v", err)
+	}
+	recovery.Wait()
+	first := mailedToken(t, mailer)
+	if err := recovery.RequestPasswordReset(ctx, "alice@example.com"); err != nil {
+		t.Fatalf("request reset: %v", err)
+	}
+	recovery.Wait()
+	second := mailedToken(t, mailer)
+	if err := recovery.ResetPassword(ctx, first, "battery staple"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a replaced token to be rejected, got %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := recovery.ResetPassword(ctx, second, "battery staple"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected an expired token to be rejected, got %v", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(store.accounts["018f1e2a-0000-7000-8000-000000000001"].PasswordHash), []byte("correct horse")); err != nil {
+		t.Fatalf("expected the password to stay unchanged")
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	account := testAccount(t, "correct horse")
+	store := newMemoryStore(account)
+	recovery, mailer := newTestRecovery(t, store)
+	ctx := context.Background()
+
+	if err := recovery.SendVerification(ctx, account.ID); err != nil {
+		t.Fatalf("send verification: %v", err)
+	}
+	token := mailedToken(t, mailer)
+	if !strings.Contains(mailer.Messages()[0].Text, "https://example.com/verify?token=") {
+		t.Fatalf("expected the verify link, got %q", mailer.Messages()[0].Text)
+	}
+	if err := recovery.ResetPassword(ctx, token, "battery staple"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a verification token to be rejected for resets, got %v", err)
+	}
+	userID, err := recovery.VerifyEmail(ctx, token)
+	if err != nil || userID != account.ID {
+		t.Fatalf("expected the email to verify, got %q, %v", userID, err)
+	}
+	if store.verified[account.ID] != "alice@example.com" {
+		t.Fatalf("expected the verification to be stored, got %v", store.verified)
+	}
+	if _, err := recovery.VerifyEmail(ctx, token); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a used token to be rejected, got %v", err)
+	}
+}
+
+func TestVerifyEmailRejectsChangedAddress(t *testing.T) {
+	account := testAccount(t, "correct horse")
+	store := newMemoryStore(account)
+	recovery, mailer := newTestRecovery(t, store)
+	ctx := context.Background()
+
+	if err := recovery.SendVerification(ctx, account.ID); err != nil {
+		t.Fatalf("send verification: %v", err)
+	}
+	token := mailedToken(t, mailer)
+	account.Email = "alice@example.org"
+	if _, err := recovery.VerifyEmail(ctx, token); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected a token for the old address to be rejected, got %v", err)
+	}
+	if len(store.verified) != 0 {
+		t.Fatalf("expected nothing to be verified")
+	}
+}
+
+func TestVerificationCovers(t *testing.T) {
+	v := Verification{Email: "Alice@example.com"}
+	if !v.Covers(" alice@EXAMPLE.com") {
+		t.Fatalf("expected a case-insensitive match")
+	}
+	if v.Covers("alice@example.org") || (Verification{}).Covers("") {
+		t.Fatalf("expected other or empty addresses not to match")
+	}
+}
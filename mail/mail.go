@@ -0,0 +1,156 @@
+// Package mail sends transactional email such as password reset links.
+// Senders depend on the Mailer interface; SMTPMailer delivers through a
+// relay, LogMailer writes messages to the log for development and Memory
+// keeps them for tests.
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// Mailer delivers messages.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogMailer writes messages to a logger instead of sending them. Use it
+// only in development: the log then contains reset and verification links.
+type LogMailer struct {
+	// Logger defaults to the standard logger.
+	Logger *log.Logger
+}
+
+// Send logs msg.
+func (m LogMailer) Send(_ context.Context, msg Message) error {
+	logger := m.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+	logger.Printf("mail to %s: %s\n%s", msg.To, msg.Subject, msg.Text)
+	return nil
+}
+
+// SMTPMailer sends messages through an SMTP relay, using STARTTLS when the
+// server offers it.
+type SMTPMailer struct {
+	// Addr is the relay's host:port.
+	Addr string
+	From string
+	// Username and Password enable PLAIN authentication when set.
+	Username string
+	Password string
+}
+
+// Send delivers msg, giving up when ctx is done.
+func (m SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if m.Addr == "" || m.From == "" {
+		return errors.New("mail: smtp address and sender are required")
+	}
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return errors.New("mail: header values must not contain line breaks")
+	}
+	host, _, err := net.SplitHostPort(m.Addr)
+	if err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.Addr)
+	if err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	defer conn.Close()
+	// net/smtp has no context support; expiring the connection's deadline
+	// unblocks whatever exchange is in progress.
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err := m.deliver(conn, host, msg); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("mail: %w", ctxErr)
+		}
+		return fmt.Errorf("mail: %w", err)
+	}
+	return nil
+}
+
+func (m SMTPMailer) deliver(conn net.Conn, host string, msg Message) error {
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if m.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.Username, m.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(m.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	body := "From: " + m.From + "\r\n" +
+		"To: " + msg.To + "\r\n" +
+		"Subject: " + msg.Subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(msg.Text, "\n", "\r\n")
+	if _, err := w.Write([]byte(body)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// Memory records messages instead of sending them.
+type Memory struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// Send records msg.
+func (m *Memory) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the recorded messages, oldest first.
+func (m *Memory) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.messages...)
+}
@@ -0,0 +1,72 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryRecordsMessages(t *testing.T) {
+	var outbox Memory
+	if err := outbox.Send(context.Background(), Message{To: "a@example.com", Subject: "Hi", Text: "Hello"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	messages := outbox.Messages()
+	if len(messages) != 1 || messages[0].To != "a@example.com" {
+		t.Fatalf("unexpected messages: %+v", messages)
+	}
+}
+
+func TestLogMailerWritesMessage(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := LogMailer{Logger: log.New(&buf, "", 0)}
+	if err := mailer.Send(context.Background(), Message{To: "a@example.com", Subject: "Reset", Text: "link"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if !strings.Contains(buf.String(), "a@example.com: Reset") {
+		t.Fatalf("unexpected log output %q", buf.String())
+	}
+}
+
+func TestSMTPMailerRejectsHeaderInjection(t *testing.T) {
+	mailer := SMTPMailer{Addr: "localhost:25", From: "blog@example.com"}
+	err := mailer.Send(context.Background(), Message{To: "a@example.com\r\nBcc: b@example.com", Subject: "Hi"})
+	if err == nil {
+		t.Fatal("expected line breaks in headers to be rejected")
+	}
+}
+
+func TestSMTPMailerGivesUpWhenContextEnds(t *testing.T) {
+	// The listener accepts connections but never greets, like a stuck relay.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer listener.Close()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		if conn, err := listener.Accept(); err == nil {
+			accepted <- conn
+		}
+	}()
+	defer func() {
+		select {
+		case conn := <-accepted:
+			conn.Close()
+		default:
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	mailer := SMTPMailer{Addr: listener.Addr().String(), From: "blog@example.com"}
+	err = mailer.Send(ctx, Message{To: "a@example.com", Subject: "Hi", Text: "Hello"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the send to stop at the deadline, got %v", err)
+	}
+}
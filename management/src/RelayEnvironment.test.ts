@@ -237,6 +237,24 @@ describe("createFetchFn", () => {
       globalThis.fetch = previousFetch;
     }
   });
+
+  it("sends only the persisted query hash for persisted operations", async () => {
+    const fetchSpy = vi.fn().mockResolvedValue(okResponse({ data: { ok: true } }));
+
+    const fetchFn = createFetchFn({
+      endpoint: "https://example.test/graphql",
+      fetchImplementation: fetchSpy as unknown as typeof fetch,
+      maxRetries: 0,
+    });
+
+    await fetchFn({ id: "abc123", text: null, name: "Example" } as never, variables);
+
+    const [, init] = fetchSpy.mock.calls[0] as [string, RequestInit];
+    expect(JSON.parse(init.body as string)).toEqual({
+      variables,
+      extensions: { persistedQuery: { version: 1, sha256Hash: "abc123" } },
+    });
+  });
 });
 
 describe("createSubscribeFn", () => {
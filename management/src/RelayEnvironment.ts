@@ -91,6 +91,30 @@ async function delay(ms: number): Promise<void> {
   await new Promise((resolve) => setTimeout(resolve, ms));
 }
 
+/**
+ * Persisted operations carry only their hash; the server resolves it from the
+ * allow-list generated by the Relay compiler (`persistConfig`).
+ */
+export function buildRequestBody(
+  request: RequestParameters,
+  variables: Variables,
+): Record<string, unknown> {
+  if (request.id) {
+    return {
+      ...(request.text ? { query: request.text } : {}),
+      variables,
+      extensions: {
+        persistedQuery: { version: 1, sha256Hash: request.id },
+      },
+    };
+  }
+
+  return {
+    query: request.text,
+    variables,
+  };
+}
+
 async function parseGraphQLResponse(
   response: Response,
 ): Promise<Record<string, unknown>> {
@@ -208,7 +232,7 @@ export const createFetchFn = (
   const totalAttempts = Math.max(0, maxRetries) + 1;
 
   return async (request, variables) => {
-    if (!request.text) {
+    if (!request.text && !request.id) {
       throw new RelayNetworkError("The Relay request is missing a GraphQL query.");
     }
 
@@ -253,10 +277,7 @@ export const createFetchFn = (
         const response = await activeFetch(endpoint, {
           method: "POST",
           headers,
-          body: JSON.stringify({
-            query: request.text,
-            variables,
-          }),
+          body: JSON.stringify(buildRequestBody(request, variables)),
         });
 
         return await parseGraphQLResponse(response);
@@ -388,15 +409,23 @@ export const createSubscribeFn = (
     _cacheConfig: CacheConfig,
     observer?: LegacyObserver<GraphQLResponse>,
   ) => {
-    const queryText = ensureGraphQLText(request);
     const activeClient = getClient();
 
     const cleanup = activeClient.subscribe(
-      {
-        query: queryText,
-        operationName: request.name,
-        variables,
-      },
+      request.id
+        ? {
+            query: request.text ?? "",
+            operationName: request.name,
+            variables,
+            extensions: {
+              persistedQuery: { version: 1, sha256Hash: request.id },
+            },
+          }
+        : {
+            query: ensureGraphQLText(request),
+            operationName: request.name,
+            variables,
+          },
       forwardToObserver(observer),
     );
 
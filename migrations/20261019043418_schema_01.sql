@@ -0,0 +1,13 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: create_table slug_histories
+CREATE TABLE slug_histories (
+    id uuid NOT NULL,
+    entity_type text NOT NULL,
+    entity_id uuid NOT NULL,
+    slug text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
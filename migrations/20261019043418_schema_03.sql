@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index slug_histories_entity_type_slug
+CREATE INDEX IF NOT EXISTS slug_histories_entity_type_slug ON slug_histories (entity_type, slug);
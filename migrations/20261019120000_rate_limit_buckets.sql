@@ -0,0 +1,7 @@
+-- Token buckets shared by API replicas when ratelimit.store is "postgres".
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key text PRIMARY KEY,
+    tokens double precision NOT NULL,
+    allowed boolean NOT NULL,
+    updated_at timestamptz NOT NULL
+);
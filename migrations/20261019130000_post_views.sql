@@ -0,0 +1,18 @@
+-- Raw page views written by the /track beacon. Rows are moved into
+-- post_view_rollups once their hour has passed.
+CREATE TABLE IF NOT EXISTS post_views (
+    id bigserial PRIMARY KEY,
+    post_id uuid NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    visitor_hash text NOT NULL,
+    viewed_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS post_views_viewed_at ON post_views (viewed_at);
+
+CREATE TABLE IF NOT EXISTS post_view_rollups (
+    post_id uuid NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    hour timestamptz NOT NULL,
+    views integer NOT NULL,
+    visitors integer NOT NULL,
+    PRIMARY KEY (post_id, hour)
+);
+CREATE INDEX IF NOT EXISTS post_view_rollups_hour ON post_view_rollups (hour);
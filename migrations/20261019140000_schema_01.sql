@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column categories.position
+ALTER TABLE categories ADD COLUMN position integer NOT NULL DEFAULT 0;
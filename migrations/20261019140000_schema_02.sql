@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index categories_parent_id_position
+CREATE INDEX IF NOT EXISTS categories_parent_id_position ON categories (parent_id, position);
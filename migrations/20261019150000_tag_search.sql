@@ -0,0 +1,5 @@
+-- Indexes backing tag suggestions in the post editor: text_pattern_ops for
+-- case-insensitive prefix matches and a trigram index for fuzzy matches.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS tags_lower_name_prefix ON tags (lower(name) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS tags_lower_name_trgm ON tags USING gin (lower(name) gin_trgm_ops);
@@ -0,0 +1,16 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: create_table content_types
+CREATE TABLE content_types (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    label text NOT NULL,
+    plural_label text NOT NULL,
+    description text,
+    supports jsonb,
+    fields jsonb,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
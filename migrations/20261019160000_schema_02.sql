@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index content_types_name_key
+CREATE UNIQUE INDEX IF NOT EXISTS content_types_name_key ON content_types (name);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column posts.content_type_id
+ALTER TABLE posts ADD COLUMN content_type_id uuid;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_foreign_key posts.fk_posts_content_type_id
+ALTER TABLE posts ADD CONSTRAINT fk_posts_content_type_id FOREIGN KEY (content_type_id) REFERENCES content_types (id);
@@ -0,0 +1,17 @@
+-- Custom field values of posts, validated against the fields schema of the
+-- post's content type. Typed columns are derived from the JSON value so
+-- filters compare numbers numerically; only keys the content type marks
+-- "x-indexed" are flagged and covered by the partial indexes.
+CREATE TABLE IF NOT EXISTS post_meta (
+    post_id uuid NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    key text NOT NULL,
+    value jsonb NOT NULL,
+    value_text text GENERATED ALWAYS AS (CASE WHEN jsonb_typeof(value) = 'string' THEN value #>> '{}' END) STORED,
+    value_number double precision GENERATED ALWAYS AS (CASE WHEN jsonb_typeof(value) = 'number' THEN (value #>> '{}')::double precision END) STORED,
+    value_bool boolean GENERATED ALWAYS AS (CASE WHEN jsonb_typeof(value) = 'boolean' THEN (value #>> '{}')::boolean END) STORED,
+    indexed boolean NOT NULL DEFAULT false,
+    PRIMARY KEY (post_id, key)
+);
+CREATE INDEX IF NOT EXISTS post_meta_key_text ON post_meta (key, value_text) WHERE indexed;
+CREATE INDEX IF NOT EXISTS post_meta_key_number ON post_meta (key, value_number) WHERE indexed;
+CREATE INDEX IF NOT EXISTS post_meta_key_bool ON post_meta (key, value_bool) WHERE indexed;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column posts.parent_id
+ALTER TABLE posts ADD COLUMN parent_id uuid;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column posts.menu_order
+ALTER TABLE posts ADD COLUMN menu_order integer NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index posts_parent_id_menu_order
+CREATE INDEX IF NOT EXISTS posts_parent_id_menu_order ON posts (parent_id, menu_order);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_foreign_key posts.fk_posts_parent_id
+ALTER TABLE posts ADD CONSTRAINT fk_posts_parent_id FOREIGN KEY (parent_id) REFERENCES posts (id) ON DELETE SET NULL;
@@ -0,0 +1,13 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: create_table menus
+CREATE TABLE menus (
+    id uuid NOT NULL,
+    name text NOT NULL,
+    location text,
+    description text,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index menus_location_key
+CREATE UNIQUE INDEX IF NOT EXISTS menus_location_key ON menus (location);
@@ -0,0 +1,17 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: create_table menu_items
+CREATE TABLE menu_items (
+    id uuid NOT NULL,
+    menu_id uuid NOT NULL,
+    parent_id uuid,
+    position integer NOT NULL DEFAULT 0,
+    kind text NOT NULL DEFAULT 'custom' CONSTRAINT menu_items_kind_enum_check CHECK (kind IN ('post', 'page', 'category', 'tag', 'custom')),
+    target_id uuid,
+    label text,
+    url text,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
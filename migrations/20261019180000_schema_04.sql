@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index menu_items_kind_target_id
+CREATE INDEX IF NOT EXISTS menu_items_kind_target_id ON menu_items (kind, target_id);
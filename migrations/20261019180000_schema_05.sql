@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index menu_items_menu_id_parent_id_position
+CREATE INDEX IF NOT EXISTS menu_items_menu_id_parent_id_position ON menu_items (menu_id, parent_id, position);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_foreign_key menu_items.fk_menu_items_menu_id
+ALTER TABLE menu_items ADD CONSTRAINT fk_menu_items_menu_id FOREIGN KEY (menu_id) REFERENCES menus (id);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_foreign_key menu_items.fk_menu_items_parent_id
+ALTER TABLE menu_items ADD CONSTRAINT fk_menu_items_parent_id FOREIGN KEY (parent_id) REFERENCES menu_items (id) ON DELETE CASCADE;
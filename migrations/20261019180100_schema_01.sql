@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: drop_foreign_key menu_items.fk_menu_items_menu_id
+ALTER TABLE menu_items DROP CONSTRAINT IF EXISTS fk_menu_items_menu_id;
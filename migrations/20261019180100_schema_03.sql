@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: drop_foreign_key posts.fk_posts_featured_media_id
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS fk_posts_featured_media_id;
@@ -0,0 +1,13 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: create_table user_metas
+CREATE TABLE user_metas (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    key text NOT NULL,
+    value jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
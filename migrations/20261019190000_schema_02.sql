@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index user_metas_user_id_key
+CREATE UNIQUE INDEX IF NOT EXISTS user_metas_user_id_key ON user_metas (user_id, key);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_foreign_key user_metas.fk_user_metas_user_id
+ALTER TABLE user_metas ADD CONSTRAINT fk_user_metas_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
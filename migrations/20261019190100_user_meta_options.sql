@@ -0,0 +1,14 @@
+-- Notification preferences used to live in options named
+-- notification_preferences:<user id>. Move those belonging to existing users
+-- into user_metas; options of subjects without a user row stay behind.
+WITH moved AS (
+    INSERT INTO user_metas (id, user_id, key, value, created_at, updated_at)
+    SELECT gen_random_uuid(), u.id, 'notification_preferences', o.value, o.created_at, o.updated_at
+    FROM options o
+    JOIN users u ON u.id::text = lower(substr(o.name, length('notification_preferences:') + 1))
+    WHERE o.name LIKE 'notification\_preferences:%' AND o.value IS NOT NULL
+    ON CONFLICT (user_id, key) DO NOTHING
+    RETURNING user_id
+)
+DELETE FROM options o USING moved
+WHERE lower(o.name) = 'notification_preferences:' || moved.user_id::text;
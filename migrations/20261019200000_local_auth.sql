@@ -0,0 +1,19 @@
+-- Failed password logins per normalised login name. Rows exist for names
+-- without a user too, so lockouts do not reveal which accounts exist.
+CREATE TABLE IF NOT EXISTS login_attempts (
+    login text PRIMARY KEY,
+    failures integer NOT NULL DEFAULT 0,
+    locked_until timestamptz,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Refresh tokens of local sessions, stored as SHA-256 hex digests. Each is
+-- used once: refreshing deletes it and issues a new one.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_hash text PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_user_id_idx ON refresh_tokens (user_id);
@@ -0,0 +1,13 @@
+-- Single-use tokens mailed to users for password resets and email
+-- verification, stored as SHA-256 hex digests. email is the address the
+-- token was sent to, so verifying it does not vouch for a later address.
+CREATE TABLE IF NOT EXISTS user_tokens (
+    token_hash text PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    purpose text NOT NULL,
+    email text NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS user_tokens_user_id_purpose_idx ON user_tokens (user_id, purpose);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column comments.deleted_at
+ALTER TABLE comments ADD COLUMN deleted_at timestamptz;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column medias.deleted_at
+ALTER TABLE medias ADD COLUMN deleted_at timestamptz;
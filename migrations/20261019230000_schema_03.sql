@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_column posts.deleted_at
+ALTER TABLE posts ADD COLUMN deleted_at timestamptz;
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index comments_deleted_at
+CREATE INDEX IF NOT EXISTS comments_deleted_at ON comments (deleted_at);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index media_deleted_at
+CREATE INDEX IF NOT EXISTS media_deleted_at ON medias (deleted_at);
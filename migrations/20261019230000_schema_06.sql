@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index posts_deleted_at
+CREATE INDEX IF NOT EXISTS posts_deleted_at ON posts (deleted_at);
@@ -0,0 +1,5 @@
+-- Code generated by erm.
+-- Schema migration.
+
+-- step 1: add_index api_keys_user_id_idx
+CREATE INDEX IF NOT EXISTS api_keys_user_id_idx ON api_keys (user_id);
@@ -0,0 +1,31 @@
+-- Rejects parent_id changes that would make a category its own ancestor.
+-- The check runs under the category tree advisory lock (lockCategories in
+-- orm/gen/tx_custom.go), so two concurrent moves cannot each pass it and
+-- together close a cycle. The lock is held until the transaction ends.
+CREATE OR REPLACE FUNCTION categories_prevent_cycle() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(435711442946);
+    IF NEW.parent_id = NEW.id OR EXISTS (
+        WITH RECURSIVE chain AS (
+            SELECT c.id, c.parent_id, ARRAY[c.id] AS seen FROM categories c WHERE c.id = NEW.parent_id
+            UNION ALL
+            SELECT c.id, c.parent_id, chain.seen || c.id
+            FROM categories c JOIN chain ON c.id = chain.parent_id
+            WHERE NOT c.id = ANY(chain.seen)
+        )
+        SELECT 1 FROM chain WHERE chain.id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'category cannot be moved beneath itself or its descendants'
+            USING ERRCODE = 'check_violation', TABLE = 'categories', COLUMN = 'parent_id',
+                  CONSTRAINT = 'categories_parent_id_cycle';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS categories_prevent_cycle ON categories;
+CREATE TRIGGER categories_prevent_cycle
+    BEFORE UPDATE OF parent_id ON categories
+    FOR EACH ROW
+    WHEN (NEW.parent_id IS NOT NULL AND NEW.parent_id IS DISTINCT FROM OLD.parent_id)
+    EXECUTE FUNCTION categories_prevent_cycle();
@@ -0,0 +1,31 @@
+-- Rejects parent_id changes that would make a page its own ancestor.
+-- The check runs under the page tree advisory lock (lockPages in
+-- orm/gen/tx_custom.go), so two concurrent updates cannot each pass it and
+-- together close a cycle. The lock is held until the transaction ends.
+CREATE OR REPLACE FUNCTION posts_prevent_cycle() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(435711442947);
+    IF NEW.parent_id = NEW.id OR EXISTS (
+        WITH RECURSIVE chain AS (
+            SELECT p.id, p.parent_id, ARRAY[p.id] AS seen FROM posts p WHERE p.id = NEW.parent_id
+            UNION ALL
+            SELECT p.id, p.parent_id, chain.seen || p.id
+            FROM posts p JOIN chain ON p.id = chain.parent_id
+            WHERE NOT p.id = ANY(chain.seen)
+        )
+        SELECT 1 FROM chain WHERE chain.id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'page cannot be nested beneath itself or its descendants'
+            USING ERRCODE = 'check_violation', TABLE = 'posts', COLUMN = 'parent_id',
+                  CONSTRAINT = 'posts_parent_id_cycle';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS posts_prevent_cycle ON posts;
+CREATE TRIGGER posts_prevent_cycle
+    BEFORE UPDATE OF parent_id ON posts
+    FOR EACH ROW
+    WHEN (NEW.parent_id IS NOT NULL AND NEW.parent_id IS DISTINCT FROM OLD.parent_id)
+    EXECUTE FUNCTION posts_prevent_cycle();
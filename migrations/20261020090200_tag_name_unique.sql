@@ -0,0 +1,39 @@
+-- Tag names are unique regardless of case, so tags created by name from post
+-- mutations cannot be duplicated by concurrent saves. Existing duplicates
+-- are merged into the oldest tag of each name first, the way mergeTags
+-- does: their post links move to it and their slugs are kept as history.
+WITH d AS (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS keeper FROM tags
+)
+INSERT INTO post_tags (post_id, tag_id)
+SELECT pt.post_id, d.keeper FROM post_tags pt JOIN d ON d.id = pt.tag_id
+WHERE d.id <> d.keeper
+ON CONFLICT DO NOTHING;
+
+WITH d AS (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS keeper FROM tags
+)
+UPDATE slug_histories h SET entity_id = d.keeper, updated_at = now()
+FROM d
+WHERE h.entity_type = 'Tag' AND h.entity_id = d.id AND d.id <> d.keeper;
+
+WITH d AS (
+    SELECT id, slug, first_value(id) OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS keeper FROM tags
+)
+INSERT INTO slug_histories (id, entity_type, entity_id, slug, created_at, updated_at)
+SELECT gen_random_uuid(), 'Tag', d.keeper, d.slug, now(), now() FROM d
+WHERE d.id <> d.keeper;
+
+WITH d AS (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS keeper FROM tags
+)
+DELETE FROM post_tags pt USING d
+WHERE pt.tag_id = d.id AND d.id <> d.keeper;
+
+WITH d AS (
+    SELECT id, first_value(id) OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS keeper FROM tags
+)
+DELETE FROM tags t USING d
+WHERE t.id = d.id AND d.id <> d.keeper;
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_lower_name_key ON tags (lower(name));
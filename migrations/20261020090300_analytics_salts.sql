@@ -0,0 +1,8 @@
+-- Random salt the /track beacon hashes visitors with, one row per UTC day so
+-- every replica hashes alike. Rows of past days are deleted as soon as a
+-- later day's salt is drawn, after which that day's hashes cannot be
+-- recomputed.
+CREATE TABLE IF NOT EXISTS analytics_salts (
+    day date PRIMARY KEY,
+    salt bytea NOT NULL
+);
@@ -0,0 +1,8 @@
+-- Persisted query allow-list shared by API replicas: operations from the
+-- manifest and from registerPersistedQueries, keyed by the lowercase
+-- SHA-256 hex digest of their text.
+CREATE TABLE IF NOT EXISTS persisted_queries (
+    hash text PRIMARY KEY,
+    query text NOT NULL,
+    created_at timestamptz NOT NULL
+);
@@ -6,6 +6,11 @@ import "time"
 type Collector interface {
 	RecordDataloaderBatch(name string, size int, duration time.Duration)
 	RecordQuery(table string, operation string, duration time.Duration, err error)
+	RecordOperation(name string, operationType string, complexity int, duration time.Duration, err error)
+	RecordResolver(object string, field string, duration time.Duration, err error)
+	RecordRejection(operation string, operationType string, reason string)
+	RecordCacheLookup(hit bool)
+	RecordCacheInvalidation(entity string, evicted int)
 }
 
 // NoopCollector discards all metrics.
@@ -17,6 +22,21 @@ func (NoopCollector) RecordDataloaderBatch(string, int, time.Duration) {}
 // RecordQuery implements Collector.
 func (NoopCollector) RecordQuery(string, string, time.Duration, error) {}
 
+// RecordOperation implements Collector.
+func (NoopCollector) RecordOperation(string, string, int, time.Duration, error) {}
+
+// RecordResolver implements Collector.
+func (NoopCollector) RecordResolver(string, string, time.Duration, error) {}
+
+// RecordRejection implements Collector.
+func (NoopCollector) RecordRejection(string, string, string) {}
+
+// RecordCacheLookup implements Collector.
+func (NoopCollector) RecordCacheLookup(bool) {}
+
+// RecordCacheInvalidation implements Collector.
+func (NoopCollector) RecordCacheInvalidation(string, int) {}
+
 // MultiCollector fan-outs events to multiple collectors.
 type MultiCollector []Collector
 
@@ -40,6 +60,56 @@ func (mc MultiCollector) RecordQuery(table string, operation string, duration ti
 	}
 }
 
+// RecordOperation implements Collector.
+func (mc MultiCollector) RecordOperation(name string, operationType string, complexity int, duration time.Duration, err error) {
+	for _, c := range mc {
+		if c == nil {
+			continue
+		}
+		c.RecordOperation(name, operationType, complexity, duration, err)
+	}
+}
+
+// RecordResolver implements Collector.
+func (mc MultiCollector) RecordResolver(object string, field string, duration time.Duration, err error) {
+	for _, c := range mc {
+		if c == nil {
+			continue
+		}
+		c.RecordResolver(object, field, duration, err)
+	}
+}
+
+// RecordRejection implements Collector.
+func (mc MultiCollector) RecordRejection(operation string, operationType string, reason string) {
+	for _, c := range mc {
+		if c == nil {
+			continue
+		}
+		c.RecordRejection(operation, operationType, reason)
+	}
+}
+
+// RecordCacheLookup implements Collector.
+func (mc MultiCollector) RecordCacheLookup(hit bool) {
+	for _, c := range mc {
+		if c == nil {
+			continue
+		}
+		c.RecordCacheLookup(hit)
+	}
+}
+
+// RecordCacheInvalidation implements Collector.
+func (mc MultiCollector) RecordCacheInvalidation(entity string, evicted int) {
+	for _, c := range mc {
+		if c == nil {
+			continue
+		}
+		c.RecordCacheInvalidation(entity, evicted)
+	}
+}
+
 // WithCollector returns a collector that fans out to all provided collectors.
 func WithCollector(primary Collector, others ...Collector) Collector {
 	collectors := make([]Collector, 0, 1+len(others))
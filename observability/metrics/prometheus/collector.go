@@ -3,6 +3,8 @@ package prometheus
 import (
 	"errors"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	prom "github.com/prometheus/client_golang/prometheus"
@@ -13,13 +15,23 @@ const (
 	namespace           = "ermblog"
 	dataloaderSubsystem = "graphql_dataloader"
 	ormSubsystem        = "orm"
+	graphqlSubsystem    = "graphql"
+
+	// DefaultMaxOperationNames bounds the distinct operation name labels.
+	DefaultMaxOperationNames = 200
+	// AnonymousOperation labels operations sent without a name.
+	AnonymousOperation = "anonymous"
+	// OverflowOperation labels named operations seen after the limit is reached.
+	OverflowOperation = "other"
 )
 
 type config struct {
-	registerer       prom.Registerer
-	gatherer         prom.Gatherer
-	durationBuckets  []float64
-	batchSizeBuckets []float64
+	registerer        prom.Registerer
+	gatherer          prom.Gatherer
+	durationBuckets   []float64
+	batchSizeBuckets  []float64
+	complexityBuckets []float64
+	maxOperationNames int
 }
 
 // Option configures a Collector during construction.
@@ -57,6 +69,26 @@ func WithBatchSizeBuckets(buckets []float64) Option {
 	}
 }
 
+// WithComplexityBuckets overrides the default histogram buckets for operation complexity.
+func WithComplexityBuckets(buckets []float64) Option {
+	return func(cfg *config) {
+		if len(buckets) > 0 {
+			cfg.complexityBuckets = append([]float64(nil), buckets...)
+		}
+	}
+}
+
+// WithMaxOperationNames caps the distinct operation names used as labels.
+// Clients choose operation names, so once the cap is reached further names are
+// reported as OverflowOperation to keep series cardinality bounded.
+func WithMaxOperationNames(n int) Option {
+	return func(cfg *config) {
+		if n > 0 {
+			cfg.maxOperationNames = n
+		}
+	}
+}
+
 // Collector publishes metrics compatible with the metrics.Collector interface using Prometheus primitives.
 type Collector struct {
 	registerer prom.Registerer
@@ -69,13 +101,30 @@ type Collector struct {
 
 	queryDuration *prom.HistogramVec
 	queryCounter  *prom.CounterVec
+
+	operationDuration   *prom.HistogramVec
+	operationCounter    *prom.CounterVec
+	operationComplexity *prom.HistogramVec
+	resolverDuration    *prom.HistogramVec
+	resolverCounter     *prom.CounterVec
+	rejectionCounter    *prom.CounterVec
+
+	cacheLookups       *prom.CounterVec
+	cacheInvalidations *prom.CounterVec
+	cacheEvictions     *prom.CounterVec
+
+	operationsMu      sync.Mutex
+	operations        map[string]struct{}
+	maxOperationNames int
 }
 
 // New constructs a Collector registered against a Prometheus registry.
 func New(opts ...Option) (*Collector, error) {
 	cfg := config{
-		durationBuckets:  prom.DefBuckets,
-		batchSizeBuckets: []float64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+		durationBuckets:   prom.DefBuckets,
+		batchSizeBuckets:  []float64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+		complexityBuckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000},
+		maxOperationNames: DefaultMaxOperationNames,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -96,7 +145,12 @@ func New(opts ...Option) (*Collector, error) {
 		return nil, errors.New("prometheus collector requires a gatherer")
 	}
 
-	c := &Collector{registerer: cfg.registerer, gatherer: cfg.gatherer}
+	c := &Collector{
+		registerer:        cfg.registerer,
+		gatherer:          cfg.gatherer,
+		operations:        make(map[string]struct{}),
+		maxOperationNames: cfg.maxOperationNames,
+	}
 	c.batchDuration = prom.NewHistogramVec(prom.HistogramOpts{
 		Namespace: namespace,
 		Subsystem: dataloaderSubsystem,
@@ -132,66 +186,128 @@ func New(opts ...Option) (*Collector, error) {
 		Help:      "Total ORM queries executed, labeled by status.",
 	}, []string{"table", "operation", "status"})
 
-	if err := cfg.registerer.Register(c.batchDuration); err != nil {
-		if are, ok := err.(prom.AlreadyRegisteredError); ok {
-			if hist, ok := are.ExistingCollector.(*prom.HistogramVec); ok {
-				c.batchDuration = hist
-			} else {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+	c.operationDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of GraphQL operations segmented by operation name and type.",
+		Buckets:   cfg.durationBuckets,
+	}, []string{"operation", "type"})
+	c.operationCounter = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "operations_total",
+		Help:      "Total GraphQL operations executed, labeled by status.",
+	}, []string{"operation", "type", "status"})
+	c.operationComplexity = prom.NewHistogramVec(prom.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "operation_complexity",
+		Help:      "Calculated complexity of GraphQL operations.",
+		Buckets:   cfg.complexityBuckets,
+	}, []string{"operation", "type"})
+	c.resolverDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "resolver_duration_seconds",
+		Help:      "Latency of GraphQL field resolvers segmented by parent type and field.",
+		Buckets:   cfg.durationBuckets,
+	}, []string{"object", "field"})
+	c.resolverCounter = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "resolvers_total",
+		Help:      "Total GraphQL field resolver calls, labeled by status.",
+	}, []string{"object", "field", "status"})
+	c.rejectionCounter = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "rejected_operations_total",
+		Help:      "GraphQL operations rejected before execution, labeled by reason.",
+	}, []string{"operation", "type", "reason"})
+
+	c.cacheLookups = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "response_cache_lookups_total",
+		Help:      "Response cache lookups for anonymous queries, labeled by hit or miss.",
+	}, []string{"result"})
+	c.cacheInvalidations = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "response_cache_invalidations_total",
+		Help:      "Entity change events that invalidated the response cache.",
+	}, []string{"entity"})
+	c.cacheEvictions = prom.NewCounterVec(prom.CounterOpts{
+		Namespace: namespace,
+		Subsystem: graphqlSubsystem,
+		Name:      "response_cache_evicted_entries_total",
+		Help:      "Cached responses dropped because an entity they read changed.",
+	}, []string{"entity"})
+
+	var err error
+	if c.batchDuration, err = register(cfg.registerer, c.batchDuration); err != nil {
+		return nil, err
 	}
-	if err := cfg.registerer.Register(c.batchSize); err != nil {
-		if are, ok := err.(prom.AlreadyRegisteredError); ok {
-			if hist, ok := are.ExistingCollector.(*prom.HistogramVec); ok {
-				c.batchSize = hist
-			} else {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+	if c.batchSize, err = register(cfg.registerer, c.batchSize); err != nil {
+		return nil, err
 	}
-	if err := cfg.registerer.Register(c.batchCounter); err != nil {
-		if are, ok := err.(prom.AlreadyRegisteredError); ok {
-			if counter, ok := are.ExistingCollector.(*prom.CounterVec); ok {
-				c.batchCounter = counter
-			} else {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+	if c.batchCounter, err = register(cfg.registerer, c.batchCounter); err != nil {
+		return nil, err
 	}
-	if err := cfg.registerer.Register(c.queryDuration); err != nil {
-		if are, ok := err.(prom.AlreadyRegisteredError); ok {
-			if hist, ok := are.ExistingCollector.(*prom.HistogramVec); ok {
-				c.queryDuration = hist
-			} else {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+	if c.queryDuration, err = register(cfg.registerer, c.queryDuration); err != nil {
+		return nil, err
 	}
-	if err := cfg.registerer.Register(c.queryCounter); err != nil {
-		if are, ok := err.(prom.AlreadyRegisteredError); ok {
-			if counter, ok := are.ExistingCollector.(*prom.CounterVec); ok {
-				c.queryCounter = counter
-			} else {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
+	if c.queryCounter, err = register(cfg.registerer, c.queryCounter); err != nil {
+		return nil, err
+	}
+	if c.operationDuration, err = register(cfg.registerer, c.operationDuration); err != nil {
+		return nil, err
+	}
+	if c.operationCounter, err = register(cfg.registerer, c.operationCounter); err != nil {
+		return nil, err
+	}
+	if c.operationComplexity, err = register(cfg.registerer, c.operationComplexity); err != nil {
+		return nil, err
+	}
+	if c.resolverDuration, err = register(cfg.registerer, c.resolverDuration); err != nil {
+		return nil, err
+	}
+	if c.resolverCounter, err = register(cfg.registerer, c.resolverCounter); err != nil {
+		return nil, err
+	}
+	if c.rejectionCounter, err = register(cfg.registerer, c.rejectionCounter); err != nil {
+		return nil, err
+	}
+	if c.cacheLookups, err = register(cfg.registerer, c.cacheLookups); err != nil {
+		return nil, err
+	}
+	if c.cacheInvalidations, err = register(cfg.registerer, c.cacheInvalidations); err != nil {
+		return nil, err
+	}
+	if c.cacheEvictions, err = register(cfg.registerer, c.cacheEvictions); err != nil {
+		return nil, err
 	}
 
 	c.handler = promhttp.HandlerFor(cfg.gatherer, promhttp.HandlerOpts{})
 	return c, nil
 }
 
+// register adds collector to the registry, reusing an identical collector that
+// is already registered so several Collectors can share one registry.
+func register[T prom.Collector](registerer prom.Registerer, collector T) (T, error) {
+	if err := registerer.Register(collector); err != nil {
+		var are prom.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing, nil
+			}
+		}
+		return collector, err
+	}
+	return collector, nil
+}
+
 // Handler exposes an HTTP handler for Prometheus scrapes.
 func (c *Collector) Handler() http.Handler {
 	if c == nil {
@@ -236,3 +352,89 @@ func (c *Collector) RecordQuery(table string, operation string, duration time.Du
 	c.queryCounter.WithLabelValues(labels...).Inc()
 	c.queryDuration.WithLabelValues(labels...).Observe(seconds)
 }
+
+// RecordOperation implements metrics.Collector.
+func (c *Collector) RecordOperation(name string, operationType string, complexity int, duration time.Duration, err error) {
+	if c == nil {
+		return
+	}
+	name = c.operationLabel(name)
+	operationType = strings.TrimSpace(operationType)
+	if operationType == "" {
+		operationType = "unknown"
+	}
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	c.operationCounter.WithLabelValues(name, operationType, status).Inc()
+	c.operationDuration.WithLabelValues(name, operationType).Observe(duration.Seconds())
+	if complexity > 0 {
+		c.operationComplexity.WithLabelValues(name, operationType).Observe(float64(complexity))
+	}
+}
+
+// RecordResolver implements metrics.Collector.
+func (c *Collector) RecordResolver(object string, field string, duration time.Duration, err error) {
+	if c == nil {
+		return
+	}
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	c.resolverCounter.WithLabelValues(object, field, status).Inc()
+	c.resolverDuration.WithLabelValues(object, field).Observe(duration.Seconds())
+}
+
+// RecordRejection implements metrics.Collector.
+func (c *Collector) RecordRejection(operation string, operationType string, reason string) {
+	if c == nil {
+		return
+	}
+	if operationType == "" {
+		operationType = "unknown"
+	}
+	c.rejectionCounter.WithLabelValues(c.operationLabel(operation), operationType, reason).Inc()
+}
+
+// RecordCacheLookup implements metrics.Collector.
+func (c *Collector) RecordCacheLookup(hit bool) {
+	if c == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	c.cacheLookups.WithLabelValues(result).Inc()
+}
+
+// RecordCacheInvalidation implements metrics.Collector.
+func (c *Collector) RecordCacheInvalidation(entity string, evicted int) {
+	if c == nil {
+		return
+	}
+	c.cacheInvalidations.WithLabelValues(entity).Inc()
+	if evicted > 0 {
+		c.cacheEvictions.WithLabelValues(entity).Add(float64(evicted))
+	}
+}
+
+// operationLabel maps client-chosen operation names onto a bounded label set.
+func (c *Collector) operationLabel(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return AnonymousOperation
+	}
+	c.operationsMu.Lock()
+	defer c.operationsMu.Unlock()
+	if _, ok := c.operations[name]; ok {
+		return name
+	}
+	if len(c.operations) >= c.maxOperationNames {
+		return OverflowOperation
+	}
+	c.operations[name] = struct{}{}
+	return name
+}
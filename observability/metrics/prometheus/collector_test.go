@@ -95,6 +95,72 @@ func TestRecordQueryUpdatesMetrics(t *testing.T) {
 	}
 }
 
+func TestRecordOperationBoundsOperationNames(t *testing.T) {
+	c, err := New(WithMaxOperationNames(1))
+	if err != nil {
+		t.Fatalf("new collector: %v", err)
+	}
+
+	c.RecordOperation("Posts", "query", 12, 40*time.Millisecond, nil)
+	c.RecordOperation("Posts", "query", 12, 20*time.Millisecond, errors.New("boom"))
+	c.RecordOperation("Drafts", "query", 3, 10*time.Millisecond, nil)
+	c.RecordOperation("", "mutation", 0, 10*time.Millisecond, nil)
+
+	for _, tc := range []struct {
+		labels map[string]string
+		want   float64
+	}{
+		{map[string]string{"operation": "Posts", "type": "query", "status": "success"}, 1},
+		{map[string]string{"operation": "Posts", "type": "query", "status": "error"}, 1},
+		{map[string]string{"operation": OverflowOperation, "type": "query", "status": "success"}, 1},
+		{map[string]string{"operation": AnonymousOperation, "type": "mutation", "status": "success"}, 1},
+	} {
+		metric := readMetric(t, c.operationCounter, tc.labels)
+		if got := metric.GetCounter().GetValue(); got != tc.want {
+			t.Fatalf("expected %v for %v, got %v", tc.want, tc.labels, got)
+		}
+	}
+
+	metric := readMetric(t, c.operationComplexity, map[string]string{"operation": "Posts", "type": "query"})
+	if hist := metric.GetHistogram(); hist.GetSampleCount() != 2 || hist.GetSampleSum() != 24 {
+		t.Fatalf("unexpected complexity histogram: count=%d sum=%v", hist.GetSampleCount(), hist.GetSampleSum())
+	}
+}
+
+func TestRecordResolverUpdatesMetrics(t *testing.T) {
+	collector := newCollectorForTest(t)
+
+	collector.RecordResolver("Query", "posts", 15*time.Millisecond, nil)
+	collector.RecordResolver("Query", "posts", 5*time.Millisecond, errors.New("boom"))
+
+	metric := readMetric(t, collector.resolverCounter, map[string]string{"object": "Query", "field": "posts", "status": "error"})
+	if got := metric.GetCounter().GetValue(); got != 1 {
+		t.Fatalf("expected error counter 1, got %v", got)
+	}
+	metric = readMetric(t, collector.resolverDuration, map[string]string{"object": "Query", "field": "posts"})
+	if hist := metric.GetHistogram(); hist.GetSampleCount() != 2 {
+		t.Fatalf("expected two resolver samples, got %d", hist.GetSampleCount())
+	}
+}
+
+func TestRecordCacheMetrics(t *testing.T) {
+	collector := newCollectorForTest(t)
+
+	collector.RecordCacheLookup(true)
+	collector.RecordCacheLookup(false)
+	collector.RecordCacheLookup(true)
+	collector.RecordCacheInvalidation("post", 3)
+
+	metric := readMetric(t, collector.cacheLookups, map[string]string{"result": "hit"})
+	if got := metric.GetCounter().GetValue(); got != 2 {
+		t.Fatalf("expected two hits, got %v", got)
+	}
+	metric = readMetric(t, collector.cacheEvictions, map[string]string{"entity": "post"})
+	if got := metric.GetCounter().GetValue(); got != 3 {
+		t.Fatalf("expected three evictions, got %v", got)
+	}
+}
+
 func readMetric(t *testing.T, collector prom.Collector, labels map[string]string) *dto.Metric {
 	t.Helper()
 	ch := make(chan prom.Metric, 16)
@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GraphQL is a gqlgen handler extension that records a span per operation and
+// a child span per resolver-backed field. Trivial field reads are skipped to
+// keep traces readable.
+type GraphQL struct {
+	// Provider supplies the tracer; nil uses the global provider.
+	Provider trace.TracerProvider
+}
+
+var (
+	_ graphql.HandlerExtension    = GraphQL{}
+	_ graphql.ResponseInterceptor = GraphQL{}
+	_ graphql.FieldInterceptor    = GraphQL{}
+)
+
+// ExtensionName implements graphql.HandlerExtension.
+func (GraphQL) ExtensionName() string {
+	return "OpenTelemetryTracing"
+}
+
+// Validate implements graphql.HandlerExtension.
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor.
+func (e GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	operationType, operationName := operationLabels(opCtx)
+	ctx, span := Tracer(e.Provider).Start(ctx, "graphql."+operationType+" "+operationName,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("graphql.operation.type", operationType),
+			attribute.String("graphql.operation.name", operationName),
+		),
+	)
+	defer span.End()
+
+	resp := next(ctx)
+	if errs := graphql.GetErrors(ctx); len(errs) > 0 {
+		span.SetAttributes(attribute.Int("graphql.errors", len(errs)))
+		span.SetStatus(codes.Error, errs.Error())
+	} else if resp != nil && len(resp.Errors) > 0 {
+		span.SetAttributes(attribute.Int("graphql.errors", len(resp.Errors)))
+		span.SetStatus(codes.Error, resp.Errors.Error())
+	}
+	return resp
+}
+
+// InterceptField implements graphql.FieldInterceptor.
+func (e GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	ctx, span := Tracer(e.Provider).Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(
+			attribute.String("graphql.field.path", fc.Path().String()),
+			attribute.String("graphql.field.parent", fc.Object),
+			attribute.String("graphql.field.name", fc.Field.Name),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
+
+func operationLabels(opCtx *graphql.OperationContext) (string, string) {
+	operationType := "unknown"
+	operationName := strings.TrimSpace(opCtx.OperationName)
+	if op := opCtx.Operation; op != nil {
+		operationType = string(op.Operation)
+		if operationName == "" {
+			operationName = op.Name
+		}
+	}
+	if operationName == "" {
+		operationName = "anonymous"
+	}
+	return operationType, operationName
+}
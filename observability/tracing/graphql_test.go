@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newRecorder() (*tracetest.InMemoryExporter, *sdktrace.TracerProvider) {
+	exporter := tracetest.NewInMemoryExporter()
+	return exporter, NewProvider(Config{}, sdktrace.WithSyncer(exporter))
+}
+
+func spanAttr(span tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestGraphQLExtensionRecordsOperationSpan(t *testing.T) {
+	t.Parallel()
+
+	exporter, provider := newRecorder()
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(GraphQL{Provider: provider})
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"query Greeting { name }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	if spans[0].Name != "graphql.query Greeting" {
+		t.Fatalf("unexpected span name %q", spans[0].Name)
+	}
+	if value, _ := spanAttr(spans[0], "graphql.operation.type"); value.AsString() != "query" {
+		t.Fatalf("unexpected operation type %q", value.AsString())
+	}
+}
+
+func TestGraphQLExtensionRecordsResolverFields(t *testing.T) {
+	t.Parallel()
+
+	exporter, provider := newRecorder()
+	ext := GraphQL{Provider: provider}
+	failure := errors.New("boom")
+
+	trivial := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Object: "Post",
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: "title", Alias: "title"}},
+	})
+	if _, err := ext.InterceptField(trivial, func(context.Context) (any, error) { return "x", nil }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolver := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Object:     "Query",
+		IsResolver: true,
+		Field:      graphql.CollectedField{Field: &ast.Field{Name: "posts", Alias: "posts"}},
+	})
+	if _, err := ext.InterceptField(resolver, func(context.Context) (any, error) { return nil, failure }); !errors.Is(err, failure) {
+		t.Fatalf("expected resolver error, got %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected only the resolver span, got %d", len(spans))
+	}
+	if spans[0].Name != "Query.posts" {
+		t.Fatalf("unexpected span name %q", spans[0].Name)
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Fatalf("expected error status, got %v", spans[0].Status)
+	}
+}
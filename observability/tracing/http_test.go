@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddlewareContinuesIncomingTrace(t *testing.T) {
+	// Setup installs the global propagator even when exporting is disabled.
+	shutdown, err := Setup(context.Background(), Config{Exporter: ExporterNone})
+	if err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	defer shutdown(context.Background())
+
+	exporter, provider := newRecorder()
+	var handlerSpan trace.SpanContext
+	handler := Middleware(provider, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name != "GET /graphql" || span.SpanKind != trace.SpanKindServer {
+		t.Fatalf("unexpected span %q (%v)", span.Name, span.SpanKind)
+	}
+	if span.Parent.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || !span.Parent.IsRemote() {
+		t.Fatalf("expected remote parent from traceparent, got %v", span.Parent)
+	}
+	if span.SpanContext.SpanID() != handlerSpan.SpanID() {
+		t.Fatal("expected handler context to carry the server span")
+	}
+	if value, _ := spanAttr(span, "http.response.status_code"); value.AsInt64() != http.StatusBadGateway {
+		t.Fatalf("unexpected status attribute %d", value.AsInt64())
+	}
+	if span.Status.Code != codes.Error {
+		t.Fatalf("expected error status for 5xx, got %v", span.Status)
+	}
+}
@@ -0,0 +1,318 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/deicod/erm/orm/pg"
+)
+
+// pool mirrors the query surface of pg.DB.Pool.
+type pool interface {
+	querier
+	Close()
+}
+
+// querier is the statement surface shared by pools and pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// The pool behind pg.DB, such as *pgxpool.Pool, usually offers more than
+// pg.DB.Pool declares. tracedPool forwards these when the wrapped pool has
+// them, so callers that type-assert for transactions, batches or COPY keep
+// working and are traced too.
+type (
+	beginner interface {
+		Begin(ctx context.Context) (pgx.Tx, error)
+	}
+	batchSender interface {
+		SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	}
+	copier interface {
+		CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error)
+	}
+)
+
+// maxStatementLength caps db.statement; generated statements with long
+// column lists or IN lists would otherwise bloat every span.
+const maxStatementLength = 2048
+
+// errNotSupported is returned when the wrapped pool lacks a forwarded method.
+var errNotSupported = errors.New("tracing: the wrapped pool does not support this operation")
+
+// InstrumentDB wraps the database pool so every statement issued through the
+// ORM records a client span, including those run in transactions, batches
+// and COPY. A nil provider uses the global one.
+func InstrumentDB(db *pg.DB, provider trace.TracerProvider) {
+	if db == nil || db.Pool == nil {
+		return
+	}
+	if _, ok := db.Pool.(*tracedPool); ok {
+		return
+	}
+	db.Pool = &tracedPool{next: db.Pool, tracer: Tracer(provider)}
+}
+
+type tracedPool struct {
+	next   pool
+	tracer trace.Tracer
+}
+
+func (p *tracedPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return tracedQuery(ctx, p.tracer, p.next, sql, args)
+}
+
+func (p *tracedPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return tracedQueryRow(ctx, p.tracer, p.next, sql, args)
+}
+
+func (p *tracedPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return tracedExec(ctx, p.tracer, p.next, sql, args)
+}
+
+// Begin starts a transaction whose statements are traced like the pool's.
+func (p *tracedPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	next, ok := p.next.(beginner)
+	if !ok {
+		return nil, errNotSupported
+	}
+	return tracedBegin(ctx, p.tracer, next)
+}
+
+func (p *tracedPool) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	next, ok := p.next.(batchSender)
+	if !ok {
+		return errBatchResults{err: errNotSupported}
+	}
+	return tracedSendBatch(ctx, p.tracer, next, b)
+}
+
+func (p *tracedPool) CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error) {
+	next, ok := p.next.(copier)
+	if !ok {
+		return 0, errNotSupported
+	}
+	return tracedCopyFrom(ctx, p.tracer, next, table, columns, src)
+}
+
+func (p *tracedPool) Close() {
+	p.next.Close()
+}
+
+// tracedTx traces the statements of a transaction opened through
+// tracedPool. Commit and Rollback record their own spans.
+type tracedTx struct {
+	pgx.Tx
+	tracer trace.Tracer
+}
+
+func (t *tracedTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return tracedQuery(ctx, t.tracer, t.Tx, sql, args)
+}
+
+func (t *tracedTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return tracedQueryRow(ctx, t.tracer, t.Tx, sql, args)
+}
+
+func (t *tracedTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return tracedExec(ctx, t.tracer, t.Tx, sql, args)
+}
+
+func (t *tracedTx) Begin(ctx context.Context) (pgx.Tx, error) {
+	return tracedBegin(ctx, t.tracer, t.Tx)
+}
+
+func (t *tracedTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return tracedSendBatch(ctx, t.tracer, t.Tx, b)
+}
+
+func (t *tracedTx) CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error) {
+	return tracedCopyFrom(ctx, t.tracer, t.Tx, table, columns, src)
+}
+
+func (t *tracedTx) Commit(ctx context.Context) error {
+	_, span := startSpan(ctx, t.tracer, "COMMIT", "")
+	err := t.Tx.Commit(ctx)
+	endSpan(span, err)
+	return err
+}
+
+func (t *tracedTx) Rollback(ctx context.Context) error {
+	_, span := startSpan(ctx, t.tracer, "ROLLBACK", "")
+	err := t.Tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		// Deferred rollbacks after a commit are expected.
+		err = nil
+	}
+	endSpan(span, err)
+	return err
+}
+
+func startSpan(ctx context.Context, tracer trace.Tracer, operation, statement string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", operation),
+	}
+	if statement != "" {
+		attrs = append(attrs, attribute.String("db.statement", truncateStatement(statement)))
+	}
+	return tracer.Start(ctx, "db."+strings.ToLower(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func tracedQuery(ctx context.Context, tracer trace.Tracer, next querier, sql string, args []any) (pgx.Rows, error) {
+	ctx, span := startSpan(ctx, tracer, sqlOperation(sql), sql)
+	rows, err := next.Query(ctx, sql, args...)
+	if err != nil {
+		endSpan(span, err)
+		return nil, err
+	}
+	// Rows stream lazily, so the span stays open until they are closed.
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+func tracedQueryRow(ctx context.Context, tracer trace.Tracer, next querier, sql string, args []any) pgx.Row {
+	ctx, span := startSpan(ctx, tracer, sqlOperation(sql), sql)
+	return &tracedRow{row: next.QueryRow(ctx, sql, args...), span: span}
+}
+
+func tracedExec(ctx context.Context, tracer trace.Tracer, next querier, sql string, args []any) (pgconn.CommandTag, error) {
+	ctx, span := startSpan(ctx, tracer, sqlOperation(sql), sql)
+	tag, err := next.Exec(ctx, sql, args...)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", tag.RowsAffected()))
+	}
+	endSpan(span, err)
+	return tag, err
+}
+
+func tracedBegin(ctx context.Context, tracer trace.Tracer, next beginner) (pgx.Tx, error) {
+	ctx, span := startSpan(ctx, tracer, "BEGIN", "")
+	tx, err := next.Begin(ctx)
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, tracer: tracer}, nil
+}
+
+func tracedSendBatch(ctx context.Context, tracer trace.Tracer, next batchSender, b *pgx.Batch) pgx.BatchResults {
+	ctx, span := startSpan(ctx, tracer, "BATCH", "")
+	if b != nil {
+		span.SetAttributes(attribute.Int("db.batch.size", b.Len()))
+	}
+	// Results are read after SendBatch returns; the span ends on Close.
+	return &tracedBatchResults{BatchResults: next.SendBatch(ctx, b), span: span}
+}
+
+func tracedCopyFrom(ctx context.Context, tracer trace.Tracer, next copier, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error) {
+	ctx, span := startSpan(ctx, tracer, "COPY", "")
+	span.SetAttributes(attribute.String("db.sql.table", table.Sanitize()))
+	n, err := next.CopyFrom(ctx, table, columns, src)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", n))
+	}
+	endSpan(span, err)
+	return n, err
+}
+
+// truncateStatement caps sql at maxStatementLength bytes without splitting
+// a UTF-8 sequence.
+func truncateStatement(sql string) string {
+	if len(sql) <= maxStatementLength {
+		return sql
+	}
+	cut := maxStatementLength
+	for cut > 0 && !utf8.RuneStart(sql[cut]) {
+		cut--
+	}
+	return sql[:cut] + "…"
+}
+
+type tracedBatchResults struct {
+	pgx.BatchResults
+	span  trace.Span
+	ended bool
+}
+
+func (r *tracedBatchResults) Close() error {
+	err := r.BatchResults.Close()
+	if !r.ended {
+		r.ended = true
+		endSpan(r.span, err)
+	}
+	return err
+}
+
+// errBatchResults reports err from every call, like pgx does for a batch
+// that could not be sent.
+type errBatchResults struct{ err error }
+
+func (r errBatchResults) Exec() (pgconn.CommandTag, error) { return pgconn.CommandTag{}, r.err }
+func (r errBatchResults) Query() (pgx.Rows, error)         { return nil, r.err }
+func (r errBatchResults) QueryRow() pgx.Row                { return errRow{err: r.err} }
+func (r errBatchResults) Close() error                     { return r.err }
+
+type errRow struct{ err error }
+
+func (r errRow) Scan(...any) error { return r.err }
+
+type tracedRows struct {
+	pgx.Rows
+	span  trace.Span
+	ended bool
+}
+
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	if r.ended {
+		return
+	}
+	r.ended = true
+	endSpan(r.span, r.Rows.Err())
+}
+
+type tracedRow struct {
+	row  pgx.Row
+	span trace.Span
+}
+
+func (r *tracedRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		endSpan(r.span, nil)
+	} else {
+		endSpan(r.span, err)
+	}
+	return err
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// sqlOperation returns the leading keyword of a statement, e.g. SELECT.
+func sqlOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(fields[0])
+}
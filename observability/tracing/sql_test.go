@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/deicod/erm/orm/pg"
+)
+
+type stubPool struct {
+	execErr error
+}
+
+func (p *stubPool) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("query not supported")
+}
+
+func (p *stubPool) QueryRow(context.Context, string, ...any) pgx.Row {
+	return stubRow{}
+}
+
+func (p *stubPool) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.NewCommandTag("UPDATE 2"), p.execErr
+}
+
+func (p *stubPool) Close() {}
+
+type stubRow struct{}
+
+func (stubRow) Scan(...any) error { return pgx.ErrNoRows }
+
+func TestInstrumentDBRecordsStatements(t *testing.T) {
+	t.Parallel()
+
+	exporter, provider := newRecorder()
+	db := &pg.DB{Pool: &stubPool{}}
+	InstrumentDB(db, provider)
+	InstrumentDB(db, provider)
+
+	ctx := context.Background()
+	if _, err := db.Pool.Exec(ctx, "UPDATE posts SET title = $1", "x"); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	if err := db.Pool.QueryRow(ctx, "  select id from posts where slug = $1", "x").Scan(new(string)); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("expected no rows, got %v", err)
+	}
+	if _, err := db.Pool.Query(ctx, "SELECT 1"); err == nil {
+		t.Fatal("expected query error")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected three spans (instrumented once), got %d", len(spans))
+	}
+	if spans[0].Name != "db.update" {
+		t.Fatalf("unexpected span name %q", spans[0].Name)
+	}
+	if value, _ := spanAttr(spans[0], "db.rows_affected"); value.AsInt64() != 2 {
+		t.Fatalf("unexpected rows affected %d", value.AsInt64())
+	}
+	if value, _ := spanAttr(spans[1], "db.operation"); value.AsString() != "SELECT" {
+		t.Fatalf("unexpected operation %q", value.AsString())
+	}
+	if spans[1].Status.Code == codes.Error {
+		t.Fatal("no rows should not mark the span as failed")
+	}
+	if spans[2].Status.Code != codes.Error {
+		t.Fatal("expected failed query span to carry an error status")
+	}
+}
+
+// txPool is a stubPool that can open transactions.
+type txPool struct {
+	stubPool
+	tx *stubTx
+}
+
+func (p *txPool) Begin(context.Context) (pgx.Tx, error) {
+	return p.tx, nil
+}
+
+type stubTx struct {
+	pgx.Tx
+	committed bool
+}
+
+func (t *stubTx) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.NewCommandTag("DELETE 1"), nil
+}
+
+func (t *stubTx) Commit(context.Context) error {
+	t.committed = true
+	return nil
+}
+
+func (t *stubTx) Rollback(context.Context) error {
+	if t.committed {
+		return pgx.ErrTxClosed
+	}
+	return nil
+}
+
+func TestInstrumentDBTracesTransactions(t *testing.T) {
+	t.Parallel()
+
+	exporter, provider := newRecorder()
+	tx := &stubTx{}
+	db := &pg.DB{Pool: &txPool{tx: tx}}
+	InstrumentDB(db, provider)
+
+	ctx := context.Background()
+	begin, ok := db.Pool.(interface {
+		Begin(context.Context) (pgx.Tx, error)
+	})
+	if !ok {
+		t.Fatal("expected the traced pool to keep Begin")
+	}
+	traced, err := begin.Begin(ctx)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if _, err := traced.Exec(ctx, "DELETE FROM users WHERE id = $1", "u1"); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	if err := traced.Commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := traced.Rollback(ctx); err != nil {
+		t.Fatalf("rollback after commit: %v", err)
+	}
+	if !tx.committed {
+		t.Fatal("expected the wrapped transaction to commit")
+	}
+
+	var names []string
+	for _, span := range exporter.GetSpans() {
+		names = append(names, span.Name)
+	}
+	want := []string{"db.begin", "db.delete", "db.commit", "db.rollback"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected spans %v, got %v", want, names)
+	}
+	if _, err := (&tracedPool{next: &stubPool{}, tracer: Tracer(provider)}).Begin(ctx); !errors.Is(err, errNotSupported) {
+		t.Fatalf("expected pools without Begin to report it, got %v", err)
+	}
+}
+
+func TestStatementsAreTruncated(t *testing.T) {
+	t.Parallel()
+
+	exporter, provider := newRecorder()
+	db := &pg.DB{Pool: &stubPool{}}
+	InstrumentDB(db, provider)
+
+	// The two-byte é straddles the cut.
+	prefix := "UPDATE posts SET title = '"
+	long := prefix + strings.Repeat("x", maxStatementLength-1-len(prefix)) + "é'"
+	if _, err := db.Pool.Exec(context.Background(), long); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	value, _ := spanAttr(exporter.GetSpans()[0], "db.statement")
+	statement := value.AsString()
+	if len(statement) > maxStatementLength+len("…") || !strings.HasSuffix(statement, "…") {
+		t.Fatalf("expected a truncated statement, got %d bytes", len(statement))
+	}
+	if !utf8.ValidString(statement) {
+		t.Fatal("expected truncation to keep valid UTF-8")
+	}
+}
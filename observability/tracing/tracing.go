@@ -0,0 +1,126 @@
+// Package tracing wires OpenTelemetry spans through the HTTP stack, GraphQL
+// execution, dataloaders and SQL queries. Spans are exported over OTLP/HTTP.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies spans produced by this module.
+const InstrumentationName = "github.com/deicod/ermblog"
+
+// DefaultServiceName is reported when Config.ServiceName is empty.
+const DefaultServiceName = "ermblog"
+
+// Supported exporters.
+const (
+	ExporterNone = "none"
+	ExporterOTLP = "otlp"
+)
+
+// Config selects and configures the span exporter.
+type Config struct {
+	// Exporter is ExporterOTLP or ExporterNone. Empty disables tracing.
+	Exporter string
+	// Endpoint is the OTLP/HTTP collector, either host:port or a full URL.
+	// When empty the OTEL_EXPORTER_OTLP_* environment variables apply.
+	Endpoint    string
+	Insecure    bool
+	Headers     map[string]string
+	ServiceName string
+	// SampleRatio is the fraction of new traces recorded. Values outside
+	// (0, 1] sample everything; sampled parents are always honoured.
+	SampleRatio float64
+}
+
+// Enabled reports whether spans are exported.
+func (cfg Config) Enabled() bool {
+	exporter := strings.ToLower(strings.TrimSpace(cfg.Exporter))
+	return exporter != "" && exporter != ExporterNone
+}
+
+// Setup installs the global tracer provider and W3C trace context propagator.
+// The returned shutdown flushes pending spans and must be called on exit.
+// Propagation is installed even when tracing is disabled so incoming trace
+// headers are still forwarded.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	provider := NewProvider(cfg, sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// NewProvider builds a tracer provider carrying the service resource and
+// sampler derived from cfg. Tests pass sdktrace.WithSyncer with an in-memory
+// exporter to capture spans.
+func NewProvider(cfg Config, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	serviceName := strings.TrimSpace(cfg.ServiceName)
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		res = resource.Default()
+	}
+	sampler := sdktrace.AlwaysSample()
+	if cfg.SampleRatio > 0 && cfg.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(cfg.SampleRatio)
+	}
+	base := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	}
+	return sdktrace.NewTracerProvider(append(base, opts...)...)
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	exporter := strings.ToLower(strings.TrimSpace(cfg.Exporter))
+	if exporter != ExporterOTLP {
+		return nil, fmt.Errorf("tracing: unsupported exporter %q", cfg.Exporter)
+	}
+	var opts []otlptracehttp.Option
+	if endpoint := strings.TrimSpace(cfg.Endpoint); endpoint != "" {
+		if strings.Contains(endpoint, "://") {
+			opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+		} else {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+	}
+	client, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("tracing: configure otlp exporter: %w", err)
+	}
+	return client, nil
+}
+
+// Tracer returns the module tracer from provider, or from the global provider
+// when nil.
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return provider.Tracer(InstrumentationName)
+}
@@ -0,0 +1,78 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// TokenValidator verifies bearer tokens.
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token string) (Claims, error)
+}
+
+// Chain accepts a token when any of its validators does, trying them in
+// order, e.g. locally issued session tokens before the identity provider's.
+type Chain []TokenValidator
+
+// ValidateToken returns the claims of the first validator accepting token,
+// or the last validator's error.
+func (c Chain) ValidateToken(ctx context.Context, token string) (Claims, error) {
+	err := errors.New("oidc: no token validators configured")
+	for _, validator := range c {
+		if validator == nil {
+			continue
+		}
+		var claims Claims
+		claims, err = validator.ValidateToken(ctx, token)
+		if err == nil {
+			return claims, nil
+		}
+	}
+	return Claims{}, err
+}
+
+// Middleware verifies the Authorization header against the chain.
+func (c Chain) Middleware(next http.Handler) http.Handler {
+	return middleware(c, next)
+}
+
+// OptionalMiddleware lets requests without an Authorization header through
+// anonymously, like Validator.OptionalMiddleware.
+func (c Chain) OptionalMiddleware(next http.Handler) http.Handler {
+	return optionalMiddleware(c, next)
+}
+
+func middleware(validator TokenValidator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		if token == "" {
+			unauthorized(w)
+			return
+		}
+		parts := strings.Fields(token)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			unauthorized(w)
+			return
+		}
+		claims, err := validator.ValidateToken(r.Context(), parts[1])
+		if err != nil {
+			unauthorized(w)
+			return
+		}
+		ctx := ToContext(r.Context(), claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func optionalMiddleware(validator TokenValidator, next http.Handler) http.Handler {
+	strict := middleware(validator, next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		strict.ServeHTTP(w, r)
+	})
+}
@@ -102,25 +102,14 @@ func NewValidator(ctx context.Context, issuer, audience string, opts ...Option)
 
 // Middleware verifies the Authorization header and injects claims on success.
 func (v *Validator) Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			unauthorized(w)
-			return
-		}
-		parts := strings.Fields(token)
-		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-			unauthorized(w)
-			return
-		}
-		claims, err := v.ValidateToken(r.Context(), parts[1])
-		if err != nil {
-			unauthorized(w)
-			return
-		}
-		ctx := ToContext(r.Context(), claims)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	return middleware(v, next)
+}
+
+// OptionalMiddleware behaves like Middleware but lets requests without an
+// Authorization header through anonymously. Fields guarded by @auth still
+// reject them; a header carrying an invalid token is rejected as before.
+func (v *Validator) OptionalMiddleware(next http.Handler) http.Handler {
+	return optionalMiddleware(v, next)
 }
 
 // ValidateToken parses and validates a JWT, returning extracted claims.
@@ -142,6 +131,12 @@ func (v *Validator) ValidateToken(ctx context.Context, token string) (Claims, er
 	return mapToClaims(claims), nil
 }
 
+// ClaimsFromJWT maps verified JWT claims onto Claims the way Validator
+// does, for other token issuers.
+func ClaimsFromJWT(claims jwt.MapClaims) Claims {
+	return mapToClaims(claims)
+}
+
 func (v *Validator) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
 	if kid == "" {
 		return nil, errors.New("oidc: token missing kid header")
@@ -113,6 +113,38 @@ func TestValidatorMiddleware(t *testing.T) {
 	if rr.Code != http.StatusOK {
 		t.Fatalf("expected 200, got %d", rr.Code)
 	}
+
+	t.Run("optional", func(t *testing.T) {
+		optional := validator.OptionalMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := FromContext(r.Context()); ok {
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		rr := httptest.NewRecorder()
+		optional.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+		if rr.Code != http.StatusNoContent {
+			t.Fatalf("expected anonymous request to pass without claims, got %d", rr.Code)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		rr = httptest.NewRecorder()
+		optional.ServeHTTP(rr, req)
+		if rr.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for invalid token, got %d", rr.Code)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/graphql", nil)
+		req.Header.Set("Authorization", "Bearer "+signed)
+		rr = httptest.NewRecorder()
+		optional.ServeHTTP(rr, req)
+		if rr.Code != http.StatusAccepted {
+			t.Fatalf("expected claims for valid token, got %d", rr.Code)
+		}
+	})
 }
 
 func TestValidatorFallsBackToCachedKeyWhenRefreshFails(t *testing.T) {
@@ -0,0 +1,50 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const touchApiKeyQuery = `UPDATE api_keys SET last_used_at = $2 WHERE id = $1::uuid`
+
+// ApiKeyByID returns the key with the given ID, or nil. IDs that are not
+// UUIDs match no key.
+func (c *Client) ApiKeyByID(ctx context.Context, keyID string) (*ApiKey, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(keyID) {
+		return nil, nil
+	}
+	return c.ApiKeys().ByID(ctx, keyID)
+}
+
+// ApiKeysForUser lists a user's keys, newest first. Users whose ID is not
+// a UUID, such as OIDC subjects without a user row, have none.
+func (c *Client) ApiKeysForUser(ctx context.Context, userID string) ([]*ApiKey, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return []*ApiKey{}, nil
+	}
+	return c.ApiKeys().Query().WhereUserIDEq(userID).OrderByCreatedAtDesc().All(ctx)
+}
+
+// TouchApiKey sets the key's last_used_at without rewriting the rest of
+// the row, as the generated Update would.
+func (c *Client) TouchApiKey(ctx context.Context, keyID string, at time.Time) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	if _, err := writer.Exec(ctx, touchApiKeyQuery, keyID, at); err != nil {
+		return err
+	}
+	c.evictCached(ctx, "ApiKey", []string{keyID})
+	return nil
+}
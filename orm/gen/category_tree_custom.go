@@ -0,0 +1,225 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrCategoryCycle is returned when a category would become its own ancestor.
+var ErrCategoryCycle = errors.New("category cannot be moved beneath itself or its descendants")
+
+// The recursive queries carry the ids visited so far so rows left cyclic by
+// earlier writes terminate instead of looping.
+const (
+	categoryTreeColumns = `c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_at, c.updated_at`
+	// categorySortKey orders siblings by position, then name; concatenated
+	// along the path it yields depth-first order.
+	categorySortKey = `lpad((c.position::bigint + 2147483648)::text, 10, '0') || '/' || c.name || '/' || c.id::text`
+
+	categoryAncestorsQuery = `WITH RECURSIVE chain AS (
+SELECT c.id, c.parent_id, 0 AS depth, ARRAY[c.id] AS seen FROM categories c WHERE c.id = $1
+UNION ALL
+SELECT c.id, c.parent_id, chain.depth + 1, chain.seen || c.id
+FROM categories c JOIN chain ON c.id = chain.parent_id
+WHERE NOT c.id = ANY(chain.seen)
+)
+SELECT ` + categoryTreeColumns + ` FROM chain JOIN categories c ON c.id = chain.id
+WHERE chain.depth > 0 ORDER BY chain.depth DESC`
+	categoryDescendantsQuery = `WITH RECURSIVE tree AS (
+SELECT c.id, 1 AS depth, ARRAY[` + categorySortKey + `] AS sort_path, ARRAY[$1::uuid, c.id] AS seen
+FROM categories c WHERE c.parent_id = $1
+UNION ALL
+SELECT c.id, tree.depth + 1, tree.sort_path || (` + categorySortKey + `), tree.seen || c.id
+FROM categories c JOIN tree ON c.parent_id = tree.id
+WHERE ($2 <= 0 OR tree.depth < $2) AND NOT c.id = ANY(tree.seen)
+)
+SELECT ` + categoryTreeColumns + `, tree.depth FROM tree JOIN categories c ON c.id = tree.id
+ORDER BY tree.sort_path`
+	categoryCreatesCycleQuery = `WITH RECURSIVE chain AS (
+SELECT c.id, c.parent_id, ARRAY[c.id] AS seen FROM categories c WHERE c.id = $2
+UNION ALL
+SELECT c.id, c.parent_id, chain.seen || c.id
+FROM categories c JOIN chain ON c.id = chain.parent_id
+WHERE NOT c.id = ANY(chain.seen)
+)
+SELECT EXISTS (SELECT 1 FROM chain WHERE id = $1)`
+	categoryPostCountsQuery = `WITH RECURSIVE tree AS (
+SELECT c.id AS root, c.id FROM categories c WHERE c.id = ANY($1::uuid[])
+UNION
+SELECT tree.root, c.id FROM categories c JOIN tree ON c.parent_id = tree.id
+)
+SELECT tree.root::text, count(DISTINCT p.id)
+FROM tree
+LEFT JOIN post_categories pc ON pc.category_id = tree.id
+LEFT JOIN posts p ON p.id = pc.post_id AND p.status = 'published' AND p.deleted_at IS NULL
+GROUP BY tree.root`
+	// moveCategoryQuery re-parents $1 under $2 (NULL for the root) at
+	// position $3 among its new siblings, renumbering them densely. It runs
+	// under the lockCategories advisory lock, which the categories trigger
+	// also takes, so no other parent change can interleave with the check.
+	moveCategoryQuery = `WITH RECURSIVE chain AS (
+SELECT c.id, c.parent_id, ARRAY[c.id] AS seen FROM categories c WHERE c.id = $2::uuid
+UNION ALL
+SELECT c.id, c.parent_id, chain.seen || c.id
+FROM categories c JOIN chain ON c.id = chain.parent_id
+WHERE NOT c.id = ANY(chain.seen)
+), moving AS (
+SELECT c.id FROM categories c
+WHERE c.id = $1::uuid AND NOT EXISTS (SELECT 1 FROM chain WHERE chain.id = $1::uuid)
+), siblings AS (
+SELECT c.id, row_number() OVER (ORDER BY c.position, c.name, c.id) - 1 AS idx
+FROM categories c
+WHERE c.parent_id IS NOT DISTINCT FROM $2::uuid AND c.id <> $1::uuid AND EXISTS (SELECT 1 FROM moving)
+), target AS (
+SELECT LEAST(GREATEST($3::int, 0), (SELECT count(*) FROM siblings))::int AS position
+), renumbered AS (
+UPDATE categories c SET position = CASE WHEN s.idx < t.position THEN s.idx ELSE s.idx + 1 END
+FROM siblings s, target t
+WHERE c.id = s.id
+RETURNING c.id
+)
+UPDATE categories c SET parent_id = $2::uuid, position = t.position, updated_at = now()
+FROM target t
+WHERE c.id IN (SELECT id FROM moving)
+RETURNING ` + categoryTreeColumns + `, ARRAY(SELECT id::text FROM renumbered)`
+)
+
+// CategoryNode is a descendant with its distance from the queried category.
+type CategoryNode struct {
+	Category *Category
+	Depth    int
+}
+
+// CategoryAncestors returns the ancestors of id, root first.
+func (c *Client) CategoryAncestors(ctx context.Context, id string) ([]*Category, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, categoryAncestorsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Category
+	for rows.Next() {
+		record := new(Category)
+		if err := rows.Scan(&record.ID, &record.Name, &record.Slug, &record.Description, &record.ParentID, &record.Position, &record.CreatedAt, &record.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// CategoryDescendants returns the descendants of id in depth-first order,
+// siblings sorted by position then name. A maxDepth of zero or less walks
+// the whole subtree.
+func (c *Client) CategoryDescendants(ctx context.Context, id string, maxDepth int) ([]CategoryNode, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, categoryDescendantsQuery, id, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []CategoryNode
+	for rows.Next() {
+		record := new(Category)
+		var depth int
+		if err := rows.Scan(&record.ID, &record.Name, &record.Slug, &record.Description, &record.ParentID, &record.Position, &record.CreatedAt, &record.UpdatedAt, &depth); err != nil {
+			return nil, err
+		}
+		out = append(out, CategoryNode{Category: record, Depth: depth})
+	}
+	return out, rows.Err()
+}
+
+// CategoryCreatesCycle reports whether making parentID the parent of id
+// would place id beneath itself.
+func (c *Client) CategoryCreatesCycle(ctx context.Context, id, parentID string) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("orm client is not configured")
+	}
+	if id == parentID {
+		return true, nil
+	}
+	var cycle bool
+	if err := c.db.Pool.QueryRow(ctx, categoryCreatesCycleQuery, id, parentID).Scan(&cycle); err != nil {
+		return false, err
+	}
+	return cycle, nil
+}
+
+// CategoryPostCounts counts the published posts filed under each category
+// or any of its descendants. Posts in several branches count once.
+func (c *Client) CategoryPostCounts(ctx context.Context, ids []string) (map[string]int, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]int, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, categoryPostCountsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var count int
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, err
+		}
+		out[id] = count
+	}
+	return out, rows.Err()
+}
+
+// MoveCategory re-parents a category (nil parentID moves it to the root) and
+// inserts it at position among its new siblings, which are renumbered from
+// zero. It returns nil when the category does not exist and
+// ErrCategoryCycle when the move would create a cycle.
+func (c *Client) MoveCategory(ctx context.Context, id string, parentID *string, position int) (*Category, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if parentID != nil && *parentID == id {
+		return nil, ErrCategoryCycle
+	}
+	out := new(Category)
+	var renumbered []string
+	var exists bool
+	err := c.inTx(ctx, func(tx pgx.Tx) error {
+		if err := lock(ctx, tx, lockCategories); err != nil {
+			return err
+		}
+		err := tx.QueryRow(ctx, moveCategoryQuery, id, parentID, position).
+			Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.Position, &out.CreatedAt, &out.UpdatedAt, &renumbered)
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		// The guard dropped the move: either the category is missing or
+		// the new parent lies beneath it.
+		return tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM categories WHERE id = $1)`, id).Scan(&exists)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out.ID == "" {
+		if exists {
+			return nil, ErrCategoryCycle
+		}
+		return nil, nil
+	}
+	store := c.cacheStore()
+	for _, sibling := range renumbered {
+		_ = store.Delete(ctx, makeCacheKey("Category", sibling))
+	}
+	_ = store.Set(ctx, makeCacheKey("Category", out.ID), out)
+	return out, nil
+}
@@ -47,6 +47,28 @@ func makeCacheKey(entity string, id any) string {
 	return "orm:" + entity + ":" + fmt.Sprint(id)
 }
 
+type TrashScope int
+
+const (
+	TrashExcluded TrashScope = iota
+	TrashIncluded
+	TrashOnly
+)
+
+func trashScopedTable(table string, scope TrashScope) string {
+	switch scope {
+	case TrashIncluded:
+		return table
+	case TrashOnly:
+		return "(SELECT * FROM " + table + " WHERE deleted_at IS NOT NULL) AS " + table
+	}
+	return "(SELECT * FROM " + table + " WHERE deleted_at IS NULL) AS " + table
+}
+
+func (c *Client) ApiKeys() *ApiKeyClient {
+	return &ApiKeyClient{db: c.db, cache: c.cacheStore()}
+}
+
 func (c *Client) Categories() *CategoryClient {
 	return &CategoryClient{db: c.db, cache: c.cacheStore()}
 }
@@ -55,10 +77,22 @@ func (c *Client) Comments() *CommentClient {
 	return &CommentClient{db: c.db, cache: c.cacheStore()}
 }
 
+func (c *Client) ContentTypes() *ContentTypeClient {
+	return &ContentTypeClient{db: c.db, cache: c.cacheStore()}
+}
+
 func (c *Client) Medias() *MediaClient {
 	return &MediaClient{db: c.db, cache: c.cacheStore()}
 }
 
+func (c *Client) Menus() *MenuClient {
+	return &MenuClient{db: c.db, cache: c.cacheStore()}
+}
+
+func (c *Client) MenuItems() *MenuItemClient {
+	return &MenuItemClient{db: c.db, cache: c.cacheStore()}
+}
+
 func (c *Client) Options() *OptionClient {
 	return &OptionClient{db: c.db, cache: c.cacheStore()}
 }
@@ -71,6 +105,10 @@ func (c *Client) Roles() *RoleClient {
 	return &RoleClient{db: c.db, cache: c.cacheStore()}
 }
 
+func (c *Client) SlugHistories() *SlugHistoryClient {
+	return &SlugHistoryClient{db: c.db, cache: c.cacheStore()}
+}
+
 func (c *Client) Tags() *TagClient {
 	return &TagClient{db: c.db, cache: c.cacheStore()}
 }
@@ -79,19 +117,23 @@ func (c *Client) Users() *UserClient {
 	return &UserClient{db: c.db, cache: c.cacheStore()}
 }
 
-const categoryInsertQuery = `INSERT INTO categories (id, name, slug, description, parent_id, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, name, slug, description, parent_id, created_at, updated_at`
-const categorySelectQuery = `SELECT id, name, slug, description, parent_id, created_at, updated_at FROM categories WHERE id = $1`
-const categoryListQuery = `SELECT id, name, slug, description, parent_id, created_at, updated_at FROM categories ORDER BY id LIMIT $1 OFFSET $2`
-const categoryUpdateQuery = `UPDATE categories SET name = $1, slug = $2, description = $3, parent_id = $4, updated_at = $5 WHERE id = $6 RETURNING id, name, slug, description, parent_id, created_at, updated_at`
-const categoryCountQuery = `SELECT COUNT(*) FROM categories`
-const categoryDeleteQuery = `DELETE FROM categories WHERE id = $1`
+func (c *Client) UserMetas() *UserMetaClient {
+	return &UserMetaClient{db: c.db, cache: c.cacheStore()}
+}
 
-type CategoryClient struct {
+const apiKeyInsertQuery = `INSERT INTO api_keys (id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, created_at`
+const apiKeySelectQuery = `SELECT id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, created_at FROM api_keys WHERE id = $1`
+const apiKeyListQuery = `SELECT id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, created_at FROM api_keys ORDER BY id LIMIT $1 OFFSET $2`
+const apiKeyUpdateQuery = `UPDATE api_keys SET user_id = $1, name = $2, prefix = $3, secret_hash = $4, scopes = $5, expires_at = $6, last_used_at = $7 WHERE id = $8 RETURNING id, user_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, created_at`
+const apiKeyCountQuery = `SELECT COUNT(*) FROM api_keys`
+const apiKeyDeleteQuery = `DELETE FROM api_keys WHERE id = $1`
+
+type ApiKeyClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *CategoryClient) Create(ctx context.Context, input *Category) (*Category, error) {
+func (c *ApiKeyClient) Create(ctx context.Context, input *ApiKey) (*ApiKey, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -106,24 +148,23 @@ func (c *CategoryClient) Create(ctx context.Context, input *Category) (*Category
 	if input.CreatedAt.IsZero() {
 		input.CreatedAt = now
 	}
-	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Category", validation.OpCreate, categoryValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "ApiKey", validation.OpCreate, apiKeyValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, categoryInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.ParentID, input.CreatedAt, input.UpdatedAt)
-	out := new(Category)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, apiKeyInsertQuery, input.ID, input.UserID, input.Name, input.Prefix, input.SecretHash, input.Scopes, input.ExpiresAt, input.LastUsedAt, input.CreatedAt)
+	out := new(ApiKey)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Name, &out.Prefix, &out.SecretHash, &out.Scopes, &out.ExpiresAt, &out.LastUsedAt, &out.CreatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Category", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("ApiKey", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *CategoryClient) BulkCreate(ctx context.Context, inputs []*Category) ([]*Category, error) {
+func (c *ApiKeyClient) BulkCreate(ctx context.Context, inputs []*ApiKey) ([]*ApiKey, error) {
 	if len(inputs) == 0 {
-		return []*Category{}, nil
+		return []*ApiKey{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -141,17 +182,16 @@ func (c *CategoryClient) BulkCreate(ctx context.Context, inputs []*Category) ([]
 		if input.CreatedAt.IsZero() {
 			input.CreatedAt = now
 		}
-		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Category", validation.OpCreate, categoryValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "ApiKey", validation.OpCreate, apiKeyValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.Name, input.Slug, input.Description, input.ParentID, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.UserID, input.Name, input.Prefix, input.SecretHash, input.Scopes, input.ExpiresAt, input.LastUsedAt, input.CreatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "categories",
-		Columns:   []string{"id", "name", "slug", "description", "parent_id", "created_at", "updated_at"},
-		Returning: []string{"id", "name", "slug", "description", "parent_id", "created_at", "updated_at"},
+		Table:     "api_keys",
+		Columns:   []string{"id", "user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at", "created_at"},
+		Returning: []string{"id", "user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at", "created_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -163,15 +203,15 @@ func (c *CategoryClient) BulkCreate(ctx context.Context, inputs []*Category) ([]
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Category
+	var created []*ApiKey
 	for rows.Next() {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ApiKey)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.Prefix, &item.SecretHash, &item.Scopes, &item.ExpiresAt, &item.LastUsedAt, &item.CreatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Category", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("ApiKey", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -180,49 +220,49 @@ func (c *CategoryClient) BulkCreate(ctx context.Context, inputs []*Category) ([]
 	return created, nil
 }
 
-func (c *CategoryClient) ByID(ctx context.Context, id string) (*Category, error) {
+func (c *ApiKeyClient) ByID(ctx context.Context, id string) (*ApiKey, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Category", id)
+		cachedKey = makeCacheKey("ApiKey", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Category); ok {
+			if entity, ok := value.(*ApiKey); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, categorySelectQuery, id)
-	out := new(Category)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, apiKeySelectQuery, id)
+	out := new(ApiKey)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Name, &out.Prefix, &out.SecretHash, &out.Scopes, &out.ExpiresAt, &out.LastUsedAt, &out.CreatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Category", out.ID)
+		cachedKey = makeCacheKey("ApiKey", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *CategoryClient) List(ctx context.Context, limit, offset int) ([]*Category, error) {
+func (c *ApiKeyClient) List(ctx context.Context, limit, offset int) ([]*ApiKey, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, categoryListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, apiKeyListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Category
+	var result []*ApiKey
 	for rows.Next() {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ApiKey)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.Prefix, &item.SecretHash, &item.Scopes, &item.ExpiresAt, &item.LastUsedAt, &item.CreatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -233,8 +273,8 @@ func (c *CategoryClient) List(ctx context.Context, limit, offset int) ([]*Catego
 	return result, nil
 }
 
-func (c *CategoryClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, categoryCountQuery)
+func (c *ApiKeyClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, apiKeyCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -242,32 +282,30 @@ func (c *CategoryClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *CategoryClient) Update(ctx context.Context, input *Category) (*Category, error) {
+func (c *ApiKeyClient) Update(ctx context.Context, input *ApiKey) (*ApiKey, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
 	if input.ID == "" {
 		return nil, errors.New("id is required")
 	}
-	now := time.Now().UTC()
-	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Category", validation.OpUpdate, categoryValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "ApiKey", validation.OpUpdate, apiKeyValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, categoryUpdateQuery, input.Name, input.Slug, input.Description, input.ParentID, input.UpdatedAt, input.ID)
-	out := new(Category)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, apiKeyUpdateQuery, input.UserID, input.Name, input.Prefix, input.SecretHash, input.Scopes, input.ExpiresAt, input.LastUsedAt, input.ID)
+	out := new(ApiKey)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Name, &out.Prefix, &out.SecretHash, &out.Scopes, &out.ExpiresAt, &out.LastUsedAt, &out.CreatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Category", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("ApiKey", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *CategoryClient) BulkUpdate(ctx context.Context, inputs []*Category) ([]*Category, error) {
+func (c *ApiKeyClient) BulkUpdate(ctx context.Context, inputs []*ApiKey) ([]*ApiKey, error) {
 	if len(inputs) == 0 {
-		return []*Category{}, nil
+		return []*ApiKey{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -277,22 +315,20 @@ func (c *CategoryClient) BulkUpdate(ctx context.Context, inputs []*Category) ([]
 		if input.ID == "" {
 			return nil, errors.New("id is required")
 		}
-		now := time.Now().UTC()
-		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Category", validation.OpUpdate, categoryValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "ApiKey", validation.OpUpdate, apiKeyValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.Name, input.Slug, input.Description, input.ParentID, input.UpdatedAt},
+			Values:  []any{input.UserID, input.Name, input.Prefix, input.SecretHash, input.Scopes, input.ExpiresAt, input.LastUsedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "categories",
+		Table:         "api_keys",
 		PrimaryColumn: "id",
-		Columns:       []string{"name", "slug", "description", "parent_id", "updated_at"},
-		Returning:     []string{"id", "name", "slug", "description", "parent_id", "created_at", "updated_at"},
+		Columns:       []string{"user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at"},
+		Returning:     []string{"id", "user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at", "created_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -304,15 +340,15 @@ func (c *CategoryClient) BulkUpdate(ctx context.Context, inputs []*Category) ([]
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Category
+	var updated []*ApiKey
 	for rows.Next() {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ApiKey)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.Prefix, &item.SecretHash, &item.Scopes, &item.ExpiresAt, &item.LastUsedAt, &item.CreatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Category", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("ApiKey", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -321,22 +357,22 @@ func (c *CategoryClient) BulkUpdate(ctx context.Context, inputs []*Category) ([]
 	return updated, nil
 }
 
-func (c *CategoryClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, categoryDeleteQuery, id); err != nil {
+func (c *ApiKeyClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, apiKeyDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Category", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("ApiKey", id))
 	}
 	return nil
 }
 
-func (c *CategoryClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *ApiKeyClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "categories",
+		Table:         "api_keys",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -353,13 +389,13 @@ func (c *CategoryClient) BulkDelete(ctx context.Context, ids []string) (int64, e
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Category", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("ApiKey", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type CategoryQuery struct {
+type ApiKeyQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -369,11 +405,11 @@ type CategoryQuery struct {
 	maxLimit     int
 }
 
-func (c *CategoryClient) Query() *CategoryQuery {
-	return &CategoryQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{db: c.db, defaultLimit: 200, maxLimit: 200}
 }
 
-func (q *CategoryQuery) Limit(n int) *CategoryQuery {
+func (q *ApiKeyQuery) Limit(n int) *ApiKeyQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -382,7 +418,7 @@ func (q *CategoryQuery) Limit(n int) *CategoryQuery {
 	return q
 }
 
-func (q *CategoryQuery) Offset(n int) *CategoryQuery {
+func (q *ApiKeyQuery) Offset(n int) *ApiKeyQuery {
 	if n < 0 {
 		return q
 	}
@@ -390,30 +426,25 @@ func (q *CategoryQuery) Offset(n int) *CategoryQuery {
 	return q
 }
 
-func (q *CategoryQuery) WhereIDEq(value string) *CategoryQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
-	return q
-}
-
-func (q *CategoryQuery) WhereSlugEq(value string) *CategoryQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+func (q *ApiKeyQuery) WhereUserIDEq(value string) *ApiKeyQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "user_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *CategoryQuery) WhereParentIDEq(value string) *CategoryQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "parent_id", Operator: runtime.OpEqual, Value: value})
+func (q *ApiKeyQuery) WherePrefixEq(value string) *ApiKeyQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "prefix", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *CategoryQuery) OrderByNameAsc() *CategoryQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
+func (q *ApiKeyQuery) OrderByCreatedAtDesc() *ApiKeyQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
 	return q
 }
 
-func (q *CategoryQuery) All(ctx context.Context) ([]*Category, error) {
+func (q *ApiKeyQuery) All(ctx context.Context) ([]*ApiKey, error) {
 	spec := runtime.SelectSpec{
-		Table:      "categories",
-		Columns:    []string{"id", "name", "slug", "description", "parent_id", "created_at", "updated_at"},
+		Table:      "api_keys",
+		Columns:    []string{"id", "user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at", "created_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -424,10 +455,10 @@ func (q *CategoryQuery) All(ctx context.Context) ([]*Category, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Category
+	var result []*ApiKey
 	for rows.Next() {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ApiKey)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.Prefix, &item.SecretHash, &item.Scopes, &item.ExpiresAt, &item.LastUsedAt, &item.CreatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -438,10 +469,10 @@ func (q *CategoryQuery) All(ctx context.Context) ([]*Category, error) {
 	return result, nil
 }
 
-func (q *CategoryQuery) Stream(ctx context.Context) (*runtime.Stream[*Category], error) {
+func (q *ApiKeyQuery) Stream(ctx context.Context) (*runtime.Stream[*ApiKey], error) {
 	spec := runtime.SelectSpec{
-		Table:      "categories",
-		Columns:    []string{"id", "name", "slug", "description", "parent_id", "created_at", "updated_at"},
+		Table:      "api_keys",
+		Columns:    []string{"id", "user_id", "name", "prefix", "secret_hash", "scopes", "expires_at", "last_used_at", "created_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -451,9 +482,9 @@ func (q *CategoryQuery) Stream(ctx context.Context) (*runtime.Stream[*Category],
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Category](rows, func(rows pgx.Rows) (*Category, error) {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*ApiKey](rows, func(rows pgx.Rows) (*ApiKey, error) {
+		item := new(ApiKey)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Name, &item.Prefix, &item.SecretHash, &item.Scopes, &item.ExpiresAt, &item.LastUsedAt, &item.CreatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -461,7 +492,7 @@ func (q *CategoryQuery) Stream(ctx context.Context) (*runtime.Stream[*Category],
 	return stream, nil
 }
 
-func (q *CategoryQuery) First(ctx context.Context) (*Category, error) {
+func (q *ApiKeyQuery) First(ctx context.Context) (*ApiKey, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -475,9 +506,9 @@ func (q *CategoryQuery) First(ctx context.Context) (*Category, error) {
 	return items[0], nil
 }
 
-func (q *CategoryQuery) Count(ctx context.Context) (int, error) {
+func (q *ApiKeyQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "categories",
+		Table:      "api_keys",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -489,7 +520,7 @@ func (q *CategoryQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *CategoryQuery) clone() *CategoryQuery {
+func (q *ApiKeyQuery) clone() *ApiKeyQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -504,7 +535,7 @@ func (q *CategoryQuery) clone() *CategoryQuery {
 	return &cp
 }
 
-func (q *CategoryQuery) effectiveLimit() int {
+func (q *ApiKeyQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -522,9 +553,9 @@ func (q *CategoryQuery) effectiveLimit() int {
 	return limit
 }
 
-const categoryParentRelationQuery = `SELECT id, name, slug, description, parent_id, created_at, updated_at FROM categories WHERE id IN (%s)`
+const apiKeyUserRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
 
-func (c *CategoryClient) LoadParent(ctx context.Context, parents ...*Category) error {
+func (c *ApiKeyClient) LoadUser(ctx context.Context, parents ...*ApiKey) error {
 	if len(parents) == 0 {
 		return nil
 	}
@@ -535,17 +566,12 @@ func (c *CategoryClient) LoadParent(ctx context.Context, parents ...*Category) e
 		if parent == nil {
 			continue
 		}
-		edges := ensureCategoryEdges(parent)
-		edges.markLoaded("parent")
+		edges := ensureApiKeyEdges(parent)
+		edges.markLoaded("user")
 		var fk keyType
-		fkPtr := parent.ParentID
-		if fkPtr == nil {
-			edges.Parent = nil
-			continue
-		}
-		fk = *fkPtr
+		fk = parent.UserID
 		if isZero(fk) {
-			edges.Parent = nil
+			edges.User = nil
 			continue
 		}
 		if _, ok := seen[fk]; !ok {
@@ -556,16 +582,16 @@ func (c *CategoryClient) LoadParent(ctx context.Context, parents ...*Category) e
 	if len(keys) == 0 {
 		return nil
 	}
-	sql, args := buildInQuery(categoryParentRelationQuery, keys)
+	sql, args := buildInQuery(apiKeyUserRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
-	related := make(map[keyType]*Category, len(keys))
+	related := make(map[keyType]*User, len(keys))
 	for rows.Next() {
-		item := new(Category)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return err
 		}
 		key := item.ID
@@ -578,119 +604,35 @@ func (c *CategoryClient) LoadParent(ctx context.Context, parents ...*Category) e
 		if parent == nil {
 			continue
 		}
-		edges := ensureCategoryEdges(parent)
+		edges := ensureApiKeyEdges(parent)
 		var fk keyType
-		fkPtr := parent.ParentID
-		if fkPtr == nil {
-			edges.Parent = nil
-			continue
-		}
-		fk = *fkPtr
+		fk = parent.UserID
 		if isZero(fk) {
-			edges.Parent = nil
+			edges.User = nil
 			continue
 		}
 		if item, ok := related[fk]; ok {
-			edges.Parent = item
+			edges.User = item
 		} else {
-			edges.Parent = nil
-		}
-	}
-	return nil
-}
-
-const categoryPostsRelationQuery = `SELECT id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, jt.category_id FROM posts AS t JOIN post_categories AS jt ON t.id = jt.post_id WHERE jt.category_id IN (%s)`
-
-func (c *CategoryClient) LoadPosts(ctx context.Context, parents ...*Category) error {
-	if len(parents) == 0 {
-		return nil
-	}
-	type keyType = string
-	keys := make([]keyType, 0, len(parents))
-	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*Category, len(parents))
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		key := parent.ID
-		if isZero(key) {
-			edges := ensureCategoryEdges(parent)
-			if edges.Posts == nil {
-				edges.Posts = []*Post{}
-			}
-			edges.markLoaded("posts")
-			continue
-		}
-		if _, ok := seen[key]; !ok {
-			seen[key] = struct{}{}
-			keys = append(keys, key)
-		}
-		buckets[key] = append(buckets[key], parent)
-	}
-	if len(keys) == 0 {
-		for _, parent := range parents {
-			if parent == nil {
-				continue
-			}
-			edges := ensureCategoryEdges(parent)
-			if edges.Posts == nil {
-				edges.Posts = []*Post{}
-			}
-			edges.markLoaded("posts")
-		}
-		return nil
-	}
-	sql, args := buildInQuery(categoryPostsRelationQuery, keys)
-	rows, err := c.db.Pool.Query(ctx, sql, args...)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-	for rows.Next() {
-		item := new(Post)
-		var owner keyType
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
-			return err
-		}
-		parents, ok := buckets[owner]
-		if !ok {
-			continue
-		}
-		for _, parent := range parents {
-			edges := ensureCategoryEdges(parent)
-			edges.Posts = append(edges.Posts, item)
-		}
-	}
-	if err := rows.Err(); err != nil {
-		return err
-	}
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		edges := ensureCategoryEdges(parent)
-		if edges.Posts == nil {
-			edges.Posts = []*Post{}
+			edges.User = nil
 		}
-		edges.markLoaded("posts")
 	}
 	return nil
 }
 
-const commentInsertQuery = `INSERT INTO comments (id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at`
-const commentSelectQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at FROM comments WHERE id = $1`
-const commentListQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at FROM comments ORDER BY id LIMIT $1 OFFSET $2`
-const commentUpdateQuery = `UPDATE comments SET post_id = $1, author_id = $2, parent_id = $3, author_name = $4, author_email = $5, author_url = $6, content = $7, status = $8, published_at = $9, updated_at = $10 WHERE id = $11 RETURNING id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at`
-const commentCountQuery = `SELECT COUNT(*) FROM comments`
-const commentDeleteQuery = `DELETE FROM comments WHERE id = $1`
+const categoryInsertQuery = `INSERT INTO categories (id, name, slug, description, parent_id, position, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, name, slug, description, parent_id, position, created_at, updated_at`
+const categorySelectQuery = `SELECT id, name, slug, description, parent_id, position, created_at, updated_at FROM categories WHERE id = $1`
+const categoryListQuery = `SELECT id, name, slug, description, parent_id, position, created_at, updated_at FROM categories ORDER BY id LIMIT $1 OFFSET $2`
+const categoryUpdateQuery = `UPDATE categories SET name = $1, slug = $2, description = $3, parent_id = $4, position = $5, updated_at = $6 WHERE id = $7 RETURNING id, name, slug, description, parent_id, position, created_at, updated_at`
+const categoryCountQuery = `SELECT COUNT(*) FROM categories`
+const categoryDeleteQuery = `DELETE FROM categories WHERE id = $1`
 
-type CommentClient struct {
+type CategoryClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *CommentClient) Create(ctx context.Context, input *Comment) (*Comment, error) {
+func (c *CategoryClient) Create(ctx context.Context, input *Category) (*Category, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -702,27 +644,27 @@ func (c *CommentClient) Create(ctx context.Context, input *Comment) (*Comment, e
 		}
 		input.ID = v
 	}
-	if input.SubmittedAt.IsZero() {
-		input.SubmittedAt = now
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpCreate, commentValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Category", validation.OpCreate, categoryValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, commentInsertQuery, input.ID, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.SubmittedAt, input.PublishedAt, input.UpdatedAt)
-	out := new(Comment)
-	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, categoryInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.ParentID, input.Position, input.CreatedAt, input.UpdatedAt)
+	out := new(Category)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.Position, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Comment", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Category", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *CommentClient) BulkCreate(ctx context.Context, inputs []*Comment) ([]*Comment, error) {
+func (c *CategoryClient) BulkCreate(ctx context.Context, inputs []*Category) ([]*Category, error) {
 	if len(inputs) == 0 {
-		return []*Comment{}, nil
+		return []*Category{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -737,20 +679,20 @@ func (c *CommentClient) BulkCreate(ctx context.Context, inputs []*Comment) ([]*C
 			}
 			input.ID = v
 		}
-		if input.SubmittedAt.IsZero() {
-			input.SubmittedAt = now
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpCreate, commentValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Category", validation.OpCreate, categoryValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.SubmittedAt, input.PublishedAt, input.UpdatedAt}
+		row := []any{input.ID, input.Name, input.Slug, input.Description, input.ParentID, input.Position, input.CreatedAt, input.UpdatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "comments",
-		Columns:   []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at"},
-		Returning: []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at"},
+		Table:     "categories",
+		Columns:   []string{"id", "name", "slug", "description", "parent_id", "position", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "slug", "description", "parent_id", "position", "created_at", "updated_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -762,15 +704,15 @@ func (c *CommentClient) BulkCreate(ctx context.Context, inputs []*Comment) ([]*C
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Comment
+	var created []*Category
 	for rows.Next() {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Comment", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Category", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -779,49 +721,49 @@ func (c *CommentClient) BulkCreate(ctx context.Context, inputs []*Comment) ([]*C
 	return created, nil
 }
 
-func (c *CommentClient) ByID(ctx context.Context, id string) (*Comment, error) {
+func (c *CategoryClient) ByID(ctx context.Context, id string) (*Category, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Comment", id)
+		cachedKey = makeCacheKey("Category", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Comment); ok {
+			if entity, ok := value.(*Category); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, commentSelectQuery, id)
-	out := new(Comment)
-	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, categorySelectQuery, id)
+	out := new(Category)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.Position, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Comment", out.ID)
+		cachedKey = makeCacheKey("Category", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *CommentClient) List(ctx context.Context, limit, offset int) ([]*Comment, error) {
+func (c *CategoryClient) List(ctx context.Context, limit, offset int) ([]*Category, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, commentListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, categoryListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Comment
+	var result []*Category
 	for rows.Next() {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -832,8 +774,8 @@ func (c *CommentClient) List(ctx context.Context, limit, offset int) ([]*Comment
 	return result, nil
 }
 
-func (c *CommentClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, commentCountQuery)
+func (c *CategoryClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, categoryCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -841,7 +783,7 @@ func (c *CommentClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *CommentClient) Update(ctx context.Context, input *Comment) (*Comment, error) {
+func (c *CategoryClient) Update(ctx context.Context, input *Category) (*Category, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -850,23 +792,23 @@ func (c *CommentClient) Update(ctx context.Context, input *Comment) (*Comment, e
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpUpdate, commentValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Category", validation.OpUpdate, categoryValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, commentUpdateQuery, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.PublishedAt, input.UpdatedAt, input.ID)
-	out := new(Comment)
-	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, categoryUpdateQuery, input.Name, input.Slug, input.Description, input.ParentID, input.Position, input.UpdatedAt, input.ID)
+	out := new(Category)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.ParentID, &out.Position, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Comment", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Category", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *CommentClient) BulkUpdate(ctx context.Context, inputs []*Comment) ([]*Comment, error) {
+func (c *CategoryClient) BulkUpdate(ctx context.Context, inputs []*Category) ([]*Category, error) {
 	if len(inputs) == 0 {
-		return []*Comment{}, nil
+		return []*Category{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -878,20 +820,20 @@ func (c *CommentClient) BulkUpdate(ctx context.Context, inputs []*Comment) ([]*C
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpUpdate, commentValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Category", validation.OpUpdate, categoryValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.PublishedAt, input.UpdatedAt},
+			Values:  []any{input.Name, input.Slug, input.Description, input.ParentID, input.Position, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "comments",
+		Table:         "categories",
 		PrimaryColumn: "id",
-		Columns:       []string{"post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "published_at", "updated_at"},
-		Returning:     []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at"},
+		Columns:       []string{"name", "slug", "description", "parent_id", "position", "updated_at"},
+		Returning:     []string{"id", "name", "slug", "description", "parent_id", "position", "created_at", "updated_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -903,15 +845,15 @@ func (c *CommentClient) BulkUpdate(ctx context.Context, inputs []*Comment) ([]*C
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Comment
+	var updated []*Category
 	for rows.Next() {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Comment", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Category", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -920,22 +862,22 @@ func (c *CommentClient) BulkUpdate(ctx context.Context, inputs []*Comment) ([]*C
 	return updated, nil
 }
 
-func (c *CommentClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, commentDeleteQuery, id); err != nil {
+func (c *CategoryClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, categoryDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Comment", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("Category", id))
 	}
 	return nil
 }
 
-func (c *CommentClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *CategoryClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "comments",
+		Table:         "categories",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -952,13 +894,13 @@ func (c *CommentClient) BulkDelete(ctx context.Context, ids []string) (int64, er
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Comment", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("Category", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type CommentQuery struct {
+type CategoryQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -968,11 +910,11 @@ type CommentQuery struct {
 	maxLimit     int
 }
 
-func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{db: c.db, defaultLimit: 50, maxLimit: 500}
+func (c *CategoryClient) Query() *CategoryQuery {
+	return &CategoryQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
 }
 
-func (q *CommentQuery) Limit(n int) *CommentQuery {
+func (q *CategoryQuery) Limit(n int) *CategoryQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -981,7 +923,7 @@ func (q *CommentQuery) Limit(n int) *CommentQuery {
 	return q
 }
 
-func (q *CommentQuery) Offset(n int) *CommentQuery {
+func (q *CategoryQuery) Offset(n int) *CategoryQuery {
 	if n < 0 {
 		return q
 	}
@@ -989,30 +931,35 @@ func (q *CommentQuery) Offset(n int) *CommentQuery {
 	return q
 }
 
-func (q *CommentQuery) WherePostIDEq(value string) *CommentQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "post_id", Operator: runtime.OpEqual, Value: value})
+func (q *CategoryQuery) WhereIDEq(value string) *CategoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *CommentQuery) WhereAuthorIDEq(value string) *CommentQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "author_id", Operator: runtime.OpEqual, Value: value})
+func (q *CategoryQuery) WhereSlugEq(value string) *CategoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *CommentQuery) WhereStatusEq(value string) *CommentQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "status", Operator: runtime.OpEqual, Value: value})
+func (q *CategoryQuery) WhereParentIDEq(value string) *CategoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "parent_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *CommentQuery) OrderBySubmittedAtAsc() *CommentQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "submitted_at", Direction: runtime.SortAsc})
+func (q *CategoryQuery) OrderByNameAsc() *CategoryQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
 	return q
 }
 
-func (q *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
+func (q *CategoryQuery) OrderByPositionAsc() *CategoryQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "position", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *CategoryQuery) All(ctx context.Context) ([]*Category, error) {
 	spec := runtime.SelectSpec{
-		Table:      "comments",
-		Columns:    []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at"},
+		Table:      "categories",
+		Columns:    []string{"id", "name", "slug", "description", "parent_id", "position", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -1023,10 +970,10 @@ func (q *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Comment
+	var result []*Category
 	for rows.Next() {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -1037,10 +984,10 @@ func (q *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	return result, nil
 }
 
-func (q *CommentQuery) Stream(ctx context.Context) (*runtime.Stream[*Comment], error) {
+func (q *CategoryQuery) Stream(ctx context.Context) (*runtime.Stream[*Category], error) {
 	spec := runtime.SelectSpec{
-		Table:      "comments",
-		Columns:    []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at"},
+		Table:      "categories",
+		Columns:    []string{"id", "name", "slug", "description", "parent_id", "position", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -1050,9 +997,9 @@ func (q *CommentQuery) Stream(ctx context.Context) (*runtime.Stream[*Comment], e
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Comment](rows, func(rows pgx.Rows) (*Comment, error) {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*Category](rows, func(rows pgx.Rows) (*Category, error) {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -1060,7 +1007,7 @@ func (q *CommentQuery) Stream(ctx context.Context) (*runtime.Stream[*Comment], e
 	return stream, nil
 }
 
-func (q *CommentQuery) First(ctx context.Context) (*Comment, error) {
+func (q *CategoryQuery) First(ctx context.Context) (*Category, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -1074,9 +1021,9 @@ func (q *CommentQuery) First(ctx context.Context) (*Comment, error) {
 	return items[0], nil
 }
 
-func (q *CommentQuery) Count(ctx context.Context) (int, error) {
+func (q *CategoryQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "comments",
+		Table:      "categories",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -1088,7 +1035,7 @@ func (q *CommentQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *CommentQuery) clone() *CommentQuery {
+func (q *CategoryQuery) clone() *CategoryQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -1103,7 +1050,7 @@ func (q *CommentQuery) clone() *CommentQuery {
 	return &cp
 }
 
-func (q *CommentQuery) effectiveLimit() int {
+func (q *CategoryQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -1121,9 +1068,9 @@ func (q *CommentQuery) effectiveLimit() int {
 	return limit
 }
 
-const commentPostRelationQuery = `SELECT id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at FROM posts WHERE id IN (%s)`
+const categoryParentRelationQuery = `SELECT id, name, slug, description, parent_id, position, created_at, updated_at FROM categories WHERE id IN (%s)`
 
-func (c *CommentClient) LoadPost(ctx context.Context, parents ...*Comment) error {
+func (c *CategoryClient) LoadParent(ctx context.Context, parents ...*Category) error {
 	if len(parents) == 0 {
 		return nil
 	}
@@ -1134,12 +1081,17 @@ func (c *CommentClient) LoadPost(ctx context.Context, parents ...*Comment) error
 		if parent == nil {
 			continue
 		}
-		edges := ensureCommentEdges(parent)
-		edges.markLoaded("post")
+		edges := ensureCategoryEdges(parent)
+		edges.markLoaded("parent")
 		var fk keyType
-		fk = parent.PostID
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
 		if isZero(fk) {
-			edges.Post = nil
+			edges.Parent = nil
 			continue
 		}
 		if _, ok := seen[fk]; !ok {
@@ -1150,16 +1102,16 @@ func (c *CommentClient) LoadPost(ctx context.Context, parents ...*Comment) error
 	if len(keys) == 0 {
 		return nil
 	}
-	sql, args := buildInQuery(commentPostRelationQuery, keys)
+	sql, args := buildInQuery(categoryParentRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
-	related := make(map[keyType]*Post, len(keys))
+	related := make(map[keyType]*Category, len(keys))
 	for rows.Next() {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Category)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return err
 		}
 		key := item.ID
@@ -1172,70 +1124,89 @@ func (c *CommentClient) LoadPost(ctx context.Context, parents ...*Comment) error
 		if parent == nil {
 			continue
 		}
-		edges := ensureCommentEdges(parent)
+		edges := ensureCategoryEdges(parent)
 		var fk keyType
-		fk = parent.PostID
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
 		if isZero(fk) {
-			edges.Post = nil
+			edges.Parent = nil
 			continue
 		}
 		if item, ok := related[fk]; ok {
-			edges.Post = item
+			edges.Parent = item
 		} else {
-			edges.Post = nil
+			edges.Parent = nil
 		}
 	}
 	return nil
 }
 
-const commentAuthorRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
+const categoryPostsRelationQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at, jt.category_id FROM posts AS t JOIN post_categories AS jt ON t.id = jt.post_id WHERE jt.category_id IN (%s) AND t.deleted_at IS NULL`
 
-func (c *CommentClient) LoadAuthor(ctx context.Context, parents ...*Comment) error {
+func (c *CategoryClient) LoadPosts(ctx context.Context, parents ...*Category) error {
 	if len(parents) == 0 {
 		return nil
 	}
 	type keyType = string
 	keys := make([]keyType, 0, len(parents))
 	seen := make(map[keyType]struct{}, len(parents))
+	buckets := make(map[keyType][]*Category, len(parents))
 	for _, parent := range parents {
 		if parent == nil {
 			continue
 		}
-		edges := ensureCommentEdges(parent)
-		edges.markLoaded("author")
-		var fk keyType
-		fkPtr := parent.AuthorID
-		if fkPtr == nil {
-			edges.Author = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.Author = nil
+		key := parent.ID
+		if isZero(key) {
+			edges := ensureCategoryEdges(parent)
+			if edges.Posts == nil {
+				edges.Posts = []*Post{}
+			}
+			edges.markLoaded("posts")
 			continue
 		}
-		if _, ok := seen[fk]; !ok {
-			seen[fk] = struct{}{}
-			keys = append(keys, fk)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
 		}
+		buckets[key] = append(buckets[key], parent)
 	}
 	if len(keys) == 0 {
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+			edges := ensureCategoryEdges(parent)
+			if edges.Posts == nil {
+				edges.Posts = []*Post{}
+			}
+			edges.markLoaded("posts")
+		}
 		return nil
 	}
-	sql, args := buildInQuery(commentAuthorRelationQuery, keys)
+	sql, args := buildInQuery(categoryPostsRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
-	related := make(map[keyType]*User, len(keys))
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Post)
+		var owner keyType
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt, &owner); err != nil {
 			return err
 		}
-		key := item.ID
-		related[key] = item
+		parents, ok := buckets[owner]
+		if !ok {
+			continue
+		}
+		for _, parent := range parents {
+			edges := ensureCategoryEdges(parent)
+			edges.Posts = append(edges.Posts, item)
+		}
 	}
 	if err := rows.Err(); err != nil {
 		return err
@@ -1244,149 +1215,62 @@ func (c *CommentClient) LoadAuthor(ctx context.Context, parents ...*Comment) err
 		if parent == nil {
 			continue
 		}
-		edges := ensureCommentEdges(parent)
-		var fk keyType
-		fkPtr := parent.AuthorID
-		if fkPtr == nil {
-			edges.Author = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.Author = nil
-			continue
-		}
-		if item, ok := related[fk]; ok {
-			edges.Author = item
-		} else {
-			edges.Author = nil
+		edges := ensureCategoryEdges(parent)
+		if edges.Posts == nil {
+			edges.Posts = []*Post{}
 		}
+		edges.markLoaded("posts")
 	}
 	return nil
 }
 
-const commentParentRelationQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at FROM comments WHERE id IN (%s)`
+const commentInsertQuery = `INSERT INTO comments (id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at`
+const commentSelectQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at FROM comments WHERE id = $1 AND deleted_at IS NULL`
+const commentListQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at FROM comments WHERE deleted_at IS NULL ORDER BY id LIMIT $1 OFFSET $2`
+const commentUpdateQuery = `UPDATE comments SET post_id = $1, author_id = $2, parent_id = $3, author_name = $4, author_email = $5, author_url = $6, content = $7, status = $8, published_at = $9, updated_at = $10 WHERE id = $11 RETURNING id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at`
+const commentCountQuery = `SELECT COUNT(*) FROM comments WHERE deleted_at IS NULL`
+const commentDeleteQuery = `UPDATE comments SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+const commentBulkDeleteQuery = `UPDATE comments SET deleted_at = now() WHERE id = ANY($1) AND deleted_at IS NULL`
+const commentRestoreQuery = `UPDATE comments SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL RETURNING id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at`
 
-func (c *CommentClient) LoadParent(ctx context.Context, parents ...*Comment) error {
-	if len(parents) == 0 {
-		return nil
+type CommentClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *CommentClient) Create(ctx context.Context, input *Comment) (*Comment, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
 	}
-	type keyType = string
-	keys := make([]keyType, 0, len(parents))
-	seen := make(map[keyType]struct{}, len(parents))
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		edges := ensureCommentEdges(parent)
-		edges.markLoaded("parent")
-		var fk keyType
-		fkPtr := parent.ParentID
-		if fkPtr == nil {
-			edges.Parent = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.Parent = nil
-			continue
-		}
-		if _, ok := seen[fk]; !ok {
-			seen[fk] = struct{}{}
-			keys = append(keys, fk)
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
 		}
+		input.ID = v
 	}
-	if len(keys) == 0 {
-		return nil
+	if input.SubmittedAt.IsZero() {
+		input.SubmittedAt = now
 	}
-	sql, args := buildInQuery(commentParentRelationQuery, keys)
-	rows, err := c.db.Pool.Query(ctx, sql, args...)
-	if err != nil {
-		return err
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpCreate, commentValidationRecord(input), input); err != nil {
+		return nil, err
 	}
-	defer rows.Close()
-	related := make(map[keyType]*Comment, len(keys))
-	for rows.Next() {
-		item := new(Comment)
-		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt); err != nil {
-			return err
-		}
-		key := item.ID
-		related[key] = item
-	}
-	if err := rows.Err(); err != nil {
-		return err
-	}
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		edges := ensureCommentEdges(parent)
-		var fk keyType
-		fkPtr := parent.ParentID
-		if fkPtr == nil {
-			edges.Parent = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.Parent = nil
-			continue
-		}
-		if item, ok := related[fk]; ok {
-			edges.Parent = item
-		} else {
-			edges.Parent = nil
-		}
-	}
-	return nil
-}
-
-const mediaInsertQuery = `INSERT INTO medias (id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at`
-const mediaSelectQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at FROM medias WHERE id = $1`
-const mediaListQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at FROM medias ORDER BY id LIMIT $1 OFFSET $2`
-const mediaUpdateQuery = `UPDATE medias SET uploaded_by_id = $1, file_name = $2, mime_type = $3, storage_key = $4, url = $5, title = $6, alt_text = $7, caption = $8, description = $9, file_size_bytes = $10, metadata = $11, updated_at = $12 WHERE id = $13 RETURNING id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at`
-const mediaCountQuery = `SELECT COUNT(*) FROM medias`
-const mediaDeleteQuery = `DELETE FROM medias WHERE id = $1`
-
-type MediaClient struct {
-	db    *pg.DB
-	cache cache.Store
-}
-
-func (c *MediaClient) Create(ctx context.Context, input *Media) (*Media, error) {
-	if input == nil {
-		return nil, errors.New("input cannot be nil")
-	}
-	now := time.Now().UTC()
-	if input.ID == "" {
-		v, err := id.NewV7()
-		if err != nil {
-			return nil, err
-		}
-		input.ID = v
-	}
-	if input.CreatedAt.IsZero() {
-		input.CreatedAt = now
-	}
-	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Media", validation.OpCreate, mediaValidationRecord(input), input); err != nil {
-		return nil, err
-	}
-	row := c.db.Pool.QueryRow(ctx, mediaInsertQuery, input.ID, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.CreatedAt, input.UpdatedAt)
-	out := new(Media)
-	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, commentInsertQuery, input.ID, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.SubmittedAt, input.PublishedAt, input.UpdatedAt, input.DeletedAt)
+	out := new(Comment)
+	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Media", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Comment", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *MediaClient) BulkCreate(ctx context.Context, inputs []*Media) ([]*Media, error) {
+func (c *CommentClient) BulkCreate(ctx context.Context, inputs []*Comment) ([]*Comment, error) {
 	if len(inputs) == 0 {
-		return []*Media{}, nil
+		return []*Comment{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -1401,20 +1285,20 @@ func (c *MediaClient) BulkCreate(ctx context.Context, inputs []*Media) ([]*Media
 			}
 			input.ID = v
 		}
-		if input.CreatedAt.IsZero() {
-			input.CreatedAt = now
+		if input.SubmittedAt.IsZero() {
+			input.SubmittedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Media", validation.OpCreate, mediaValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpCreate, commentValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.SubmittedAt, input.PublishedAt, input.UpdatedAt, input.DeletedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "medias",
-		Columns:   []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at"},
-		Returning: []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at"},
+		Table:     "comments",
+		Columns:   []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at", "deleted_at"},
+		Returning: []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at", "deleted_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -1426,15 +1310,15 @@ func (c *MediaClient) BulkCreate(ctx context.Context, inputs []*Media) ([]*Media
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Media
+	var created []*Comment
 	for rows.Next() {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Media", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Comment", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -1443,49 +1327,49 @@ func (c *MediaClient) BulkCreate(ctx context.Context, inputs []*Media) ([]*Media
 	return created, nil
 }
 
-func (c *MediaClient) ByID(ctx context.Context, id string) (*Media, error) {
+func (c *CommentClient) ByID(ctx context.Context, id string) (*Comment, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Media", id)
+		cachedKey = makeCacheKey("Comment", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Media); ok {
+			if entity, ok := value.(*Comment); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, mediaSelectQuery, id)
-	out := new(Media)
-	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, commentSelectQuery, id)
+	out := new(Comment)
+	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Media", out.ID)
+		cachedKey = makeCacheKey("Comment", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *MediaClient) List(ctx context.Context, limit, offset int) ([]*Media, error) {
+func (c *CommentClient) List(ctx context.Context, limit, offset int) ([]*Comment, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, mediaListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, commentListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Media
+	var result []*Comment
 	for rows.Next() {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -1496,8 +1380,8 @@ func (c *MediaClient) List(ctx context.Context, limit, offset int) ([]*Media, er
 	return result, nil
 }
 
-func (c *MediaClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, mediaCountQuery)
+func (c *CommentClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, commentCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -1505,7 +1389,7 @@ func (c *MediaClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *MediaClient) Update(ctx context.Context, input *Media) (*Media, error) {
+func (c *CommentClient) Update(ctx context.Context, input *Comment) (*Comment, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -1514,23 +1398,23 @@ func (c *MediaClient) Update(ctx context.Context, input *Media) (*Media, error)
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Media", validation.OpUpdate, mediaValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpUpdate, commentValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, mediaUpdateQuery, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.UpdatedAt, input.ID)
-	out := new(Media)
-	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, commentUpdateQuery, input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.PublishedAt, input.UpdatedAt, input.ID)
+	out := new(Comment)
+	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Media", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Comment", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *MediaClient) BulkUpdate(ctx context.Context, inputs []*Media) ([]*Media, error) {
+func (c *CommentClient) BulkUpdate(ctx context.Context, inputs []*Comment) ([]*Comment, error) {
 	if len(inputs) == 0 {
-		return []*Media{}, nil
+		return []*Comment{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -1542,20 +1426,20 @@ func (c *MediaClient) BulkUpdate(ctx context.Context, inputs []*Media) ([]*Media
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Media", validation.OpUpdate, mediaValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Comment", validation.OpUpdate, commentValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.UpdatedAt},
+			Values:  []any{input.PostID, input.AuthorID, input.ParentID, input.AuthorName, input.AuthorEmail, input.AuthorURL, input.Content, input.Status, input.PublishedAt, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "medias",
+		Table:         "comments",
 		PrimaryColumn: "id",
-		Columns:       []string{"uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "updated_at"},
-		Returning:     []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at"},
+		Columns:       []string{"post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "published_at", "updated_at"},
+		Returning:     []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at", "deleted_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -1567,15 +1451,15 @@ func (c *MediaClient) BulkUpdate(ctx context.Context, inputs []*Media) ([]*Media
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Media
+	var updated []*Comment
 	for rows.Next() {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Media", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Comment", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -1584,45 +1468,48 @@ func (c *MediaClient) BulkUpdate(ctx context.Context, inputs []*Media) ([]*Media
 	return updated, nil
 }
 
-func (c *MediaClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, mediaDeleteQuery, id); err != nil {
+func (c *CommentClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, commentDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Media", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("Comment", id))
 	}
 	return nil
 }
 
-func (c *MediaClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *CommentClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
-	spec := runtime.BulkDeleteSpec{
-		Table:         "medias",
-		PrimaryColumn: "id",
-		IDs:           make([]any, len(ids)),
-	}
-	for i, id := range ids {
-		spec.IDs[i] = id
-	}
-	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
-	if err != nil {
-		return 0, err
-	}
-	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	tag, err := c.db.Pool.Exec(ctx, commentBulkDeleteQuery, ids)
 	if err != nil {
 		return 0, err
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Media", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("Comment", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type MediaQuery struct {
+func (c *CommentClient) Restore(ctx context.Context, id string) (*Comment, error) {
+	row := c.db.Pool.QueryRow(ctx, commentRestoreQuery, id)
+	out := new(Comment)
+	if err := row.Scan(&out.ID, &out.PostID, &out.AuthorID, &out.ParentID, &out.AuthorName, &out.AuthorEmail, &out.AuthorURL, &out.Content, &out.Status, &out.SubmittedAt, &out.PublishedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Comment", out.ID), out)
+	}
+	return out, nil
+}
+
+type CommentQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -1630,13 +1517,14 @@ type MediaQuery struct {
 	offset       int
 	defaultLimit int
 	maxLimit     int
+	trash        TrashScope
 }
 
-func (c *MediaClient) Query() *MediaQuery {
-	return &MediaQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{db: c.db, defaultLimit: 50, maxLimit: 500}
 }
 
-func (q *MediaQuery) Limit(n int) *MediaQuery {
+func (q *CommentQuery) Limit(n int) *CommentQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -1645,7 +1533,7 @@ func (q *MediaQuery) Limit(n int) *MediaQuery {
 	return q
 }
 
-func (q *MediaQuery) Offset(n int) *MediaQuery {
+func (q *CommentQuery) Offset(n int) *CommentQuery {
 	if n < 0 {
 		return q
 	}
@@ -1653,30 +1541,40 @@ func (q *MediaQuery) Offset(n int) *MediaQuery {
 	return q
 }
 
-func (q *MediaQuery) WhereIDEq(value string) *MediaQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+func (q *CommentQuery) WithTrashed() *CommentQuery {
+	q.trash = TrashIncluded
 	return q
 }
 
-func (q *MediaQuery) WhereUploadedByIDEq(value string) *MediaQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "uploaded_by_id", Operator: runtime.OpEqual, Value: value})
+func (q *CommentQuery) OnlyTrashed() *CommentQuery {
+	q.trash = TrashOnly
 	return q
 }
 
-func (q *MediaQuery) WhereMimeTypeILike(value string) *MediaQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "mime_type", Operator: runtime.OpILike, Value: value})
+func (q *CommentQuery) WherePostIDEq(value string) *CommentQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "post_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *MediaQuery) OrderByCreatedAtDesc() *MediaQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+func (q *CommentQuery) WhereAuthorIDEq(value string) *CommentQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "author_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *MediaQuery) All(ctx context.Context) ([]*Media, error) {
+func (q *CommentQuery) WhereStatusEq(value string) *CommentQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "status", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *CommentQuery) OrderBySubmittedAtAsc() *CommentQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "submitted_at", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *CommentQuery) All(ctx context.Context) ([]*Comment, error) {
 	spec := runtime.SelectSpec{
-		Table:      "medias",
-		Columns:    []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at"},
+		Table:      trashScopedTable("comments", q.trash),
+		Columns:    []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at", "deleted_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -1687,10 +1585,10 @@ func (q *MediaQuery) All(ctx context.Context) ([]*Media, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Media
+	var result []*Comment
 	for rows.Next() {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -1701,10 +1599,10 @@ func (q *MediaQuery) All(ctx context.Context) ([]*Media, error) {
 	return result, nil
 }
 
-func (q *MediaQuery) Stream(ctx context.Context) (*runtime.Stream[*Media], error) {
+func (q *CommentQuery) Stream(ctx context.Context) (*runtime.Stream[*Comment], error) {
 	spec := runtime.SelectSpec{
-		Table:      "medias",
-		Columns:    []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at"},
+		Table:      trashScopedTable("comments", q.trash),
+		Columns:    []string{"id", "post_id", "author_id", "parent_id", "author_name", "author_email", "author_url", "content", "status", "submitted_at", "published_at", "updated_at", "deleted_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -1714,9 +1612,9 @@ func (q *MediaQuery) Stream(ctx context.Context) (*runtime.Stream[*Media], error
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Media](rows, func(rows pgx.Rows) (*Media, error) {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*Comment](rows, func(rows pgx.Rows) (*Comment, error) {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -1724,7 +1622,7 @@ func (q *MediaQuery) Stream(ctx context.Context) (*runtime.Stream[*Media], error
 	return stream, nil
 }
 
-func (q *MediaQuery) First(ctx context.Context) (*Media, error) {
+func (q *CommentQuery) First(ctx context.Context) (*Comment, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -1738,9 +1636,9 @@ func (q *MediaQuery) First(ctx context.Context) (*Media, error) {
 	return items[0], nil
 }
 
-func (q *MediaQuery) Count(ctx context.Context) (int, error) {
+func (q *CommentQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "medias",
+		Table:      trashScopedTable("comments", q.trash),
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -1752,7 +1650,7 @@ func (q *MediaQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *MediaQuery) clone() *MediaQuery {
+func (q *CommentQuery) clone() *CommentQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -1767,7 +1665,7 @@ func (q *MediaQuery) clone() *MediaQuery {
 	return &cp
 }
 
-func (q *MediaQuery) effectiveLimit() int {
+func (q *CommentQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -1785,9 +1683,9 @@ func (q *MediaQuery) effectiveLimit() int {
 	return limit
 }
 
-const mediaUploadedByRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
+const commentPostRelationQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at FROM posts WHERE id IN (%s) AND deleted_at IS NULL`
 
-func (c *MediaClient) LoadUploadedBy(ctx context.Context, parents ...*Media) error {
+func (c *CommentClient) LoadPost(ctx context.Context, parents ...*Comment) error {
 	if len(parents) == 0 {
 		return nil
 	}
@@ -1798,17 +1696,12 @@ func (c *MediaClient) LoadUploadedBy(ctx context.Context, parents ...*Media) err
 		if parent == nil {
 			continue
 		}
-		edges := ensureMediaEdges(parent)
-		edges.markLoaded("uploaded_by")
+		edges := ensureCommentEdges(parent)
+		edges.markLoaded("post")
 		var fk keyType
-		fkPtr := parent.UploadedByID
-		if fkPtr == nil {
-			edges.UploadedBy = nil
-			continue
-		}
-		fk = *fkPtr
+		fk = parent.PostID
 		if isZero(fk) {
-			edges.UploadedBy = nil
+			edges.Post = nil
 			continue
 		}
 		if _, ok := seen[fk]; !ok {
@@ -1819,16 +1712,16 @@ func (c *MediaClient) LoadUploadedBy(ctx context.Context, parents ...*Media) err
 	if len(keys) == 0 {
 		return nil
 	}
-	sql, args := buildInQuery(mediaUploadedByRelationQuery, keys)
+	sql, args := buildInQuery(commentPostRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
-	related := make(map[keyType]*User, len(keys))
+	related := make(map[keyType]*Post, len(keys))
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return err
 		}
 		key := item.ID
@@ -1841,40 +1734,189 @@ func (c *MediaClient) LoadUploadedBy(ctx context.Context, parents ...*Media) err
 		if parent == nil {
 			continue
 		}
-		edges := ensureMediaEdges(parent)
+		edges := ensureCommentEdges(parent)
 		var fk keyType
-		fkPtr := parent.UploadedByID
-		if fkPtr == nil {
-			edges.UploadedBy = nil
-			continue
-		}
-		fk = *fkPtr
+		fk = parent.PostID
 		if isZero(fk) {
-			edges.UploadedBy = nil
+			edges.Post = nil
 			continue
 		}
 		if item, ok := related[fk]; ok {
-			edges.UploadedBy = item
+			edges.Post = item
 		} else {
-			edges.UploadedBy = nil
+			edges.Post = nil
 		}
 	}
 	return nil
 }
 
-const optionInsertQuery = `INSERT INTO options (id, name, value, autoload, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, name, value, autoload, created_at, updated_at`
-const optionSelectQuery = `SELECT id, name, value, autoload, created_at, updated_at FROM options WHERE id = $1`
-const optionListQuery = `SELECT id, name, value, autoload, created_at, updated_at FROM options ORDER BY id LIMIT $1 OFFSET $2`
-const optionUpdateQuery = `UPDATE options SET name = $1, value = $2, autoload = $3, updated_at = $4 WHERE id = $5 RETURNING id, name, value, autoload, created_at, updated_at`
-const optionCountQuery = `SELECT COUNT(*) FROM options`
-const optionDeleteQuery = `DELETE FROM options WHERE id = $1`
+const commentAuthorRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
 
-type OptionClient struct {
+func (c *CommentClient) LoadAuthor(ctx context.Context, parents ...*Comment) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureCommentEdges(parent)
+		edges.markLoaded("author")
+		var fk keyType
+		fkPtr := parent.AuthorID
+		if fkPtr == nil {
+			edges.Author = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Author = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(commentAuthorRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*User, len(keys))
+	for rows.Next() {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureCommentEdges(parent)
+		var fk keyType
+		fkPtr := parent.AuthorID
+		if fkPtr == nil {
+			edges.Author = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Author = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Author = item
+		} else {
+			edges.Author = nil
+		}
+	}
+	return nil
+}
+
+const commentParentRelationQuery = `SELECT id, post_id, author_id, parent_id, author_name, author_email, author_url, content, status, submitted_at, published_at, updated_at, deleted_at FROM comments WHERE id IN (%s) AND deleted_at IS NULL`
+
+func (c *CommentClient) LoadParent(ctx context.Context, parents ...*Comment) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureCommentEdges(parent)
+		edges.markLoaded("parent")
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(commentParentRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*Comment, len(keys))
+	for rows.Next() {
+		item := new(Comment)
+		if err := rows.Scan(&item.ID, &item.PostID, &item.AuthorID, &item.ParentID, &item.AuthorName, &item.AuthorEmail, &item.AuthorURL, &item.Content, &item.Status, &item.SubmittedAt, &item.PublishedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureCommentEdges(parent)
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Parent = item
+		} else {
+			edges.Parent = nil
+		}
+	}
+	return nil
+}
+
+const contentTypeInsertQuery = `INSERT INTO content_types (id, name, label, plural_label, description, supports, fields, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, name, label, plural_label, description, supports, fields, created_at, updated_at`
+const contentTypeSelectQuery = `SELECT id, name, label, plural_label, description, supports, fields, created_at, updated_at FROM content_types WHERE id = $1`
+const contentTypeListQuery = `SELECT id, name, label, plural_label, description, supports, fields, created_at, updated_at FROM content_types ORDER BY id LIMIT $1 OFFSET $2`
+const contentTypeUpdateQuery = `UPDATE content_types SET name = $1, label = $2, plural_label = $3, description = $4, supports = $5, fields = $6, updated_at = $7 WHERE id = $8 RETURNING id, name, label, plural_label, description, supports, fields, created_at, updated_at`
+const contentTypeCountQuery = `SELECT COUNT(*) FROM content_types`
+const contentTypeDeleteQuery = `DELETE FROM content_types WHERE id = $1`
+
+type ContentTypeClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *OptionClient) Create(ctx context.Context, input *Option) (*Option, error) {
+func (c *ContentTypeClient) Create(ctx context.Context, input *ContentType) (*ContentType, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -1890,23 +1932,23 @@ func (c *OptionClient) Create(ctx context.Context, input *Option) (*Option, erro
 		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Option", validation.OpCreate, optionValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "ContentType", validation.OpCreate, contentTypeValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, optionInsertQuery, input.ID, input.Name, input.Value, input.Autoload, input.CreatedAt, input.UpdatedAt)
-	out := new(Option)
-	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, contentTypeInsertQuery, input.ID, input.Name, input.Label, input.PluralLabel, input.Description, input.Supports, input.Fields, input.CreatedAt, input.UpdatedAt)
+	out := new(ContentType)
+	if err := row.Scan(&out.ID, &out.Name, &out.Label, &out.PluralLabel, &out.Description, &out.Supports, &out.Fields, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Option", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("ContentType", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *OptionClient) BulkCreate(ctx context.Context, inputs []*Option) ([]*Option, error) {
+func (c *ContentTypeClient) BulkCreate(ctx context.Context, inputs []*ContentType) ([]*ContentType, error) {
 	if len(inputs) == 0 {
-		return []*Option{}, nil
+		return []*ContentType{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -1925,16 +1967,16 @@ func (c *OptionClient) BulkCreate(ctx context.Context, inputs []*Option) ([]*Opt
 			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Option", validation.OpCreate, optionValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "ContentType", validation.OpCreate, contentTypeValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.Name, input.Value, input.Autoload, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.Name, input.Label, input.PluralLabel, input.Description, input.Supports, input.Fields, input.CreatedAt, input.UpdatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "options",
-		Columns:   []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
-		Returning: []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Table:     "content_types",
+		Columns:   []string{"id", "name", "label", "plural_label", "description", "supports", "fields", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "label", "plural_label", "description", "supports", "fields", "created_at", "updated_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -1946,15 +1988,15 @@ func (c *OptionClient) BulkCreate(ctx context.Context, inputs []*Option) ([]*Opt
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Option
+	var created []*ContentType
 	for rows.Next() {
-		item := new(Option)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Option", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("ContentType", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -1963,49 +2005,49 @@ func (c *OptionClient) BulkCreate(ctx context.Context, inputs []*Option) ([]*Opt
 	return created, nil
 }
 
-func (c *OptionClient) ByID(ctx context.Context, id string) (*Option, error) {
+func (c *ContentTypeClient) ByID(ctx context.Context, id string) (*ContentType, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Option", id)
+		cachedKey = makeCacheKey("ContentType", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Option); ok {
+			if entity, ok := value.(*ContentType); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, optionSelectQuery, id)
-	out := new(Option)
-	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, contentTypeSelectQuery, id)
+	out := new(ContentType)
+	if err := row.Scan(&out.ID, &out.Name, &out.Label, &out.PluralLabel, &out.Description, &out.Supports, &out.Fields, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Option", out.ID)
+		cachedKey = makeCacheKey("ContentType", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *OptionClient) List(ctx context.Context, limit, offset int) ([]*Option, error) {
+func (c *ContentTypeClient) List(ctx context.Context, limit, offset int) ([]*ContentType, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, optionListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, contentTypeListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Option
+	var result []*ContentType
 	for rows.Next() {
-		item := new(Option)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -2016,8 +2058,8 @@ func (c *OptionClient) List(ctx context.Context, limit, offset int) ([]*Option,
 	return result, nil
 }
 
-func (c *OptionClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, optionCountQuery)
+func (c *ContentTypeClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, contentTypeCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -2025,7 +2067,7 @@ func (c *OptionClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *OptionClient) Update(ctx context.Context, input *Option) (*Option, error) {
+func (c *ContentTypeClient) Update(ctx context.Context, input *ContentType) (*ContentType, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -2034,23 +2076,23 @@ func (c *OptionClient) Update(ctx context.Context, input *Option) (*Option, erro
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Option", validation.OpUpdate, optionValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "ContentType", validation.OpUpdate, contentTypeValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, optionUpdateQuery, input.Name, input.Value, input.Autoload, input.UpdatedAt, input.ID)
-	out := new(Option)
-	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, contentTypeUpdateQuery, input.Name, input.Label, input.PluralLabel, input.Description, input.Supports, input.Fields, input.UpdatedAt, input.ID)
+	out := new(ContentType)
+	if err := row.Scan(&out.ID, &out.Name, &out.Label, &out.PluralLabel, &out.Description, &out.Supports, &out.Fields, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Option", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("ContentType", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *OptionClient) BulkUpdate(ctx context.Context, inputs []*Option) ([]*Option, error) {
+func (c *ContentTypeClient) BulkUpdate(ctx context.Context, inputs []*ContentType) ([]*ContentType, error) {
 	if len(inputs) == 0 {
-		return []*Option{}, nil
+		return []*ContentType{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -2062,20 +2104,20 @@ func (c *OptionClient) BulkUpdate(ctx context.Context, inputs []*Option) ([]*Opt
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Option", validation.OpUpdate, optionValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "ContentType", validation.OpUpdate, contentTypeValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.Name, input.Value, input.Autoload, input.UpdatedAt},
+			Values:  []any{input.Name, input.Label, input.PluralLabel, input.Description, input.Supports, input.Fields, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "options",
+		Table:         "content_types",
 		PrimaryColumn: "id",
-		Columns:       []string{"name", "value", "autoload", "updated_at"},
-		Returning:     []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Columns:       []string{"name", "label", "plural_label", "description", "supports", "fields", "updated_at"},
+		Returning:     []string{"id", "name", "label", "plural_label", "description", "supports", "fields", "created_at", "updated_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -2087,15 +2129,15 @@ func (c *OptionClient) BulkUpdate(ctx context.Context, inputs []*Option) ([]*Opt
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Option
+	var updated []*ContentType
 	for rows.Next() {
-		item := new(Option)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Option", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("ContentType", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -2104,22 +2146,22 @@ func (c *OptionClient) BulkUpdate(ctx context.Context, inputs []*Option) ([]*Opt
 	return updated, nil
 }
 
-func (c *OptionClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, optionDeleteQuery, id); err != nil {
+func (c *ContentTypeClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, contentTypeDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Option", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("ContentType", id))
 	}
 	return nil
 }
 
-func (c *OptionClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *ContentTypeClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "options",
+		Table:         "content_types",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -2136,13 +2178,13 @@ func (c *OptionClient) BulkDelete(ctx context.Context, ids []string) (int64, err
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Option", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("ContentType", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type OptionQuery struct {
+type ContentTypeQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -2152,11 +2194,11 @@ type OptionQuery struct {
 	maxLimit     int
 }
 
-func (c *OptionClient) Query() *OptionQuery {
-	return &OptionQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
+func (c *ContentTypeClient) Query() *ContentTypeQuery {
+	return &ContentTypeQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
 }
 
-func (q *OptionQuery) Limit(n int) *OptionQuery {
+func (q *ContentTypeQuery) Limit(n int) *ContentTypeQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -2165,7 +2207,7 @@ func (q *OptionQuery) Limit(n int) *OptionQuery {
 	return q
 }
 
-func (q *OptionQuery) Offset(n int) *OptionQuery {
+func (q *ContentTypeQuery) Offset(n int) *ContentTypeQuery {
 	if n < 0 {
 		return q
 	}
@@ -2173,20 +2215,25 @@ func (q *OptionQuery) Offset(n int) *OptionQuery {
 	return q
 }
 
-func (q *OptionQuery) WhereNameEq(value string) *OptionQuery {
+func (q *ContentTypeQuery) WhereIDEq(value string) *ContentTypeQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *ContentTypeQuery) WhereNameEq(value string) *ContentTypeQuery {
 	q.predicates = append(q.predicates, runtime.Predicate{Column: "name", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *OptionQuery) OrderByIDAsc() *OptionQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "id", Direction: runtime.SortAsc})
+func (q *ContentTypeQuery) OrderByNameAsc() *ContentTypeQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
 	return q
 }
 
-func (q *OptionQuery) All(ctx context.Context) ([]*Option, error) {
+func (q *ContentTypeQuery) All(ctx context.Context) ([]*ContentType, error) {
 	spec := runtime.SelectSpec{
-		Table:      "options",
-		Columns:    []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Table:      "content_types",
+		Columns:    []string{"id", "name", "label", "plural_label", "description", "supports", "fields", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -2197,10 +2244,10 @@ func (q *OptionQuery) All(ctx context.Context) ([]*Option, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Option
+	var result []*ContentType
 	for rows.Next() {
-		item := new(Option)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -2211,10 +2258,10 @@ func (q *OptionQuery) All(ctx context.Context) ([]*Option, error) {
 	return result, nil
 }
 
-func (q *OptionQuery) Stream(ctx context.Context) (*runtime.Stream[*Option], error) {
+func (q *ContentTypeQuery) Stream(ctx context.Context) (*runtime.Stream[*ContentType], error) {
 	spec := runtime.SelectSpec{
-		Table:      "options",
-		Columns:    []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Table:      "content_types",
+		Columns:    []string{"id", "name", "label", "plural_label", "description", "supports", "fields", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -2224,9 +2271,9 @@ func (q *OptionQuery) Stream(ctx context.Context) (*runtime.Stream[*Option], err
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Option](rows, func(rows pgx.Rows) (*Option, error) {
-		item := new(Option)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*ContentType](rows, func(rows pgx.Rows) (*ContentType, error) {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -2234,7 +2281,7 @@ func (q *OptionQuery) Stream(ctx context.Context) (*runtime.Stream[*Option], err
 	return stream, nil
 }
 
-func (q *OptionQuery) First(ctx context.Context) (*Option, error) {
+func (q *ContentTypeQuery) First(ctx context.Context) (*ContentType, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -2248,9 +2295,9 @@ func (q *OptionQuery) First(ctx context.Context) (*Option, error) {
 	return items[0], nil
 }
 
-func (q *OptionQuery) Count(ctx context.Context) (int, error) {
+func (q *ContentTypeQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "options",
+		Table:      "content_types",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -2262,7 +2309,7 @@ func (q *OptionQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *OptionQuery) clone() *OptionQuery {
+func (q *ContentTypeQuery) clone() *ContentTypeQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -2277,7 +2324,7 @@ func (q *OptionQuery) clone() *OptionQuery {
 	return &cp
 }
 
-func (q *OptionQuery) effectiveLimit() int {
+func (q *ContentTypeQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -2295,19 +2342,21 @@ func (q *OptionQuery) effectiveLimit() int {
 	return limit
 }
 
-const postInsertQuery = `INSERT INTO posts (id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at`
-const postSelectQuery = `SELECT id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at FROM posts WHERE id = $1`
-const postListQuery = `SELECT id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at FROM posts ORDER BY id LIMIT $1 OFFSET $2`
-const postUpdateQuery = `UPDATE posts SET author_id = $1, featured_media_id = $2, title = $3, slug = $4, status = $5, type = $6, excerpt = $7, content = $8, seo = $9, published_at = $10, updated_at = $11 WHERE id = $12 RETURNING id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at`
-const postCountQuery = `SELECT COUNT(*) FROM posts`
-const postDeleteQuery = `DELETE FROM posts WHERE id = $1`
+const mediaInsertQuery = `INSERT INTO medias (id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at`
+const mediaSelectQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at FROM medias WHERE id = $1 AND deleted_at IS NULL`
+const mediaListQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at FROM medias WHERE deleted_at IS NULL ORDER BY id LIMIT $1 OFFSET $2`
+const mediaUpdateQuery = `UPDATE medias SET uploaded_by_id = $1, file_name = $2, mime_type = $3, storage_key = $4, url = $5, title = $6, alt_text = $7, caption = $8, description = $9, file_size_bytes = $10, metadata = $11, updated_at = $12 WHERE id = $13 RETURNING id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at`
+const mediaCountQuery = `SELECT COUNT(*) FROM medias WHERE deleted_at IS NULL`
+const mediaDeleteQuery = `UPDATE medias SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+const mediaBulkDeleteQuery = `UPDATE medias SET deleted_at = now() WHERE id = ANY($1) AND deleted_at IS NULL`
+const mediaRestoreQuery = `UPDATE medias SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL RETURNING id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at`
 
-type PostClient struct {
+type MediaClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *PostClient) Create(ctx context.Context, input *Post) (*Post, error) {
+func (c *MediaClient) Create(ctx context.Context, input *Media) (*Media, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -2323,23 +2372,23 @@ func (c *PostClient) Create(ctx context.Context, input *Post) (*Post, error) {
 		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Post", validation.OpCreate, postValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Media", validation.OpCreate, mediaValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, postInsertQuery, input.ID, input.AuthorID, input.FeaturedMediaID, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.CreatedAt, input.UpdatedAt)
-	out := new(Post)
-	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, mediaInsertQuery, input.ID, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.CreatedAt, input.UpdatedAt, input.DeletedAt)
+	out := new(Media)
+	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Post", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Media", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *PostClient) BulkCreate(ctx context.Context, inputs []*Post) ([]*Post, error) {
+func (c *MediaClient) BulkCreate(ctx context.Context, inputs []*Media) ([]*Media, error) {
 	if len(inputs) == 0 {
-		return []*Post{}, nil
+		return []*Media{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -2358,16 +2407,16 @@ func (c *PostClient) BulkCreate(ctx context.Context, inputs []*Post) ([]*Post, e
 			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Post", validation.OpCreate, postValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Media", validation.OpCreate, mediaValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.AuthorID, input.FeaturedMediaID, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.CreatedAt, input.UpdatedAt, input.DeletedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "posts",
-		Columns:   []string{"id", "author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at"},
-		Returning: []string{"id", "author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at"},
+		Table:     "medias",
+		Columns:   []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at", "deleted_at"},
+		Returning: []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at", "deleted_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -2379,15 +2428,15 @@ func (c *PostClient) BulkCreate(ctx context.Context, inputs []*Post) ([]*Post, e
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Post
+	var created []*Media
 	for rows.Next() {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Post", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Media", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -2396,49 +2445,49 @@ func (c *PostClient) BulkCreate(ctx context.Context, inputs []*Post) ([]*Post, e
 	return created, nil
 }
 
-func (c *PostClient) ByID(ctx context.Context, id string) (*Post, error) {
+func (c *MediaClient) ByID(ctx context.Context, id string) (*Media, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Post", id)
+		cachedKey = makeCacheKey("Media", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Post); ok {
+			if entity, ok := value.(*Media); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, postSelectQuery, id)
-	out := new(Post)
-	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, mediaSelectQuery, id)
+	out := new(Media)
+	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Post", out.ID)
+		cachedKey = makeCacheKey("Media", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *PostClient) List(ctx context.Context, limit, offset int) ([]*Post, error) {
+func (c *MediaClient) List(ctx context.Context, limit, offset int) ([]*Media, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, postListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, mediaListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Post
+	var result []*Media
 	for rows.Next() {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -2449,8 +2498,8 @@ func (c *PostClient) List(ctx context.Context, limit, offset int) ([]*Post, erro
 	return result, nil
 }
 
-func (c *PostClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, postCountQuery)
+func (c *MediaClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, mediaCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -2458,7 +2507,7 @@ func (c *PostClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *PostClient) Update(ctx context.Context, input *Post) (*Post, error) {
+func (c *MediaClient) Update(ctx context.Context, input *Media) (*Media, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -2467,23 +2516,23 @@ func (c *PostClient) Update(ctx context.Context, input *Post) (*Post, error) {
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Post", validation.OpUpdate, postValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Media", validation.OpUpdate, mediaValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, postUpdateQuery, input.AuthorID, input.FeaturedMediaID, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.UpdatedAt, input.ID)
-	out := new(Post)
-	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, mediaUpdateQuery, input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.UpdatedAt, input.ID)
+	out := new(Media)
+	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Post", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Media", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *PostClient) BulkUpdate(ctx context.Context, inputs []*Post) ([]*Post, error) {
+func (c *MediaClient) BulkUpdate(ctx context.Context, inputs []*Media) ([]*Media, error) {
 	if len(inputs) == 0 {
-		return []*Post{}, nil
+		return []*Media{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -2495,20 +2544,20 @@ func (c *PostClient) BulkUpdate(ctx context.Context, inputs []*Post) ([]*Post, e
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Post", validation.OpUpdate, postValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Media", validation.OpUpdate, mediaValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.AuthorID, input.FeaturedMediaID, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.UpdatedAt},
+			Values:  []any{input.UploadedByID, input.FileName, input.MimeType, input.StorageKey, input.URL, input.Title, input.AltText, input.Caption, input.Description, input.FileSizeBytes, input.Metadata, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "posts",
+		Table:         "medias",
 		PrimaryColumn: "id",
-		Columns:       []string{"author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "updated_at"},
-		Returning:     []string{"id", "author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at"},
+		Columns:       []string{"uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "updated_at"},
+		Returning:     []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at", "deleted_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -2520,15 +2569,15 @@ func (c *PostClient) BulkUpdate(ctx context.Context, inputs []*Post) ([]*Post, e
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Post
+	var updated []*Media
 	for rows.Next() {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Post", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Media", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -2537,45 +2586,48 @@ func (c *PostClient) BulkUpdate(ctx context.Context, inputs []*Post) ([]*Post, e
 	return updated, nil
 }
 
-func (c *PostClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, postDeleteQuery, id); err != nil {
+func (c *MediaClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, mediaDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Post", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("Media", id))
 	}
 	return nil
 }
 
-func (c *PostClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *MediaClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
-	spec := runtime.BulkDeleteSpec{
-		Table:         "posts",
-		PrimaryColumn: "id",
-		IDs:           make([]any, len(ids)),
-	}
-	for i, id := range ids {
-		spec.IDs[i] = id
-	}
-	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
-	if err != nil {
-		return 0, err
-	}
-	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	tag, err := c.db.Pool.Exec(ctx, mediaBulkDeleteQuery, ids)
 	if err != nil {
 		return 0, err
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Post", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("Media", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type PostQuery struct {
+func (c *MediaClient) Restore(ctx context.Context, id string) (*Media, error) {
+	row := c.db.Pool.QueryRow(ctx, mediaRestoreQuery, id)
+	out := new(Media)
+	if err := row.Scan(&out.ID, &out.UploadedByID, &out.FileName, &out.MimeType, &out.StorageKey, &out.URL, &out.Title, &out.AltText, &out.Caption, &out.Description, &out.FileSizeBytes, &out.Metadata, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Media", out.ID), out)
+	}
+	return out, nil
+}
+
+type MediaQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -2583,13 +2635,14 @@ type PostQuery struct {
 	offset       int
 	defaultLimit int
 	maxLimit     int
+	trash        TrashScope
 }
 
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{db: c.db, defaultLimit: 20, maxLimit: 200}
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
 }
 
-func (q *PostQuery) Limit(n int) *PostQuery {
+func (q *MediaQuery) Limit(n int) *MediaQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -2598,7 +2651,7 @@ func (q *PostQuery) Limit(n int) *PostQuery {
 	return q
 }
 
-func (q *PostQuery) Offset(n int) *PostQuery {
+func (q *MediaQuery) Offset(n int) *MediaQuery {
 	if n < 0 {
 		return q
 	}
@@ -2606,45 +2659,40 @@ func (q *PostQuery) Offset(n int) *PostQuery {
 	return q
 }
 
-func (q *PostQuery) WhereIDEq(value string) *PostQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
-	return q
-}
-
-func (q *PostQuery) WhereSlugEq(value string) *PostQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+func (q *MediaQuery) WithTrashed() *MediaQuery {
+	q.trash = TrashIncluded
 	return q
 }
 
-func (q *PostQuery) WhereAuthorIDEq(value string) *PostQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "author_id", Operator: runtime.OpEqual, Value: value})
+func (q *MediaQuery) OnlyTrashed() *MediaQuery {
+	q.trash = TrashOnly
 	return q
 }
 
-func (q *PostQuery) WhereStatusEq(value string) *PostQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "status", Operator: runtime.OpEqual, Value: value})
+func (q *MediaQuery) WhereIDEq(value string) *MediaQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *PostQuery) WhereTypeEq(value string) *PostQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "type", Operator: runtime.OpEqual, Value: value})
+func (q *MediaQuery) WhereUploadedByIDEq(value string) *MediaQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "uploaded_by_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *PostQuery) OrderByPublishedAtDesc() *PostQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "published_at", Direction: runtime.SortDesc})
+func (q *MediaQuery) WhereMimeTypeILike(value string) *MediaQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "mime_type", Operator: runtime.OpILike, Value: value})
 	return q
 }
 
-func (q *PostQuery) OrderByCreatedAtDesc() *PostQuery {
+func (q *MediaQuery) OrderByCreatedAtDesc() *MediaQuery {
 	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
 	return q
 }
 
-func (q *PostQuery) All(ctx context.Context) ([]*Post, error) {
+func (q *MediaQuery) All(ctx context.Context) ([]*Media, error) {
 	spec := runtime.SelectSpec{
-		Table:      "posts",
-		Columns:    []string{"id", "author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at"},
+		Table:      trashScopedTable("medias", q.trash),
+		Columns:    []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at", "deleted_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -2655,10 +2703,10 @@ func (q *PostQuery) All(ctx context.Context) ([]*Post, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Post
+	var result []*Media
 	for rows.Next() {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -2669,10 +2717,10 @@ func (q *PostQuery) All(ctx context.Context) ([]*Post, error) {
 	return result, nil
 }
 
-func (q *PostQuery) Stream(ctx context.Context) (*runtime.Stream[*Post], error) {
+func (q *MediaQuery) Stream(ctx context.Context) (*runtime.Stream[*Media], error) {
 	spec := runtime.SelectSpec{
-		Table:      "posts",
-		Columns:    []string{"id", "author_id", "featured_media_id", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at"},
+		Table:      trashScopedTable("medias", q.trash),
+		Columns:    []string{"id", "uploaded_by_id", "file_name", "mime_type", "storage_key", "url", "title", "alt_text", "caption", "description", "file_size_bytes", "metadata", "created_at", "updated_at", "deleted_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -2682,9 +2730,9 @@ func (q *PostQuery) Stream(ctx context.Context) (*runtime.Stream[*Post], error)
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Post](rows, func(rows pgx.Rows) (*Post, error) {
-		item := new(Post)
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*Media](rows, func(rows pgx.Rows) (*Media, error) {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -2692,7 +2740,7 @@ func (q *PostQuery) Stream(ctx context.Context) (*runtime.Stream[*Post], error)
 	return stream, nil
 }
 
-func (q *PostQuery) First(ctx context.Context) (*Post, error) {
+func (q *MediaQuery) First(ctx context.Context) (*Media, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -2706,9 +2754,9 @@ func (q *PostQuery) First(ctx context.Context) (*Post, error) {
 	return items[0], nil
 }
 
-func (q *PostQuery) Count(ctx context.Context) (int, error) {
+func (q *MediaQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "posts",
+		Table:      trashScopedTable("medias", q.trash),
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -2720,7 +2768,7 @@ func (q *PostQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *PostQuery) clone() *PostQuery {
+func (q *MediaQuery) clone() *MediaQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -2735,7 +2783,7 @@ func (q *PostQuery) clone() *PostQuery {
 	return &cp
 }
 
-func (q *PostQuery) effectiveLimit() int {
+func (q *MediaQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -2753,9 +2801,9 @@ func (q *PostQuery) effectiveLimit() int {
 	return limit
 }
 
-const postAuthorRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
+const mediaUploadedByRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
 
-func (c *PostClient) LoadAuthor(ctx context.Context, parents ...*Post) error {
+func (c *MediaClient) LoadUploadedBy(ctx context.Context, parents ...*Media) error {
 	if len(parents) == 0 {
 		return nil
 	}
@@ -2766,12 +2814,17 @@ func (c *PostClient) LoadAuthor(ctx context.Context, parents ...*Post) error {
 		if parent == nil {
 			continue
 		}
-		edges := ensurePostEdges(parent)
-		edges.markLoaded("author")
+		edges := ensureMediaEdges(parent)
+		edges.markLoaded("uploaded_by")
 		var fk keyType
-		fk = parent.AuthorID
+		fkPtr := parent.UploadedByID
+		if fkPtr == nil {
+			edges.UploadedBy = nil
+			continue
+		}
+		fk = *fkPtr
 		if isZero(fk) {
-			edges.Author = nil
+			edges.UploadedBy = nil
 			continue
 		}
 		if _, ok := seen[fk]; !ok {
@@ -2782,7 +2835,7 @@ func (c *PostClient) LoadAuthor(ctx context.Context, parents ...*Post) error {
 	if len(keys) == 0 {
 		return nil
 	}
-	sql, args := buildInQuery(postAuthorRelationQuery, keys)
+	sql, args := buildInQuery(mediaUploadedByRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
@@ -2804,270 +2857,3403 @@ func (c *PostClient) LoadAuthor(ctx context.Context, parents ...*Post) error {
 		if parent == nil {
 			continue
 		}
-		edges := ensurePostEdges(parent)
+		edges := ensureMediaEdges(parent)
 		var fk keyType
-		fk = parent.AuthorID
+		fkPtr := parent.UploadedByID
+		if fkPtr == nil {
+			edges.UploadedBy = nil
+			continue
+		}
+		fk = *fkPtr
 		if isZero(fk) {
-			edges.Author = nil
+			edges.UploadedBy = nil
 			continue
 		}
 		if item, ok := related[fk]; ok {
-			edges.Author = item
+			edges.UploadedBy = item
 		} else {
-			edges.Author = nil
+			edges.UploadedBy = nil
 		}
 	}
 	return nil
 }
 
-const postFeaturedMediaRelationQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at FROM medias WHERE id IN (%s)`
+const menuInsertQuery = `INSERT INTO menus (id, name, location, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, name, location, description, created_at, updated_at`
+const menuSelectQuery = `SELECT id, name, location, description, created_at, updated_at FROM menus WHERE id = $1`
+const menuListQuery = `SELECT id, name, location, description, created_at, updated_at FROM menus ORDER BY id LIMIT $1 OFFSET $2`
+const menuUpdateQuery = `UPDATE menus SET name = $1, location = $2, description = $3, updated_at = $4 WHERE id = $5 RETURNING id, name, location, description, created_at, updated_at`
+const menuCountQuery = `SELECT COUNT(*) FROM menus`
+const menuDeleteQuery = `DELETE FROM menus WHERE id = $1`
 
-func (c *PostClient) LoadFeaturedMedia(ctx context.Context, parents ...*Post) error {
-	if len(parents) == 0 {
-		return nil
+type MenuClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *MenuClient) Create(ctx context.Context, input *Menu) (*Menu, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
 	}
-	type keyType = string
-	keys := make([]keyType, 0, len(parents))
-	seen := make(map[keyType]struct{}, len(parents))
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		edges := ensurePostEdges(parent)
-		edges.markLoaded("featured_media")
-		var fk keyType
-		fkPtr := parent.FeaturedMediaID
-		if fkPtr == nil {
-			edges.FeaturedMedia = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.FeaturedMedia = nil
-			continue
-		}
-		if _, ok := seen[fk]; !ok {
-			seen[fk] = struct{}{}
-			keys = append(keys, fk)
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
 		}
+		input.ID = v
 	}
-	if len(keys) == 0 {
-		return nil
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
 	}
-	sql, args := buildInQuery(postFeaturedMediaRelationQuery, keys)
-	rows, err := c.db.Pool.Query(ctx, sql, args...)
-	if err != nil {
-		return err
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Menu", validation.OpCreate, menuValidationRecord(input), input); err != nil {
+		return nil, err
 	}
-	defer rows.Close()
-	related := make(map[keyType]*Media, len(keys))
-	for rows.Next() {
-		item := new(Media)
-		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt); err != nil {
-			return err
-		}
-		key := item.ID
-		related[key] = item
+	row := c.db.Pool.QueryRow(ctx, menuInsertQuery, input.ID, input.Name, input.Location, input.Description, input.CreatedAt, input.UpdatedAt)
+	out := new(Menu)
+	if err := row.Scan(&out.ID, &out.Name, &out.Location, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
 	}
-	if err := rows.Err(); err != nil {
-		return err
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Menu", out.ID), out)
 	}
-	for _, parent := range parents {
-		if parent == nil {
-			continue
-		}
-		edges := ensurePostEdges(parent)
-		var fk keyType
-		fkPtr := parent.FeaturedMediaID
-		if fkPtr == nil {
-			edges.FeaturedMedia = nil
-			continue
-		}
-		fk = *fkPtr
-		if isZero(fk) {
-			edges.FeaturedMedia = nil
-			continue
-		}
-		if item, ok := related[fk]; ok {
-			edges.FeaturedMedia = item
-		} else {
-			edges.FeaturedMedia = nil
-		}
-	}
-	return nil
+	return out, nil
 }
 
-const postCategoriesRelationQuery = `SELECT id, name, slug, description, parent_id, created_at, updated_at, jt.post_id FROM categories AS t JOIN post_categories AS jt ON t.id = jt.category_id WHERE jt.post_id IN (%s)`
-
-func (c *PostClient) LoadCategories(ctx context.Context, parents ...*Post) error {
-	if len(parents) == 0 {
-		return nil
+func (c *MenuClient) BulkCreate(ctx context.Context, inputs []*Menu) ([]*Menu, error) {
+	if len(inputs) == 0 {
+		return []*Menu{}, nil
 	}
-	type keyType = string
-	keys := make([]keyType, 0, len(parents))
-	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*Post, len(parents))
-	for _, parent := range parents {
-		if parent == nil {
-			continue
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
 		}
-		key := parent.ID
-		if isZero(key) {
-			edges := ensurePostEdges(parent)
-			if edges.Categories == nil {
-				edges.Categories = []*Category{}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
 			}
-			edges.markLoaded("categories")
-			continue
+			input.ID = v
 		}
-		if _, ok := seen[key]; !ok {
-			seen[key] = struct{}{}
-			keys = append(keys, key)
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
 		}
-		buckets[key] = append(buckets[key], parent)
-	}
-	if len(keys) == 0 {
-		for _, parent := range parents {
-			if parent == nil {
-				continue
-			}
-			edges := ensurePostEdges(parent)
-			if edges.Categories == nil {
-				edges.Categories = []*Category{}
-			}
-			edges.markLoaded("categories")
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Menu", validation.OpCreate, menuValidationRecord(input), input); err != nil {
+			return nil, err
 		}
-		return nil
+		row := []any{input.ID, input.Name, input.Location, input.Description, input.CreatedAt, input.UpdatedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "menus",
+		Columns:   []string{"id", "name", "location", "description", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "location", "description", "created_at", "updated_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
 	}
-	sql, args := buildInQuery(postCategoriesRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rows.Close()
+	var created []*Menu
 	for rows.Next() {
-		item := new(Category)
-		var owner keyType
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
-			return err
-		}
-		parents, ok := buckets[owner]
-		if !ok {
-			continue
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
 		}
-		for _, parent := range parents {
-			edges := ensurePostEdges(parent)
-			edges.Categories = append(edges.Categories, item)
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Menu", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
-		return err
+		return nil, err
 	}
-	for _, parent := range parents {
-		if parent == nil {
-			continue
+	return created, nil
+}
+
+func (c *MenuClient) ByID(ctx context.Context, id string) (*Menu, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Menu", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*Menu); ok {
+				return entity, nil
+			}
 		}
-		edges := ensurePostEdges(parent)
-		if edges.Categories == nil {
-			edges.Categories = []*Category{}
+	}
+	row := c.db.Pool.QueryRow(ctx, menuSelectQuery, id)
+	out := new(Menu)
+	if err := row.Scan(&out.ID, &out.Name, &out.Location, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
 		}
-		edges.markLoaded("categories")
+		return nil, err
 	}
-	return nil
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Menu", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
 }
 
-const postTagsRelationQuery = `SELECT id, name, slug, description, created_at, updated_at, jt.post_id FROM tags AS t JOIN post_tags AS jt ON t.id = jt.tag_id WHERE jt.post_id IN (%s)`
+func (c *MenuClient) List(ctx context.Context, limit, offset int) ([]*Menu, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, menuListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Menu
+	for rows.Next() {
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-func (c *PostClient) LoadTags(ctx context.Context, parents ...*Post) error {
-	if len(parents) == 0 {
-		return nil
+func (c *MenuClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, menuCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
 	}
-	type keyType = string
-	keys := make([]keyType, 0, len(parents))
-	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*Post, len(parents))
-	for _, parent := range parents {
-		if parent == nil {
-			continue
+	return total, nil
+}
+
+func (c *MenuClient) Update(ctx context.Context, input *Menu) (*Menu, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Menu", validation.OpUpdate, menuValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, menuUpdateQuery, input.Name, input.Location, input.Description, input.UpdatedAt, input.ID)
+	out := new(Menu)
+	if err := row.Scan(&out.ID, &out.Name, &out.Location, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Menu", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *MenuClient) BulkUpdate(ctx context.Context, inputs []*Menu) ([]*Menu, error) {
+	if len(inputs) == 0 {
+		return []*Menu{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
 		}
-		key := parent.ID
-		if isZero(key) {
-			edges := ensurePostEdges(parent)
-			if edges.Tags == nil {
-				edges.Tags = []*Tag{}
-			}
-			edges.markLoaded("tags")
-			continue
+		if input.ID == "" {
+			return nil, errors.New("id is required")
 		}
-		if _, ok := seen[key]; !ok {
-			seen[key] = struct{}{}
-			keys = append(keys, key)
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Menu", validation.OpUpdate, menuValidationRecord(input), input); err != nil {
+			return nil, err
 		}
-		buckets[key] = append(buckets[key], parent)
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.Name, input.Location, input.Description, input.UpdatedAt},
+		}
+		specs = append(specs, row)
 	}
-	if len(keys) == 0 {
-		for _, parent := range parents {
-			if parent == nil {
-				continue
-			}
-			edges := ensurePostEdges(parent)
-			if edges.Tags == nil {
-				edges.Tags = []*Tag{}
-			}
-			edges.markLoaded("tags")
+	spec := runtime.BulkUpdateSpec{
+		Table:         "menus",
+		PrimaryColumn: "id",
+		Columns:       []string{"name", "location", "description", "updated_at"},
+		Returning:     []string{"id", "name", "location", "description", "created_at", "updated_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*Menu
+	for rows.Next() {
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
 		}
-		return nil
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Menu", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *MenuClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, menuDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("Menu", id))
+	}
+	return nil
+}
+
+func (c *MenuClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	spec := runtime.BulkDeleteSpec{
+		Table:         "menus",
+		PrimaryColumn: "id",
+		IDs:           make([]any, len(ids)),
+	}
+	for i, id := range ids {
+		spec.IDs[i] = id
+	}
+	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("Menu", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+type MenuQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+}
+
+func (c *MenuClient) Query() *MenuQuery {
+	return &MenuQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+}
+
+func (q *MenuQuery) Limit(n int) *MenuQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *MenuQuery) Offset(n int) *MenuQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *MenuQuery) WhereIDEq(value string) *MenuQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *MenuQuery) WhereLocationEq(value string) *MenuQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "location", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *MenuQuery) OrderByNameAsc() *MenuQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *MenuQuery) All(ctx context.Context) ([]*Menu, error) {
+	spec := runtime.SelectSpec{
+		Table:      "menus",
+		Columns:    []string{"id", "name", "location", "description", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Menu
+	for rows.Next() {
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *MenuQuery) Stream(ctx context.Context) (*runtime.Stream[*Menu], error) {
+	spec := runtime.SelectSpec{
+		Table:      "menus",
+		Columns:    []string{"id", "name", "location", "description", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*Menu](rows, func(rows pgx.Rows) (*Menu, error) {
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *MenuQuery) First(ctx context.Context) (*Menu, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *MenuQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      "menus",
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *MenuQuery) clone() *MenuQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *MenuQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
+		}
+		return limit
+	}
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
+}
+
+const menuItemInsertQuery = `INSERT INTO menu_items (id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at`
+const menuItemSelectQuery = `SELECT id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at FROM menu_items WHERE id = $1`
+const menuItemListQuery = `SELECT id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at FROM menu_items ORDER BY id LIMIT $1 OFFSET $2`
+const menuItemUpdateQuery = `UPDATE menu_items SET menu_id = $1, parent_id = $2, position = $3, kind = $4, target_id = $5, label = $6, url = $7, updated_at = $8 WHERE id = $9 RETURNING id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at`
+const menuItemCountQuery = `SELECT COUNT(*) FROM menu_items`
+const menuItemDeleteQuery = `DELETE FROM menu_items WHERE id = $1`
+
+type MenuItemClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *MenuItemClient) Create(ctx context.Context, input *MenuItem) (*MenuItem, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		input.ID = v
+	}
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "MenuItem", validation.OpCreate, menuItemValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, menuItemInsertQuery, input.ID, input.MenuID, input.ParentID, input.Position, input.Kind, input.TargetID, input.Label, input.URL, input.CreatedAt, input.UpdatedAt)
+	out := new(MenuItem)
+	if err := row.Scan(&out.ID, &out.MenuID, &out.ParentID, &out.Position, &out.Kind, &out.TargetID, &out.Label, &out.URL, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("MenuItem", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *MenuItemClient) BulkCreate(ctx context.Context, inputs []*MenuItem) ([]*MenuItem, error) {
+	if len(inputs) == 0 {
+		return []*MenuItem{}, nil
+	}
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			input.ID = v
+		}
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
+		}
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "MenuItem", validation.OpCreate, menuItemValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := []any{input.ID, input.MenuID, input.ParentID, input.Position, input.Kind, input.TargetID, input.Label, input.URL, input.CreatedAt, input.UpdatedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "menu_items",
+		Columns:   []string{"id", "menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "created_at", "updated_at"},
+		Returning: []string{"id", "menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "created_at", "updated_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var created []*MenuItem
+	for rows.Next() {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("MenuItem", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *MenuItemClient) ByID(ctx context.Context, id string) (*MenuItem, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("MenuItem", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*MenuItem); ok {
+				return entity, nil
+			}
+		}
+	}
+	row := c.db.Pool.QueryRow(ctx, menuItemSelectQuery, id)
+	out := new(MenuItem)
+	if err := row.Scan(&out.ID, &out.MenuID, &out.ParentID, &out.Position, &out.Kind, &out.TargetID, &out.Label, &out.URL, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		cachedKey = makeCacheKey("MenuItem", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
+}
+
+func (c *MenuItemClient) List(ctx context.Context, limit, offset int) ([]*MenuItem, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, menuItemListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*MenuItem
+	for rows.Next() {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *MenuItemClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, menuItemCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (c *MenuItemClient) Update(ctx context.Context, input *MenuItem) (*MenuItem, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "MenuItem", validation.OpUpdate, menuItemValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, menuItemUpdateQuery, input.MenuID, input.ParentID, input.Position, input.Kind, input.TargetID, input.Label, input.URL, input.UpdatedAt, input.ID)
+	out := new(MenuItem)
+	if err := row.Scan(&out.ID, &out.MenuID, &out.ParentID, &out.Position, &out.Kind, &out.TargetID, &out.Label, &out.URL, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("MenuItem", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *MenuItemClient) BulkUpdate(ctx context.Context, inputs []*MenuItem) ([]*MenuItem, error) {
+	if len(inputs) == 0 {
+		return []*MenuItem{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		if input.ID == "" {
+			return nil, errors.New("id is required")
+		}
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "MenuItem", validation.OpUpdate, menuItemValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.MenuID, input.ParentID, input.Position, input.Kind, input.TargetID, input.Label, input.URL, input.UpdatedAt},
+		}
+		specs = append(specs, row)
+	}
+	spec := runtime.BulkUpdateSpec{
+		Table:         "menu_items",
+		PrimaryColumn: "id",
+		Columns:       []string{"menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "updated_at"},
+		Returning:     []string{"id", "menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "created_at", "updated_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*MenuItem
+	for rows.Next() {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("MenuItem", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *MenuItemClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, menuItemDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("MenuItem", id))
+	}
+	return nil
+}
+
+func (c *MenuItemClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	spec := runtime.BulkDeleteSpec{
+		Table:         "menu_items",
+		PrimaryColumn: "id",
+		IDs:           make([]any, len(ids)),
+	}
+	for i, id := range ids {
+		spec.IDs[i] = id
+	}
+	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("MenuItem", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+type MenuItemQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+}
+
+func (c *MenuItemClient) Query() *MenuItemQuery {
+	return &MenuItemQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
+}
+
+func (q *MenuItemQuery) Limit(n int) *MenuItemQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *MenuItemQuery) Offset(n int) *MenuItemQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *MenuItemQuery) WhereIDEq(value string) *MenuItemQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *MenuItemQuery) WhereMenuIDEq(value string) *MenuItemQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "menu_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *MenuItemQuery) WhereParentIDEq(value string) *MenuItemQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "parent_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *MenuItemQuery) OrderByPositionAsc() *MenuItemQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "position", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *MenuItemQuery) All(ctx context.Context) ([]*MenuItem, error) {
+	spec := runtime.SelectSpec{
+		Table:      "menu_items",
+		Columns:    []string{"id", "menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*MenuItem
+	for rows.Next() {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *MenuItemQuery) Stream(ctx context.Context) (*runtime.Stream[*MenuItem], error) {
+	spec := runtime.SelectSpec{
+		Table:      "menu_items",
+		Columns:    []string{"id", "menu_id", "parent_id", "position", "kind", "target_id", "label", "url", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*MenuItem](rows, func(rows pgx.Rows) (*MenuItem, error) {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *MenuItemQuery) First(ctx context.Context) (*MenuItem, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *MenuItemQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      "menu_items",
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *MenuItemQuery) clone() *MenuItemQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *MenuItemQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
+		}
+		return limit
+	}
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
+}
+
+const menuItemMenuRelationQuery = `SELECT id, name, location, description, created_at, updated_at FROM menus WHERE id IN (%s)`
+
+func (c *MenuItemClient) LoadMenu(ctx context.Context, parents ...*MenuItem) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureMenuItemEdges(parent)
+		edges.markLoaded("menu")
+		var fk keyType
+		fk = parent.MenuID
+		if isZero(fk) {
+			edges.Menu = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(menuItemMenuRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*Menu, len(keys))
+	for rows.Next() {
+		item := new(Menu)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Location, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureMenuItemEdges(parent)
+		var fk keyType
+		fk = parent.MenuID
+		if isZero(fk) {
+			edges.Menu = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Menu = item
+		} else {
+			edges.Menu = nil
+		}
+	}
+	return nil
+}
+
+const menuItemParentRelationQuery = `SELECT id, menu_id, parent_id, position, kind, target_id, label, url, created_at, updated_at FROM menu_items WHERE id IN (%s)`
+
+func (c *MenuItemClient) LoadParent(ctx context.Context, parents ...*MenuItem) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureMenuItemEdges(parent)
+		edges.markLoaded("parent")
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(menuItemParentRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*MenuItem, len(keys))
+	for rows.Next() {
+		item := new(MenuItem)
+		if err := rows.Scan(&item.ID, &item.MenuID, &item.ParentID, &item.Position, &item.Kind, &item.TargetID, &item.Label, &item.URL, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureMenuItemEdges(parent)
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Parent = item
+		} else {
+			edges.Parent = nil
+		}
+	}
+	return nil
+}
+
+const optionInsertQuery = `INSERT INTO options (id, name, value, autoload, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, name, value, autoload, created_at, updated_at`
+const optionSelectQuery = `SELECT id, name, value, autoload, created_at, updated_at FROM options WHERE id = $1`
+const optionListQuery = `SELECT id, name, value, autoload, created_at, updated_at FROM options ORDER BY id LIMIT $1 OFFSET $2`
+const optionUpdateQuery = `UPDATE options SET name = $1, value = $2, autoload = $3, updated_at = $4 WHERE id = $5 RETURNING id, name, value, autoload, created_at, updated_at`
+const optionCountQuery = `SELECT COUNT(*) FROM options`
+const optionDeleteQuery = `DELETE FROM options WHERE id = $1`
+
+type OptionClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *OptionClient) Create(ctx context.Context, input *Option) (*Option, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		input.ID = v
+	}
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Option", validation.OpCreate, optionValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, optionInsertQuery, input.ID, input.Name, input.Value, input.Autoload, input.CreatedAt, input.UpdatedAt)
+	out := new(Option)
+	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Option", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *OptionClient) BulkCreate(ctx context.Context, inputs []*Option) ([]*Option, error) {
+	if len(inputs) == 0 {
+		return []*Option{}, nil
+	}
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			input.ID = v
+		}
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
+		}
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Option", validation.OpCreate, optionValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := []any{input.ID, input.Name, input.Value, input.Autoload, input.CreatedAt, input.UpdatedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "options",
+		Columns:   []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var created []*Option
+	for rows.Next() {
+		item := new(Option)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Option", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *OptionClient) ByID(ctx context.Context, id string) (*Option, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Option", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*Option); ok {
+				return entity, nil
+			}
+		}
+	}
+	row := c.db.Pool.QueryRow(ctx, optionSelectQuery, id)
+	out := new(Option)
+	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Option", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
+}
+
+func (c *OptionClient) List(ctx context.Context, limit, offset int) ([]*Option, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, optionListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Option
+	for rows.Next() {
+		item := new(Option)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *OptionClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, optionCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (c *OptionClient) Update(ctx context.Context, input *Option) (*Option, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Option", validation.OpUpdate, optionValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, optionUpdateQuery, input.Name, input.Value, input.Autoload, input.UpdatedAt, input.ID)
+	out := new(Option)
+	if err := row.Scan(&out.ID, &out.Name, &out.Value, &out.Autoload, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Option", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *OptionClient) BulkUpdate(ctx context.Context, inputs []*Option) ([]*Option, error) {
+	if len(inputs) == 0 {
+		return []*Option{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		if input.ID == "" {
+			return nil, errors.New("id is required")
+		}
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Option", validation.OpUpdate, optionValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.Name, input.Value, input.Autoload, input.UpdatedAt},
+		}
+		specs = append(specs, row)
+	}
+	spec := runtime.BulkUpdateSpec{
+		Table:         "options",
+		PrimaryColumn: "id",
+		Columns:       []string{"name", "value", "autoload", "updated_at"},
+		Returning:     []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*Option
+	for rows.Next() {
+		item := new(Option)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Option", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *OptionClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, optionDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("Option", id))
+	}
+	return nil
+}
+
+func (c *OptionClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	spec := runtime.BulkDeleteSpec{
+		Table:         "options",
+		PrimaryColumn: "id",
+		IDs:           make([]any, len(ids)),
+	}
+	for i, id := range ids {
+		spec.IDs[i] = id
+	}
+	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("Option", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+type OptionQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+}
+
+func (c *OptionClient) Query() *OptionQuery {
+	return &OptionQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
+}
+
+func (q *OptionQuery) Limit(n int) *OptionQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *OptionQuery) Offset(n int) *OptionQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *OptionQuery) WhereNameEq(value string) *OptionQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "name", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *OptionQuery) OrderByIDAsc() *OptionQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "id", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *OptionQuery) All(ctx context.Context) ([]*Option, error) {
+	spec := runtime.SelectSpec{
+		Table:      "options",
+		Columns:    []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Option
+	for rows.Next() {
+		item := new(Option)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *OptionQuery) Stream(ctx context.Context) (*runtime.Stream[*Option], error) {
+	spec := runtime.SelectSpec{
+		Table:      "options",
+		Columns:    []string{"id", "name", "value", "autoload", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*Option](rows, func(rows pgx.Rows) (*Option, error) {
+		item := new(Option)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Value, &item.Autoload, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *OptionQuery) First(ctx context.Context) (*Option, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *OptionQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      "options",
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *OptionQuery) clone() *OptionQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *OptionQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
+		}
+		return limit
+	}
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
+}
+
+const postInsertQuery = `INSERT INTO posts (id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) RETURNING id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at`
+const postSelectQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at FROM posts WHERE id = $1 AND deleted_at IS NULL`
+const postListQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at FROM posts WHERE deleted_at IS NULL ORDER BY id LIMIT $1 OFFSET $2`
+const postUpdateQuery = `UPDATE posts SET author_id = $1, featured_media_id = $2, content_type_id = $3, parent_id = $4, menu_order = $5, title = $6, slug = $7, status = $8, type = $9, excerpt = $10, content = $11, seo = $12, published_at = $13, updated_at = $14 WHERE id = $15 RETURNING id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at`
+const postCountQuery = `SELECT COUNT(*) FROM posts WHERE deleted_at IS NULL`
+const postDeleteQuery = `UPDATE posts SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL`
+const postBulkDeleteQuery = `UPDATE posts SET deleted_at = now() WHERE id = ANY($1) AND deleted_at IS NULL`
+const postRestoreQuery = `UPDATE posts SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL RETURNING id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at`
+
+type PostClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *PostClient) Create(ctx context.Context, input *Post) (*Post, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		input.ID = v
+	}
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Post", validation.OpCreate, postValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, postInsertQuery, input.ID, input.AuthorID, input.FeaturedMediaID, input.ContentTypeID, input.ParentID, input.MenuOrder, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.CreatedAt, input.UpdatedAt, input.DeletedAt)
+	out := new(Post)
+	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.ContentTypeID, &out.ParentID, &out.MenuOrder, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Post", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *PostClient) BulkCreate(ctx context.Context, inputs []*Post) ([]*Post, error) {
+	if len(inputs) == 0 {
+		return []*Post{}, nil
+	}
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			input.ID = v
+		}
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
+		}
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Post", validation.OpCreate, postValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := []any{input.ID, input.AuthorID, input.FeaturedMediaID, input.ContentTypeID, input.ParentID, input.MenuOrder, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.CreatedAt, input.UpdatedAt, input.DeletedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "posts",
+		Columns:   []string{"id", "author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at", "deleted_at"},
+		Returning: []string{"id", "author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at", "deleted_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var created []*Post
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Post", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *PostClient) ByID(ctx context.Context, id string) (*Post, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Post", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*Post); ok {
+				return entity, nil
+			}
+		}
+	}
+	row := c.db.Pool.QueryRow(ctx, postSelectQuery, id)
+	out := new(Post)
+	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.ContentTypeID, &out.ParentID, &out.MenuOrder, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Post", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
+}
+
+func (c *PostClient) List(ctx context.Context, limit, offset int) ([]*Post, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, postListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Post
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *PostClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, postCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (c *PostClient) Update(ctx context.Context, input *Post) (*Post, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Post", validation.OpUpdate, postValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, postUpdateQuery, input.AuthorID, input.FeaturedMediaID, input.ContentTypeID, input.ParentID, input.MenuOrder, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.UpdatedAt, input.ID)
+	out := new(Post)
+	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.ContentTypeID, &out.ParentID, &out.MenuOrder, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Post", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *PostClient) BulkUpdate(ctx context.Context, inputs []*Post) ([]*Post, error) {
+	if len(inputs) == 0 {
+		return []*Post{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		if input.ID == "" {
+			return nil, errors.New("id is required")
+		}
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Post", validation.OpUpdate, postValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.AuthorID, input.FeaturedMediaID, input.ContentTypeID, input.ParentID, input.MenuOrder, input.Title, input.Slug, input.Status, input.Type, input.Excerpt, input.Content, input.Seo, input.PublishedAt, input.UpdatedAt},
+		}
+		specs = append(specs, row)
+	}
+	spec := runtime.BulkUpdateSpec{
+		Table:         "posts",
+		PrimaryColumn: "id",
+		Columns:       []string{"author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "updated_at"},
+		Returning:     []string{"id", "author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at", "deleted_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*Post
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Post", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *PostClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, postDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("Post", id))
+	}
+	return nil
+}
+
+func (c *PostClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	tag, err := c.db.Pool.Exec(ctx, postBulkDeleteQuery, ids)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("Post", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+func (c *PostClient) Restore(ctx context.Context, id string) (*Post, error) {
+	row := c.db.Pool.QueryRow(ctx, postRestoreQuery, id)
+	out := new(Post)
+	if err := row.Scan(&out.ID, &out.AuthorID, &out.FeaturedMediaID, &out.ContentTypeID, &out.ParentID, &out.MenuOrder, &out.Title, &out.Slug, &out.Status, &out.Type, &out.Excerpt, &out.Content, &out.Seo, &out.PublishedAt, &out.CreatedAt, &out.UpdatedAt, &out.DeletedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Post", out.ID), out)
+	}
+	return out, nil
+}
+
+type PostQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+	trash        TrashScope
+}
+
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{db: c.db, defaultLimit: 20, maxLimit: 200}
+}
+
+func (q *PostQuery) Limit(n int) *PostQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *PostQuery) Offset(n int) *PostQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *PostQuery) WithTrashed() *PostQuery {
+	q.trash = TrashIncluded
+	return q
+}
+
+func (q *PostQuery) OnlyTrashed() *PostQuery {
+	q.trash = TrashOnly
+	return q
+}
+
+func (q *PostQuery) WhereIDEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereSlugEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereAuthorIDEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "author_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereStatusEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "status", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereTypeEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "type", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereContentTypeIDEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "content_type_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) WhereParentIDEq(value string) *PostQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "parent_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *PostQuery) OrderByPublishedAtDesc() *PostQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "published_at", Direction: runtime.SortDesc})
+	return q
+}
+
+func (q *PostQuery) OrderByCreatedAtDesc() *PostQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+	return q
+}
+
+func (q *PostQuery) All(ctx context.Context) ([]*Post, error) {
+	spec := runtime.SelectSpec{
+		Table:      trashScopedTable("posts", q.trash),
+		Columns:    []string{"id", "author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at", "deleted_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Post
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *PostQuery) Stream(ctx context.Context) (*runtime.Stream[*Post], error) {
+	spec := runtime.SelectSpec{
+		Table:      trashScopedTable("posts", q.trash),
+		Columns:    []string{"id", "author_id", "featured_media_id", "content_type_id", "parent_id", "menu_order", "title", "slug", "status", "type", "excerpt", "content", "seo", "published_at", "created_at", "updated_at", "deleted_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*Post](rows, func(rows pgx.Rows) (*Post, error) {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *PostQuery) First(ctx context.Context) (*Post, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *PostQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      trashScopedTable("posts", q.trash),
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *PostQuery) clone() *PostQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *PostQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
+		}
+		return limit
+	}
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
+}
+
+const postAuthorRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
+
+func (c *PostClient) LoadAuthor(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		edges.markLoaded("author")
+		var fk keyType
+		fk = parent.AuthorID
+		if isZero(fk) {
+			edges.Author = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(postAuthorRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*User, len(keys))
+	for rows.Next() {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		var fk keyType
+		fk = parent.AuthorID
+		if isZero(fk) {
+			edges.Author = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Author = item
+		} else {
+			edges.Author = nil
+		}
+	}
+	return nil
+}
+
+const postFeaturedMediaRelationQuery = `SELECT id, uploaded_by_id, file_name, mime_type, storage_key, url, title, alt_text, caption, description, file_size_bytes, metadata, created_at, updated_at, deleted_at FROM medias WHERE id IN (%s) AND deleted_at IS NULL`
+
+func (c *PostClient) LoadFeaturedMedia(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		edges.markLoaded("featured_media")
+		var fk keyType
+		fkPtr := parent.FeaturedMediaID
+		if fkPtr == nil {
+			edges.FeaturedMedia = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.FeaturedMedia = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(postFeaturedMediaRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*Media, len(keys))
+	for rows.Next() {
+		item := new(Media)
+		if err := rows.Scan(&item.ID, &item.UploadedByID, &item.FileName, &item.MimeType, &item.StorageKey, &item.URL, &item.Title, &item.AltText, &item.Caption, &item.Description, &item.FileSizeBytes, &item.Metadata, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		var fk keyType
+		fkPtr := parent.FeaturedMediaID
+		if fkPtr == nil {
+			edges.FeaturedMedia = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.FeaturedMedia = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.FeaturedMedia = item
+		} else {
+			edges.FeaturedMedia = nil
+		}
+	}
+	return nil
+}
+
+const postContentTypeRelationQuery = `SELECT id, name, label, plural_label, description, supports, fields, created_at, updated_at FROM content_types WHERE id IN (%s)`
+
+func (c *PostClient) LoadContentType(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		edges.markLoaded("content_type")
+		var fk keyType
+		fkPtr := parent.ContentTypeID
+		if fkPtr == nil {
+			edges.ContentType = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.ContentType = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(postContentTypeRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*ContentType, len(keys))
+	for rows.Next() {
+		item := new(ContentType)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Label, &item.PluralLabel, &item.Description, &item.Supports, &item.Fields, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		var fk keyType
+		fkPtr := parent.ContentTypeID
+		if fkPtr == nil {
+			edges.ContentType = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.ContentType = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.ContentType = item
+		} else {
+			edges.ContentType = nil
+		}
+	}
+	return nil
+}
+
+const postParentRelationQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at FROM posts WHERE id IN (%s) AND deleted_at IS NULL`
+
+func (c *PostClient) LoadParent(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		edges.markLoaded("parent")
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sql, args := buildInQuery(postParentRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	related := make(map[keyType]*Post, len(keys))
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt); err != nil {
+			return err
+		}
+		key := item.ID
+		related[key] = item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		var fk keyType
+		fkPtr := parent.ParentID
+		if fkPtr == nil {
+			edges.Parent = nil
+			continue
+		}
+		fk = *fkPtr
+		if isZero(fk) {
+			edges.Parent = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.Parent = item
+		} else {
+			edges.Parent = nil
+		}
+	}
+	return nil
+}
+
+const postCategoriesRelationQuery = `SELECT id, name, slug, description, parent_id, position, created_at, updated_at, jt.post_id FROM categories AS t JOIN post_categories AS jt ON t.id = jt.category_id WHERE jt.post_id IN (%s)`
+
+func (c *PostClient) LoadCategories(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	buckets := make(map[keyType][]*Post, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		key := parent.ID
+		if isZero(key) {
+			edges := ensurePostEdges(parent)
+			if edges.Categories == nil {
+				edges.Categories = []*Category{}
+			}
+			edges.markLoaded("categories")
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], parent)
+	}
+	if len(keys) == 0 {
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+			edges := ensurePostEdges(parent)
+			if edges.Categories == nil {
+				edges.Categories = []*Category{}
+			}
+			edges.markLoaded("categories")
+		}
+		return nil
+	}
+	sql, args := buildInQuery(postCategoriesRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(Category)
+		var owner keyType
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.ParentID, &item.Position, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+			return err
+		}
+		parents, ok := buckets[owner]
+		if !ok {
+			continue
+		}
+		for _, parent := range parents {
+			edges := ensurePostEdges(parent)
+			edges.Categories = append(edges.Categories, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		if edges.Categories == nil {
+			edges.Categories = []*Category{}
+		}
+		edges.markLoaded("categories")
+	}
+	return nil
+}
+
+const postTagsRelationQuery = `SELECT id, name, slug, description, created_at, updated_at, jt.post_id FROM tags AS t JOIN post_tags AS jt ON t.id = jt.tag_id WHERE jt.post_id IN (%s)`
+
+func (c *PostClient) LoadTags(ctx context.Context, parents ...*Post) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	buckets := make(map[keyType][]*Post, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		key := parent.ID
+		if isZero(key) {
+			edges := ensurePostEdges(parent)
+			if edges.Tags == nil {
+				edges.Tags = []*Tag{}
+			}
+			edges.markLoaded("tags")
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], parent)
+	}
+	if len(keys) == 0 {
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+			edges := ensurePostEdges(parent)
+			if edges.Tags == nil {
+				edges.Tags = []*Tag{}
+			}
+			edges.markLoaded("tags")
+		}
+		return nil
+	}
+	sql, args := buildInQuery(postTagsRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(Tag)
+		var owner keyType
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+			return err
+		}
+		parents, ok := buckets[owner]
+		if !ok {
+			continue
+		}
+		for _, parent := range parents {
+			edges := ensurePostEdges(parent)
+			edges.Tags = append(edges.Tags, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensurePostEdges(parent)
+		if edges.Tags == nil {
+			edges.Tags = []*Tag{}
+		}
+		edges.markLoaded("tags")
+	}
+	return nil
+}
+
+const roleInsertQuery = `INSERT INTO roles (id, name, slug, description, capabilities, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, name, slug, description, capabilities, created_at, updated_at`
+const roleSelectQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at FROM roles WHERE id = $1`
+const roleListQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at FROM roles ORDER BY id LIMIT $1 OFFSET $2`
+const roleUpdateQuery = `UPDATE roles SET name = $1, slug = $2, description = $3, capabilities = $4, updated_at = $5 WHERE id = $6 RETURNING id, name, slug, description, capabilities, created_at, updated_at`
+const roleCountQuery = `SELECT COUNT(*) FROM roles`
+const roleDeleteQuery = `DELETE FROM roles WHERE id = $1`
+
+type RoleClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *RoleClient) Create(ctx context.Context, input *Role) (*Role, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		input.ID = v
+	}
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Role", validation.OpCreate, roleValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, roleInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.Capabilities, input.CreatedAt, input.UpdatedAt)
+	out := new(Role)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Role", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *RoleClient) BulkCreate(ctx context.Context, inputs []*Role) ([]*Role, error) {
+	if len(inputs) == 0 {
+		return []*Role{}, nil
+	}
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			input.ID = v
+		}
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
+		}
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Role", validation.OpCreate, roleValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := []any{input.ID, input.Name, input.Slug, input.Description, input.Capabilities, input.CreatedAt, input.UpdatedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "roles",
+		Columns:   []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var created []*Role
+	for rows.Next() {
+		item := new(Role)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Role", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *RoleClient) ByID(ctx context.Context, id string) (*Role, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Role", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*Role); ok {
+				return entity, nil
+			}
+		}
+	}
+	row := c.db.Pool.QueryRow(ctx, roleSelectQuery, id)
+	out := new(Role)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		cachedKey = makeCacheKey("Role", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
+}
+
+func (c *RoleClient) List(ctx context.Context, limit, offset int) ([]*Role, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, roleListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Role
+	for rows.Next() {
+		item := new(Role)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *RoleClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, roleCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (c *RoleClient) Update(ctx context.Context, input *Role) (*Role, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "Role", validation.OpUpdate, roleValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, roleUpdateQuery, input.Name, input.Slug, input.Description, input.Capabilities, input.UpdatedAt, input.ID)
+	out := new(Role)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("Role", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *RoleClient) BulkUpdate(ctx context.Context, inputs []*Role) ([]*Role, error) {
+	if len(inputs) == 0 {
+		return []*Role{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		if input.ID == "" {
+			return nil, errors.New("id is required")
+		}
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "Role", validation.OpUpdate, roleValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.Name, input.Slug, input.Description, input.Capabilities, input.UpdatedAt},
+		}
+		specs = append(specs, row)
+	}
+	spec := runtime.BulkUpdateSpec{
+		Table:         "roles",
+		PrimaryColumn: "id",
+		Columns:       []string{"name", "slug", "description", "capabilities", "updated_at"},
+		Returning:     []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*Role
+	for rows.Next() {
+		item := new(Role)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("Role", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *RoleClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, roleDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("Role", id))
+	}
+	return nil
+}
+
+func (c *RoleClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	spec := runtime.BulkDeleteSpec{
+		Table:         "roles",
+		PrimaryColumn: "id",
+		IDs:           make([]any, len(ids)),
+	}
+	for i, id := range ids {
+		spec.IDs[i] = id
+	}
+	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("Role", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+type RoleQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+}
+
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+}
+
+func (q *RoleQuery) Limit(n int) *RoleQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *RoleQuery) Offset(n int) *RoleQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *RoleQuery) WhereIDEq(value string) *RoleQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *RoleQuery) WhereSlugEq(value string) *RoleQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *RoleQuery) OrderByCreatedAtDesc() *RoleQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+	return q
+}
+
+func (q *RoleQuery) OrderBySlugAsc() *RoleQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "slug", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *RoleQuery) All(ctx context.Context) ([]*Role, error) {
+	spec := runtime.SelectSpec{
+		Table:      "roles",
+		Columns:    []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*Role
+	for rows.Next() {
+		item := new(Role)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (q *RoleQuery) Stream(ctx context.Context) (*runtime.Stream[*Role], error) {
+	spec := runtime.SelectSpec{
+		Table:      "roles",
+		Columns:    []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*Role](rows, func(rows pgx.Rows) (*Role, error) {
+		item := new(Role)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *RoleQuery) First(ctx context.Context) (*Role, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *RoleQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      "roles",
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *RoleQuery) clone() *RoleQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *RoleQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
+		}
+		return limit
+	}
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
+}
+
+const roleUsersRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at, jt.role_id FROM users AS t JOIN user_roles AS jt ON t.id = jt.user_id WHERE jt.role_id IN (%s)`
+
+func (c *RoleClient) LoadUsers(ctx context.Context, parents ...*Role) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	type keyType = string
+	keys := make([]keyType, 0, len(parents))
+	seen := make(map[keyType]struct{}, len(parents))
+	buckets := make(map[keyType][]*Role, len(parents))
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		key := parent.ID
+		if isZero(key) {
+			edges := ensureRoleEdges(parent)
+			if edges.Users == nil {
+				edges.Users = []*User{}
+			}
+			edges.markLoaded("users")
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], parent)
+	}
+	if len(keys) == 0 {
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+			edges := ensureRoleEdges(parent)
+			if edges.Users == nil {
+				edges.Users = []*User{}
+			}
+			edges.markLoaded("users")
+		}
+		return nil
+	}
+	sql, args := buildInQuery(roleUsersRelationQuery, keys)
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(User)
+		var owner keyType
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+			return err
+		}
+		parents, ok := buckets[owner]
+		if !ok {
+			continue
+		}
+		for _, parent := range parents {
+			edges := ensureRoleEdges(parent)
+			edges.Users = append(edges.Users, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if parent == nil {
+			continue
+		}
+		edges := ensureRoleEdges(parent)
+		if edges.Users == nil {
+			edges.Users = []*User{}
+		}
+		edges.markLoaded("users")
+	}
+	return nil
+}
+
+const slugHistoryInsertQuery = `INSERT INTO slug_histories (id, entity_type, entity_id, slug, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, entity_type, entity_id, slug, created_at, updated_at`
+const slugHistorySelectQuery = `SELECT id, entity_type, entity_id, slug, created_at, updated_at FROM slug_histories WHERE id = $1`
+const slugHistoryListQuery = `SELECT id, entity_type, entity_id, slug, created_at, updated_at FROM slug_histories ORDER BY id LIMIT $1 OFFSET $2`
+const slugHistoryUpdateQuery = `UPDATE slug_histories SET entity_type = $1, entity_id = $2, slug = $3, updated_at = $4 WHERE id = $5 RETURNING id, entity_type, entity_id, slug, created_at, updated_at`
+const slugHistoryCountQuery = `SELECT COUNT(*) FROM slug_histories`
+const slugHistoryDeleteQuery = `DELETE FROM slug_histories WHERE id = $1`
+
+type SlugHistoryClient struct {
+	db    *pg.DB
+	cache cache.Store
+}
+
+func (c *SlugHistoryClient) Create(ctx context.Context, input *SlugHistory) (*SlugHistory, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	now := time.Now().UTC()
+	if input.ID == "" {
+		v, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		input.ID = v
+	}
+	if input.CreatedAt.IsZero() {
+		input.CreatedAt = now
+	}
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "SlugHistory", validation.OpCreate, slugHistoryValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, slugHistoryInsertQuery, input.ID, input.EntityType, input.EntityID, input.Slug, input.CreatedAt, input.UpdatedAt)
+	out := new(SlugHistory)
+	if err := row.Scan(&out.ID, &out.EntityType, &out.EntityID, &out.Slug, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("SlugHistory", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *SlugHistoryClient) BulkCreate(ctx context.Context, inputs []*SlugHistory) ([]*SlugHistory, error) {
+	if len(inputs) == 0 {
+		return []*SlugHistory{}, nil
+	}
+	rowsSpec := make([][]any, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		now := time.Now().UTC()
+		if input.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			input.ID = v
+		}
+		if input.CreatedAt.IsZero() {
+			input.CreatedAt = now
+		}
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "SlugHistory", validation.OpCreate, slugHistoryValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := []any{input.ID, input.EntityType, input.EntityID, input.Slug, input.CreatedAt, input.UpdatedAt}
+		rowsSpec = append(rowsSpec, row)
+	}
+	spec := runtime.BulkInsertSpec{
+		Table:     "slug_histories",
+		Columns:   []string{"id", "entity_type", "entity_id", "slug", "created_at", "updated_at"},
+		Returning: []string{"id", "entity_type", "entity_id", "slug", "created_at", "updated_at"},
+		Rows:      rowsSpec,
+	}
+	sql, args, err := runtime.BuildBulkInsertSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var created []*SlugHistory
+	for rows.Next() {
+		item := new(SlugHistory)
+		if err := rows.Scan(&item.ID, &item.EntityType, &item.EntityID, &item.Slug, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		created = append(created, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("SlugHistory", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *SlugHistoryClient) ByID(ctx context.Context, id string) (*SlugHistory, error) {
+	var cachedKey string
+	if c.cache != nil {
+		cachedKey = makeCacheKey("SlugHistory", id)
+		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
+			return nil, err
+		} else if ok {
+			if entity, ok := value.(*SlugHistory); ok {
+				return entity, nil
+			}
+		}
+	}
+	row := c.db.Pool.QueryRow(ctx, slugHistorySelectQuery, id)
+	out := new(SlugHistory)
+	if err := row.Scan(&out.ID, &out.EntityType, &out.EntityID, &out.Slug, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if c.cache != nil {
+		cachedKey = makeCacheKey("SlugHistory", out.ID)
+		_ = c.cache.Set(ctx, cachedKey, out)
+	}
+	return out, nil
+}
+
+func (c *SlugHistoryClient) List(ctx context.Context, limit, offset int) ([]*SlugHistory, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := c.db.Pool.Query(ctx, slugHistoryListQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result []*SlugHistory
+	for rows.Next() {
+		item := new(SlugHistory)
+		if err := rows.Scan(&item.ID, &item.EntityType, &item.EntityID, &item.Slug, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *SlugHistoryClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, slugHistoryCountQuery)
+	var total int
+	if err := row.Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (c *SlugHistoryClient) Update(ctx context.Context, input *SlugHistory) (*SlugHistory, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+	if input.ID == "" {
+		return nil, errors.New("id is required")
+	}
+	now := time.Now().UTC()
+	input.UpdatedAt = now
+	if err := ValidationRegistry.Validate(ctx, "SlugHistory", validation.OpUpdate, slugHistoryValidationRecord(input), input); err != nil {
+		return nil, err
+	}
+	row := c.db.Pool.QueryRow(ctx, slugHistoryUpdateQuery, input.EntityType, input.EntityID, input.Slug, input.UpdatedAt, input.ID)
+	out := new(SlugHistory)
+	if err := row.Scan(&out.ID, &out.EntityType, &out.EntityID, &out.Slug, &out.CreatedAt, &out.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.cache != nil {
+		_ = c.cache.Set(ctx, makeCacheKey("SlugHistory", out.ID), out)
+	}
+	return out, nil
+}
+
+func (c *SlugHistoryClient) BulkUpdate(ctx context.Context, inputs []*SlugHistory) ([]*SlugHistory, error) {
+	if len(inputs) == 0 {
+		return []*SlugHistory{}, nil
+	}
+	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil {
+			return nil, errors.New("input cannot be nil")
+		}
+		if input.ID == "" {
+			return nil, errors.New("id is required")
+		}
+		now := time.Now().UTC()
+		input.UpdatedAt = now
+		if err := ValidationRegistry.Validate(ctx, "SlugHistory", validation.OpUpdate, slugHistoryValidationRecord(input), input); err != nil {
+			return nil, err
+		}
+		row := runtime.BulkUpdateRow{
+			Primary: input.ID,
+			Values:  []any{input.EntityType, input.EntityID, input.Slug, input.UpdatedAt},
+		}
+		specs = append(specs, row)
+	}
+	spec := runtime.BulkUpdateSpec{
+		Table:         "slug_histories",
+		PrimaryColumn: "id",
+		Columns:       []string{"entity_type", "entity_id", "slug", "updated_at"},
+		Returning:     []string{"id", "entity_type", "entity_id", "slug", "created_at", "updated_at"},
+		Rows:          specs,
+	}
+	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var updated []*SlugHistory
+	for rows.Next() {
+		item := new(SlugHistory)
+		if err := rows.Scan(&item.ID, &item.EntityType, &item.EntityID, &item.Slug, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
+		}
+		updated = append(updated, item)
+		if c.cache != nil {
+			_ = c.cache.Set(ctx, makeCacheKey("SlugHistory", item.ID), item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (c *SlugHistoryClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, slugHistoryDeleteQuery, id); err != nil {
+		return err
+	}
+	if c.cache != nil {
+		_ = c.cache.Delete(ctx, makeCacheKey("SlugHistory", id))
+	}
+	return nil
+}
+
+func (c *SlugHistoryClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	spec := runtime.BulkDeleteSpec{
+		Table:         "slug_histories",
+		PrimaryColumn: "id",
+		IDs:           make([]any, len(ids)),
+	}
+	for i, id := range ids {
+		spec.IDs[i] = id
+	}
+	sql, args, err := runtime.BuildBulkDeleteSQL(spec)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := c.db.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	if c.cache != nil {
+		for _, id := range ids {
+			_ = c.cache.Delete(ctx, makeCacheKey("SlugHistory", id))
+		}
+	}
+	return int64(tag.RowsAffected()), nil
+}
+
+type SlugHistoryQuery struct {
+	db           *pg.DB
+	predicates   []runtime.Predicate
+	orders       []runtime.Order
+	limit        *int
+	offset       int
+	defaultLimit int
+	maxLimit     int
+}
+
+func (c *SlugHistoryClient) Query() *SlugHistoryQuery {
+	return &SlugHistoryQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+}
+
+func (q *SlugHistoryQuery) Limit(n int) *SlugHistoryQuery {
+	if n <= 0 {
+		q.limit = nil
+		return q
+	}
+	q.limit = &n
+	return q
+}
+
+func (q *SlugHistoryQuery) Offset(n int) *SlugHistoryQuery {
+	if n < 0 {
+		return q
+	}
+	q.offset = n
+	return q
+}
+
+func (q *SlugHistoryQuery) WhereEntityTypeEq(value string) *SlugHistoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "entity_type", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *SlugHistoryQuery) WhereEntityIDEq(value string) *SlugHistoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "entity_id", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *SlugHistoryQuery) WhereSlugEq(value string) *SlugHistoryQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+	return q
+}
+
+func (q *SlugHistoryQuery) OrderByCreatedAtDesc() *SlugHistoryQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+	return q
+}
+
+func (q *SlugHistoryQuery) All(ctx context.Context) ([]*SlugHistory, error) {
+	spec := runtime.SelectSpec{
+		Table:      "slug_histories",
+		Columns:    []string{"id", "entity_type", "entity_id", "slug", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
 	}
-	sql, args := buildInQuery(postTagsRelationQuery, keys)
-	rows, err := c.db.Pool.Query(ctx, sql, args...)
+	rows, err := q.db.Select(ctx, spec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rows.Close()
+	var result []*SlugHistory
 	for rows.Next() {
-		item := new(Tag)
-		var owner keyType
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
-			return err
-		}
-		parents, ok := buckets[owner]
-		if !ok {
-			continue
-		}
-		for _, parent := range parents {
-			edges := ensurePostEdges(parent)
-			edges.Tags = append(edges.Tags, item)
+		item := new(SlugHistory)
+		if err := rows.Scan(&item.ID, &item.EntityType, &item.EntityID, &item.Slug, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
 		}
+		result = append(result, item)
 	}
 	if err := rows.Err(); err != nil {
-		return err
+		return nil, err
 	}
-	for _, parent := range parents {
-		if parent == nil {
-			continue
+	return result, nil
+}
+
+func (q *SlugHistoryQuery) Stream(ctx context.Context) (*runtime.Stream[*SlugHistory], error) {
+	spec := runtime.SelectSpec{
+		Table:      "slug_histories",
+		Columns:    []string{"id", "entity_type", "entity_id", "slug", "created_at", "updated_at"},
+		Predicates: q.predicates,
+		Orders:     q.orders,
+		Limit:      q.effectiveLimit(),
+		Offset:     q.offset,
+	}
+	rows, err := q.db.Select(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	stream := runtime.NewStream[*SlugHistory](rows, func(rows pgx.Rows) (*SlugHistory, error) {
+		item := new(SlugHistory)
+		if err := rows.Scan(&item.ID, &item.EntityType, &item.EntityID, &item.Slug, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, err
 		}
-		edges := ensurePostEdges(parent)
-		if edges.Tags == nil {
-			edges.Tags = []*Tag{}
+		return item, nil
+	})
+	return stream, nil
+}
+
+func (q *SlugHistoryQuery) First(ctx context.Context) (*SlugHistory, error) {
+	clone := q.clone()
+	one := 1
+	clone.limit = &one
+	items, err := clone.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return items[0], nil
+}
+
+func (q *SlugHistoryQuery) Count(ctx context.Context) (int, error) {
+	spec := runtime.AggregateSpec{
+		Table:      "slug_histories",
+		Predicates: q.predicates,
+		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
+	}
+	row := q.db.Aggregate(ctx, spec)
+	var out int
+	if err := row.Scan(&out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (q *SlugHistoryQuery) clone() *SlugHistoryQuery {
+	cp := *q
+	if len(q.predicates) > 0 {
+		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
+	}
+	if len(q.orders) > 0 {
+		cp.orders = append([]runtime.Order(nil), q.orders...)
+	}
+	if q.limit != nil {
+		limit := *q.limit
+		cp.limit = &limit
+	}
+	return &cp
+}
+
+func (q *SlugHistoryQuery) effectiveLimit() int {
+	if q.limit != nil {
+		limit := *q.limit
+		if q.maxLimit > 0 && limit > q.maxLimit {
+			return q.maxLimit
 		}
-		edges.markLoaded("tags")
+		return limit
 	}
-	return nil
+	limit := q.defaultLimit
+	if limit <= 0 && q.maxLimit > 0 {
+		return q.maxLimit
+	}
+	if q.maxLimit > 0 && limit > q.maxLimit {
+		return q.maxLimit
+	}
+	return limit
 }
 
-const roleInsertQuery = `INSERT INTO roles (id, name, slug, description, capabilities, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, name, slug, description, capabilities, created_at, updated_at`
-const roleSelectQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at FROM roles WHERE id = $1`
-const roleListQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at FROM roles ORDER BY id LIMIT $1 OFFSET $2`
-const roleUpdateQuery = `UPDATE roles SET name = $1, slug = $2, description = $3, capabilities = $4, updated_at = $5 WHERE id = $6 RETURNING id, name, slug, description, capabilities, created_at, updated_at`
-const roleCountQuery = `SELECT COUNT(*) FROM roles`
-const roleDeleteQuery = `DELETE FROM roles WHERE id = $1`
+const tagInsertQuery = `INSERT INTO tags (id, name, slug, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, name, slug, description, created_at, updated_at`
+const tagSelectQuery = `SELECT id, name, slug, description, created_at, updated_at FROM tags WHERE id = $1`
+const tagListQuery = `SELECT id, name, slug, description, created_at, updated_at FROM tags ORDER BY id LIMIT $1 OFFSET $2`
+const tagUpdateQuery = `UPDATE tags SET name = $1, slug = $2, description = $3, updated_at = $4 WHERE id = $5 RETURNING id, name, slug, description, created_at, updated_at`
+const tagCountQuery = `SELECT COUNT(*) FROM tags`
+const tagDeleteQuery = `DELETE FROM tags WHERE id = $1`
 
-type RoleClient struct {
+type TagClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *RoleClient) Create(ctx context.Context, input *Role) (*Role, error) {
+func (c *TagClient) Create(ctx context.Context, input *Tag) (*Tag, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -3083,23 +6269,23 @@ func (c *RoleClient) Create(ctx context.Context, input *Role) (*Role, error) {
 		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Role", validation.OpCreate, roleValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpCreate, tagValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, roleInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.Capabilities, input.CreatedAt, input.UpdatedAt)
-	out := new(Role)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, tagInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.CreatedAt, input.UpdatedAt)
+	out := new(Tag)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Role", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Tag", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *RoleClient) BulkCreate(ctx context.Context, inputs []*Role) ([]*Role, error) {
+func (c *TagClient) BulkCreate(ctx context.Context, inputs []*Tag) ([]*Tag, error) {
 	if len(inputs) == 0 {
-		return []*Role{}, nil
+		return []*Tag{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -3118,16 +6304,16 @@ func (c *RoleClient) BulkCreate(ctx context.Context, inputs []*Role) ([]*Role, e
 			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Role", validation.OpCreate, roleValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpCreate, tagValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.Name, input.Slug, input.Description, input.Capabilities, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.Name, input.Slug, input.Description, input.CreatedAt, input.UpdatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "roles",
-		Columns:   []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
-		Returning: []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Table:     "tags",
+		Columns:   []string{"id", "name", "slug", "description", "created_at", "updated_at"},
+		Returning: []string{"id", "name", "slug", "description", "created_at", "updated_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -3139,15 +6325,15 @@ func (c *RoleClient) BulkCreate(ctx context.Context, inputs []*Role) ([]*Role, e
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Role
+	var created []*Tag
 	for rows.Next() {
-		item := new(Role)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Tag)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Role", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Tag", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -3156,49 +6342,49 @@ func (c *RoleClient) BulkCreate(ctx context.Context, inputs []*Role) ([]*Role, e
 	return created, nil
 }
 
-func (c *RoleClient) ByID(ctx context.Context, id string) (*Role, error) {
+func (c *TagClient) ByID(ctx context.Context, id string) (*Tag, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Role", id)
+		cachedKey = makeCacheKey("Tag", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Role); ok {
+			if entity, ok := value.(*Tag); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, roleSelectQuery, id)
-	out := new(Role)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, tagSelectQuery, id)
+	out := new(Tag)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Role", out.ID)
+		cachedKey = makeCacheKey("Tag", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *RoleClient) List(ctx context.Context, limit, offset int) ([]*Role, error) {
+func (c *TagClient) List(ctx context.Context, limit, offset int) ([]*Tag, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, roleListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, tagListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Role
+	var result []*Tag
 	for rows.Next() {
-		item := new(Role)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Tag)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -3209,8 +6395,8 @@ func (c *RoleClient) List(ctx context.Context, limit, offset int) ([]*Role, erro
 	return result, nil
 }
 
-func (c *RoleClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, roleCountQuery)
+func (c *TagClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, tagCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -3218,7 +6404,7 @@ func (c *RoleClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *RoleClient) Update(ctx context.Context, input *Role) (*Role, error) {
+func (c *TagClient) Update(ctx context.Context, input *Tag) (*Tag, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -3227,23 +6413,23 @@ func (c *RoleClient) Update(ctx context.Context, input *Role) (*Role, error) {
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Role", validation.OpUpdate, roleValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpUpdate, tagValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, roleUpdateQuery, input.Name, input.Slug, input.Description, input.Capabilities, input.UpdatedAt, input.ID)
-	out := new(Role)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.Capabilities, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, tagUpdateQuery, input.Name, input.Slug, input.Description, input.UpdatedAt, input.ID)
+	out := new(Tag)
+	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Role", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("Tag", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *RoleClient) BulkUpdate(ctx context.Context, inputs []*Role) ([]*Role, error) {
+func (c *TagClient) BulkUpdate(ctx context.Context, inputs []*Tag) ([]*Tag, error) {
 	if len(inputs) == 0 {
-		return []*Role{}, nil
+		return []*Tag{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -3255,20 +6441,20 @@ func (c *RoleClient) BulkUpdate(ctx context.Context, inputs []*Role) ([]*Role, e
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Role", validation.OpUpdate, roleValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpUpdate, tagValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.Name, input.Slug, input.Description, input.Capabilities, input.UpdatedAt},
+			Values:  []any{input.Name, input.Slug, input.Description, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "roles",
+		Table:         "tags",
 		PrimaryColumn: "id",
-		Columns:       []string{"name", "slug", "description", "capabilities", "updated_at"},
-		Returning:     []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Columns:       []string{"name", "slug", "description", "updated_at"},
+		Returning:     []string{"id", "name", "slug", "description", "created_at", "updated_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -3280,15 +6466,15 @@ func (c *RoleClient) BulkUpdate(ctx context.Context, inputs []*Role) ([]*Role, e
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Role
+	var updated []*Tag
 	for rows.Next() {
-		item := new(Role)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Tag)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Role", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("Tag", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -3297,22 +6483,22 @@ func (c *RoleClient) BulkUpdate(ctx context.Context, inputs []*Role) ([]*Role, e
 	return updated, nil
 }
 
-func (c *RoleClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, roleDeleteQuery, id); err != nil {
+func (c *TagClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, tagDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Role", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("Tag", id))
 	}
 	return nil
 }
 
-func (c *RoleClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *TagClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "roles",
+		Table:         "tags",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -3329,13 +6515,13 @@ func (c *RoleClient) BulkDelete(ctx context.Context, ids []string) (int64, error
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Role", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("Tag", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type RoleQuery struct {
+type TagQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -3345,11 +6531,11 @@ type RoleQuery struct {
 	maxLimit     int
 }
 
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
 }
 
-func (q *RoleQuery) Limit(n int) *RoleQuery {
+func (q *TagQuery) Limit(n int) *TagQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -3358,7 +6544,7 @@ func (q *RoleQuery) Limit(n int) *RoleQuery {
 	return q
 }
 
-func (q *RoleQuery) Offset(n int) *RoleQuery {
+func (q *TagQuery) Offset(n int) *TagQuery {
 	if n < 0 {
 		return q
 	}
@@ -3366,30 +6552,25 @@ func (q *RoleQuery) Offset(n int) *RoleQuery {
 	return q
 }
 
-func (q *RoleQuery) WhereIDEq(value string) *RoleQuery {
+func (q *TagQuery) WhereIDEq(value string) *TagQuery {
 	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *RoleQuery) WhereSlugEq(value string) *RoleQuery {
+func (q *TagQuery) WhereSlugEq(value string) *TagQuery {
 	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *RoleQuery) OrderByCreatedAtDesc() *RoleQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
-	return q
-}
-
-func (q *RoleQuery) OrderBySlugAsc() *RoleQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "slug", Direction: runtime.SortAsc})
+func (q *TagQuery) OrderByNameAsc() *TagQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
 	return q
 }
 
-func (q *RoleQuery) All(ctx context.Context) ([]*Role, error) {
+func (q *TagQuery) All(ctx context.Context) ([]*Tag, error) {
 	spec := runtime.SelectSpec{
-		Table:      "roles",
-		Columns:    []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Table:      "tags",
+		Columns:    []string{"id", "name", "slug", "description", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -3400,10 +6581,10 @@ func (q *RoleQuery) All(ctx context.Context) ([]*Role, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Role
+	var result []*Tag
 	for rows.Next() {
-		item := new(Role)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(Tag)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -3414,10 +6595,10 @@ func (q *RoleQuery) All(ctx context.Context) ([]*Role, error) {
 	return result, nil
 }
 
-func (q *RoleQuery) Stream(ctx context.Context) (*runtime.Stream[*Role], error) {
+func (q *TagQuery) Stream(ctx context.Context) (*runtime.Stream[*Tag], error) {
 	spec := runtime.SelectSpec{
-		Table:      "roles",
-		Columns:    []string{"id", "name", "slug", "description", "capabilities", "created_at", "updated_at"},
+		Table:      "tags",
+		Columns:    []string{"id", "name", "slug", "description", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -3427,9 +6608,9 @@ func (q *RoleQuery) Stream(ctx context.Context) (*runtime.Stream[*Role], error)
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Role](rows, func(rows pgx.Rows) (*Role, error) {
-		item := new(Role)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*Tag](rows, func(rows pgx.Rows) (*Tag, error) {
+		item := new(Tag)
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -3437,7 +6618,7 @@ func (q *RoleQuery) Stream(ctx context.Context) (*runtime.Stream[*Role], error)
 	return stream, nil
 }
 
-func (q *RoleQuery) First(ctx context.Context) (*Role, error) {
+func (q *TagQuery) First(ctx context.Context) (*Tag, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -3451,9 +6632,9 @@ func (q *RoleQuery) First(ctx context.Context) (*Role, error) {
 	return items[0], nil
 }
 
-func (q *RoleQuery) Count(ctx context.Context) (int, error) {
+func (q *TagQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "roles",
+		Table:      "tags",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -3465,7 +6646,7 @@ func (q *RoleQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *RoleQuery) clone() *RoleQuery {
+func (q *TagQuery) clone() *TagQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -3480,7 +6661,7 @@ func (q *RoleQuery) clone() *RoleQuery {
 	return &cp
 }
 
-func (q *RoleQuery) effectiveLimit() int {
+func (q *TagQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -3498,27 +6679,27 @@ func (q *RoleQuery) effectiveLimit() int {
 	return limit
 }
 
-const roleUsersRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at, jt.role_id FROM users AS t JOIN user_roles AS jt ON t.id = jt.user_id WHERE jt.role_id IN (%s)`
+const tagPostsRelationQuery = `SELECT id, author_id, featured_media_id, content_type_id, parent_id, menu_order, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, deleted_at, jt.tag_id FROM posts AS t JOIN post_tags AS jt ON t.id = jt.post_id WHERE jt.tag_id IN (%s) AND t.deleted_at IS NULL`
 
-func (c *RoleClient) LoadUsers(ctx context.Context, parents ...*Role) error {
+func (c *TagClient) LoadPosts(ctx context.Context, parents ...*Tag) error {
 	if len(parents) == 0 {
 		return nil
 	}
 	type keyType = string
 	keys := make([]keyType, 0, len(parents))
 	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*Role, len(parents))
+	buckets := make(map[keyType][]*Tag, len(parents))
 	for _, parent := range parents {
 		if parent == nil {
 			continue
 		}
 		key := parent.ID
 		if isZero(key) {
-			edges := ensureRoleEdges(parent)
-			if edges.Users == nil {
-				edges.Users = []*User{}
+			edges := ensureTagEdges(parent)
+			if edges.Posts == nil {
+				edges.Posts = []*Post{}
 			}
-			edges.markLoaded("users")
+			edges.markLoaded("posts")
 			continue
 		}
 		if _, ok := seen[key]; !ok {
@@ -3532,24 +6713,24 @@ func (c *RoleClient) LoadUsers(ctx context.Context, parents ...*Role) error {
 			if parent == nil {
 				continue
 			}
-			edges := ensureRoleEdges(parent)
-			if edges.Users == nil {
-				edges.Users = []*User{}
+			edges := ensureTagEdges(parent)
+			if edges.Posts == nil {
+				edges.Posts = []*Post{}
 			}
-			edges.markLoaded("users")
+			edges.markLoaded("posts")
 		}
 		return nil
 	}
-	sql, args := buildInQuery(roleUsersRelationQuery, keys)
+	sql, args := buildInQuery(tagPostsRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		item := new(User)
+		item := new(Post)
 		var owner keyType
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.ContentTypeID, &item.ParentID, &item.MenuOrder, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt, &owner); err != nil {
 			return err
 		}
 		parents, ok := buckets[owner]
@@ -3557,8 +6738,8 @@ func (c *RoleClient) LoadUsers(ctx context.Context, parents ...*Role) error {
 			continue
 		}
 		for _, parent := range parents {
-			edges := ensureRoleEdges(parent)
-			edges.Users = append(edges.Users, item)
+			edges := ensureTagEdges(parent)
+			edges.Posts = append(edges.Posts, item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -3568,28 +6749,28 @@ func (c *RoleClient) LoadUsers(ctx context.Context, parents ...*Role) error {
 		if parent == nil {
 			continue
 		}
-		edges := ensureRoleEdges(parent)
-		if edges.Users == nil {
-			edges.Users = []*User{}
+		edges := ensureTagEdges(parent)
+		if edges.Posts == nil {
+			edges.Posts = []*Post{}
 		}
-		edges.markLoaded("users")
+		edges.markLoaded("posts")
 	}
 	return nil
 }
 
-const tagInsertQuery = `INSERT INTO tags (id, name, slug, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, name, slug, description, created_at, updated_at`
-const tagSelectQuery = `SELECT id, name, slug, description, created_at, updated_at FROM tags WHERE id = $1`
-const tagListQuery = `SELECT id, name, slug, description, created_at, updated_at FROM tags ORDER BY id LIMIT $1 OFFSET $2`
-const tagUpdateQuery = `UPDATE tags SET name = $1, slug = $2, description = $3, updated_at = $4 WHERE id = $5 RETURNING id, name, slug, description, created_at, updated_at`
-const tagCountQuery = `SELECT COUNT(*) FROM tags`
-const tagDeleteQuery = `DELETE FROM tags WHERE id = $1`
+const userInsertQuery = `INSERT INTO users (id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at`
+const userSelectQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id = $1`
+const userListQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users ORDER BY id LIMIT $1 OFFSET $2`
+const userUpdateQuery = `UPDATE users SET username = $1, email = $2, password_hash = $3, display_name = $4, bio = $5, avatar_url = $6, website_url = $7, last_login_at = $8, updated_at = $9 WHERE id = $10 RETURNING id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at`
+const userCountQuery = `SELECT COUNT(*) FROM users`
+const userDeleteQuery = `DELETE FROM users WHERE id = $1`
 
-type TagClient struct {
+type UserClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *TagClient) Create(ctx context.Context, input *Tag) (*Tag, error) {
+func (c *UserClient) Create(ctx context.Context, input *User) (*User, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -3605,23 +6786,23 @@ func (c *TagClient) Create(ctx context.Context, input *Tag) (*Tag, error) {
 		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpCreate, tagValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "User", validation.OpCreate, userValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, tagInsertQuery, input.ID, input.Name, input.Slug, input.Description, input.CreatedAt, input.UpdatedAt)
-	out := new(Tag)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userInsertQuery, input.ID, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.CreatedAt, input.UpdatedAt)
+	out := new(User)
+	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Tag", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("User", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *TagClient) BulkCreate(ctx context.Context, inputs []*Tag) ([]*Tag, error) {
+func (c *UserClient) BulkCreate(ctx context.Context, inputs []*User) ([]*User, error) {
 	if len(inputs) == 0 {
-		return []*Tag{}, nil
+		return []*User{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -3640,16 +6821,16 @@ func (c *TagClient) BulkCreate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpCreate, tagValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "User", validation.OpCreate, userValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.Name, input.Slug, input.Description, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.CreatedAt, input.UpdatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "tags",
-		Columns:   []string{"id", "name", "slug", "description", "created_at", "updated_at"},
-		Returning: []string{"id", "name", "slug", "description", "created_at", "updated_at"},
+		Table:     "users",
+		Columns:   []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
+		Returning: []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -3661,15 +6842,15 @@ func (c *TagClient) BulkCreate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*Tag
+	var created []*User
 	for rows.Next() {
-		item := new(Tag)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Tag", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("User", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -3678,49 +6859,49 @@ func (c *TagClient) BulkCreate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 	return created, nil
 }
 
-func (c *TagClient) ByID(ctx context.Context, id string) (*Tag, error) {
+func (c *UserClient) ByID(ctx context.Context, id string) (*User, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Tag", id)
+		cachedKey = makeCacheKey("User", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*Tag); ok {
+			if entity, ok := value.(*User); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, tagSelectQuery, id)
-	out := new(Tag)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userSelectQuery, id)
+	out := new(User)
+	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("Tag", out.ID)
+		cachedKey = makeCacheKey("User", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *TagClient) List(ctx context.Context, limit, offset int) ([]*Tag, error) {
+func (c *UserClient) List(ctx context.Context, limit, offset int) ([]*User, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, tagListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, userListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Tag
+	var result []*User
 	for rows.Next() {
-		item := new(Tag)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -3731,8 +6912,8 @@ func (c *TagClient) List(ctx context.Context, limit, offset int) ([]*Tag, error)
 	return result, nil
 }
 
-func (c *TagClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, tagCountQuery)
+func (c *UserClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, userCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -3740,7 +6921,7 @@ func (c *TagClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *TagClient) Update(ctx context.Context, input *Tag) (*Tag, error) {
+func (c *UserClient) Update(ctx context.Context, input *User) (*User, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -3749,23 +6930,23 @@ func (c *TagClient) Update(ctx context.Context, input *Tag) (*Tag, error) {
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpUpdate, tagValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "User", validation.OpUpdate, userValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, tagUpdateQuery, input.Name, input.Slug, input.Description, input.UpdatedAt, input.ID)
-	out := new(Tag)
-	if err := row.Scan(&out.ID, &out.Name, &out.Slug, &out.Description, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userUpdateQuery, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.UpdatedAt, input.ID)
+	out := new(User)
+	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("Tag", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("User", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *TagClient) BulkUpdate(ctx context.Context, inputs []*Tag) ([]*Tag, error) {
+func (c *UserClient) BulkUpdate(ctx context.Context, inputs []*User) ([]*User, error) {
 	if len(inputs) == 0 {
-		return []*Tag{}, nil
+		return []*User{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -3777,20 +6958,20 @@ func (c *TagClient) BulkUpdate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "Tag", validation.OpUpdate, tagValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "User", validation.OpUpdate, userValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.Name, input.Slug, input.Description, input.UpdatedAt},
+			Values:  []any{input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "tags",
+		Table:         "users",
 		PrimaryColumn: "id",
-		Columns:       []string{"name", "slug", "description", "updated_at"},
-		Returning:     []string{"id", "name", "slug", "description", "created_at", "updated_at"},
+		Columns:       []string{"username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "updated_at"},
+		Returning:     []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -3802,15 +6983,15 @@ func (c *TagClient) BulkUpdate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*Tag
+	var updated []*User
 	for rows.Next() {
-		item := new(Tag)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("Tag", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("User", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -3819,22 +7000,22 @@ func (c *TagClient) BulkUpdate(ctx context.Context, inputs []*Tag) ([]*Tag, erro
 	return updated, nil
 }
 
-func (c *TagClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, tagDeleteQuery, id); err != nil {
+func (c *UserClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, userDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("Tag", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("User", id))
 	}
 	return nil
 }
 
-func (c *TagClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *UserClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "tags",
+		Table:         "users",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -3851,13 +7032,13 @@ func (c *TagClient) BulkDelete(ctx context.Context, ids []string) (int64, error)
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("Tag", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("User", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type TagQuery struct {
+type UserQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -3867,11 +7048,11 @@ type TagQuery struct {
 	maxLimit     int
 }
 
-func (c *TagClient) Query() *TagQuery {
-	return &TagQuery{db: c.db, defaultLimit: 100, maxLimit: 500}
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
 }
 
-func (q *TagQuery) Limit(n int) *TagQuery {
+func (q *UserQuery) Limit(n int) *UserQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -3880,7 +7061,7 @@ func (q *TagQuery) Limit(n int) *TagQuery {
 	return q
 }
 
-func (q *TagQuery) Offset(n int) *TagQuery {
+func (q *UserQuery) Offset(n int) *UserQuery {
 	if n < 0 {
 		return q
 	}
@@ -3888,25 +7069,35 @@ func (q *TagQuery) Offset(n int) *TagQuery {
 	return q
 }
 
-func (q *TagQuery) WhereIDEq(value string) *TagQuery {
+func (q *UserQuery) WhereIDEq(value string) *UserQuery {
 	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *TagQuery) WhereSlugEq(value string) *TagQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "slug", Operator: runtime.OpEqual, Value: value})
+func (q *UserQuery) WhereUsernameEq(value string) *UserQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "username", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *TagQuery) OrderByNameAsc() *TagQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "name", Direction: runtime.SortAsc})
+func (q *UserQuery) WhereEmailEq(value string) *UserQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "email", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *TagQuery) All(ctx context.Context) ([]*Tag, error) {
+func (q *UserQuery) OrderByCreatedAtDesc() *UserQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+	return q
+}
+
+func (q *UserQuery) OrderByUsernameAsc() *UserQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "username", Direction: runtime.SortAsc})
+	return q
+}
+
+func (q *UserQuery) All(ctx context.Context) ([]*User, error) {
 	spec := runtime.SelectSpec{
-		Table:      "tags",
-		Columns:    []string{"id", "name", "slug", "description", "created_at", "updated_at"},
+		Table:      "users",
+		Columns:    []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -3917,10 +7108,10 @@ func (q *TagQuery) All(ctx context.Context) ([]*Tag, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*Tag
+	var result []*User
 	for rows.Next() {
-		item := new(Tag)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -3931,10 +7122,10 @@ func (q *TagQuery) All(ctx context.Context) ([]*Tag, error) {
 	return result, nil
 }
 
-func (q *TagQuery) Stream(ctx context.Context) (*runtime.Stream[*Tag], error) {
+func (q *UserQuery) Stream(ctx context.Context) (*runtime.Stream[*User], error) {
 	spec := runtime.SelectSpec{
-		Table:      "tags",
-		Columns:    []string{"id", "name", "slug", "description", "created_at", "updated_at"},
+		Table:      "users",
+		Columns:    []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -3944,9 +7135,9 @@ func (q *TagQuery) Stream(ctx context.Context) (*runtime.Stream[*Tag], error) {
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*Tag](rows, func(rows pgx.Rows) (*Tag, error) {
-		item := new(Tag)
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*User](rows, func(rows pgx.Rows) (*User, error) {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -3954,7 +7145,7 @@ func (q *TagQuery) Stream(ctx context.Context) (*runtime.Stream[*Tag], error) {
 	return stream, nil
 }
 
-func (q *TagQuery) First(ctx context.Context) (*Tag, error) {
+func (q *UserQuery) First(ctx context.Context) (*User, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -3968,9 +7159,9 @@ func (q *TagQuery) First(ctx context.Context) (*Tag, error) {
 	return items[0], nil
 }
 
-func (q *TagQuery) Count(ctx context.Context) (int, error) {
+func (q *UserQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "tags",
+		Table:      "users",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -3982,7 +7173,7 @@ func (q *TagQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *TagQuery) clone() *TagQuery {
+func (q *UserQuery) clone() *UserQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -3997,7 +7188,7 @@ func (q *TagQuery) clone() *TagQuery {
 	return &cp
 }
 
-func (q *TagQuery) effectiveLimit() int {
+func (q *UserQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -4015,27 +7206,27 @@ func (q *TagQuery) effectiveLimit() int {
 	return limit
 }
 
-const tagPostsRelationQuery = `SELECT id, author_id, featured_media_id, title, slug, status, type, excerpt, content, seo, published_at, created_at, updated_at, jt.tag_id FROM posts AS t JOIN post_tags AS jt ON t.id = jt.post_id WHERE jt.tag_id IN (%s)`
+const userRolesRelationQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at, jt.user_id FROM roles AS t JOIN user_roles AS jt ON t.id = jt.role_id WHERE jt.user_id IN (%s)`
 
-func (c *TagClient) LoadPosts(ctx context.Context, parents ...*Tag) error {
+func (c *UserClient) LoadRoles(ctx context.Context, parents ...*User) error {
 	if len(parents) == 0 {
 		return nil
 	}
 	type keyType = string
 	keys := make([]keyType, 0, len(parents))
 	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*Tag, len(parents))
+	buckets := make(map[keyType][]*User, len(parents))
 	for _, parent := range parents {
 		if parent == nil {
 			continue
 		}
 		key := parent.ID
 		if isZero(key) {
-			edges := ensureTagEdges(parent)
-			if edges.Posts == nil {
-				edges.Posts = []*Post{}
+			edges := ensureUserEdges(parent)
+			if edges.Roles == nil {
+				edges.Roles = []*Role{}
 			}
-			edges.markLoaded("posts")
+			edges.markLoaded("roles")
 			continue
 		}
 		if _, ok := seen[key]; !ok {
@@ -4049,24 +7240,24 @@ func (c *TagClient) LoadPosts(ctx context.Context, parents ...*Tag) error {
 			if parent == nil {
 				continue
 			}
-			edges := ensureTagEdges(parent)
-			if edges.Posts == nil {
-				edges.Posts = []*Post{}
+			edges := ensureUserEdges(parent)
+			if edges.Roles == nil {
+				edges.Roles = []*Role{}
 			}
-			edges.markLoaded("posts")
+			edges.markLoaded("roles")
 		}
 		return nil
 	}
-	sql, args := buildInQuery(tagPostsRelationQuery, keys)
+	sql, args := buildInQuery(userRolesRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		item := new(Post)
+		item := new(Role)
 		var owner keyType
-		if err := rows.Scan(&item.ID, &item.AuthorID, &item.FeaturedMediaID, &item.Title, &item.Slug, &item.Status, &item.Type, &item.Excerpt, &item.Content, &item.Seo, &item.PublishedAt, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
 			return err
 		}
 		parents, ok := buckets[owner]
@@ -4074,8 +7265,8 @@ func (c *TagClient) LoadPosts(ctx context.Context, parents ...*Tag) error {
 			continue
 		}
 		for _, parent := range parents {
-			edges := ensureTagEdges(parent)
-			edges.Posts = append(edges.Posts, item)
+			edges := ensureUserEdges(parent)
+			edges.Roles = append(edges.Roles, item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -4085,28 +7276,28 @@ func (c *TagClient) LoadPosts(ctx context.Context, parents ...*Tag) error {
 		if parent == nil {
 			continue
 		}
-		edges := ensureTagEdges(parent)
-		if edges.Posts == nil {
-			edges.Posts = []*Post{}
+		edges := ensureUserEdges(parent)
+		if edges.Roles == nil {
+			edges.Roles = []*Role{}
 		}
-		edges.markLoaded("posts")
+		edges.markLoaded("roles")
 	}
 	return nil
 }
 
-const userInsertQuery = `INSERT INTO users (id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at`
-const userSelectQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id = $1`
-const userListQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users ORDER BY id LIMIT $1 OFFSET $2`
-const userUpdateQuery = `UPDATE users SET username = $1, email = $2, password_hash = $3, display_name = $4, bio = $5, avatar_url = $6, website_url = $7, last_login_at = $8, updated_at = $9 WHERE id = $10 RETURNING id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at`
-const userCountQuery = `SELECT COUNT(*) FROM users`
-const userDeleteQuery = `DELETE FROM users WHERE id = $1`
+const userMetaInsertQuery = `INSERT INTO user_metas (id, user_id, key, value, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, user_id, key, value, created_at, updated_at`
+const userMetaSelectQuery = `SELECT id, user_id, key, value, created_at, updated_at FROM user_metas WHERE id = $1`
+const userMetaListQuery = `SELECT id, user_id, key, value, created_at, updated_at FROM user_metas ORDER BY id LIMIT $1 OFFSET $2`
+const userMetaUpdateQuery = `UPDATE user_metas SET user_id = $1, key = $2, value = $3, updated_at = $4 WHERE id = $5 RETURNING id, user_id, key, value, created_at, updated_at`
+const userMetaCountQuery = `SELECT COUNT(*) FROM user_metas`
+const userMetaDeleteQuery = `DELETE FROM user_metas WHERE id = $1`
 
-type UserClient struct {
+type UserMetaClient struct {
 	db    *pg.DB
 	cache cache.Store
 }
 
-func (c *UserClient) Create(ctx context.Context, input *User) (*User, error) {
+func (c *UserMetaClient) Create(ctx context.Context, input *UserMeta) (*UserMeta, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -4122,23 +7313,23 @@ func (c *UserClient) Create(ctx context.Context, input *User) (*User, error) {
 		input.CreatedAt = now
 	}
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "User", validation.OpCreate, userValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "UserMeta", validation.OpCreate, userMetaValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, userInsertQuery, input.ID, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.CreatedAt, input.UpdatedAt)
-	out := new(User)
-	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userMetaInsertQuery, input.ID, input.UserID, input.Key, input.Value, input.CreatedAt, input.UpdatedAt)
+	out := new(UserMeta)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Key, &out.Value, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("User", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("UserMeta", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *UserClient) BulkCreate(ctx context.Context, inputs []*User) ([]*User, error) {
+func (c *UserMetaClient) BulkCreate(ctx context.Context, inputs []*UserMeta) ([]*UserMeta, error) {
 	if len(inputs) == 0 {
-		return []*User{}, nil
+		return []*UserMeta{}, nil
 	}
 	rowsSpec := make([][]any, 0, len(inputs))
 	for _, input := range inputs {
@@ -4157,16 +7348,16 @@ func (c *UserClient) BulkCreate(ctx context.Context, inputs []*User) ([]*User, e
 			input.CreatedAt = now
 		}
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "User", validation.OpCreate, userValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "UserMeta", validation.OpCreate, userMetaValidationRecord(input), input); err != nil {
 			return nil, err
 		}
-		row := []any{input.ID, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.CreatedAt, input.UpdatedAt}
+		row := []any{input.ID, input.UserID, input.Key, input.Value, input.CreatedAt, input.UpdatedAt}
 		rowsSpec = append(rowsSpec, row)
 	}
 	spec := runtime.BulkInsertSpec{
-		Table:     "users",
-		Columns:   []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
-		Returning: []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
+		Table:     "user_metas",
+		Columns:   []string{"id", "user_id", "key", "value", "created_at", "updated_at"},
+		Returning: []string{"id", "user_id", "key", "value", "created_at", "updated_at"},
 		Rows:      rowsSpec,
 	}
 	sql, args, err := runtime.BuildBulkInsertSQL(spec)
@@ -4178,15 +7369,15 @@ func (c *UserClient) BulkCreate(ctx context.Context, inputs []*User) ([]*User, e
 		return nil, err
 	}
 	defer rows.Close()
-	var created []*User
+	var created []*UserMeta
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(UserMeta)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Key, &item.Value, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		created = append(created, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("User", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("UserMeta", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -4195,49 +7386,49 @@ func (c *UserClient) BulkCreate(ctx context.Context, inputs []*User) ([]*User, e
 	return created, nil
 }
 
-func (c *UserClient) ByID(ctx context.Context, id string) (*User, error) {
+func (c *UserMetaClient) ByID(ctx context.Context, id string) (*UserMeta, error) {
 	var cachedKey string
 	if c.cache != nil {
-		cachedKey = makeCacheKey("User", id)
+		cachedKey = makeCacheKey("UserMeta", id)
 		if value, ok, err := c.cache.Get(ctx, cachedKey); err != nil {
 			return nil, err
 		} else if ok {
-			if entity, ok := value.(*User); ok {
+			if entity, ok := value.(*UserMeta); ok {
 				return entity, nil
 			}
 		}
 	}
-	row := c.db.Pool.QueryRow(ctx, userSelectQuery, id)
-	out := new(User)
-	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userMetaSelectQuery, id)
+	out := new(UserMeta)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Key, &out.Value, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
 	if c.cache != nil {
-		cachedKey = makeCacheKey("User", out.ID)
+		cachedKey = makeCacheKey("UserMeta", out.ID)
 		_ = c.cache.Set(ctx, cachedKey, out)
 	}
 	return out, nil
 }
 
-func (c *UserClient) List(ctx context.Context, limit, offset int) ([]*User, error) {
+func (c *UserMetaClient) List(ctx context.Context, limit, offset int) ([]*UserMeta, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	rows, err := c.db.Pool.Query(ctx, userListQuery, limit, offset)
+	rows, err := c.db.Pool.Query(ctx, userMetaListQuery, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*User
+	var result []*UserMeta
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(UserMeta)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Key, &item.Value, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -4248,8 +7439,8 @@ func (c *UserClient) List(ctx context.Context, limit, offset int) ([]*User, erro
 	return result, nil
 }
 
-func (c *UserClient) Count(ctx context.Context) (int, error) {
-	row := c.db.Pool.QueryRow(ctx, userCountQuery)
+func (c *UserMetaClient) Count(ctx context.Context) (int, error) {
+	row := c.db.Pool.QueryRow(ctx, userMetaCountQuery)
 	var total int
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -4257,7 +7448,7 @@ func (c *UserClient) Count(ctx context.Context) (int, error) {
 	return total, nil
 }
 
-func (c *UserClient) Update(ctx context.Context, input *User) (*User, error) {
+func (c *UserMetaClient) Update(ctx context.Context, input *UserMeta) (*UserMeta, error) {
 	if input == nil {
 		return nil, errors.New("input cannot be nil")
 	}
@@ -4266,23 +7457,23 @@ func (c *UserClient) Update(ctx context.Context, input *User) (*User, error) {
 	}
 	now := time.Now().UTC()
 	input.UpdatedAt = now
-	if err := ValidationRegistry.Validate(ctx, "User", validation.OpUpdate, userValidationRecord(input), input); err != nil {
+	if err := ValidationRegistry.Validate(ctx, "UserMeta", validation.OpUpdate, userMetaValidationRecord(input), input); err != nil {
 		return nil, err
 	}
-	row := c.db.Pool.QueryRow(ctx, userUpdateQuery, input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.UpdatedAt, input.ID)
-	out := new(User)
-	if err := row.Scan(&out.ID, &out.Username, &out.Email, &out.Password, &out.DisplayName, &out.Bio, &out.AvatarURL, &out.WebsiteURL, &out.LastLoginAt, &out.CreatedAt, &out.UpdatedAt); err != nil {
+	row := c.db.Pool.QueryRow(ctx, userMetaUpdateQuery, input.UserID, input.Key, input.Value, input.UpdatedAt, input.ID)
+	out := new(UserMeta)
+	if err := row.Scan(&out.ID, &out.UserID, &out.Key, &out.Value, &out.CreatedAt, &out.UpdatedAt); err != nil {
 		return nil, err
 	}
 	if c.cache != nil {
-		_ = c.cache.Set(ctx, makeCacheKey("User", out.ID), out)
+		_ = c.cache.Set(ctx, makeCacheKey("UserMeta", out.ID), out)
 	}
 	return out, nil
 }
 
-func (c *UserClient) BulkUpdate(ctx context.Context, inputs []*User) ([]*User, error) {
+func (c *UserMetaClient) BulkUpdate(ctx context.Context, inputs []*UserMeta) ([]*UserMeta, error) {
 	if len(inputs) == 0 {
-		return []*User{}, nil
+		return []*UserMeta{}, nil
 	}
 	specs := make([]runtime.BulkUpdateRow, 0, len(inputs))
 	for _, input := range inputs {
@@ -4294,20 +7485,20 @@ func (c *UserClient) BulkUpdate(ctx context.Context, inputs []*User) ([]*User, e
 		}
 		now := time.Now().UTC()
 		input.UpdatedAt = now
-		if err := ValidationRegistry.Validate(ctx, "User", validation.OpUpdate, userValidationRecord(input), input); err != nil {
+		if err := ValidationRegistry.Validate(ctx, "UserMeta", validation.OpUpdate, userMetaValidationRecord(input), input); err != nil {
 			return nil, err
 		}
 		row := runtime.BulkUpdateRow{
 			Primary: input.ID,
-			Values:  []any{input.Username, input.Email, input.Password, input.DisplayName, input.Bio, input.AvatarURL, input.WebsiteURL, input.LastLoginAt, input.UpdatedAt},
+			Values:  []any{input.UserID, input.Key, input.Value, input.UpdatedAt},
 		}
 		specs = append(specs, row)
 	}
 	spec := runtime.BulkUpdateSpec{
-		Table:         "users",
+		Table:         "user_metas",
 		PrimaryColumn: "id",
-		Columns:       []string{"username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "updated_at"},
-		Returning:     []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
+		Columns:       []string{"user_id", "key", "value", "updated_at"},
+		Returning:     []string{"id", "user_id", "key", "value", "created_at", "updated_at"},
 		Rows:          specs,
 	}
 	sql, args, err := runtime.BuildBulkUpdateSQL(spec)
@@ -4319,15 +7510,15 @@ func (c *UserClient) BulkUpdate(ctx context.Context, inputs []*User) ([]*User, e
 		return nil, err
 	}
 	defer rows.Close()
-	var updated []*User
+	var updated []*UserMeta
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(UserMeta)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Key, &item.Value, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		updated = append(updated, item)
 		if c.cache != nil {
-			_ = c.cache.Set(ctx, makeCacheKey("User", item.ID), item)
+			_ = c.cache.Set(ctx, makeCacheKey("UserMeta", item.ID), item)
 		}
 	}
 	if err := rows.Err(); err != nil {
@@ -4336,22 +7527,22 @@ func (c *UserClient) BulkUpdate(ctx context.Context, inputs []*User) ([]*User, e
 	return updated, nil
 }
 
-func (c *UserClient) Delete(ctx context.Context, id string) error {
-	if _, err := c.db.Pool.Exec(ctx, userDeleteQuery, id); err != nil {
+func (c *UserMetaClient) Delete(ctx context.Context, id string) error {
+	if _, err := c.db.Pool.Exec(ctx, userMetaDeleteQuery, id); err != nil {
 		return err
 	}
 	if c.cache != nil {
-		_ = c.cache.Delete(ctx, makeCacheKey("User", id))
+		_ = c.cache.Delete(ctx, makeCacheKey("UserMeta", id))
 	}
 	return nil
 }
 
-func (c *UserClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
+func (c *UserMetaClient) BulkDelete(ctx context.Context, ids []string) (int64, error) {
 	if len(ids) == 0 {
 		return 0, nil
 	}
 	spec := runtime.BulkDeleteSpec{
-		Table:         "users",
+		Table:         "user_metas",
 		PrimaryColumn: "id",
 		IDs:           make([]any, len(ids)),
 	}
@@ -4368,13 +7559,13 @@ func (c *UserClient) BulkDelete(ctx context.Context, ids []string) (int64, error
 	}
 	if c.cache != nil {
 		for _, id := range ids {
-			_ = c.cache.Delete(ctx, makeCacheKey("User", id))
+			_ = c.cache.Delete(ctx, makeCacheKey("UserMeta", id))
 		}
 	}
 	return int64(tag.RowsAffected()), nil
 }
 
-type UserQuery struct {
+type UserMetaQuery struct {
 	db           *pg.DB
 	predicates   []runtime.Predicate
 	orders       []runtime.Order
@@ -4384,11 +7575,11 @@ type UserQuery struct {
 	maxLimit     int
 }
 
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
+func (c *UserMetaClient) Query() *UserMetaQuery {
+	return &UserMetaQuery{db: c.db, defaultLimit: 50, maxLimit: 200}
 }
 
-func (q *UserQuery) Limit(n int) *UserQuery {
+func (q *UserMetaQuery) Limit(n int) *UserMetaQuery {
 	if n <= 0 {
 		q.limit = nil
 		return q
@@ -4397,7 +7588,7 @@ func (q *UserQuery) Limit(n int) *UserQuery {
 	return q
 }
 
-func (q *UserQuery) Offset(n int) *UserQuery {
+func (q *UserMetaQuery) Offset(n int) *UserMetaQuery {
 	if n < 0 {
 		return q
 	}
@@ -4405,35 +7596,25 @@ func (q *UserQuery) Offset(n int) *UserQuery {
 	return q
 }
 
-func (q *UserQuery) WhereIDEq(value string) *UserQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "id", Operator: runtime.OpEqual, Value: value})
-	return q
-}
-
-func (q *UserQuery) WhereUsernameEq(value string) *UserQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "username", Operator: runtime.OpEqual, Value: value})
-	return q
-}
-
-func (q *UserQuery) WhereEmailEq(value string) *UserQuery {
-	q.predicates = append(q.predicates, runtime.Predicate{Column: "email", Operator: runtime.OpEqual, Value: value})
+func (q *UserMetaQuery) WhereUserIDEq(value string) *UserMetaQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "user_id", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *UserQuery) OrderByCreatedAtDesc() *UserQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "created_at", Direction: runtime.SortDesc})
+func (q *UserMetaQuery) WhereKeyEq(value string) *UserMetaQuery {
+	q.predicates = append(q.predicates, runtime.Predicate{Column: "key", Operator: runtime.OpEqual, Value: value})
 	return q
 }
 
-func (q *UserQuery) OrderByUsernameAsc() *UserQuery {
-	q.orders = append(q.orders, runtime.Order{Column: "username", Direction: runtime.SortAsc})
+func (q *UserMetaQuery) OrderByKeyAsc() *UserMetaQuery {
+	q.orders = append(q.orders, runtime.Order{Column: "key", Direction: runtime.SortAsc})
 	return q
 }
 
-func (q *UserQuery) All(ctx context.Context) ([]*User, error) {
+func (q *UserMetaQuery) All(ctx context.Context) ([]*UserMeta, error) {
 	spec := runtime.SelectSpec{
-		Table:      "users",
-		Columns:    []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
+		Table:      "user_metas",
+		Columns:    []string{"id", "user_id", "key", "value", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -4444,10 +7625,10 @@ func (q *UserQuery) All(ctx context.Context) ([]*User, error) {
 		return nil, err
 	}
 	defer rows.Close()
-	var result []*User
+	var result []*UserMeta
 	for rows.Next() {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		item := new(UserMeta)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Key, &item.Value, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, item)
@@ -4458,10 +7639,10 @@ func (q *UserQuery) All(ctx context.Context) ([]*User, error) {
 	return result, nil
 }
 
-func (q *UserQuery) Stream(ctx context.Context) (*runtime.Stream[*User], error) {
+func (q *UserMetaQuery) Stream(ctx context.Context) (*runtime.Stream[*UserMeta], error) {
 	spec := runtime.SelectSpec{
-		Table:      "users",
-		Columns:    []string{"id", "username", "email", "password_hash", "display_name", "bio", "avatar_url", "website_url", "last_login_at", "created_at", "updated_at"},
+		Table:      "user_metas",
+		Columns:    []string{"id", "user_id", "key", "value", "created_at", "updated_at"},
 		Predicates: q.predicates,
 		Orders:     q.orders,
 		Limit:      q.effectiveLimit(),
@@ -4471,9 +7652,9 @@ func (q *UserQuery) Stream(ctx context.Context) (*runtime.Stream[*User], error)
 	if err != nil {
 		return nil, err
 	}
-	stream := runtime.NewStream[*User](rows, func(rows pgx.Rows) (*User, error) {
-		item := new(User)
-		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+	stream := runtime.NewStream[*UserMeta](rows, func(rows pgx.Rows) (*UserMeta, error) {
+		item := new(UserMeta)
+		if err := rows.Scan(&item.ID, &item.UserID, &item.Key, &item.Value, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, err
 		}
 		return item, nil
@@ -4481,7 +7662,7 @@ func (q *UserQuery) Stream(ctx context.Context) (*runtime.Stream[*User], error)
 	return stream, nil
 }
 
-func (q *UserQuery) First(ctx context.Context) (*User, error) {
+func (q *UserMetaQuery) First(ctx context.Context) (*UserMeta, error) {
 	clone := q.clone()
 	one := 1
 	clone.limit = &one
@@ -4495,9 +7676,9 @@ func (q *UserQuery) First(ctx context.Context) (*User, error) {
 	return items[0], nil
 }
 
-func (q *UserQuery) Count(ctx context.Context) (int, error) {
+func (q *UserMetaQuery) Count(ctx context.Context) (int, error) {
 	spec := runtime.AggregateSpec{
-		Table:      "users",
+		Table:      "user_metas",
 		Predicates: q.predicates,
 		Aggregate:  runtime.Aggregate{Func: runtime.AggCount, Column: "*"},
 	}
@@ -4509,7 +7690,7 @@ func (q *UserQuery) Count(ctx context.Context) (int, error) {
 	return out, nil
 }
 
-func (q *UserQuery) clone() *UserQuery {
+func (q *UserMetaQuery) clone() *UserMetaQuery {
 	cp := *q
 	if len(q.predicates) > 0 {
 		cp.predicates = append([]runtime.Predicate(nil), q.predicates...)
@@ -4524,7 +7705,7 @@ func (q *UserQuery) clone() *UserQuery {
 	return &cp
 }
 
-func (q *UserQuery) effectiveLimit() int {
+func (q *UserMetaQuery) effectiveLimit() int {
 	if q.limit != nil {
 		limit := *q.limit
 		if q.maxLimit > 0 && limit > q.maxLimit {
@@ -4542,68 +7723,49 @@ func (q *UserQuery) effectiveLimit() int {
 	return limit
 }
 
-const userRolesRelationQuery = `SELECT id, name, slug, description, capabilities, created_at, updated_at, jt.user_id FROM roles AS t JOIN user_roles AS jt ON t.id = jt.role_id WHERE jt.user_id IN (%s)`
+const userMetaUserRelationQuery = `SELECT id, username, email, password_hash, display_name, bio, avatar_url, website_url, last_login_at, created_at, updated_at FROM users WHERE id IN (%s)`
 
-func (c *UserClient) LoadRoles(ctx context.Context, parents ...*User) error {
+func (c *UserMetaClient) LoadUser(ctx context.Context, parents ...*UserMeta) error {
 	if len(parents) == 0 {
 		return nil
 	}
 	type keyType = string
 	keys := make([]keyType, 0, len(parents))
 	seen := make(map[keyType]struct{}, len(parents))
-	buckets := make(map[keyType][]*User, len(parents))
 	for _, parent := range parents {
 		if parent == nil {
 			continue
 		}
-		key := parent.ID
-		if isZero(key) {
-			edges := ensureUserEdges(parent)
-			if edges.Roles == nil {
-				edges.Roles = []*Role{}
-			}
-			edges.markLoaded("roles")
+		edges := ensureUserMetaEdges(parent)
+		edges.markLoaded("user")
+		var fk keyType
+		fk = parent.UserID
+		if isZero(fk) {
+			edges.User = nil
 			continue
 		}
-		if _, ok := seen[key]; !ok {
-			seen[key] = struct{}{}
-			keys = append(keys, key)
+		if _, ok := seen[fk]; !ok {
+			seen[fk] = struct{}{}
+			keys = append(keys, fk)
 		}
-		buckets[key] = append(buckets[key], parent)
 	}
 	if len(keys) == 0 {
-		for _, parent := range parents {
-			if parent == nil {
-				continue
-			}
-			edges := ensureUserEdges(parent)
-			if edges.Roles == nil {
-				edges.Roles = []*Role{}
-			}
-			edges.markLoaded("roles")
-		}
 		return nil
 	}
-	sql, args := buildInQuery(userRolesRelationQuery, keys)
+	sql, args := buildInQuery(userMetaUserRelationQuery, keys)
 	rows, err := c.db.Pool.Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 	defer rows.Close()
+	related := make(map[keyType]*User, len(keys))
 	for rows.Next() {
-		item := new(Role)
-		var owner keyType
-		if err := rows.Scan(&item.ID, &item.Name, &item.Slug, &item.Description, &item.Capabilities, &item.CreatedAt, &item.UpdatedAt, &owner); err != nil {
+		item := new(User)
+		if err := rows.Scan(&item.ID, &item.Username, &item.Email, &item.Password, &item.DisplayName, &item.Bio, &item.AvatarURL, &item.WebsiteURL, &item.LastLoginAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return err
 		}
-		parents, ok := buckets[owner]
-		if !ok {
-			continue
-		}
-		for _, parent := range parents {
-			edges := ensureUserEdges(parent)
-			edges.Roles = append(edges.Roles, item)
-		}
+		key := item.ID
+		related[key] = item
 	}
 	if err := rows.Err(); err != nil {
 		return err
@@ -4612,15 +7774,39 @@ func (c *UserClient) LoadRoles(ctx context.Context, parents ...*User) error {
 		if parent == nil {
 			continue
 		}
-		edges := ensureUserEdges(parent)
-		if edges.Roles == nil {
-			edges.Roles = []*Role{}
+		edges := ensureUserMetaEdges(parent)
+		var fk keyType
+		fk = parent.UserID
+		if isZero(fk) {
+			edges.User = nil
+			continue
+		}
+		if item, ok := related[fk]; ok {
+			edges.User = item
+		} else {
+			edges.User = nil
 		}
-		edges.markLoaded("roles")
 	}
 	return nil
 }
 
+func apiKeyValidationRecord(input *ApiKey) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":         input.ID,
+		"UserID":     input.UserID,
+		"Name":       input.Name,
+		"Prefix":     input.Prefix,
+		"SecretHash": input.SecretHash,
+		"Scopes":     input.Scopes,
+		"ExpiresAt":  input.ExpiresAt,
+		"LastUsedAt": input.LastUsedAt,
+		"CreatedAt":  input.CreatedAt,
+	}
+}
+
 func categoryValidationRecord(input *Category) validation.Record {
 	if input == nil {
 		return nil
@@ -4631,6 +7817,7 @@ func categoryValidationRecord(input *Category) validation.Record {
 		"Slug":        input.Slug,
 		"Description": input.Description,
 		"ParentID":    input.ParentID,
+		"Position":    input.Position,
 		"CreatedAt":   input.CreatedAt,
 		"UpdatedAt":   input.UpdatedAt,
 	}
@@ -4653,6 +7840,24 @@ func commentValidationRecord(input *Comment) validation.Record {
 		"SubmittedAt": input.SubmittedAt,
 		"PublishedAt": input.PublishedAt,
 		"UpdatedAt":   input.UpdatedAt,
+		"DeletedAt":   input.DeletedAt,
+	}
+}
+
+func contentTypeValidationRecord(input *ContentType) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":          input.ID,
+		"Name":        input.Name,
+		"Label":       input.Label,
+		"PluralLabel": input.PluralLabel,
+		"Description": input.Description,
+		"Supports":    input.Supports,
+		"Fields":      input.Fields,
+		"CreatedAt":   input.CreatedAt,
+		"UpdatedAt":   input.UpdatedAt,
 	}
 }
 
@@ -4675,6 +7880,39 @@ func mediaValidationRecord(input *Media) validation.Record {
 		"Metadata":      input.Metadata,
 		"CreatedAt":     input.CreatedAt,
 		"UpdatedAt":     input.UpdatedAt,
+		"DeletedAt":     input.DeletedAt,
+	}
+}
+
+func menuValidationRecord(input *Menu) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":          input.ID,
+		"Name":        input.Name,
+		"Location":    input.Location,
+		"Description": input.Description,
+		"CreatedAt":   input.CreatedAt,
+		"UpdatedAt":   input.UpdatedAt,
+	}
+}
+
+func menuItemValidationRecord(input *MenuItem) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":        input.ID,
+		"MenuID":    input.MenuID,
+		"ParentID":  input.ParentID,
+		"Position":  input.Position,
+		"Kind":      input.Kind,
+		"TargetID":  input.TargetID,
+		"Label":     input.Label,
+		"URL":       input.URL,
+		"CreatedAt": input.CreatedAt,
+		"UpdatedAt": input.UpdatedAt,
 	}
 }
 
@@ -4700,6 +7938,9 @@ func postValidationRecord(input *Post) validation.Record {
 		"ID":              input.ID,
 		"AuthorID":        input.AuthorID,
 		"FeaturedMediaID": input.FeaturedMediaID,
+		"ContentTypeID":   input.ContentTypeID,
+		"ParentID":        input.ParentID,
+		"MenuOrder":       input.MenuOrder,
 		"Title":           input.Title,
 		"Slug":            input.Slug,
 		"Status":          input.Status,
@@ -4710,6 +7951,7 @@ func postValidationRecord(input *Post) validation.Record {
 		"PublishedAt":     input.PublishedAt,
 		"CreatedAt":       input.CreatedAt,
 		"UpdatedAt":       input.UpdatedAt,
+		"DeletedAt":       input.DeletedAt,
 	}
 }
 
@@ -4728,6 +7970,20 @@ func roleValidationRecord(input *Role) validation.Record {
 	}
 }
 
+func slugHistoryValidationRecord(input *SlugHistory) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":         input.ID,
+		"EntityType": input.EntityType,
+		"EntityID":   input.EntityID,
+		"Slug":       input.Slug,
+		"CreatedAt":  input.CreatedAt,
+		"UpdatedAt":  input.UpdatedAt,
+	}
+}
+
 func tagValidationRecord(input *Tag) validation.Record {
 	if input == nil {
 		return nil
@@ -4761,6 +8017,20 @@ func userValidationRecord(input *User) validation.Record {
 	}
 }
 
+func userMetaValidationRecord(input *UserMeta) validation.Record {
+	if input == nil {
+		return nil
+	}
+	return validation.Record{
+		"ID":        input.ID,
+		"UserID":    input.UserID,
+		"Key":       input.Key,
+		"Value":     input.Value,
+		"CreatedAt": input.CreatedAt,
+		"UpdatedAt": input.UpdatedAt,
+	}
+}
+
 func buildInQuery[T any](base string, values []T) (string, []any) {
 	if len(values) == 0 {
 		return base, nil
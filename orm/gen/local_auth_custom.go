@@ -0,0 +1,323 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// userByLoginQuery prefers a username match over an email match so a
+	// username that looks like someone else's email cannot shadow it.
+	userByLoginQuery = `SELECT id::text, username, email, password_hash, display_name FROM users
+WHERE lower(username) = lower($1) OR lower(email) = lower($1)
+ORDER BY lower(username) = lower($1) DESC
+LIMIT 1`
+	loginLockedUntilQuery = `SELECT locked_until FROM login_attempts WHERE login = $1 AND locked_until > $2`
+	// recordLoginFailureQuery counts a failure, restarting the count when
+	// the previous one is older than the lockout window ($5), and locks the
+	// login until $4 once $3 failures were counted.
+	recordLoginFailureQuery = `WITH counted AS (
+SELECT CASE WHEN a.updated_at >= $5 THEN a.failures + 1 ELSE 1 END AS failures
+FROM (SELECT 1) one LEFT JOIN login_attempts a ON a.login = $1
+)
+INSERT INTO login_attempts AS a (login, failures, locked_until, updated_at)
+SELECT $1, CASE WHEN failures >= $3 THEN 0 ELSE failures END, CASE WHEN failures >= $3 THEN $4::timestamptz END, $2
+FROM counted
+ON CONFLICT (login) DO UPDATE SET failures = EXCLUDED.failures,
+locked_until = COALESCE(EXCLUDED.locked_until, a.locked_until), updated_at = EXCLUDED.updated_at`
+	clearLoginFailuresQuery  = `DELETE FROM login_attempts WHERE login = $1`
+	recordUserLoginQuery     = `UPDATE users SET last_login_at = $2 WHERE id = $1::uuid`
+	createRefreshTokenQuery  = `INSERT INTO refresh_tokens (token_hash, user_id, expires_at) VALUES ($1, $2::uuid, $3)`
+	purgeRefreshTokensQuery  = `DELETE FROM refresh_tokens WHERE user_id = $1::uuid AND expires_at <= now()`
+	consumeRefreshTokenQuery = `DELETE FROM refresh_tokens WHERE token_hash = $1 RETURNING user_id::text, expires_at`
+	revokeRefreshTokenQuery  = `DELETE FROM refresh_tokens WHERE token_hash = $1`
+	revokeUserRefreshTokens  = `DELETE FROM refresh_tokens WHERE user_id = $1::uuid`
+	userByEmailQuery         = `SELECT id::text, username, email, password_hash, display_name FROM users
+WHERE lower(email) = lower($1) LIMIT 1`
+	// createUserTokenQuery replaces the user's earlier tokens of the same
+	// purpose, so only the latest mail works.
+	createUserTokenQuery = `WITH replaced AS (
+DELETE FROM user_tokens WHERE user_id = $2::uuid AND purpose = $3
+)
+INSERT INTO user_tokens (token_hash, user_id, purpose, email, expires_at) VALUES ($1, $2::uuid, $3, $4, $5)`
+	consumeUserTokenQuery = `DELETE FROM user_tokens WHERE token_hash = $1 AND purpose = $2
+RETURNING user_id::text, email, expires_at`
+	userTokenQuery = `SELECT user_id::text, email FROM user_tokens
+WHERE token_hash = $1 AND purpose = $2 AND expires_at > $3`
+	// resetUserPasswordQuery consumes the reset token of user $3, sets the
+	// password and revokes the user's sessions in one statement, so a token
+	// is only used up by a reset that happens.
+	resetUserPasswordQuery = `WITH used AS (
+DELETE FROM user_tokens WHERE token_hash = $1 AND purpose = $2 AND user_id = $3::uuid AND expires_at > $5
+RETURNING user_id
+), updated AS (
+UPDATE users SET password_hash = $4, updated_at = now() WHERE id IN (SELECT user_id FROM used)
+RETURNING id
+), revoked AS (
+DELETE FROM refresh_tokens WHERE user_id IN (SELECT id FROM updated)
+)
+SELECT EXISTS (SELECT 1 FROM updated)`
+)
+
+// UserByLogin returns the user whose username or email matches login,
+// ignoring case, with its password hash. It returns nil when none does.
+// It reads from the writer so a lagging replica never accepts a password
+// that was just changed.
+func (c *Client) UserByLogin(ctx context.Context, login string) (*User, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	record := new(User)
+	err := writer.QueryRow(ctx, userByLoginQuery, login).
+		Scan(&record.ID, &record.Username, &record.Email, &record.Password, &record.DisplayName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// LoginLockedUntil returns when the lockout of login ends, or the zero time
+// when it is not locked at now. It reads from the writer, where failures
+// are recorded, so replica lag cannot buy extra guesses.
+func (c *Client) LoginLockedUntil(ctx context.Context, login string, now time.Time) (time.Time, error) {
+	if c == nil {
+		return time.Time{}, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return time.Time{}, fmt.Errorf("orm writer pool is not configured")
+	}
+	var until time.Time
+	err := writer.QueryRow(ctx, loginLockedUntilQuery, login, now).Scan(&until)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return until, nil
+}
+
+// RecordLoginFailure counts a failed login at the given time. The limit-th
+// failure within lockout of the previous one locks login for lockout.
+func (c *Client) RecordLoginFailure(ctx context.Context, login string, at time.Time, limit int, lockout time.Duration) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, recordLoginFailureQuery, login, at, limit, at.Add(lockout), at.Add(-lockout))
+	return err
+}
+
+// ClearLoginFailures forgets the failed logins of login.
+func (c *Client) ClearLoginFailures(ctx context.Context, login string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, clearLoginFailuresQuery, login)
+	return err
+}
+
+// RecordUserLogin sets the user's last_login_at.
+func (c *Client) RecordUserLogin(ctx context.Context, userID string, at time.Time) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, recordUserLoginQuery, userID, at)
+	return err
+}
+
+// CreateRefreshToken stores the digest of a refresh token issued to a user
+// and drops the user's expired ones.
+func (c *Client) CreateRefreshToken(ctx context.Context, tokenHash, userID string, expiresAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	if _, err := writer.Exec(ctx, purgeRefreshTokensQuery, userID); err != nil {
+		return err
+	}
+	_, err := writer.Exec(ctx, createRefreshTokenQuery, tokenHash, userID, expiresAt)
+	return err
+}
+
+// ConsumeRefreshToken deletes the refresh token with the given digest and
+// returns its user. It returns "" when the token is unknown or expired at
+// now.
+func (c *Client) ConsumeRefreshToken(ctx context.Context, tokenHash string, now time.Time) (string, error) {
+	if c == nil {
+		return "", fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return "", fmt.Errorf("orm writer pool is not configured")
+	}
+	var userID string
+	var expiresAt time.Time
+	err := writer.QueryRow(ctx, consumeRefreshTokenQuery, tokenHash).Scan(&userID, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !expiresAt.After(now) {
+		return "", nil
+	}
+	return userID, nil
+}
+
+// RevokeRefreshToken deletes the refresh token with the given digest.
+func (c *Client) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, revokeRefreshTokenQuery, tokenHash)
+	return err
+}
+
+// RevokeUserRefreshTokens deletes every refresh token of a user.
+func (c *Client) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, revokeUserRefreshTokens, userID)
+	return err
+}
+
+// UserByEmail returns the user with the given email, ignoring case, or nil.
+// Like UserByLogin it reads from the writer.
+func (c *Client) UserByEmail(ctx context.Context, email string) (*User, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	record := new(User)
+	err := writer.QueryRow(ctx, userByEmailQuery, email).
+		Scan(&record.ID, &record.Username, &record.Email, &record.Password, &record.DisplayName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ResetUserPassword replaces the password hash of the user a reset token
+// was issued to, using up the token and revoking the user's refresh tokens
+// in the same statement. It reports false, changing nothing, when the token
+// with the given digest and purpose is unknown, belongs to someone else or
+// expired at now.
+func (c *Client) ResetUserPassword(ctx context.Context, tokenHash, purpose, userID, passwordHash string, now time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return false, fmt.Errorf("orm writer pool is not configured")
+	}
+	var reset bool
+	if err := writer.QueryRow(ctx, resetUserPasswordQuery, tokenHash, purpose, userID, passwordHash, now).Scan(&reset); err != nil {
+		return false, err
+	}
+	return reset, nil
+}
+
+// CreateUserToken stores the digest of a token mailed to email for purpose,
+// replacing the user's earlier tokens for the same purpose.
+func (c *Client) CreateUserToken(ctx context.Context, tokenHash, userID, purpose, email string, expiresAt time.Time) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, createUserTokenQuery, tokenHash, userID, purpose, email, expiresAt)
+	return err
+}
+
+// ConsumeUserToken deletes the token with the given digest and purpose and
+// returns its user and email. Both are empty when the token is unknown or
+// expired at now.
+func (c *Client) ConsumeUserToken(ctx context.Context, tokenHash, purpose string, now time.Time) (string, string, error) {
+	if c == nil {
+		return "", "", fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return "", "", fmt.Errorf("orm writer pool is not configured")
+	}
+	var userID, email string
+	var expiresAt time.Time
+	err := writer.QueryRow(ctx, consumeUserTokenQuery, tokenHash, purpose).Scan(&userID, &email, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if !expiresAt.After(now) {
+		return "", "", nil
+	}
+	return userID, email, nil
+}
+
+// UserToken returns the user and email of the token with the given digest
+// and purpose without using it up. Both are empty when the token is unknown
+// or expired at now.
+func (c *Client) UserToken(ctx context.Context, tokenHash, purpose string, now time.Time) (string, string, error) {
+	if c == nil {
+		return "", "", fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return "", "", fmt.Errorf("orm writer pool is not configured")
+	}
+	var userID, email string
+	err := writer.QueryRow(ctx, userTokenQuery, tokenHash, purpose, now).Scan(&userID, &email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return userID, email, nil
+}
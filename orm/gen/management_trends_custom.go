@@ -0,0 +1,118 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TrendMetric selects the table and timestamp aggregated by Trend.
+type TrendMetric string
+
+const (
+	TrendPostsPublished TrendMetric = "posts_published"
+	TrendComments       TrendMetric = "comments"
+	TrendMediaUploaded  TrendMetric = "media_uploaded"
+	TrendNewUsers       TrendMetric = "new_users"
+)
+
+// Trend buckets are truncated in UTC. Rows before $3 belong to the previous
+// period and are only counted in previous so both periods come from one scan.
+// Trashed content is left out.
+const (
+	trendPostsPublishedQuery = `SELECT date_trunc($1, published_at AT TIME ZONE 'UTC') AS bucket, '' AS key,
+count(*) FILTER (WHERE published_at >= $3) AS current, count(*) FILTER (WHERE published_at < $3) AS previous
+FROM posts WHERE status = 'published' AND deleted_at IS NULL AND published_at >= $2 AND published_at < $4
+GROUP BY 1, 2 ORDER BY 1, 2`
+	trendCommentsQuery = `SELECT date_trunc($1, submitted_at AT TIME ZONE 'UTC') AS bucket, status::text AS key,
+count(*) FILTER (WHERE submitted_at >= $3) AS current, count(*) FILTER (WHERE submitted_at < $3) AS previous
+FROM comments WHERE deleted_at IS NULL AND submitted_at >= $2 AND submitted_at < $4
+GROUP BY 1, 2 ORDER BY 1, 2`
+	trendMediaUploadedQuery = `SELECT date_trunc($1, created_at AT TIME ZONE 'UTC') AS bucket, '' AS key,
+count(*) FILTER (WHERE created_at >= $3) AS current, count(*) FILTER (WHERE created_at < $3) AS previous
+FROM medias WHERE deleted_at IS NULL AND created_at >= $2 AND created_at < $4
+GROUP BY 1, 2 ORDER BY 1, 2`
+	trendNewUsersQuery = `SELECT date_trunc($1, created_at AT TIME ZONE 'UTC') AS bucket, '' AS key,
+count(*) FILTER (WHERE created_at >= $3) AS current, count(*) FILTER (WHERE created_at < $3) AS previous
+FROM users WHERE created_at >= $2 AND created_at < $4
+GROUP BY 1, 2 ORDER BY 1, 2`
+	topAuthorsQuery = `SELECT author_id::text,
+count(*) FILTER (WHERE published_at >= $2) AS current, count(*) FILTER (WHERE published_at < $2) AS previous
+FROM posts WHERE status = 'published' AND deleted_at IS NULL AND published_at >= $1 AND published_at < $3
+GROUP BY author_id
+HAVING count(*) FILTER (WHERE published_at >= $2) > 0
+ORDER BY current DESC, author_id
+LIMIT $4`
+)
+
+var trendQueries = map[TrendMetric]string{
+	TrendPostsPublished: trendPostsPublishedQuery,
+	TrendComments:       trendCommentsQuery,
+	TrendMediaUploaded:  trendMediaUploadedQuery,
+	TrendNewUsers:       trendNewUsersQuery,
+}
+
+// TrendRow is one bucket of a trend. Key is the comment status for
+// TrendComments and empty otherwise.
+type TrendRow struct {
+	Bucket   time.Time
+	Key      string
+	Current  int
+	Previous int
+}
+
+// AuthorTrend counts an author's published posts in both periods.
+type AuthorTrend struct {
+	AuthorID string
+	Current  int
+	Previous int
+}
+
+// Trend counts metric rows in [from, to) grouped by date_trunc(unit). Rows
+// before split are counted as the previous period.
+func (c *Client) Trend(ctx context.Context, metric TrendMetric, unit string, from, split, to time.Time) ([]TrendRow, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	query, ok := trendQueries[metric]
+	if !ok {
+		return nil, fmt.Errorf("unknown trend metric %q", metric)
+	}
+	rows, err := c.db.Pool.Query(ctx, query, unit, from, split, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []TrendRow
+	for rows.Next() {
+		var row TrendRow
+		if err := rows.Scan(&row.Bucket, &row.Key, &row.Current, &row.Previous); err != nil {
+			return nil, err
+		}
+		row.Bucket = row.Bucket.UTC()
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// TopAuthors ranks authors by posts published in [split, to), including their
+// count for [from, split).
+func (c *Client) TopAuthors(ctx context.Context, from, split, to time.Time, limit int) ([]AuthorTrend, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, topAuthorsQuery, from, split, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []AuthorTrend
+	for rows.Next() {
+		var row AuthorTrend
+		if err := rows.Scan(&row.AuthorID, &row.Current, &row.Previous); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,268 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrMenuItemParent is returned when a menu item would be nested under
+	// an item of another menu, itself or one of its descendants.
+	ErrMenuItemParent = errors.New("menu item parent must be another item of the same menu that is not nested beneath it")
+	// ErrMenuItemOrder is returned when a reorder does not list every sibling
+	// exactly once.
+	ErrMenuItemOrder = errors.New("item ids must list every item under the parent exactly once")
+)
+
+const (
+	menuColumns     = `m.id, m.name, m.location, m.description, m.created_at, m.updated_at`
+	menuItemColumns = `mi.id, mi.menu_id, mi.parent_id, mi.position, mi.kind, mi.target_id, mi.label, mi.url, mi.created_at, mi.updated_at`
+
+	menuByLocationQuery = `SELECT ` + menuColumns + ` FROM menus m WHERE m.location = $1`
+	menuItemsQuery      = `SELECT ` + menuItemColumns + ` FROM menu_items mi
+WHERE mi.menu_id = ANY($1::uuid[]) ORDER BY mi.menu_id, mi.position, mi.id`
+	postsByIDsQuery      = `SELECT ` + postColumns + ` FROM posts p WHERE p.id = ANY($1::uuid[]) AND p.deleted_at IS NULL`
+	categoriesByIDsQuery = `SELECT ` + categoryTreeColumns + ` FROM categories c WHERE c.id = ANY($1::uuid[])`
+	tagsByIDsQuery       = `SELECT ` + tagColumns + ` FROM tags t WHERE t.id = ANY($1::uuid[])`
+	// moveMenuItemQuery re-parents $1 under $2 (NULL for the top level) at
+	// position $3 among its new siblings, renumbering them densely. The
+	// guards keep the parent in the same menu and outside the moved subtree.
+	moveMenuItemQuery = `WITH RECURSIVE chain AS (
+SELECT mi.id, mi.parent_id, ARRAY[mi.id] AS seen FROM menu_items mi WHERE mi.id = $2::uuid
+UNION ALL
+SELECT mi.id, mi.parent_id, chain.seen || mi.id
+FROM menu_items mi JOIN chain ON mi.id = chain.parent_id
+WHERE NOT mi.id = ANY(chain.seen)
+), moving AS (
+SELECT mi.id, mi.menu_id FROM menu_items mi
+WHERE mi.id = $1::uuid AND NOT EXISTS (SELECT 1 FROM chain WHERE chain.id = $1::uuid)
+AND ($2::uuid IS NULL OR EXISTS (SELECT 1 FROM menu_items parent WHERE parent.id = $2::uuid AND parent.menu_id = mi.menu_id))
+), siblings AS (
+SELECT mi.id, row_number() OVER (ORDER BY mi.position, mi.id) - 1 AS idx
+FROM menu_items mi JOIN moving ON mi.menu_id = moving.menu_id
+WHERE mi.parent_id IS NOT DISTINCT FROM $2::uuid AND mi.id <> $1::uuid
+), target AS (
+SELECT LEAST(GREATEST($3::int, 0), (SELECT count(*) FROM siblings))::int AS position
+), renumbered AS (
+UPDATE menu_items mi SET position = CASE WHEN s.idx < t.position THEN s.idx ELSE s.idx + 1 END
+FROM siblings s, target t
+WHERE mi.id = s.id
+RETURNING mi.id
+)
+UPDATE menu_items mi SET parent_id = $2::uuid, position = t.position, updated_at = now()
+FROM target t
+WHERE mi.id IN (SELECT id FROM moving)
+RETURNING ` + menuItemColumns + `, ARRAY(SELECT id::text FROM renumbered)`
+	// reorderMenuItemsQuery numbers the children of $2 (NULL for the top
+	// level) in menu $1 in the order of $3, but only when $3 lists each of
+	// them exactly once.
+	reorderMenuItemsQuery = `WITH ordered AS (
+SELECT o.id, (o.ord - 1)::int AS position FROM unnest($3::uuid[]) WITH ORDINALITY AS o(id, ord)
+), siblings AS (
+SELECT mi.id FROM menu_items mi WHERE mi.menu_id = $1::uuid AND mi.parent_id IS NOT DISTINCT FROM $2::uuid
+), complete AS (
+SELECT (SELECT count(*) FROM siblings) = cardinality($3::uuid[])
+AND NOT EXISTS (SELECT 1 FROM siblings WHERE siblings.id NOT IN (SELECT id FROM ordered)) AS ok
+)
+UPDATE menu_items mi SET position = ordered.position, updated_at = now()
+FROM ordered, complete
+WHERE mi.id = ordered.id AND complete.ok
+RETURNING ` + menuItemColumns
+)
+
+// MenuByLocation returns the menu assigned to location, or nil.
+func (c *Client) MenuByLocation(ctx context.Context, location string) (*Menu, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	record := new(Menu)
+	err := c.db.Pool.QueryRow(ctx, menuByLocationQuery, location).
+		Scan(&record.ID, &record.Name, &record.Location, &record.Description, &record.CreatedAt, &record.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// MenuItemsByMenu returns the items of each menu, siblings ordered by position.
+// Menus without items are absent from the result.
+func (c *Client) MenuItemsByMenu(ctx context.Context, menuIDs []string) (map[string][]*MenuItem, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string][]*MenuItem, len(menuIDs))
+	if len(menuIDs) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, menuItemsQuery, menuIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := new(MenuItem)
+		if err := rows.Scan(menuItemTargets(record)...); err != nil {
+			return nil, err
+		}
+		out[record.MenuID] = append(out[record.MenuID], record)
+	}
+	return out, rows.Err()
+}
+
+// PostsByIDs returns the existing posts among ids keyed by ID.
+func (c *Client) PostsByIDs(ctx context.Context, ids []string) (map[string]*Post, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]*Post, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, postsByIDsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := new(Post)
+		if err := rows.Scan(postTargets(record)...); err != nil {
+			return nil, err
+		}
+		out[record.ID] = record
+	}
+	return out, rows.Err()
+}
+
+// CategoriesByIDs returns the existing categories among ids keyed by ID.
+func (c *Client) CategoriesByIDs(ctx context.Context, ids []string) (map[string]*Category, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]*Category, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, categoriesByIDsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := new(Category)
+		if err := rows.Scan(&record.ID, &record.Name, &record.Slug, &record.Description, &record.ParentID, &record.Position, &record.CreatedAt, &record.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out[record.ID] = record
+	}
+	return out, rows.Err()
+}
+
+// TagsByIDs returns the existing tags among ids keyed by ID.
+func (c *Client) TagsByIDs(ctx context.Context, ids []string) (map[string]*Tag, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]*Tag, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	records, err := c.queryTags(ctx, tagsByIDsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		out[record.ID] = record
+	}
+	return out, nil
+}
+
+// MoveMenuItem re-parents a menu item (nil parentID moves it to the top
+// level) and inserts it at position among its new siblings, which are
+// renumbered from zero. It returns nil when the item does not exist and
+// ErrMenuItemParent when the parent is not a valid choice.
+func (c *Client) MoveMenuItem(ctx context.Context, id string, parentID *string, position int) (*MenuItem, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if parentID != nil && *parentID == id {
+		return nil, ErrMenuItemParent
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	out := new(MenuItem)
+	var renumbered []string
+	err := writer.QueryRow(ctx, moveMenuItemQuery, id, parentID, position).Scan(menuItemTargets(out, &renumbered)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		existing, lookupErr := c.MenuItems().ByID(ctx, id)
+		if lookupErr != nil || existing == nil {
+			return nil, lookupErr
+		}
+		return nil, ErrMenuItemParent
+	}
+	if err != nil {
+		return nil, err
+	}
+	store := c.cacheStore()
+	for _, sibling := range renumbered {
+		_ = store.Delete(ctx, makeCacheKey("MenuItem", sibling))
+	}
+	_ = store.Set(ctx, makeCacheKey("MenuItem", out.ID), out)
+	return out, nil
+}
+
+// ReorderMenuItems sets the positions of the children of parentID (nil for
+// the top level) in a menu to their index in ids, which must list each of
+// them exactly once; otherwise it returns ErrMenuItemOrder. The reordered
+// items are returned by position.
+func (c *Client) ReorderMenuItems(ctx context.Context, menuID string, parentID *string, ids []string) ([]*MenuItem, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	if len(ids) == 0 {
+		return nil, ErrMenuItemOrder
+	}
+	rows, err := writer.Query(ctx, reorderMenuItemsQuery, menuID, parentID, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*MenuItem, 0, len(ids))
+	for rows.Next() {
+		record := new(MenuItem)
+		if err := rows.Scan(menuItemTargets(record)...); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(out) != len(ids) {
+		return nil, ErrMenuItemOrder
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Position < out[j].Position })
+	store := c.cacheStore()
+	for _, record := range out {
+		_ = store.Set(ctx, makeCacheKey("MenuItem", record.ID), record)
+	}
+	return out, nil
+}
+
+// menuItemTargets returns scan destinations for the columns in
+// menuItemColumns.
+func menuItemTargets(record *MenuItem, extra ...any) []any {
+	return append([]any{&record.ID, &record.MenuID, &record.ParentID, &record.Position, &record.Kind, &record.TargetID, &record.Label, &record.URL, &record.CreatedAt, &record.UpdatedAt}, extra...)
+}
@@ -6,12 +6,64 @@ import (
 	"time"
 )
 
+type ApiKey struct {
+	ID         string          `db:"id" json:"id"`
+	UserID     string          `db:"user_id" json:"user_id"`
+	Name       string          `db:"name" json:"name"`
+	Prefix     string          `db:"prefix" json:"prefix"`
+	SecretHash string          `db:"secret_hash" json:"secret_hash"`
+	Scopes     json.RawMessage `db:"scopes" json:"scopes"`
+	ExpiresAt  *time.Time      `db:"expires_at,omitempty" json:"expires_at,omitempty"`
+	LastUsedAt *time.Time      `db:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+	CreatedAt  time.Time       `db:"created_at" json:"created_at"`
+	Edges      *ApiKeyEdges    `json:"edges,omitempty"`
+}
+
+type ApiKeyEdges struct {
+	loaded map[string]bool
+	User   *User `json:"user,omitempty"`
+}
+
+func (e *ApiKeyEdges) markLoaded(name string) {
+	if e == nil {
+		return
+	}
+	if e.loaded == nil {
+		e.loaded = make(map[string]bool)
+	}
+	e.loaded[name] = true
+}
+
+func ensureApiKeyEdges(m *ApiKey) *ApiKeyEdges {
+	if m.Edges == nil {
+		m.Edges = &ApiKeyEdges{}
+	}
+	if m.Edges.loaded == nil {
+		m.Edges.loaded = make(map[string]bool)
+	}
+	return m.Edges
+}
+
+func (m *ApiKey) EdgeLoaded(name string) bool {
+	if m == nil || m.Edges == nil || m.Edges.loaded == nil {
+		return false
+	}
+	return m.Edges.loaded[name]
+}
+
+func (m *ApiKey) SetUser(value *User) {
+	edges := ensureApiKeyEdges(m)
+	edges.User = value
+	edges.markLoaded("user")
+}
+
 type Category struct {
 	ID          string         `db:"id" json:"id"`
 	Name        string         `db:"name" json:"name"`
 	Slug        string         `db:"slug" json:"slug"`
 	Description *string        `db:"description,omitempty" json:"description,omitempty"`
 	ParentID    *string        `db:"parent_id,omitempty" json:"parent_id,omitempty"`
+	Position    int32          `db:"position" json:"position"`
 	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
 	Edges       *CategoryEdges `json:"edges,omitempty"`
@@ -88,6 +140,7 @@ type Comment struct {
 	SubmittedAt time.Time     `db:"submitted_at" json:"submitted_at"`
 	PublishedAt *time.Time    `db:"published_at,omitempty" json:"published_at,omitempty"`
 	UpdatedAt   time.Time     `db:"updated_at" json:"updated_at"`
+	DeletedAt   *time.Time    `db:"deleted_at,omitempty" json:"deleted_at,omitempty"`
 	Edges       *CommentEdges `json:"edges,omitempty"`
 }
 
@@ -153,6 +206,60 @@ func (m *Comment) SetReplies(values []*Comment) {
 	edges.markLoaded("replies")
 }
 
+type ContentType struct {
+	ID          string            `db:"id" json:"id"`
+	Name        string            `db:"name" json:"name"`
+	Label       string            `db:"label" json:"label"`
+	PluralLabel string            `db:"plural_label" json:"plural_label"`
+	Description *string           `db:"description,omitempty" json:"description,omitempty"`
+	Supports    json.RawMessage   `db:"supports,omitempty" json:"supports,omitempty"`
+	Fields      json.RawMessage   `db:"fields,omitempty" json:"fields,omitempty"`
+	CreatedAt   time.Time         `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time         `db:"updated_at" json:"updated_at"`
+	Edges       *ContentTypeEdges `json:"edges,omitempty"`
+}
+
+type ContentTypeEdges struct {
+	loaded map[string]bool
+	Posts  []*Post `json:"posts,omitempty"`
+}
+
+func (e *ContentTypeEdges) markLoaded(name string) {
+	if e == nil {
+		return
+	}
+	if e.loaded == nil {
+		e.loaded = make(map[string]bool)
+	}
+	e.loaded[name] = true
+}
+
+func ensureContentTypeEdges(m *ContentType) *ContentTypeEdges {
+	if m.Edges == nil {
+		m.Edges = &ContentTypeEdges{}
+	}
+	if m.Edges.loaded == nil {
+		m.Edges.loaded = make(map[string]bool)
+	}
+	return m.Edges
+}
+
+func (m *ContentType) EdgeLoaded(name string) bool {
+	if m == nil || m.Edges == nil || m.Edges.loaded == nil {
+		return false
+	}
+	return m.Edges.loaded[name]
+}
+
+func (m *ContentType) SetPosts(values []*Post) {
+	edges := ensureContentTypeEdges(m)
+	if values == nil {
+		values = []*Post{}
+	}
+	edges.Posts = values
+	edges.markLoaded("posts")
+}
+
 type Media struct {
 	ID            string          `db:"id" json:"id"`
 	UploadedByID  *string         `db:"uploaded_by_id,omitempty" json:"uploaded_by_id,omitempty"`
@@ -168,6 +275,7 @@ type Media struct {
 	Metadata      json.RawMessage `db:"metadata,omitempty" json:"metadata,omitempty"`
 	CreatedAt     time.Time       `db:"created_at" json:"created_at"`
 	UpdatedAt     time.Time       `db:"updated_at" json:"updated_at"`
+	DeletedAt     *time.Time      `db:"deleted_at,omitempty" json:"deleted_at,omitempty"`
 	Edges         *MediaEdges     `json:"edges,omitempty"`
 }
 
@@ -219,6 +327,126 @@ func (m *Media) SetFeaturedInPosts(values []*Post) {
 	edges.markLoaded("featured_in_posts")
 }
 
+type Menu struct {
+	ID          string     `db:"id" json:"id"`
+	Name        string     `db:"name" json:"name"`
+	Location    *string    `db:"location,omitempty" json:"location,omitempty"`
+	Description *string    `db:"description,omitempty" json:"description,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at"`
+	Edges       *MenuEdges `json:"edges,omitempty"`
+}
+
+type MenuEdges struct {
+	loaded map[string]bool
+	Items  []*MenuItem `json:"items,omitempty"`
+}
+
+func (e *MenuEdges) markLoaded(name string) {
+	if e == nil {
+		return
+	}
+	if e.loaded == nil {
+		e.loaded = make(map[string]bool)
+	}
+	e.loaded[name] = true
+}
+
+func ensureMenuEdges(m *Menu) *MenuEdges {
+	if m.Edges == nil {
+		m.Edges = &MenuEdges{}
+	}
+	if m.Edges.loaded == nil {
+		m.Edges.loaded = make(map[string]bool)
+	}
+	return m.Edges
+}
+
+func (m *Menu) EdgeLoaded(name string) bool {
+	if m == nil || m.Edges == nil || m.Edges.loaded == nil {
+		return false
+	}
+	return m.Edges.loaded[name]
+}
+
+func (m *Menu) SetItems(values []*MenuItem) {
+	edges := ensureMenuEdges(m)
+	if values == nil {
+		values = []*MenuItem{}
+	}
+	edges.Items = values
+	edges.markLoaded("items")
+}
+
+type MenuItem struct {
+	ID        string         `db:"id" json:"id"`
+	MenuID    string         `db:"menu_id" json:"menu_id"`
+	ParentID  *string        `db:"parent_id,omitempty" json:"parent_id,omitempty"`
+	Position  int32          `db:"position" json:"position"`
+	Kind      string         `db:"kind" json:"kind"`
+	TargetID  *string        `db:"target_id,omitempty" json:"target_id,omitempty"`
+	Label     *string        `db:"label,omitempty" json:"label,omitempty"`
+	URL       *string        `db:"url,omitempty" json:"url,omitempty"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at" json:"updated_at"`
+	Edges     *MenuItemEdges `json:"edges,omitempty"`
+}
+
+type MenuItemEdges struct {
+	loaded   map[string]bool
+	Menu     *Menu       `json:"menu,omitempty"`
+	Parent   *MenuItem   `json:"parent,omitempty"`
+	Children []*MenuItem `json:"children,omitempty"`
+}
+
+func (e *MenuItemEdges) markLoaded(name string) {
+	if e == nil {
+		return
+	}
+	if e.loaded == nil {
+		e.loaded = make(map[string]bool)
+	}
+	e.loaded[name] = true
+}
+
+func ensureMenuItemEdges(m *MenuItem) *MenuItemEdges {
+	if m.Edges == nil {
+		m.Edges = &MenuItemEdges{}
+	}
+	if m.Edges.loaded == nil {
+		m.Edges.loaded = make(map[string]bool)
+	}
+	return m.Edges
+}
+
+func (m *MenuItem) EdgeLoaded(name string) bool {
+	if m == nil || m.Edges == nil || m.Edges.loaded == nil {
+		return false
+	}
+	return m.Edges.loaded[name]
+}
+
+func (m *MenuItem) SetMenu(value *Menu) {
+	edges := ensureMenuItemEdges(m)
+	edges.Menu = value
+	edges.markLoaded("menu")
+}
+
+func (m *MenuItem) SetParent(value *MenuItem) {
+	edges := ensureMenuItemEdges(m)
+	edges.Parent = value
+	edges.markLoaded("parent")
+}
+
+func (m *MenuItem) SetChildren(values []*MenuItem) {
+	edges := ensureMenuItemEdges(m)
+	if values == nil {
+		values = []*MenuItem{}
+	}
+	edges.Children = values
+	edges.markLoaded("children")
+}
+
 type Option struct {
 	ID        string          `db:"id" json:"id"`
 	Name      string          `db:"name" json:"name"`
@@ -232,6 +460,9 @@ type Post struct {
 	ID              string          `db:"id" json:"id"`
 	AuthorID        string          `db:"author_id" json:"author_id"`
 	FeaturedMediaID *string         `db:"featured_media_id,omitempty" json:"featured_media_id,omitempty"`
+	ContentTypeID   *string         `db:"content_type_id,omitempty" json:"content_type_id,omitempty"`
+	ParentID        *string         `db:"parent_id,omitempty" json:"parent_id,omitempty"`
+	MenuOrder       int32           `db:"menu_order" json:"menu_order"`
 	Title           string          `db:"title" json:"title"`
 	Slug            string          `db:"slug" json:"slug"`
 	Status          string          `db:"status" json:"status"`
@@ -242,16 +473,20 @@ type Post struct {
 	PublishedAt     *time.Time      `db:"published_at,omitempty" json:"published_at,omitempty"`
 	CreatedAt       time.Time       `db:"created_at" json:"created_at"`
 	UpdatedAt       time.Time       `db:"updated_at" json:"updated_at"`
+	DeletedAt       *time.Time      `db:"deleted_at,omitempty" json:"deleted_at,omitempty"`
 	Edges           *PostEdges      `json:"edges,omitempty"`
 }
 
 type PostEdges struct {
 	loaded        map[string]bool
-	Author        *User       `json:"author,omitempty"`
-	FeaturedMedia *Media      `json:"featured_media,omitempty"`
-	Comments      []*Comment  `json:"comments,omitempty"`
-	Categories    []*Category `json:"categories,omitempty"`
-	Tags          []*Tag      `json:"tags,omitempty"`
+	Author        *User        `json:"author,omitempty"`
+	FeaturedMedia *Media       `json:"featured_media,omitempty"`
+	ContentType   *ContentType `json:"content_type,omitempty"`
+	Parent        *Post        `json:"parent,omitempty"`
+	Children      []*Post      `json:"children,omitempty"`
+	Comments      []*Comment   `json:"comments,omitempty"`
+	Categories    []*Category  `json:"categories,omitempty"`
+	Tags          []*Tag       `json:"tags,omitempty"`
 }
 
 func (e *PostEdges) markLoaded(name string) {
@@ -293,6 +528,27 @@ func (m *Post) SetFeaturedMedia(value *Media) {
 	edges.markLoaded("featured_media")
 }
 
+func (m *Post) SetContentType(value *ContentType) {
+	edges := ensurePostEdges(m)
+	edges.ContentType = value
+	edges.markLoaded("content_type")
+}
+
+func (m *Post) SetParent(value *Post) {
+	edges := ensurePostEdges(m)
+	edges.Parent = value
+	edges.markLoaded("parent")
+}
+
+func (m *Post) SetChildren(values []*Post) {
+	edges := ensurePostEdges(m)
+	if values == nil {
+		values = []*Post{}
+	}
+	edges.Children = values
+	edges.markLoaded("children")
+}
+
 func (m *Post) SetComments(values []*Comment) {
 	edges := ensurePostEdges(m)
 	if values == nil {
@@ -372,6 +628,15 @@ func (m *Role) SetUsers(values []*User) {
 	edges.markLoaded("users")
 }
 
+type SlugHistory struct {
+	ID         string    `db:"id" json:"id"`
+	EntityType string    `db:"entity_type" json:"entity_type"`
+	EntityID   string    `db:"entity_id" json:"entity_id"`
+	Slug       string    `db:"slug" json:"slug"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type Tag struct {
 	ID          string    `db:"id" json:"id"`
 	Name        string    `db:"name" json:"name"`
@@ -508,3 +773,51 @@ func (m *User) SetRoles(values []*Role) {
 	edges.Roles = values
 	edges.markLoaded("roles")
 }
+
+type UserMeta struct {
+	ID        string          `db:"id" json:"id"`
+	UserID    string          `db:"user_id" json:"user_id"`
+	Key       string          `db:"key" json:"key"`
+	Value     json.RawMessage `db:"value" json:"value"`
+	CreatedAt time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at" json:"updated_at"`
+	Edges     *UserMetaEdges  `json:"edges,omitempty"`
+}
+
+type UserMetaEdges struct {
+	loaded map[string]bool
+	User   *User `json:"user,omitempty"`
+}
+
+func (e *UserMetaEdges) markLoaded(name string) {
+	if e == nil {
+		return
+	}
+	if e.loaded == nil {
+		e.loaded = make(map[string]bool)
+	}
+	e.loaded[name] = true
+}
+
+func ensureUserMetaEdges(m *UserMeta) *UserMetaEdges {
+	if m.Edges == nil {
+		m.Edges = &UserMetaEdges{}
+	}
+	if m.Edges.loaded == nil {
+		m.Edges.loaded = make(map[string]bool)
+	}
+	return m.Edges
+}
+
+func (m *UserMeta) EdgeLoaded(name string) bool {
+	if m == nil || m.Edges == nil || m.Edges.loaded == nil {
+		return false
+	}
+	return m.Edges.loaded[name]
+}
+
+func (m *UserMeta) SetUser(value *User) {
+	edges := ensureUserMetaEdges(m)
+	edges.User = value
+	edges.markLoaded("user")
+}
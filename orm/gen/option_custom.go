@@ -0,0 +1,30 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+)
+
+const autoloadOptionsQuery = `SELECT o.id, o.name, o.value, o.autoload, o.created_at, o.updated_at
+FROM options o WHERE o.autoload ORDER BY o.name`
+
+// AutoloadOptions returns every option flagged for autoloading, by name.
+func (c *Client) AutoloadOptions(ctx context.Context) ([]*Option, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, autoloadOptionsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Option
+	for rows.Next() {
+		record := new(Option)
+		if err := rows.Scan(&record.ID, &record.Name, &record.Value, &record.Autoload, &record.CreatedAt, &record.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,167 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrPageCycle is returned when a page would become its own ancestor.
+var ErrPageCycle = errors.New("page cannot be nested beneath itself or its descendants")
+
+// Like the category queries, the page walks carry the ids visited so far so
+// rows left cyclic by earlier writes terminate instead of looping.
+const (
+	// pageSortKey orders siblings by menu_order, then title; concatenated
+	// along the path it yields depth-first order.
+	pageSortKey = `lpad((p.menu_order::bigint + 2147483648)::text, 10, '0') || '/' || p.title || '/' || p.id::text`
+
+	pageAncestorsQuery = `WITH RECURSIVE chain AS (
+SELECT p.id, p.parent_id, 0 AS depth, ARRAY[p.id] AS seen FROM posts p WHERE p.id = $1
+UNION ALL
+SELECT p.id, p.parent_id, chain.depth + 1, chain.seen || p.id
+FROM posts p JOIN chain ON p.id = chain.parent_id
+WHERE NOT p.id = ANY(chain.seen)
+)
+SELECT ` + postColumns + ` FROM chain JOIN posts p ON p.id = chain.id
+WHERE chain.depth > 0 AND p.deleted_at IS NULL ORDER BY chain.depth DESC`
+	// pageTreeQuery walks the pages beneath $1, or from the root pages when
+	// $1 is NULL, up to $2 levels. A non-NULL $3 only follows pages with
+	// that status, so unpublished pages hide their subtree; trashed pages
+	// always do. Paths start at the root page, so the slugs of $1 and its
+	// ancestors prefix them.
+	pageTreeQuery = `WITH RECURSIVE chain AS (
+SELECT p.id, p.parent_id, p.slug, 0 AS depth, ARRAY[p.id] AS seen FROM posts p WHERE p.id = $1::uuid
+UNION ALL
+SELECT p.id, p.parent_id, p.slug, chain.depth + 1, chain.seen || p.id
+FROM posts p JOIN chain ON p.id = chain.parent_id
+WHERE NOT p.id = ANY(chain.seen)
+), prefix AS (
+SELECT COALESCE(string_agg(chain.slug::text, '/' ORDER BY chain.depth DESC) || '/', '') AS path FROM chain
+), tree AS (
+SELECT p.id, 1 AS depth, ARRAY[` + pageSortKey + `] AS sort_path, prefix.path || p.slug AS path, ARRAY[p.id] AS seen
+FROM posts p, prefix
+WHERE p.type = 'page' AND p.parent_id IS NOT DISTINCT FROM $1::uuid AND p.deleted_at IS NULL AND ($3::text IS NULL OR p.status = $3)
+UNION ALL
+SELECT p.id, tree.depth + 1, tree.sort_path || (` + pageSortKey + `), tree.path || '/' || p.slug, tree.seen || p.id
+FROM posts p JOIN tree ON p.parent_id = tree.id
+WHERE p.type = 'page' AND p.deleted_at IS NULL AND ($2 <= 0 OR tree.depth < $2) AND ($3::text IS NULL OR p.status = $3) AND NOT p.id = ANY(tree.seen)
+)
+SELECT ` + postColumns + `, tree.depth, tree.path FROM tree JOIN posts p ON p.id = tree.id
+ORDER BY tree.sort_path`
+	pageCreatesCycleQuery = `WITH RECURSIVE chain AS (
+SELECT p.id, p.parent_id, ARRAY[p.id] AS seen FROM posts p WHERE p.id = $2
+UNION ALL
+SELECT p.id, p.parent_id, chain.seen || p.id
+FROM posts p JOIN chain ON p.id = chain.parent_id
+WHERE NOT p.id = ANY(chain.seen)
+)
+SELECT EXISTS (SELECT 1 FROM chain WHERE id = $1)`
+	// pageByPathQuery follows the slugs in $1 from a root page downwards and
+	// returns the page reached by the last one.
+	pageByPathQuery = `WITH RECURSIVE walk AS (
+SELECT p.id, 1 AS depth FROM posts p
+WHERE p.type = 'page' AND p.deleted_at IS NULL AND p.parent_id IS NULL AND p.slug = ($1::text[])[1]
+UNION ALL
+SELECT p.id, walk.depth + 1
+FROM posts p JOIN walk ON p.parent_id = walk.id
+WHERE p.type = 'page' AND p.deleted_at IS NULL AND walk.depth < cardinality($1::text[]) AND p.slug = ($1::text[])[walk.depth + 1]
+)
+SELECT ` + postColumns + ` FROM walk JOIN posts p ON p.id = walk.id
+WHERE walk.depth = cardinality($1::text[])
+LIMIT 1`
+)
+
+// PageNode is a page in a tree walk with its depth below the starting point
+// and the slash-separated slugs leading to it from its root page.
+type PageNode struct {
+	Page  *Post
+	Depth int
+	Path  string
+}
+
+// PageAncestors returns the ancestors of a page, root first.
+func (c *Client) PageAncestors(ctx context.Context, id string) ([]*Post, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, pageAncestorsQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Post
+	for rows.Next() {
+		record := new(Post)
+		if err := rows.Scan(postTargets(record)...); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// PageTree returns the pages beneath rootID (nil starts at the root pages)
+// in depth-first order, siblings sorted by menu_order then title. A maxDepth
+// of zero or less walks the whole tree; a non-nil status skips pages with a
+// different status together with their subtrees.
+func (c *Client) PageTree(ctx context.Context, rootID *string, maxDepth int, status *string) ([]PageNode, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, pageTreeQuery, rootID, maxDepth, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []PageNode
+	for rows.Next() {
+		node := PageNode{Page: new(Post)}
+		if err := rows.Scan(postTargets(node.Page, &node.Depth, &node.Path)...); err != nil {
+			return nil, err
+		}
+		out = append(out, node)
+	}
+	return out, rows.Err()
+}
+
+// PageCreatesCycle reports whether making parentID the parent of id would
+// place the page beneath itself. It lets callers reject a parent early; the
+// posts_prevent_cycle trigger repeats the check under the page tree lock in
+// the transaction that writes parent_id.
+func (c *Client) PageCreatesCycle(ctx context.Context, id, parentID string) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("orm client is not configured")
+	}
+	if id == parentID {
+		return true, nil
+	}
+	var cycle bool
+	if err := c.db.Pool.QueryRow(ctx, pageCreatesCycleQuery, id, parentID).Scan(&cycle); err != nil {
+		return false, err
+	}
+	return cycle, nil
+}
+
+// PageByPath returns the page reached by following slugs from a root page,
+// so ["about", "team"] finds the "team" page nested under "about". It
+// returns nil when no page matches.
+func (c *Client) PageByPath(ctx context.Context, slugs []string) (*Post, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+	record := new(Post)
+	err := c.db.Pool.QueryRow(ctx, pageByPathQuery, slugs).Scan(postTargets(record)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
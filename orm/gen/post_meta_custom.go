@@ -0,0 +1,309 @@
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MetaOp selects how a MetaFilter compares a post's meta value.
+type MetaOp string
+
+const (
+	MetaEq      MetaOp = "eq"
+	MetaGt      MetaOp = "gt"
+	MetaGte     MetaOp = "gte"
+	MetaLt      MetaOp = "lt"
+	MetaLte     MetaOp = "lte"
+	MetaIn      MetaOp = "in"
+	MetaExists  MetaOp = "exists"
+	MetaMissing MetaOp = "missing"
+)
+
+var metaOperators = map[MetaOp]string{MetaEq: "=", MetaGt: ">", MetaGte: ">=", MetaLt: "<", MetaLte: "<="}
+
+// MetaFilter matches posts by one meta key. Values hold strings, float64s or
+// bools of a single kind: one value for comparisons, any number for MetaIn
+// and none for MetaExists and MetaMissing. Comparisons only see values whose
+// content type indexes the key.
+type MetaFilter struct {
+	Key    string
+	Op     MetaOp
+	Values []any
+}
+
+// PostFilter narrows a post listing. Nil fields do not filter.
+type PostFilter struct {
+	Status        *string
+	Type          *string
+	ContentTypeID *string
+	AuthorID      *string
+	Meta          []MetaFilter
+}
+
+const (
+	postColumns   = `p.id, p.author_id, p.featured_media_id, p.content_type_id, p.parent_id, p.menu_order, p.title, p.slug, p.status, p.type, p.excerpt, p.content, p.seo, p.published_at, p.created_at, p.updated_at, p.deleted_at`
+	postMetaQuery = `SELECT post_id::text, key, value FROM post_meta WHERE post_id = ANY($1::uuid[])`
+	// replacePostMetaQuery makes $2, a JSON object, the complete meta of post
+	// $1; keys listed in $3 are flagged for the partial indexes.
+	replacePostMetaQuery = `WITH input AS (
+SELECT key, value FROM jsonb_each($2::jsonb)
+), deleted AS (
+DELETE FROM post_meta pm WHERE pm.post_id = $1 AND NOT EXISTS (SELECT 1 FROM input WHERE input.key = pm.key)
+)
+INSERT INTO post_meta (post_id, key, value, indexed)
+SELECT $1, input.key, input.value, input.key = ANY($3::text[]) FROM input
+ON CONFLICT (post_id, key) DO UPDATE SET value = EXCLUDED.value, indexed = EXCLUDED.indexed`
+	reindexPostMetaQuery = `UPDATE post_meta pm SET indexed = pm.key = ANY($2::text[])
+FROM posts p WHERE p.id = pm.post_id AND p.content_type_id = $1 AND pm.indexed IS DISTINCT FROM (pm.key = ANY($2::text[]))`
+	indexedMetaKeysQuery = `SELECT DISTINCT f.key FROM content_types ct, jsonb_each(COALESCE(ct.fields -> 'properties', '{}'::jsonb)) AS f(key, definition)
+WHERE jsonb_typeof(ct.fields -> 'properties') = 'object' AND f.definition ->> 'x-indexed' = 'true' ORDER BY f.key`
+)
+
+// PostMeta returns the meta documents of the given posts keyed by post ID.
+// Posts without meta are absent from the result.
+func (c *Client) PostMeta(ctx context.Context, postIDs []string) (map[string]map[string]json.RawMessage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]map[string]json.RawMessage, len(postIDs))
+	if len(postIDs) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, postMetaQuery, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var postID, key string
+		var value json.RawMessage
+		if err := rows.Scan(&postID, &key, &value); err != nil {
+			return nil, err
+		}
+		if out[postID] == nil {
+			out[postID] = make(map[string]json.RawMessage)
+		}
+		out[postID][key] = value
+	}
+	return out, rows.Err()
+}
+
+// ReplacePostMeta stores values as the complete meta of a post, deleting keys
+// that are no longer present. Keys listed in indexed are covered by the meta
+// indexes.
+func (c *Client) ReplacePostMeta(ctx context.Context, postID string, values map[string]json.RawMessage, indexed []string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	if postID == "" {
+		return fmt.Errorf("postID is required")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	if values == nil {
+		values = map[string]json.RawMessage{}
+	}
+	doc, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if indexed == nil {
+		indexed = []string{}
+	}
+	_, err = writer.Exec(ctx, replacePostMetaQuery, postID, string(doc), indexed)
+	return err
+}
+
+// ReindexPostMeta updates which meta keys of a content type's posts are
+// covered by the meta indexes after its fields schema changed.
+func (c *Client) ReindexPostMeta(ctx context.Context, contentTypeID string, indexed []string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	if indexed == nil {
+		indexed = []string{}
+	}
+	_, err := writer.Exec(ctx, reindexPostMetaQuery, contentTypeID, indexed)
+	return err
+}
+
+// IndexedMetaKeys returns every meta key some content type marks
+// "x-indexed".
+func (c *Client) IndexedMetaKeys(ctx context.Context) ([]string, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, indexedMetaKeysQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// FilterPosts lists posts matching filter in the same order as List and
+// returns the total number of matches. Trashed posts never match.
+func (c *Client) FilterPosts(ctx context.Context, filter PostFilter, limit, offset int) ([]*Post, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("orm client is not configured")
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	where, args, err := filter.where()
+	if err != nil {
+		return nil, 0, err
+	}
+	var total int
+	if err := c.db.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM posts p`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	query := fmt.Sprintf(`SELECT %s FROM posts p%s ORDER BY p.id LIMIT $%d OFFSET $%d`, postColumns, where, len(args)+1, len(args)+2)
+	rows, err := c.db.Pool.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	records := make([]*Post, 0)
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(postTargets(item)...); err != nil {
+			return nil, 0, err
+		}
+		records = append(records, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// postTargets returns scan destinations for the columns in postColumns.
+func postTargets(record *Post, extra ...any) []any {
+	return append([]any{&record.ID, &record.AuthorID, &record.FeaturedMediaID, &record.ContentTypeID, &record.ParentID, &record.MenuOrder, &record.Title, &record.Slug, &record.Status, &record.Type, &record.Excerpt, &record.Content, &record.Seo, &record.PublishedAt, &record.CreatedAt, &record.UpdatedAt, &record.DeletedAt}, extra...)
+}
+
+func (f PostFilter) where() (string, []any, error) {
+	conds := []string{"p.deleted_at IS NULL"}
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if f.Status != nil {
+		conds = append(conds, "p.status = "+arg(*f.Status))
+	}
+	if f.Type != nil {
+		conds = append(conds, "p.type = "+arg(*f.Type))
+	}
+	if f.ContentTypeID != nil {
+		conds = append(conds, "p.content_type_id = "+arg(*f.ContentTypeID)+"::uuid")
+	}
+	if f.AuthorID != nil {
+		conds = append(conds, "p.author_id = "+arg(*f.AuthorID)+"::uuid")
+	}
+	for _, meta := range f.Meta {
+		if meta.Key == "" {
+			return "", nil, fmt.Errorf("meta filter key is required")
+		}
+		subquery := "SELECT 1 FROM post_meta pm WHERE pm.post_id = p.id AND pm.key = " + arg(meta.Key)
+		switch meta.Op {
+		case MetaExists:
+			conds = append(conds, "EXISTS ("+subquery+")")
+		case MetaMissing:
+			conds = append(conds, "NOT EXISTS ("+subquery+")")
+		case MetaIn:
+			column, cast, err := metaColumn(meta.Values)
+			if err != nil {
+				return "", nil, err
+			}
+			conds = append(conds, fmt.Sprintf("EXISTS (%s AND pm.indexed AND pm.%s = ANY(%s::%s[]))", subquery, column, arg(typedSlice(meta.Values)), cast))
+		default:
+			operator, ok := metaOperators[meta.Op]
+			if !ok {
+				return "", nil, fmt.Errorf("unsupported meta operator %q", meta.Op)
+			}
+			if len(meta.Values) != 1 {
+				return "", nil, fmt.Errorf("meta operator %q takes exactly one value", meta.Op)
+			}
+			column, cast, err := metaColumn(meta.Values)
+			if err != nil {
+				return "", nil, err
+			}
+			if column == "value_bool" && meta.Op != MetaEq {
+				return "", nil, fmt.Errorf("booleans only support eq")
+			}
+			conds = append(conds, fmt.Sprintf("EXISTS (%s AND pm.indexed AND pm.%s %s %s::%s)", subquery, column, operator, arg(meta.Values[0]), cast))
+		}
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args, nil
+}
+
+// metaColumn picks the typed post_meta column matching the values, which
+// must all be of one kind.
+func metaColumn(values []any) (string, string, error) {
+	if len(values) == 0 {
+		return "", "", fmt.Errorf("meta filter needs a value")
+	}
+	var column, cast string
+	for _, value := range values {
+		var c, t string
+		switch value.(type) {
+		case string:
+			c, t = "value_text", "text"
+		case float64:
+			c, t = "value_number", "double precision"
+		case bool:
+			c, t = "value_bool", "boolean"
+		default:
+			return "", "", fmt.Errorf("unsupported meta filter value %T", value)
+		}
+		if column != "" && column != c {
+			return "", "", fmt.Errorf("meta filter values must share one type")
+		}
+		column, cast = c, t
+	}
+	return column, cast, nil
+}
+
+func typedSlice(values []any) any {
+	switch values[0].(type) {
+	case string:
+		out := make([]string, len(values))
+		for i, value := range values {
+			out[i] = value.(string)
+		}
+		return out
+	case float64:
+		out := make([]float64, len(values))
+		for i, value := range values {
+			out[i] = value.(float64)
+		}
+		return out
+	default:
+		out := make([]bool, len(values))
+		for i, value := range values {
+			out[i] = value.(bool)
+		}
+		return out
+	}
+}
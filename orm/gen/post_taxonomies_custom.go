@@ -3,6 +3,11 @@ package gen
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/deicod/erm/orm/id"
 )
 
 const (
@@ -22,6 +27,12 @@ SELECT DISTINCT value::uuid AS tag_id FROM unnest($2::uuid[]) AS t(value)
 INSERT INTO post_tags (post_id, tag_id)
 SELECT $1, input.tag_id FROM input
 ON CONFLICT (post_id, tag_id) DO NOTHING`
+	// createNamedTagsQuery inserts the tags given as parallel arrays of id,
+	// name and slug, skipping names that exist in any case.
+	createNamedTagsQuery = `INSERT INTO tags AS t (id, name, slug, created_at, updated_at)
+SELECT n.id, n.name, n.slug, now(), now() FROM unnest($1::uuid[], $2::text[], $3::text[]) AS n(id, name, slug)
+ON CONFLICT ((lower(name))) DO NOTHING
+RETURNING ` + tagColumns
 )
 
 func (c *Client) ReplacePostCategories(ctx context.Context, postID string, categoryIDs []string) error {
@@ -58,26 +69,28 @@ func (c *Client) ReplacePostCategories(ctx context.Context, postID string, categ
 	return nil
 }
 
-func (c *Client) ReplacePostTags(ctx context.Context, postID string, tagIDs []string) error {
+// ReplacePostTags sets the tags of a post to tagIDs plus the tags called
+// like the names in named, creating those that do not exist yet with the
+// given id and slug. Creating and linking run in one transaction, so a
+// failed save leaves no unused tags behind, and the tags_lower_name_key
+// index makes a concurrent save reuse the tag instead of adding a second
+// one. It returns the tags it created.
+func (c *Client) ReplacePostTags(ctx context.Context, postID string, tagIDs []string, named []*Tag) ([]*Tag, error) {
 	if c == nil {
-		return fmt.Errorf("orm client is not configured")
+		return nil, fmt.Errorf("orm client is not configured")
 	}
 	if postID == "" {
-		return fmt.Errorf("postID is required")
+		return nil, fmt.Errorf("postID is required")
 	}
 	if _, err := c.Posts().ByID(ctx, postID); err != nil {
-		return err
-	}
-	writer := c.db.Writer()
-	if writer == nil {
-		return fmt.Errorf("orm writer pool is not configured")
+		return nil, err
 	}
 
 	seen := make(map[string]struct{}, len(tagIDs))
-	uniqueTagIDs := make([]string, 0, len(tagIDs))
+	uniqueTagIDs := make([]string, 0, len(tagIDs)+len(named))
 	for _, tagID := range tagIDs {
 		if tagID == "" {
-			return fmt.Errorf("tagID is required")
+			return nil, fmt.Errorf("tagID is required")
 		}
 		if _, ok := seen[tagID]; ok {
 			continue
@@ -85,9 +98,269 @@ func (c *Client) ReplacePostTags(ctx context.Context, postID string, tagIDs []st
 		seen[tagID] = struct{}{}
 		uniqueTagIDs = append(uniqueTagIDs, tagID)
 	}
+	if len(named) == 0 {
+		writer := c.db.Writer()
+		if writer == nil {
+			return nil, fmt.Errorf("orm writer pool is not configured")
+		}
+		if _, err := writer.Exec(ctx, replacePostTagsQuery, postID, uniqueTagIDs); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	ids := make([]string, len(named))
+	names := make([]string, len(named))
+	lowered := make([]string, len(named))
+	slugs := make([]string, len(named))
+	for i, tag := range named {
+		if tag.ID == "" {
+			v, err := id.NewV7()
+			if err != nil {
+				return nil, err
+			}
+			tag.ID = v
+		}
+		ids[i], names[i], lowered[i], slugs[i] = tag.ID, tag.Name, strings.ToLower(tag.Name), tag.Slug
+	}
 
-	if _, err := writer.Exec(ctx, replacePostTagsQuery, postID, uniqueTagIDs); err != nil {
+	var created []*Tag
+	err := c.inTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		if created, err = scanTags(tx.Query(ctx, createNamedTagsQuery, ids, names, slugs)); err != nil {
+			return err
+		}
+		// Read the tags back in a new statement: names another transaction
+		// created while this one waited on the index are visible now.
+		tags, err := scanTags(tx.Query(ctx, tagsByNameQuery, lowered))
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			if _, ok := seen[tag.ID]; !ok {
+				seen[tag.ID] = struct{}{}
+				uniqueTagIDs = append(uniqueTagIDs, tag.ID)
+			}
+		}
+		_, err = tx.Exec(ctx, replacePostTagsQuery, postID, uniqueTagIDs)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	store := c.cacheStore()
+	for _, tag := range created {
+		_ = store.Set(ctx, makeCacheKey("Tag", tag.ID), tag)
+	}
+	return created, nil
+}
+
+// Merges and bulk assignments run as single statements so a failure leaves
+// every join table untouched.
+const (
+	// mergeTaxonomyQuery moves post links from the sources ($1) to the target
+	// ($2), keeps the sources' slugs as history rows ($3 supplies their ids)
+	// pointing at the target, and deletes the sources. It returns the
+	// removed ids and the posts that were linked to any source.
+	mergeTaxonomyQuery = `WITH sources AS (
+SELECT t.id, t.slug FROM %[1]s t
+WHERE t.id = ANY($1::uuid[]) AND t.id <> $2::uuid AND EXISTS (SELECT 1 FROM %[1]s WHERE id = $2::uuid)
+), affected AS (
+SELECT DISTINCT j.post_id FROM %[2]s j WHERE j.%[3]s IN (SELECT id FROM sources)
+), linked AS (
+INSERT INTO %[2]s (post_id, %[3]s)
+SELECT post_id, $2::uuid FROM affected
+ON CONFLICT DO NOTHING
+), unlinked AS (
+DELETE FROM %[2]s WHERE %[3]s IN (SELECT id FROM sources)
+), repointed AS (
+UPDATE slug_histories SET entity_id = $2::uuid, updated_at = now()
+WHERE entity_type = '%[4]s' AND entity_id IN (SELECT id FROM sources)
+), recorded AS (
+INSERT INTO slug_histories (id, entity_type, entity_id, slug, created_at, updated_at)
+SELECT h.history_id, '%[4]s', $2::uuid, s.slug, now(), now()
+FROM unnest($1::uuid[], $3::uuid[]) AS h(source_id, history_id)
+JOIN sources s ON s.id = h.source_id
+)%[5]s, removed AS (
+DELETE FROM %[1]s WHERE id IN (SELECT id FROM sources) RETURNING id
+)
+SELECT COALESCE((SELECT array_agg(id::text) FROM removed), '{}'),
+COALESCE((SELECT array_agg(post_id::text) FROM affected), '{}'),
+%[6]s`
+	// reparentMergedCategories hands the children of merged categories to
+	// the target so the parent foreign key holds once the sources are gone.
+	reparentMergedCategories = `, reparented AS (
+UPDATE categories SET parent_id = $2::uuid, updated_at = now()
+WHERE parent_id IN (SELECT id FROM sources) AND id NOT IN (SELECT id FROM sources)
+RETURNING id
+)`
+	reparentedCategoryIDs     = `COALESCE((SELECT array_agg(id::text) FROM reparented), '{}')`
+	bulkAssignTaxonomiesQuery = `WITH targets AS (
+SELECT id FROM posts WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+), add_categories AS (
+INSERT INTO post_categories (post_id, category_id)
+SELECT t.id, c.id FROM targets t CROSS JOIN (SELECT DISTINCT unnest($2::uuid[]) AS id) c
+ON CONFLICT (category_id, post_id) DO NOTHING
+), remove_categories AS (
+DELETE FROM post_categories WHERE post_id IN (SELECT id FROM targets) AND category_id = ANY($3::uuid[])
+), add_tags AS (
+INSERT INTO post_tags (post_id, tag_id)
+SELECT t.id, g.id FROM targets t CROSS JOIN (SELECT DISTINCT unnest($4::uuid[]) AS id) g
+ON CONFLICT (post_id, tag_id) DO NOTHING
+), remove_tags AS (
+DELETE FROM post_tags WHERE post_id IN (SELECT id FROM targets) AND tag_id = ANY($5::uuid[])
+)
+SELECT COALESCE(array_agg(id::text), '{}') FROM targets`
+)
+
+// TaxonomyMerge reports the outcome of MergeTags or MergeCategories.
+type TaxonomyMerge struct {
+	// RemovedIDs lists the source records that were deleted.
+	RemovedIDs []string
+	// PostIDs lists the posts whose taxonomy links changed.
+	PostIDs []string
+	// ReparentedIDs lists child categories moved beneath the target.
+	ReparentedIDs []string
+}
+
+// TaxonomyAssignment adds and removes categories and tags on many posts.
+// An ID may not be both added and removed.
+type TaxonomyAssignment struct {
+	PostIDs           []string
+	AddCategoryIDs    []string
+	RemoveCategoryIDs []string
+	AddTagIDs         []string
+	RemoveTagIDs      []string
+}
+
+// MergeTags folds the source tags into targetID: posts tagged with a source
+// are tagged with the target, source slugs redirect to the target and the
+// sources are deleted. Sources that do not exist are ignored.
+func (c *Client) MergeTags(ctx context.Context, sourceIDs []string, targetID string) (*TaxonomyMerge, error) {
+	query := fmt.Sprintf(mergeTaxonomyQuery, "tags", "post_tags", "tag_id", "Tag", "", "'{}'::text[]")
+	merge, err := c.mergeTaxonomy(ctx, query, sourceIDs, targetID)
+	if err != nil {
+		return nil, err
+	}
+	c.evictCached(ctx, "Tag", merge.RemovedIDs)
+	return merge, nil
+}
+
+// MergeCategories folds the source categories into targetID like MergeTags.
+// Children of the sources move beneath the target. The target must not be a
+// descendant of a source.
+func (c *Client) MergeCategories(ctx context.Context, sourceIDs []string, targetID string) (*TaxonomyMerge, error) {
+	for _, sourceID := range sourceIDs {
+		if sourceID == targetID {
+			continue
+		}
+		below, err := c.CategoryCreatesCycle(ctx, sourceID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if below {
+			return nil, ErrCategoryCycle
+		}
+	}
+	query := fmt.Sprintf(mergeTaxonomyQuery, "categories", "post_categories", "category_id", "Category", reparentMergedCategories, reparentedCategoryIDs)
+	merge, err := c.mergeTaxonomy(ctx, query, sourceIDs, targetID)
+	if err != nil {
+		return nil, err
+	}
+	c.evictCached(ctx, "Category", merge.RemovedIDs)
+	c.evictCached(ctx, "Category", merge.ReparentedIDs)
+	return merge, nil
+}
+
+func (c *Client) mergeTaxonomy(ctx context.Context, query string, sourceIDs []string, targetID string) (*TaxonomyMerge, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if targetID == "" {
+		return nil, fmt.Errorf("targetID is required")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	seen := make(map[string]struct{}, len(sourceIDs))
+	uniqueSourceIDs := make([]string, 0, len(sourceIDs))
+	historyIDs := make([]string, 0, len(sourceIDs))
+	for _, sourceID := range sourceIDs {
+		if sourceID == "" {
+			return nil, fmt.Errorf("sourceID is required")
+		}
+		if _, ok := seen[sourceID]; ok {
+			continue
+		}
+		seen[sourceID] = struct{}{}
+		historyID, err := id.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		uniqueSourceIDs = append(uniqueSourceIDs, sourceID)
+		historyIDs = append(historyIDs, historyID)
+	}
+	merge := &TaxonomyMerge{}
+	if err := writer.QueryRow(ctx, query, uniqueSourceIDs, targetID, historyIDs).Scan(&merge.RemovedIDs, &merge.PostIDs, &merge.ReparentedIDs); err != nil {
+		return nil, err
+	}
+	return merge, nil
+}
+
+// BulkAssignTaxonomies applies the assignment to every existing post in it
+// and returns the IDs of those posts.
+func (c *Client) BulkAssignTaxonomies(ctx context.Context, assignment TaxonomyAssignment) ([]string, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if err := checkDisjoint("category", assignment.AddCategoryIDs, assignment.RemoveCategoryIDs); err != nil {
+		return nil, err
+	}
+	if err := checkDisjoint("tag", assignment.AddTagIDs, assignment.RemoveTagIDs); err != nil {
+		return nil, err
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	var postIDs []string
+	err := writer.QueryRow(ctx, bulkAssignTaxonomiesQuery,
+		nonNil(assignment.PostIDs),
+		nonNil(assignment.AddCategoryIDs),
+		nonNil(assignment.RemoveCategoryIDs),
+		nonNil(assignment.AddTagIDs),
+		nonNil(assignment.RemoveTagIDs),
+	).Scan(&postIDs)
+	if err != nil {
+		return nil, err
+	}
+	return postIDs, nil
+}
+
+func checkDisjoint(kind string, add, remove []string) error {
+	adding := make(map[string]struct{}, len(add))
+	for _, value := range add {
+		adding[value] = struct{}{}
+	}
+	for _, value := range remove {
+		if _, ok := adding[value]; ok {
+			return fmt.Errorf("%s %s cannot be both added and removed", kind, value)
+		}
 	}
 	return nil
 }
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func (c *Client) evictCached(ctx context.Context, entity string, ids []string) {
+	store := c.cacheStore()
+	for _, value := range ids {
+		_ = store.Delete(ctx, makeCacheKey(entity, value))
+	}
+}
@@ -0,0 +1,157 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Views are appended to post_views and periodically moved into hourly
+// post_view_rollups. Reads combine both so recent views are visible before
+// the next rollup.
+const (
+	recordPostViewQuery = `INSERT INTO post_views (post_id, visitor_hash, viewed_at)
+SELECT id, $2, $3 FROM posts WHERE id = $1::uuid AND status = 'published' AND deleted_at IS NULL`
+	rollupPostViewsQuery = `WITH moved AS (
+DELETE FROM post_views WHERE viewed_at < $1 RETURNING post_id, visitor_hash, viewed_at
+), hourly AS (
+SELECT post_id, date_trunc('hour', viewed_at, 'UTC') AS hour, count(*) AS views, count(DISTINCT visitor_hash) AS visitors
+FROM moved GROUP BY 1, 2
+)
+INSERT INTO post_view_rollups AS r (post_id, hour, views, visitors)
+SELECT post_id, hour, views, visitors FROM hourly
+ON CONFLICT (post_id, hour) DO UPDATE SET views = r.views + EXCLUDED.views, visitors = r.visitors + EXCLUDED.visitors`
+	hourlyPostViews = `SELECT post_id, hour, views, visitors FROM post_view_rollups WHERE hour >= $1 AND hour < $2
+UNION ALL
+SELECT post_id, date_trunc('hour', viewed_at, 'UTC'), count(*), count(DISTINCT visitor_hash)
+FROM post_views WHERE viewed_at >= $1 AND viewed_at < $2 GROUP BY 1, 2`
+	postViewBucketsQuery = `WITH hourly AS (` + hourlyPostViews + `)
+SELECT date_trunc($4, hour AT TIME ZONE 'UTC') AS bucket, sum(views)::bigint, sum(visitors)::bigint
+FROM hourly WHERE post_id = $3::uuid GROUP BY 1 ORDER BY 1`
+	// visitorSaltQuery stores $2 as the salt of day $1 unless a replica got
+	// there first, returns whichever salt the day has, and deletes the salts
+	// of earlier days. The no-op update makes a conflicting insert return
+	// the existing row.
+	visitorSaltQuery = `WITH expired AS (
+DELETE FROM analytics_salts WHERE day < $1::date
+)
+INSERT INTO analytics_salts AS s (day, salt) VALUES ($1::date, $2)
+ON CONFLICT (day) DO UPDATE SET salt = s.salt
+RETURNING s.salt`
+	popularPostsQuery = `WITH hourly AS (` + hourlyPostViews + `)
+SELECT h.post_id::text, sum(h.views)::bigint AS views, sum(h.visitors)::bigint AS visitors
+FROM hourly h JOIN posts p ON p.id = h.post_id
+WHERE p.status = 'published' AND p.deleted_at IS NULL
+GROUP BY h.post_id ORDER BY views DESC, h.post_id LIMIT $3`
+)
+
+// PostViewBucket aggregates a post's views over one bucket. Visitors are
+// unique per hour and summed, so a reader returning in a later hour counts
+// again.
+type PostViewBucket struct {
+	Bucket   time.Time
+	Views    int
+	Visitors int
+}
+
+// PostViewCount is a post's total views for a period.
+type PostViewCount struct {
+	PostID   string
+	Views    int
+	Visitors int
+}
+
+// RecordPostView appends a view of a published post. It reports false when
+// the post does not exist or is not published.
+func (c *Client) RecordPostView(ctx context.Context, postID, visitorHash string, at time.Time) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return false, fmt.Errorf("orm writer pool is not configured")
+	}
+	tag, err := writer.Exec(ctx, recordPostViewQuery, postID, visitorHash, at)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// RollupPostViews moves views recorded before cutoff into hourly rollups and
+// returns the number of raw views moved.
+func (c *Client) RollupPostViews(ctx context.Context, cutoff time.Time) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return 0, fmt.Errorf("orm writer pool is not configured")
+	}
+	tag, err := writer.Exec(ctx, rollupPostViewsQuery, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// VisitorSalt returns the salt visitors are hashed with on day, storing
+// candidate when the day has none yet. Salts of earlier days are discarded.
+func (c *Client) VisitorSalt(ctx context.Context, day time.Time, candidate []byte) ([]byte, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	var salt []byte
+	if err := writer.QueryRow(ctx, visitorSaltQuery, day.UTC().Format(time.DateOnly), candidate).Scan(&salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// PostViewBuckets returns a post's views in [from, to) grouped by
+// date_trunc(unit) in UTC.
+func (c *Client) PostViewBuckets(ctx context.Context, postID, unit string, from, to time.Time) ([]PostViewBucket, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, postViewBucketsQuery, from, to, postID, unit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []PostViewBucket
+	for rows.Next() {
+		var row PostViewBucket
+		if err := rows.Scan(&row.Bucket, &row.Views, &row.Visitors); err != nil {
+			return nil, err
+		}
+		row.Bucket = row.Bucket.UTC()
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// PopularPosts ranks published posts by views in [from, to).
+func (c *Client) PopularPosts(ctx context.Context, from, to time.Time, limit int) ([]PostViewCount, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	rows, err := c.db.Pool.Query(ctx, popularPostsQuery, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []PostViewCount
+	for rows.Next() {
+		var row PostViewCount
+		if err := rows.Scan(&row.PostID, &row.Views, &row.Visitors); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
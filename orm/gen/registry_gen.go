@@ -8,6 +8,28 @@ import (
 
 var Registry = runtime.Registry{
 	Entities: map[string]runtime.EntitySpec{
+		"ApiKey": {
+			Name:  "ApiKey",
+			Table: "api_keys",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "user_id", Column: "user_id", GoType: "string", Type: dsl.TypeUUID, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "name", Column: "name", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "prefix", Column: "prefix", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "secret_hash", Column: "secret_hash", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "scopes", Column: "scopes", GoType: "json.RawMessage", Type: dsl.TypeJSONB, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"format": "jsonb"}, EnumValues: nil, EnumName: ""},
+				{Name: "expires_at", Column: "expires_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "last_used_at", Column: "last_used_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{
+				{Name: "user", Column: "user_id", RefName: "", Through: "", Target: "User", Kind: dsl.EdgeToOne, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeCascade, OnUpdate: runtime.CascadeUnset}},
+			},
+			Indexes: []runtime.IndexSpec{
+				{Name: "api_keys_prefix_key", Columns: []string{"prefix"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "api_keys_user_id_idx", Columns: []string{"user_id"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
 		"Category": {
 			Name:  "Category",
 			Table: "categories",
@@ -17,6 +39,7 @@ var Registry = runtime.Registry{
 				{Name: "slug", Column: "slug", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
 				{Name: "description", Column: "description", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "parent_id", Column: "parent_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "position", Column: "position", GoType: "int32", Type: dsl.TypeInteger, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "0", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 			},
@@ -27,6 +50,7 @@ var Registry = runtime.Registry{
 			},
 			Indexes: []runtime.IndexSpec{
 				{Name: "categories_slug_key", Columns: []string{"slug"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "categories_parent_id_position", Columns: []string{"parent_id", "position"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
 		"Comment": {
@@ -45,6 +69,7 @@ var Registry = runtime.Registry{
 				{Name: "submitted_at", Column: "submitted_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "published_at", Column: "published_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "deleted_at", Column: "deleted_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 			},
 			Edges: []runtime.EdgeSpec{
 				{Name: "post", Column: "post_id", RefName: "", Through: "", Target: "Post", Kind: dsl.EdgeToOne, Nullable: false, Unique: false, Annotations: nil, Inverse: "comments", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeCascade, OnUpdate: runtime.CascadeUnset}},
@@ -55,6 +80,28 @@ var Registry = runtime.Registry{
 			Indexes: []runtime.IndexSpec{
 				{Name: "comments_post_submitted_at", Columns: []string{"post_id", "submitted_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 				{Name: "comments_status_post", Columns: []string{"status", "post_id"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "comments_deleted_at", Columns: []string{"deleted_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
+		"ContentType": {
+			Name:  "ContentType",
+			Table: "content_types",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "name", Column: "name", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "label", Column: "label", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "plural_label", Column: "plural_label", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "description", Column: "description", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "supports", Column: "supports", GoType: "json.RawMessage", Type: dsl.TypeJSONB, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"format": "jsonb"}, EnumValues: nil, EnumName: ""},
+				{Name: "fields", Column: "fields", GoType: "json.RawMessage", Type: dsl.TypeJSONB, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"format": "jsonb"}, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{
+				{Name: "posts", Column: "posts", RefName: "content_type", Through: "", Target: "Post", Kind: dsl.EdgeToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
+			},
+			Indexes: []runtime.IndexSpec{
+				{Name: "content_types_name_key", Columns: []string{"name"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
 		"Media": {
@@ -75,6 +122,7 @@ var Registry = runtime.Registry{
 				{Name: "metadata", Column: "metadata", GoType: "json.RawMessage", Type: dsl.TypeJSONB, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"format": "jsonb"}, EnumValues: nil, EnumName: ""},
 				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "deleted_at", Column: "deleted_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 			},
 			Edges: []runtime.EdgeSpec{
 				{Name: "uploaded_by", Column: "uploaded_by_id", RefName: "", Through: "", Target: "User", Kind: dsl.EdgeToOne, Nullable: true, Unique: false, Annotations: nil, Inverse: "media", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeSetNull, OnUpdate: runtime.CascadeUnset}},
@@ -82,6 +130,50 @@ var Registry = runtime.Registry{
 			},
 			Indexes: []runtime.IndexSpec{
 				{Name: "media_storage_key_key", Columns: []string{"storage_key"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "media_deleted_at", Columns: []string{"deleted_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
+		"Menu": {
+			Name:  "Menu",
+			Table: "menus",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "name", Column: "name", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "location", Column: "location", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "description", Column: "description", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{
+				{Name: "items", Column: "items", RefName: "menu", Through: "", Target: "MenuItem", Kind: dsl.EdgeToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
+			},
+			Indexes: []runtime.IndexSpec{
+				{Name: "menus_location_key", Columns: []string{"location"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
+		"MenuItem": {
+			Name:  "MenuItem",
+			Table: "menu_items",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "menu_id", Column: "menu_id", GoType: "string", Type: dsl.TypeUUID, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "parent_id", Column: "parent_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "position", Column: "position", GoType: "int32", Type: dsl.TypeInteger, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "0", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "kind", Column: "kind", GoType: "string", Type: dsl.TypeEnum, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "'custom'", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"enum": true, "enum_name": "MenuItemKind", "enum_values": []string{"post", "page", "category", "tag", "custom"}}, EnumValues: []string{"post", "page", "category", "tag", "custom"}, EnumName: "MenuItemKind"},
+				{Name: "target_id", Column: "target_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "label", Column: "label", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "url", Column: "url", GoType: "*string", Type: dsl.TypeText, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{
+				{Name: "menu", Column: "menu_id", RefName: "", Through: "", Target: "Menu", Kind: dsl.EdgeToOne, Nullable: false, Unique: false, Annotations: nil, Inverse: "items", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeCascade, OnUpdate: runtime.CascadeUnset}},
+				{Name: "parent", Column: "parent_id", RefName: "", Through: "", Target: "MenuItem", Kind: dsl.EdgeToOne, Nullable: true, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeCascade, OnUpdate: runtime.CascadeUnset}},
+				{Name: "children", Column: "children", RefName: "parent", Through: "", Target: "MenuItem", Kind: dsl.EdgeToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
+			},
+			Indexes: []runtime.IndexSpec{
+				{Name: "menu_items_menu_id_parent_id_position", Columns: []string{"menu_id", "parent_id", "position"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "menu_items_kind_target_id", Columns: []string{"kind", "target_id"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
 		"Option": {
@@ -107,6 +199,9 @@ var Registry = runtime.Registry{
 				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "author_id", Column: "author_id", GoType: "string", Type: dsl.TypeUUID, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "featured_media_id", Column: "featured_media_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "content_type_id", Column: "content_type_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "parent_id", Column: "parent_id", GoType: "*string", Type: dsl.TypeUUID, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "menu_order", Column: "menu_order", GoType: "int32", Type: dsl.TypeInteger, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "0", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "title", Column: "title", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
 				{Name: "slug", Column: "slug", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
 				{Name: "status", Column: "status", GoType: "string", Type: dsl.TypeEnum, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "'draft'", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"enum": true, "enum_name": "PostStatus", "enum_values": []string{"draft", "pending", "private", "published", "archived"}}, EnumValues: []string{"draft", "pending", "private", "published", "archived"}, EnumName: "PostStatus"},
@@ -117,10 +212,14 @@ var Registry = runtime.Registry{
 				{Name: "published_at", Column: "published_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "deleted_at", Column: "deleted_at", GoType: "*time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: true, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
 			},
 			Edges: []runtime.EdgeSpec{
 				{Name: "author", Column: "author_id", RefName: "", Through: "", Target: "User", Kind: dsl.EdgeToOne, Nullable: false, Unique: false, Annotations: nil, Inverse: "posts", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeRestrict, OnUpdate: runtime.CascadeUnset}},
 				{Name: "featured_media", Column: "featured_media_id", RefName: "", Through: "", Target: "Media", Kind: dsl.EdgeToOne, Nullable: true, Unique: false, Annotations: nil, Inverse: "featured_in_posts", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeSetNull, OnUpdate: runtime.CascadeUnset}},
+				{Name: "content_type", Column: "content_type_id", RefName: "", Through: "", Target: "ContentType", Kind: dsl.EdgeToOne, Nullable: true, Unique: false, Annotations: nil, Inverse: "posts", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeRestrict, OnUpdate: runtime.CascadeUnset}},
+				{Name: "parent", Column: "parent_id", RefName: "", Through: "", Target: "Post", Kind: dsl.EdgeToOne, Nullable: true, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeSetNull, OnUpdate: runtime.CascadeUnset}},
+				{Name: "children", Column: "children", RefName: "parent", Through: "", Target: "Post", Kind: dsl.EdgeToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
 				{Name: "comments", Column: "comments", RefName: "post", Through: "", Target: "Comment", Kind: dsl.EdgeToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
 				{Name: "categories", Column: "categories", RefName: "", Through: "post_categories", Target: "Category", Kind: dsl.EdgeManyToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "posts", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
 				{Name: "tags", Column: "tags", RefName: "", Through: "post_tags", Target: "Tag", Kind: dsl.EdgeManyToMany, Nullable: false, Unique: false, Annotations: nil, Inverse: "posts", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeUnset, OnUpdate: runtime.CascadeUnset}},
@@ -129,6 +228,8 @@ var Registry = runtime.Registry{
 				{Name: "posts_slug_key", Columns: []string{"slug"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 				{Name: "posts_status_published_at", Columns: []string{"status", "published_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 				{Name: "posts_author_created_at", Columns: []string{"author_id", "created_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "posts_parent_id_menu_order", Columns: []string{"parent_id", "menu_order"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "posts_deleted_at", Columns: []string{"deleted_at"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
 		"Role": {
@@ -150,6 +251,23 @@ var Registry = runtime.Registry{
 				{Name: "roles_slug_key", Columns: []string{"slug"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
+		"SlugHistory": {
+			Name:  "SlugHistory",
+			Table: "slug_histories",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "entity_type", Column: "entity_type", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "entity_id", Column: "entity_id", GoType: "string", Type: dsl.TypeUUID, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "slug", Column: "slug", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{},
+			Indexes: []runtime.IndexSpec{
+				{Name: "slug_histories_entity_type_slug", Columns: []string{"entity_type", "slug"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+				{Name: "slug_histories_entity_id", Columns: []string{"entity_id"}, Unique: false, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
 		"Tag": {
 			Name:  "Tag",
 			Table: "tags",
@@ -195,5 +313,23 @@ var Registry = runtime.Registry{
 				{Name: "users_email_key", Columns: []string{"email"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
 			},
 		},
+		"UserMeta": {
+			Name:  "UserMeta",
+			Table: "user_metas",
+			Fields: []runtime.FieldSpec{
+				{Name: "id", Column: "id", GoType: "string", Type: dsl.TypeUUID, Primary: true, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "user_id", Column: "user_id", GoType: "string", Type: dsl.TypeUUID, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "key", Column: "key", GoType: "string", Type: dsl.TypeText, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"notEmpty": true}, EnumValues: nil, EnumName: ""},
+				{Name: "value", Column: "value", GoType: "json.RawMessage", Type: dsl.TypeJSONB, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: map[string]any{"format": "jsonb"}, EnumValues: nil, EnumName: ""},
+				{Name: "created_at", Column: "created_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: true, UpdateNow: false, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+				{Name: "updated_at", Column: "updated_at", GoType: "time.Time", Type: dsl.TypeTimestampTZ, Primary: false, Nullable: false, Unique: false, DefaultNow: false, UpdateNow: true, DefaultExpr: "", ReadOnly: false, ComputedSpec: nil, Annotations: nil, EnumValues: nil, EnumName: ""},
+			},
+			Edges: []runtime.EdgeSpec{
+				{Name: "user", Column: "user_id", RefName: "", Through: "", Target: "User", Kind: dsl.EdgeToOne, Nullable: false, Unique: false, Annotations: nil, Inverse: "", PolymorphicTargets: nil, Cascade: runtime.CascadeSpec{OnDelete: runtime.CascadeCascade, OnUpdate: runtime.CascadeUnset}},
+			},
+			Indexes: []runtime.IndexSpec{
+				{Name: "user_metas_user_id_key", Columns: []string{"user_id", "key"}, Unique: true, Where: "", Method: "", NullsNotDistinct: false, Annotations: nil},
+			},
+		},
 	},
 }
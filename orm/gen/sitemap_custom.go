@@ -0,0 +1,96 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+)
+
+// The sitemap queries page by keyset: a batch starts behind the last row of
+// the previous one ($2, $3), so rows published or renamed meanwhile cannot
+// shift later batches. The id breaks ties between equal sort keys. $4 skips
+// rows only where a chunk starts without a previous row.
+const (
+	sitemapPostsQuery = `SELECT ` + postColumns + ` FROM posts p
+WHERE p.status = 'published' AND p.type = $1 AND p.deleted_at IS NULL
+AND ($2::timestamptz IS NULL OR (COALESCE(p.published_at, p.created_at), p.id) < ($2::timestamptz, $3::uuid))
+ORDER BY COALESCE(p.published_at, p.created_at) DESC, p.id DESC
+OFFSET $4 LIMIT $5`
+	sitemapCategoriesQuery = `SELECT ` + categoryTreeColumns + ` FROM categories c
+WHERE $1::text IS NULL OR (c.name, c.id) > ($1::text, $2::uuid)
+ORDER BY c.name, c.id
+OFFSET $3 LIMIT $4`
+	sitemapTagsQuery = `SELECT ` + tagColumns + ` FROM tags t
+WHERE $1::text IS NULL OR (t.name, t.id) > ($1::text, $2::uuid)
+ORDER BY t.name, t.id
+OFFSET $3 LIMIT $4`
+)
+
+// SitemapPosts returns up to limit published posts of postType, newest
+// first by publication (or creation) date. When after is set the batch
+// starts behind it; offset skips further rows.
+func (c *Client) SitemapPosts(ctx context.Context, postType string, after *Post, offset, limit int) ([]*Post, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	var key, id any
+	if after != nil {
+		date := after.CreatedAt
+		if after.PublishedAt != nil {
+			date = *after.PublishedAt
+		}
+		key, id = date, after.ID
+	}
+	rows, err := c.db.Pool.Query(ctx, sitemapPostsQuery, postType, key, id, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*Post, 0, limit)
+	for rows.Next() {
+		record := new(Post)
+		if err := rows.Scan(postTargets(record)...); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// SitemapCategories returns up to limit categories ordered by name. When
+// after is set the batch starts behind it; offset skips further rows.
+func (c *Client) SitemapCategories(ctx context.Context, after *Category, offset, limit int) ([]*Category, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	var key, id any
+	if after != nil {
+		key, id = after.Name, after.ID
+	}
+	rows, err := c.db.Pool.Query(ctx, sitemapCategoriesQuery, key, id, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*Category, 0, limit)
+	for rows.Next() {
+		record := new(Category)
+		if err := rows.Scan(&record.ID, &record.Name, &record.Slug, &record.Description, &record.ParentID, &record.Position, &record.CreatedAt, &record.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
+
+// SitemapTags returns up to limit tags ordered by name. When after is set
+// the batch starts behind it; offset skips further rows.
+func (c *Client) SitemapTags(ctx context.Context, after *Tag, offset, limit int) ([]*Tag, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	var key, id any
+	if after != nil {
+		key, id = after.Name, after.ID
+	}
+	return c.queryTags(ctx, sitemapTagsQuery, key, id, offset, limit)
+}
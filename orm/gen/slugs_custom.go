@@ -0,0 +1,45 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+)
+
+// slugTables maps the entities with unique slugs to their tables.
+var slugTables = map[string]string{
+	"Post":     "posts",
+	"Category": "categories",
+	"Tag":      "tags",
+	"Role":     "roles",
+}
+
+// slugOwnersQuery lists the slugs equal to $1 or starting with the escaped
+// prefix $2 ("base-%") with their owners. Trashed posts are included since
+// posts_slug_key still covers them.
+const slugOwnersQuery = `SELECT slug, id::text FROM %s WHERE slug = $1 OR slug LIKE $2 ESCAPE '\'`
+
+// SlugOwners returns the records of entity whose slug is base or base
+// followed by a hyphen and anything else, keyed by slug.
+func (c *Client) SlugOwners(ctx context.Context, entity, base string) (map[string]string, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	table, ok := slugTables[entity]
+	if !ok {
+		return nil, fmt.Errorf("slug owners: unsupported entity %q", entity)
+	}
+	rows, err := c.db.Pool.Query(ctx, fmt.Sprintf(slugOwnersQuery, table), base, likeEscaper.Replace(base)+"-%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[string]string)
+	for rows.Next() {
+		var slug, id string
+		if err := rows.Scan(&slug, &id); err != nil {
+			return nil, err
+		}
+		out[slug] = id
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,116 @@
+package gen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	tagColumns = `t.id, t.name, t.slug, t.description, t.created_at, t.updated_at`
+	// suggestTagsQuery ranks case-insensitive prefix matches ($1 is an
+	// escaped LIKE pattern) ahead of trigram matches on the raw prefix ($2),
+	// which only apply from three characters on. Within each group the most
+	// used tags come first; usage is counted once per matching tag by a
+	// grouped join rather than per sorted row.
+	suggestTagsQuery = `WITH matches AS (
+SELECT ` + tagColumns + `, lower(t.name) LIKE $1 ESCAPE '\' AS prefixed FROM tags t
+WHERE lower(t.name) LIKE $1 ESCAPE '\' OR (length($2::text) >= 3 AND lower(t.name) % $2::text)
+), uses AS (
+SELECT pt.tag_id, count(*) AS total FROM post_tags pt
+WHERE pt.tag_id IN (SELECT id FROM matches)
+GROUP BY pt.tag_id
+)
+SELECT ` + tagColumns + ` FROM matches t
+LEFT JOIN uses u ON u.tag_id = t.id
+ORDER BY t.prefixed DESC, COALESCE(u.total, 0) DESC,
+similarity(lower(t.name), $2::text) DESC, t.name
+LIMIT $3`
+	tagsByNameQuery = `SELECT ` + tagColumns + ` FROM tags t
+WHERE lower(t.name) = ANY($1::text[]) ORDER BY t.created_at, t.id`
+	tagPostCountsQuery = `SELECT t.id::text, count(p.id)
+FROM tags t
+LEFT JOIN post_tags pt ON pt.tag_id = t.id
+LEFT JOIN posts p ON p.id = pt.post_id AND p.status = 'published' AND p.deleted_at IS NULL
+WHERE t.id = ANY($1::uuid[])
+GROUP BY t.id`
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestTags returns up to limit tags whose name starts with prefix, falling
+// back to similar names for typos. Matching ignores case.
+func (c *Client) SuggestTags(ctx context.Context, prefix string, limit int) ([]*Tag, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" || limit <= 0 {
+		return []*Tag{}, nil
+	}
+	return c.queryTags(ctx, suggestTagsQuery, likeEscaper.Replace(prefix)+"%", prefix, limit)
+}
+
+// TagsByName returns the tags whose name matches one of names, ignoring case.
+func (c *Client) TagsByName(ctx context.Context, names []string) ([]*Tag, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if len(names) == 0 {
+		return []*Tag{}, nil
+	}
+	lowered := make([]string, len(names))
+	for i, name := range names {
+		lowered[i] = strings.ToLower(name)
+	}
+	return c.queryTags(ctx, tagsByNameQuery, lowered)
+}
+
+// TagPostCounts returns the number of published posts per tag. Every
+// existing tag in ids is present in the result, unused ones with zero.
+func (c *Client) TagPostCounts(ctx context.Context, ids []string) (map[string]int, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]int, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, tagPostCountsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		var count int
+		if err := rows.Scan(&id, &count); err != nil {
+			return nil, err
+		}
+		out[id] = count
+	}
+	return out, rows.Err()
+}
+
+func (c *Client) queryTags(ctx context.Context, query string, args ...any) ([]*Tag, error) {
+	return scanTags(c.db.Pool.Query(ctx, query, args...))
+}
+
+// scanTags reads tagColumns rows, taking Query's results as they are.
+func scanTags(rows pgx.Rows, err error) ([]*Tag, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]*Tag, 0)
+	for rows.Next() {
+		record := new(Tag)
+		if err := rows.Scan(&record.ID, &record.Name, &record.Slug, &record.Description, &record.CreatedAt, &record.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, record)
+	}
+	return out, rows.Err()
+}
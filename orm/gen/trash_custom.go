@@ -0,0 +1,435 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TrashKind names the entities that are trashed instead of deleted.
+type TrashKind string
+
+const (
+	TrashPost    TrashKind = "Post"
+	TrashComment TrashKind = "Comment"
+	TrashMedia   TrashKind = "Media"
+)
+
+// TrashItem is a trashed record; exactly one of Post, Comment and Media is
+// set, matching Kind.
+type TrashItem struct {
+	Kind      TrashKind
+	ID        string
+	DeletedAt time.Time
+	Post      *Post
+	Comment   *Comment
+	Media     *Media
+}
+
+// TrashPurge lists the records a purge removed for good.
+type TrashPurge struct {
+	PostIDs    []string
+	CommentIDs []string
+	MediaIDs   []string
+}
+
+const (
+	commentColumns = `c.id, c.post_id, c.author_id, c.parent_id, c.author_name, c.author_email, c.author_url, c.content, c.status, c.submitted_at, c.published_at, c.updated_at, c.deleted_at`
+	mediaColumns   = `m.id, m.uploaded_by_id, m.file_name, m.mime_type, m.storage_key, m.url, m.title, m.alt_text, m.caption, m.description, m.file_size_bytes, m.metadata, m.created_at, m.updated_at, m.deleted_at`
+	// trashedRows lists every trashed record; $1 narrows it to one kind
+	// unless empty.
+	trashedRows = `SELECT * FROM (
+SELECT 'Post' AS kind, id::text, deleted_at FROM posts WHERE deleted_at IS NOT NULL
+UNION ALL
+SELECT 'Comment', id::text, deleted_at FROM comments WHERE deleted_at IS NOT NULL
+UNION ALL
+SELECT 'Media', id::text, deleted_at FROM medias WHERE deleted_at IS NOT NULL
+) trash WHERE $1 = '' OR kind = $1`
+	countTrashQuery      = `SELECT count(*) FROM (` + trashedRows + `) trashed`
+	listTrashQuery       = trashedRows + ` ORDER BY deleted_at DESC, id LIMIT $2 OFFSET $3`
+	trashedPostsQuery    = `SELECT ` + postColumns + ` FROM posts p WHERE p.id = ANY($1::uuid[])`
+	trashedCommentsQuery = `SELECT ` + commentColumns + ` FROM comments c WHERE c.id = ANY($1::uuid[])`
+	trashedMediaQuery    = `SELECT ` + mediaColumns + ` FROM medias m WHERE m.id = ANY($1::uuid[])`
+	// purgePostsQuery removes the trashed posts among $1 with their
+	// comments, taxonomy links and old slugs. Child pages lose their parent
+	// through the foreign key; they are returned so their cache is evicted.
+	purgePostsQuery = `WITH doomed AS (
+SELECT id FROM posts WHERE id = ANY($1::uuid[]) AND deleted_at IS NOT NULL
+), children AS (
+SELECT id FROM posts WHERE parent_id IN (SELECT id FROM doomed) AND id NOT IN (SELECT id FROM doomed)
+), removed_comments AS (
+DELETE FROM comments WHERE post_id IN (SELECT id FROM doomed) RETURNING id
+), unlinked_categories AS (
+DELETE FROM post_categories WHERE post_id IN (SELECT id FROM doomed)
+), unlinked_tags AS (
+DELETE FROM post_tags WHERE post_id IN (SELECT id FROM doomed)
+), forgotten_slugs AS (
+DELETE FROM slug_histories WHERE entity_type = 'Post' AND entity_id IN (SELECT id FROM doomed)
+), removed AS (
+DELETE FROM posts WHERE id IN (SELECT id FROM doomed) RETURNING id
+)
+SELECT COALESCE((SELECT array_agg(id::text) FROM removed), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM removed_comments), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM children), '{}')`
+	// purgeCommentsQuery removes the trashed comments among $1. Replies that
+	// stay move up to the closest ancestor that is not purged, so purging a
+	// comment never takes live replies with it.
+	purgeCommentsQuery = `WITH RECURSIVE doomed AS (
+SELECT id, parent_id FROM comments WHERE id = ANY($1::uuid[]) AND deleted_at IS NOT NULL
+), lifted AS (
+SELECT d.id, d.parent_id AS ancestor, ARRAY[d.id] AS seen FROM doomed d
+UNION ALL
+SELECT l.id, d.parent_id, l.seen || d.id
+FROM lifted l JOIN doomed d ON d.id = l.ancestor
+WHERE NOT d.id = ANY(l.seen)
+), survivor AS (
+SELECT id, ancestor FROM lifted WHERE ancestor IS NULL OR ancestor NOT IN (SELECT id FROM doomed)
+), reparented AS (
+UPDATE comments c SET parent_id = s.ancestor, updated_at = now()
+FROM survivor s WHERE c.parent_id = s.id AND c.id NOT IN (SELECT id FROM doomed)
+RETURNING c.id
+), removed AS (
+DELETE FROM comments WHERE id IN (SELECT id FROM doomed) RETURNING id
+)
+SELECT COALESCE((SELECT array_agg(id::text) FROM removed), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM reparented), '{}')`
+	// purgeMediaQuery removes the trashed media among $1 after clearing them
+	// as featured media.
+	purgeMediaQuery = `WITH doomed AS (
+SELECT id FROM medias WHERE id = ANY($1::uuid[]) AND deleted_at IS NOT NULL
+), unfeatured AS (
+UPDATE posts SET featured_media_id = NULL, updated_at = now()
+WHERE featured_media_id IN (SELECT id FROM doomed)
+RETURNING id
+), removed AS (
+DELETE FROM medias WHERE id IN (SELECT id FROM doomed) RETURNING id
+)
+SELECT COALESCE((SELECT array_agg(id::text) FROM removed), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM unfeatured), '{}')`
+	expiredTrashQuery = `SELECT kind, id FROM (` + trashedRows + `) trashed WHERE deleted_at < $2`
+)
+
+// TrashItems lists trashed records, most recently deleted first, and
+// returns how many there are. An empty kind lists every kind.
+func (c *Client) TrashItems(ctx context.Context, kind TrashKind, limit, offset int) ([]TrashItem, int, error) {
+	if c == nil {
+		return nil, 0, fmt.Errorf("orm client is not configured")
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	var total int
+	if err := c.db.Pool.QueryRow(ctx, countTrashQuery, string(kind)).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := c.db.Pool.Query(ctx, listTrashQuery, string(kind), limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	items := make([]TrashItem, 0)
+	ids := make(map[TrashKind][]string)
+	for rows.Next() {
+		var item TrashItem
+		if err := rows.Scan(&item.Kind, &item.ID, &item.DeletedAt); err != nil {
+			return nil, 0, err
+		}
+		items = append(items, item)
+		ids[item.Kind] = append(ids[item.Kind], item.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	posts, err := c.trashedPosts(ctx, ids[TrashPost])
+	if err != nil {
+		return nil, 0, err
+	}
+	comments, err := c.trashedComments(ctx, ids[TrashComment])
+	if err != nil {
+		return nil, 0, err
+	}
+	media, err := c.trashedMedia(ctx, ids[TrashMedia])
+	if err != nil {
+		return nil, 0, err
+	}
+	// A record purged between the two queries is dropped from the page.
+	loaded := make([]TrashItem, 0, len(items))
+	for _, item := range items {
+		switch item.Kind {
+		case TrashPost:
+			item.Post = posts[item.ID]
+		case TrashComment:
+			item.Comment = comments[item.ID]
+		case TrashMedia:
+			item.Media = media[item.ID]
+		}
+		if item.Post == nil && item.Comment == nil && item.Media == nil {
+			continue
+		}
+		loaded = append(loaded, item)
+	}
+	return loaded, total, nil
+}
+
+func (c *Client) trashedPosts(ctx context.Context, ids []string) (map[string]*Post, error) {
+	records := make(map[string]*Post, len(ids))
+	if len(ids) == 0 {
+		return records, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, trashedPostsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(Post)
+		if err := rows.Scan(postTargets(item)...); err != nil {
+			return nil, err
+		}
+		records[item.ID] = item
+	}
+	return records, rows.Err()
+}
+
+func (c *Client) trashedComments(ctx context.Context, ids []string) (map[string]*Comment, error) {
+	records := make(map[string]*Comment, len(ids))
+	if len(ids) == 0 {
+		return records, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, trashedCommentsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(Comment)
+		if err := rows.Scan(commentTargets(item)...); err != nil {
+			return nil, err
+		}
+		records[item.ID] = item
+	}
+	return records, rows.Err()
+}
+
+func (c *Client) trashedMedia(ctx context.Context, ids []string) (map[string]*Media, error) {
+	records := make(map[string]*Media, len(ids))
+	if len(ids) == 0 {
+		return records, nil
+	}
+	rows, err := c.db.Pool.Query(ctx, trashedMediaQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := new(Media)
+		if err := rows.Scan(mediaTargets(item)...); err != nil {
+			return nil, err
+		}
+		records[item.ID] = item
+	}
+	return records, rows.Err()
+}
+
+// commentTargets returns scan destinations for the columns in commentColumns.
+func commentTargets(record *Comment) []any {
+	return []any{&record.ID, &record.PostID, &record.AuthorID, &record.ParentID, &record.AuthorName, &record.AuthorEmail, &record.AuthorURL, &record.Content, &record.Status, &record.SubmittedAt, &record.PublishedAt, &record.UpdatedAt, &record.DeletedAt}
+}
+
+// mediaTargets returns scan destinations for the columns in mediaColumns.
+func mediaTargets(record *Media) []any {
+	return []any{&record.ID, &record.UploadedByID, &record.FileName, &record.MimeType, &record.StorageKey, &record.URL, &record.Title, &record.AltText, &record.Caption, &record.Description, &record.FileSizeBytes, &record.Metadata, &record.CreatedAt, &record.UpdatedAt, &record.DeletedAt}
+}
+
+// RestorePost takes a post out of the trash, returning nil when it is not
+// trashed.
+func (c *Client) RestorePost(ctx context.Context, id string) (*Post, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(id) {
+		return nil, nil
+	}
+	return c.Posts().Restore(ctx, id)
+}
+
+// RestoreComment takes a comment out of the trash, returning nil when it is
+// not trashed.
+func (c *Client) RestoreComment(ctx context.Context, id string) (*Comment, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(id) {
+		return nil, nil
+	}
+	return c.Comments().Restore(ctx, id)
+}
+
+// RestoreMedia takes a media item out of the trash, returning nil when it
+// is not trashed.
+func (c *Client) RestoreMedia(ctx context.Context, id string) (*Media, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(id) {
+		return nil, nil
+	}
+	return c.Medias().Restore(ctx, id)
+}
+
+// PurgePosts permanently deletes the trashed posts among ids along with
+// their comments. Posts that are not in the trash are left alone.
+func (c *Client) PurgePosts(ctx context.Context, ids []string) (*TrashPurge, error) {
+	return c.purgeOnWriter(ctx, ids, purgePosts)
+}
+
+// PurgeComments permanently deletes the trashed comments among ids. Their
+// remaining replies are attached to the nearest surviving ancestor.
+func (c *Client) PurgeComments(ctx context.Context, ids []string) (*TrashPurge, error) {
+	return c.purgeOnWriter(ctx, ids, purgeComments)
+}
+
+// PurgeMedia permanently deletes the trashed media among ids. Posts that
+// featured them are left without featured media.
+func (c *Client) PurgeMedia(ctx context.Context, ids []string) (*TrashPurge, error) {
+	return c.purgeOnWriter(ctx, ids, purgeMedia)
+}
+
+// purgeStep runs one purge statement on q, adding what it removed to purge
+// and the records it changed on the way to touched.
+type purgeStep func(ctx context.Context, q querier, ids []string, purge, touched *TrashPurge) error
+
+func (c *Client) purgeOnWriter(ctx context.Context, ids []string, step purgeStep) (*TrashPurge, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	purge, touched := &TrashPurge{}, &TrashPurge{}
+	ids = uuidsOnly(ids)
+	if len(ids) == 0 {
+		return purge, nil
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	if err := step(ctx, writer, ids, purge, touched); err != nil {
+		return nil, err
+	}
+	c.evictPurged(ctx, purge, touched)
+	return purge, nil
+}
+
+func purgePosts(ctx context.Context, q querier, ids []string, purge, touched *TrashPurge) error {
+	var posts, comments, children []string
+	if err := q.QueryRow(ctx, purgePostsQuery, ids).Scan(&posts, &comments, &children); err != nil {
+		return err
+	}
+	purge.PostIDs = append(purge.PostIDs, posts...)
+	purge.CommentIDs = append(purge.CommentIDs, comments...)
+	touched.PostIDs = append(touched.PostIDs, children...)
+	return nil
+}
+
+func purgeComments(ctx context.Context, q querier, ids []string, purge, touched *TrashPurge) error {
+	var comments, reparented []string
+	if err := q.QueryRow(ctx, purgeCommentsQuery, ids).Scan(&comments, &reparented); err != nil {
+		return err
+	}
+	purge.CommentIDs = append(purge.CommentIDs, comments...)
+	touched.CommentIDs = append(touched.CommentIDs, reparented...)
+	return nil
+}
+
+func purgeMedia(ctx context.Context, q querier, ids []string, purge, touched *TrashPurge) error {
+	var media, unfeatured []string
+	if err := q.QueryRow(ctx, purgeMediaQuery, ids).Scan(&media, &unfeatured); err != nil {
+		return err
+	}
+	purge.MediaIDs = append(purge.MediaIDs, media...)
+	touched.PostIDs = append(touched.PostIDs, unfeatured...)
+	return nil
+}
+
+func (c *Client) evictPurged(ctx context.Context, sets ...*TrashPurge) {
+	for _, set := range sets {
+		c.evictCached(ctx, "Post", set.PostIDs)
+		c.evictCached(ctx, "Comment", set.CommentIDs)
+		c.evictCached(ctx, "Media", set.MediaIDs)
+	}
+}
+
+// PurgeTrash permanently deletes everything trashed before the cutoff.
+// Posts go first so their comments are purged with them. The purge runs in
+// one transaction under an advisory lock, so when several replicas run the
+// purger only one of them purges per tick; the others get an empty result.
+func (c *Client) PurgeTrash(ctx context.Context, before time.Time) (*TrashPurge, error) {
+	total, touched := &TrashPurge{}, &TrashPurge{}
+	err := c.inTx(ctx, func(tx pgx.Tx) error {
+		if err := tryLock(ctx, tx, lockTrashPurge); err != nil {
+			return err
+		}
+		expired, err := expiredTrash(ctx, tx, before)
+		if err != nil {
+			return err
+		}
+		for _, step := range []struct {
+			kind TrashKind
+			run  purgeStep
+		}{
+			{TrashPost, purgePosts},
+			{TrashComment, purgeComments},
+			{TrashMedia, purgeMedia},
+		} {
+			ids := uuidsOnly(expired[step.kind])
+			if len(ids) == 0 {
+				continue
+			}
+			if err := step.run(ctx, tx, ids, total, touched); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, ErrLockHeld) {
+		return &TrashPurge{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.evictPurged(ctx, total, touched)
+	return total, nil
+}
+
+func expiredTrash(ctx context.Context, q querier, before time.Time) (map[TrashKind][]string, error) {
+	rows, err := q.Query(ctx, expiredTrashQuery, "", before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	expired := make(map[TrashKind][]string)
+	for rows.Next() {
+		var kind TrashKind
+		var id string
+		if err := rows.Scan(&kind, &id); err != nil {
+			return nil, err
+		}
+		expired[kind] = append(expired[kind], id)
+	}
+	return expired, rows.Err()
+}
+
+func uuidsOnly(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if isUUID(id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
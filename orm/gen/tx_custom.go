@@ -0,0 +1,82 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier is the statement surface shared by pg.DB.Pool and pgx.Tx, so the
+// custom queries can run on the pool or inside a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// beginner is implemented by pools that open transactions, such as
+// *pgxpool.Pool and the traced pool wrapping it.
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// ErrLockHeld is returned by work guarded with an advisory lock that another
+// session already holds.
+var ErrLockHeld = errors.New("orm: advisory lock is held by another session")
+
+// Advisory lock keys. Each names one kind of work that must not run on two
+// replicas, or two requests, at the same time. The tree keys are also taken
+// by the parent_id triggers in migrations, so keep the values in sync.
+const (
+	lockTrashPurge int64 = 0x65726d0001
+	lockCategories int64 = 0x65726d0002
+	lockPages      int64 = 0x65726d0003
+)
+
+// inTx runs fn in a transaction on the writer pool, committing when it
+// returns nil and rolling back otherwise.
+func (c *Client) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	b, ok := writer.(beginner)
+	if !ok {
+		return fmt.Errorf("orm writer pool %T does not support transactions", writer)
+	}
+	tx, err := b.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// tryLock takes the transaction-scoped advisory lock key, returning
+// ErrLockHeld when another session holds it. The lock is released when tx
+// ends.
+func tryLock(ctx context.Context, tx pgx.Tx, key int64) error {
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, key).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		return ErrLockHeld
+	}
+	return nil
+}
+
+// lock waits for the transaction-scoped advisory lock key.
+func lock(ctx context.Context, tx pgx.Tx, key int64) error {
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, key)
+	return err
+}
@@ -0,0 +1,159 @@
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// UserDeletion reports what DeleteUserWithContent changed.
+type UserDeletion struct {
+	// Deleted is false when the user did not exist.
+	Deleted bool
+	// PostIDs and MediaIDs were handed to the new owner.
+	PostIDs  []string
+	MediaIDs []string
+	// CommentIDs were detached from the user, and scrubbed when anonymizing.
+	CommentIDs []string
+}
+
+const (
+	// countUserPostsQuery counts trashed posts too: reassignment moves them
+	// to the new author like any other, so they still need one.
+	countUserPostsQuery = `SELECT count(*) FROM posts WHERE author_id = $1::uuid`
+	// deleteUserWithContentQuery deletes user $1 and moves everything
+	// holding a foreign key to them in one statement, so the moves and the
+	// delete commit or fail together. Posts and media go to $2; without one,
+	// media lose their uploader and posts stay put, failing the delete if
+	// there are any. Comments keep a name and email copied from the user,
+	// unless $3 asks to anonymize: then their guest fields, and those of
+	// guest comments left with the user's email, are cleared, and the
+	// user's meta, tokens and failed login counters go too. Role
+	// assignments are removed. The foreign keys are NO ACTION, so they are
+	// checked once the whole statement has run.
+	deleteUserWithContentQuery = `WITH doomed AS (
+SELECT id, username, email, display_name FROM users WHERE id = $1::uuid
+), moved_posts AS (
+UPDATE posts SET author_id = $2::uuid, updated_at = now()
+WHERE $2::uuid IS NOT NULL AND author_id IN (SELECT id FROM doomed)
+RETURNING id
+), moved_media AS (
+UPDATE medias SET uploaded_by_id = $2::uuid, updated_at = now()
+WHERE uploaded_by_id IN (SELECT id FROM doomed)
+RETURNING id
+), detached AS (
+UPDATE comments c SET author_id = NULL,
+author_name = CASE WHEN $3 THEN NULL ELSE COALESCE(c.author_name, d.display_name, d.username) END,
+author_email = CASE WHEN $3 THEN NULL ELSE COALESCE(c.author_email, d.email) END,
+author_url = CASE WHEN $3 THEN NULL ELSE c.author_url END,
+updated_at = now()
+FROM doomed d
+WHERE c.author_id = d.id OR ($3 AND lower(c.author_email) = lower(d.email))
+RETURNING c.id
+), unassigned AS (
+DELETE FROM user_roles WHERE user_id IN (SELECT id FROM doomed)
+), scrubbed_meta AS (
+DELETE FROM user_metas WHERE $3 AND user_id IN (SELECT id FROM doomed)
+), scrubbed_tokens AS (
+DELETE FROM user_tokens WHERE $3 AND user_id IN (SELECT id FROM doomed)
+), scrubbed_logins AS (
+DELETE FROM login_attempts a USING doomed d
+WHERE $3 AND a.login IN (lower(d.username), lower(d.email))
+), removed AS (
+DELETE FROM users WHERE id IN (SELECT id FROM doomed)
+RETURNING id
+)
+SELECT EXISTS (SELECT 1 FROM removed),
+COALESCE((SELECT array_agg(id::text) FROM moved_posts), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM moved_media), '{}'),
+COALESCE((SELECT array_agg(id::text) FROM detached), '{}')`
+	// exportUserDataQuery gathers everything stored about user $1 into one
+	// document. Password hashes and key digests are left out.
+	exportUserDataQuery = `SELECT jsonb_build_object(
+'exportedAt', now(),
+'user', to_jsonb(u) - 'password_hash',
+'roles', COALESCE((SELECT jsonb_agg(r.slug ORDER BY r.slug) FROM user_roles ur JOIN roles r ON r.id = ur.role_id WHERE ur.user_id = u.id), '[]'),
+'meta', COALESCE((SELECT jsonb_object_agg(m.key, m.value) FROM user_metas m WHERE m.user_id = u.id), '{}'),
+'posts', COALESCE((SELECT jsonb_agg(to_jsonb(p) ORDER BY p.created_at, p.id) FROM posts p WHERE p.author_id = u.id), '[]'),
+'comments', COALESCE((SELECT jsonb_agg(to_jsonb(c) ORDER BY c.submitted_at, c.id) FROM comments c WHERE c.author_id = u.id OR lower(c.author_email) = lower(u.email)), '[]'),
+'media', COALESCE((SELECT jsonb_agg(to_jsonb(m) ORDER BY m.created_at, m.id) FROM medias m WHERE m.uploaded_by_id = u.id), '[]'),
+'apiKeys', COALESCE((SELECT jsonb_agg(to_jsonb(k) - 'secret_hash' ORDER BY k.created_at, k.id) FROM api_keys k WHERE k.user_id = u.id), '[]')
+)
+FROM users u WHERE u.id = $1::uuid`
+)
+
+// CountUserPosts returns how many posts a user authored.
+func (c *Client) CountUserPosts(ctx context.Context, userID string) (int, error) {
+	if c == nil {
+		return 0, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return 0, nil
+	}
+	var count int
+	if err := c.db.Pool.QueryRow(ctx, countUserPostsQuery, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteUserWithContent deletes a user after handing their posts and media
+// to reassignTo, which may be empty when they have no posts, detaching their
+// comments and removing their roles. With anonymize their comments lose the
+// name, email and URL they were left with, and their meta, tokens and
+// failed login counters are deleted. Everything happens in one statement;
+// Users().BulkDelete skips the moves and fails on the foreign keys of any
+// user who still owns content or roles.
+func (c *Client) DeleteUserWithContent(ctx context.Context, userID, reassignTo string, anonymize bool) (*UserDeletion, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return &UserDeletion{}, nil
+	}
+	var target *string
+	if reassignTo != "" {
+		if !isUUID(reassignTo) {
+			return nil, fmt.Errorf("invalid user id %q", reassignTo)
+		}
+		target = &reassignTo
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return nil, fmt.Errorf("orm writer pool is not configured")
+	}
+	deleted := &UserDeletion{}
+	err := writer.QueryRow(ctx, deleteUserWithContentQuery, userID, target, anonymize).
+		Scan(&deleted.Deleted, &deleted.PostIDs, &deleted.MediaIDs, &deleted.CommentIDs)
+	if err != nil {
+		return nil, err
+	}
+	c.evictCached(ctx, "User", []string{userID})
+	c.evictCached(ctx, "Post", deleted.PostIDs)
+	c.evictCached(ctx, "Media", deleted.MediaIDs)
+	c.evictCached(ctx, "Comment", deleted.CommentIDs)
+	return deleted, nil
+}
+
+// ExportUserData returns a JSON document of the user's account, roles,
+// meta, posts, comments, media and API keys, or nil when the user does not
+// exist.
+func (c *Client) ExportUserData(ctx context.Context, userID string) (json.RawMessage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return nil, nil
+	}
+	var data []byte
+	if err := c.db.Pool.QueryRow(ctx, exportUserDataQuery, userID).Scan(&data); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,120 @@
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/deicod/erm/orm/id"
+)
+
+// ErrUserNotFound is returned when meta is written for a user that does not
+// exist.
+var ErrUserNotFound = errors.New("user does not exist")
+
+const (
+	userMetaQuery = `SELECT key, value FROM user_metas
+WHERE user_id = $1 AND (cardinality($2::text[]) = 0 OR key = ANY($2::text[]))`
+	// setUserMetaQuery only inserts when user $1 exists, so a missing user
+	// affects no rows instead of failing the foreign key. $4 is the id of a
+	// new row.
+	setUserMetaQuery = `INSERT INTO user_metas (id, user_id, key, value, created_at, updated_at)
+SELECT $4, u.id, $2, $3::jsonb, now(), now() FROM users u WHERE u.id = $1
+ON CONFLICT (user_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = now()`
+	deleteUserMetaQuery = `DELETE FROM user_metas WHERE user_id = $1 AND key = $2`
+)
+
+// UserMeta returns the meta values of a user keyed by meta key, limited to
+// keys when any are given. IDs that are not UUIDs have no meta.
+func (c *Client) UserMeta(ctx context.Context, userID string, keys ...string) (map[string]json.RawMessage, error) {
+	if c == nil {
+		return nil, fmt.Errorf("orm client is not configured")
+	}
+	out := make(map[string]json.RawMessage)
+	if !isUUID(userID) {
+		return out, nil
+	}
+	if keys == nil {
+		keys = []string{}
+	}
+	rows, err := c.db.Pool.Query(ctx, userMetaQuery, userID, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var value json.RawMessage
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		out[key] = value
+	}
+	return out, rows.Err()
+}
+
+// SetUserMeta stores value under key for a user, replacing any previous
+// value. It returns ErrUserNotFound when the user does not exist.
+func (c *Client) SetUserMeta(ctx context.Context, userID, key string, value json.RawMessage) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return ErrUserNotFound
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	rowID, err := id.NewV7()
+	if err != nil {
+		return err
+	}
+	tag, err := writer.Exec(ctx, setUserMetaQuery, userID, key, string(value), rowID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// DeleteUserMeta removes key from a user's meta.
+func (c *Client) DeleteUserMeta(ctx context.Context, userID, key string) error {
+	if c == nil {
+		return fmt.Errorf("orm client is not configured")
+	}
+	if !isUUID(userID) {
+		return nil
+	}
+	writer := c.db.Writer()
+	if writer == nil {
+		return fmt.Errorf("orm writer pool is not configured")
+	}
+	_, err := writer.Exec(ctx, deleteUserMetaQuery, userID, key)
+	return err
+}
+
+// isUUID reports whether s is a hyphenated UUID, so text IDs such as OIDC
+// subjects are not cast to uuid columns.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, c := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+				return false
+			}
+		}
+	}
+	return true
+}
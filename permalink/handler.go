@@ -0,0 +1,71 @@
+package permalink
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deicod/ermblog/graphql/relay"
+)
+
+// Handler serves public permalinks. Old or non-canonical paths are answered
+// with a 301 to the canonical URL; canonical paths return a small JSON
+// document naming the content so front ends can fetch it via GraphQL.
+type Handler struct {
+	router   *Router
+	fallback http.Handler
+}
+
+// NewHandler wraps the router. Requests that do not resolve to content are
+// passed to fallback, or answered with 404 when fallback is nil.
+func NewHandler(router *Router, fallback http.Handler) (*Handler, error) {
+	if router == nil {
+		return nil, errors.New("permalink: router is required")
+	}
+	if fallback == nil {
+		fallback = http.NotFoundHandler()
+	}
+	return &Handler{router: router, fallback: fallback}, nil
+}
+
+type resolvedContent struct {
+	Typename string `json:"__typename"`
+	ID       string `json:"id"`
+	Path     string `json:"path"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	resolution, err := h.router.Resolve(r.Context(), r.URL.EscapedPath())
+	if err != nil {
+		http.Error(w, "failed to resolve path", http.StatusInternalServerError)
+		return
+	}
+	if resolution == nil {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	if resolution.Redirect {
+		location := resolution.Path
+		if r.URL.RawQuery != "" {
+			location += "?" + r.URL.RawQuery
+		}
+		http.Redirect(w, r, location, http.StatusMovedPermanently)
+		return
+	}
+	typename := EntityType(resolution.Kind)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resolvedContent{
+		Typename: typename,
+		ID:       relay.ToGlobalID(typename, resolution.Target.ID),
+		Path:     resolution.Path,
+	})
+}
@@ -0,0 +1,47 @@
+package permalink
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandlerRedirectsOldSlugs(t *testing.T) {
+	t.Parallel()
+
+	router, err := NewRouter(Set{}, newFakeStore())
+	if err != nil {
+		t.Fatalf("NewRouter returned error: %v", err)
+	}
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler, err := NewHandler(router, fallback)
+	if err != nil {
+		t.Fatalf("NewHandler returned error: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/2024/05/hello?utm=feed", nil))
+	if rec.Code != http.StatusMovedPermanently {
+		t.Fatalf("expected 301, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Location"); got != "/2024/05/hello-world?utm=feed" {
+		t.Fatalf("unexpected Location %q", got)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/2024/05/hello-world", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); body != `{"__typename":"Post","id":"UG9zdDpwMQ==","path":"/2024/05/hello-world"}`+"\n" {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected fallback for unknown paths, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,125 @@
+package permalink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// Entity types recorded in slug_histories.entity_type. They match the GraphQL
+// type names so history rows line up with global IDs.
+const (
+	EntityPost     = "Post"
+	EntityCategory = "Category"
+	EntityTag      = "Tag"
+)
+
+const publishedStatus = "published"
+
+// EntityType returns the slug history entity type for kind. Posts and pages
+// share the Post entity.
+func EntityType(kind Kind) string {
+	switch kind {
+	case KindPost, KindPage:
+		return EntityPost
+	case KindCategory:
+		return EntityCategory
+	default:
+		return EntityTag
+	}
+}
+
+// ORMStore resolves permalinks against the generated ORM client. Only
+// published posts and pages are routable.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a router Store.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// ByID loads content by primary key.
+func (s *ORMStore) ByID(ctx context.Context, kind Kind, id string) (*Target, error) {
+	switch kind {
+	case KindPost, KindPage:
+		post, err := s.client.Posts().ByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return postTarget(post, kind), nil
+	case KindCategory:
+		category, err := s.client.Categories().ByID(ctx, id)
+		if err != nil || category == nil {
+			return nil, err
+		}
+		return &Target{ID: category.ID, Slug: category.Slug, Date: category.CreatedAt}, nil
+	case KindTag:
+		tag, err := s.client.Tags().ByID(ctx, id)
+		if err != nil || tag == nil {
+			return nil, err
+		}
+		return &Target{ID: tag.ID, Slug: tag.Slug, Date: tag.CreatedAt}, nil
+	default:
+		return nil, fmt.Errorf("permalink: unknown kind %q", kind)
+	}
+}
+
+// BySlug loads content by its current slug.
+func (s *ORMStore) BySlug(ctx context.Context, kind Kind, slug string) (*Target, error) {
+	switch kind {
+	case KindPost, KindPage:
+		post, err := s.client.Posts().Query().
+			WhereSlugEq(slug).
+			WhereTypeEq(string(kind)).
+			WhereStatusEq(publishedStatus).
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return postTarget(post, kind), nil
+	case KindCategory:
+		category, err := s.client.Categories().Query().WhereSlugEq(slug).First(ctx)
+		if err != nil || category == nil {
+			return nil, err
+		}
+		return &Target{ID: category.ID, Slug: category.Slug, Date: category.CreatedAt}, nil
+	case KindTag:
+		tag, err := s.client.Tags().Query().WhereSlugEq(slug).First(ctx)
+		if err != nil || tag == nil {
+			return nil, err
+		}
+		return &Target{ID: tag.ID, Slug: tag.Slug, Date: tag.CreatedAt}, nil
+	default:
+		return nil, fmt.Errorf("permalink: unknown kind %q", kind)
+	}
+}
+
+// BySlugHistory follows the most recent slug history entry for slug to the
+// content's current record.
+func (s *ORMStore) BySlugHistory(ctx context.Context, kind Kind, slug string) (*Target, error) {
+	entry, err := s.client.SlugHistories().Query().
+		WhereEntityTypeEq(EntityType(kind)).
+		WhereSlugEq(slug).
+		OrderByCreatedAtDesc().
+		First(ctx)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return s.ByID(ctx, kind, entry.EntityID)
+}
+
+// postTarget filters out drafts and posts of another type; the Kind values
+// for posts and pages double as post type names.
+func postTarget(post *gen.Post, kind Kind) *Target {
+	if post == nil || post.Status != publishedStatus || post.Type != string(kind) {
+		return nil
+	}
+	date := post.CreatedAt
+	if post.PublishedAt != nil {
+		date = *post.PublishedAt
+	}
+	return &Target{ID: post.ID, Slug: post.Slug, Date: date}
+}
@@ -0,0 +1,164 @@
+// Package permalink renders public URLs for posts, pages and taxonomies from
+// configurable patterns such as "/{year}/{month}/{slug}".
+package permalink
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Supported placeholders.
+const (
+	TokenSlug  = "{slug}"
+	TokenID    = "{id}"
+	TokenYear  = "{year}"
+	TokenMonth = "{month}"
+	TokenDay   = "{day}"
+)
+
+// Default patterns mirror WordPress' "month and name" permalink structure.
+const (
+	DefaultPostPattern     = "/{year}/{month}/{slug}"
+	DefaultPagePattern     = "/{slug}"
+	DefaultCategoryPattern = "/category/{slug}"
+	DefaultTagPattern      = "/tag/{slug}"
+)
+
+var knownTokens = []string{TokenSlug, TokenID, TokenYear, TokenMonth, TokenDay}
+
+// Pattern is a validated permalink template.
+type Pattern struct {
+	raw     string
+	matcher *regexp.Regexp
+}
+
+// Values supplies the data substituted into a pattern.
+type Values struct {
+	ID   string
+	Slug string
+	Date time.Time
+}
+
+// Parse validates a permalink pattern. Patterns must start with a slash and
+// may only reference the supported placeholders.
+func Parse(raw string) (Pattern, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return Pattern{}, errors.New("permalink: pattern is required")
+	}
+	if !strings.HasPrefix(raw, "/") {
+		return Pattern{}, fmt.Errorf("permalink: pattern %q must start with /", raw)
+	}
+	var expr strings.Builder
+	expr.WriteString("^")
+	rest := raw
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return Pattern{}, fmt.Errorf("permalink: unterminated placeholder in %q", raw)
+		}
+		token := rest[start : start+end+1]
+		if !isKnownToken(token) {
+			return Pattern{}, fmt.Errorf("permalink: unknown placeholder %s in %q", token, raw)
+		}
+		expr.WriteString(regexp.QuoteMeta(rest[:start]))
+		expr.WriteString(tokenExpr(token))
+		rest = rest[start+end+1:]
+	}
+	if !strings.Contains(raw, TokenSlug) && !strings.Contains(raw, TokenID) {
+		return Pattern{}, fmt.Errorf("permalink: pattern %q must contain {slug} or {id}", raw)
+	}
+	expr.WriteString(regexp.QuoteMeta(strings.TrimSuffix(rest, "/")))
+	expr.WriteString("/?$")
+	matcher, err := regexp.Compile(expr.String())
+	if err != nil {
+		return Pattern{}, fmt.Errorf("permalink: compile %q: %w", raw, err)
+	}
+	return Pattern{raw: raw, matcher: matcher}, nil
+}
+
+// MustParse is like Parse but panics on invalid patterns.
+func MustParse(raw string) Pattern {
+	p, err := Parse(raw)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the raw pattern.
+func (p Pattern) String() string {
+	return p.raw
+}
+
+// Path renders the pattern into an escaped URL path.
+func (p Pattern) Path(values Values) string {
+	date := values.Date.UTC()
+	replacer := strings.NewReplacer(
+		TokenSlug, url.PathEscape(values.Slug),
+		TokenID, url.PathEscape(values.ID),
+		TokenYear, fmt.Sprintf("%04d", date.Year()),
+		TokenMonth, fmt.Sprintf("%02d", int(date.Month())),
+		TokenDay, fmt.Sprintf("%02d", date.Day()),
+	)
+	return replacer.Replace(p.raw)
+}
+
+// Match reports whether an escaped URL path fits the pattern and returns the
+// slug and id it carries. Date placeholders are only checked for shape; callers
+// compare the canonical Path to detect stale dates.
+func (p Pattern) Match(path string) (Values, bool) {
+	if p.matcher == nil {
+		return Values{}, false
+	}
+	groups := p.matcher.FindStringSubmatch(path)
+	if groups == nil {
+		return Values{}, false
+	}
+	var values Values
+	for i, name := range p.matcher.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		value, err := url.PathUnescape(groups[i])
+		if err != nil {
+			return Values{}, false
+		}
+		switch name {
+		case "slug":
+			values.Slug = value
+		case "id":
+			values.ID = value
+		}
+	}
+	return values, true
+}
+
+func tokenExpr(token string) string {
+	name := strings.Trim(token, "{}")
+	switch token {
+	case TokenYear:
+		return `(?P<` + name + `>\d{4})`
+	case TokenMonth, TokenDay:
+		return `(?P<` + name + `>\d{2})`
+	default:
+		return `(?P<` + name + `>[^/]+)`
+	}
+}
+
+func isKnownToken(token string) bool {
+	for _, known := range knownTokens {
+		if token == known {
+			return true
+		}
+	}
+	return false
+}
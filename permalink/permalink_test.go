@@ -0,0 +1,48 @@
+package permalink
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPatternPath(t *testing.T) {
+	t.Parallel()
+
+	pattern := MustParse("/{year}/{month}/{day}/{slug}")
+	date := time.Date(2024, time.March, 7, 23, 0, 0, 0, time.UTC)
+
+	got := pattern.Path(Values{ID: "p1", Slug: "hello world", Date: date})
+	if got != "/2024/03/07/hello%20world" {
+		t.Fatalf("unexpected path %q", got)
+	}
+}
+
+func TestParseRejectsInvalidPatterns(t *testing.T) {
+	t.Parallel()
+
+	cases := []string{"", "posts/{slug}", "/{year}/{month}", "/{slug}/{author}", "/{slug"}
+	for _, raw := range cases {
+		if _, err := Parse(raw); err == nil {
+			t.Fatalf("expected error for pattern %q", raw)
+		}
+	}
+}
+
+func TestPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	pattern := MustParse("/{year}/{month}/{slug}")
+	values, ok := pattern.Match("/2024/03/hello%20world/")
+	if !ok {
+		t.Fatal("expected path to match")
+	}
+	if values.Slug != "hello world" {
+		t.Fatalf("unexpected slug %q", values.Slug)
+	}
+
+	for _, path := range []string{"/2024/3/hello", "/2024/03/hello/extra", "/category/hello", "/"} {
+		if _, ok := pattern.Match(path); ok {
+			t.Fatalf("expected %q not to match", path)
+		}
+	}
+}
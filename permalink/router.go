@@ -0,0 +1,110 @@
+package permalink
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Target is the content a permalink resolves to.
+type Target struct {
+	ID   string
+	Slug string
+	Date time.Time
+}
+
+// Store looks up content for the router. Lookups return nil when nothing
+// matches.
+type Store interface {
+	ByID(ctx context.Context, kind Kind, id string) (*Target, error)
+	BySlug(ctx context.Context, kind Kind, slug string) (*Target, error)
+	// BySlugHistory returns the content that previously used slug.
+	BySlugHistory(ctx context.Context, kind Kind, slug string) (*Target, error)
+}
+
+// Resolution describes the content behind a public path.
+type Resolution struct {
+	Kind   Kind
+	Target Target
+	// Path is the canonical path for Target.
+	Path string
+	// Redirect is set when the requested path is not canonical, e.g. an old
+	// slug or a stale date segment.
+	Redirect bool
+}
+
+// resolveOrder tries the most specific patterns first so a catch-all page
+// pattern such as "/{slug}" does not shadow posts or taxonomies.
+var resolveOrder = []Kind{KindPost, KindCategory, KindTag, KindPage}
+
+// Router maps public paths to content using a permalink Set.
+type Router struct {
+	set   Set
+	store Store
+}
+
+// NewRouter returns a router for the set. Unset patterns use the defaults.
+func NewRouter(set Set, store Store) (*Router, error) {
+	if store == nil {
+		return nil, errors.New("permalink: store is required")
+	}
+	return &Router{set: set.WithDefaults(), store: store}, nil
+}
+
+// Set returns the permalink patterns used by the router.
+func (r *Router) Set() Set {
+	return r.set
+}
+
+// Resolve finds the content for an escaped URL path. It returns nil when the
+// path does not belong to any known content.
+func (r *Router) Resolve(ctx context.Context, path string) (*Resolution, error) {
+	requested := normalisePath(path)
+	for _, kind := range resolveOrder {
+		pattern := r.set.Pattern(kind)
+		values, ok := pattern.Match(requested)
+		if !ok {
+			continue
+		}
+		target, err := r.lookup(ctx, kind, values)
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			continue
+		}
+		canonical := pattern.Path(Values{ID: target.ID, Slug: target.Slug, Date: target.Date})
+		return &Resolution{
+			Kind:     kind,
+			Target:   *target,
+			Path:     canonical,
+			Redirect: normalisePath(canonical) != requested,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (r *Router) lookup(ctx context.Context, kind Kind, values Values) (*Target, error) {
+	if values.ID != "" {
+		return r.store.ByID(ctx, kind, values.ID)
+	}
+	target, err := r.store.BySlug(ctx, kind, values.Slug)
+	if err != nil || target != nil {
+		return target, err
+	}
+	return r.store.BySlugHistory(ctx, kind, values.Slug)
+}
+
+func normalisePath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
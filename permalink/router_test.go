@@ -0,0 +1,120 @@
+package permalink
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeStore struct {
+	live    map[Kind]map[string]Target
+	history map[Kind]map[string]Target
+	err     error
+}
+
+func (s *fakeStore) ByID(_ context.Context, kind Kind, id string) (*Target, error) {
+	for _, target := range s.live[kind] {
+		if target.ID == id {
+			return &target, nil
+		}
+	}
+	return nil, s.err
+}
+
+func (s *fakeStore) BySlug(_ context.Context, kind Kind, slug string) (*Target, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if target, ok := s.live[kind][slug]; ok {
+		return &target, nil
+	}
+	return nil, nil
+}
+
+func (s *fakeStore) BySlugHistory(_ context.Context, kind Kind, slug string) (*Target, error) {
+	if target, ok := s.history[kind][slug]; ok {
+		return &target, nil
+	}
+	return nil, nil
+}
+
+func newFakeStore() *fakeStore {
+	published := time.Date(2024, time.May, 2, 9, 0, 0, 0, time.UTC)
+	post := Target{ID: "p1", Slug: "hello-world", Date: published}
+	return &fakeStore{
+		live: map[Kind]map[string]Target{
+			KindPost:     {"hello-world": post},
+			KindPage:     {"about": {ID: "pg1", Slug: "about"}},
+			KindCategory: {"news": {ID: "c1", Slug: "news"}},
+		},
+		history: map[Kind]map[string]Target{
+			KindPost:     {"hello": post},
+			KindCategory: {"updates": {ID: "c1", Slug: "news"}},
+		},
+	}
+}
+
+func TestRouterResolve(t *testing.T) {
+	t.Parallel()
+
+	router, err := NewRouter(Set{}, newFakeStore())
+	if err != nil {
+		t.Fatalf("NewRouter returned error: %v", err)
+	}
+
+	cases := []struct {
+		path     string
+		kind     Kind
+		id       string
+		canon    string
+		redirect bool
+	}{
+		{path: "/2024/05/hello-world", kind: KindPost, id: "p1", canon: "/2024/05/hello-world"},
+		{path: "/2024/05/hello-world/", kind: KindPost, id: "p1", canon: "/2024/05/hello-world"},
+		{path: "/2024/05/hello", kind: KindPost, id: "p1", canon: "/2024/05/hello-world", redirect: true},
+		{path: "/2023/01/hello-world", kind: KindPost, id: "p1", canon: "/2024/05/hello-world", redirect: true},
+		{path: "/category/news", kind: KindCategory, id: "c1", canon: "/category/news"},
+		{path: "/category/updates", kind: KindCategory, id: "c1", canon: "/category/news", redirect: true},
+		{path: "/about", kind: KindPage, id: "pg1", canon: "/about"},
+	}
+	for _, tc := range cases {
+		resolution, err := router.Resolve(context.Background(), tc.path)
+		if err != nil {
+			t.Fatalf("Resolve(%q) returned error: %v", tc.path, err)
+		}
+		if resolution == nil {
+			t.Fatalf("Resolve(%q) returned nil", tc.path)
+		}
+		if resolution.Kind != tc.kind || resolution.Target.ID != tc.id {
+			t.Fatalf("Resolve(%q) = %s %s, want %s %s", tc.path, resolution.Kind, resolution.Target.ID, tc.kind, tc.id)
+		}
+		if resolution.Path != tc.canon || resolution.Redirect != tc.redirect {
+			t.Fatalf("Resolve(%q) = %q redirect=%v, want %q redirect=%v", tc.path, resolution.Path, resolution.Redirect, tc.canon, tc.redirect)
+		}
+	}
+
+	for _, path := range []string{"/", "/missing", "/tag/unknown", "/2024/05/missing"} {
+		resolution, err := router.Resolve(context.Background(), path)
+		if err != nil {
+			t.Fatalf("Resolve(%q) returned error: %v", path, err)
+		}
+		if resolution != nil {
+			t.Fatalf("expected no resolution for %q, got %+v", path, resolution)
+		}
+	}
+}
+
+func TestRouterResolvePropagatesStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	store.err = errors.New("boom")
+	router, err := NewRouter(Set{}, store)
+	if err != nil {
+		t.Fatalf("NewRouter returned error: %v", err)
+	}
+	if _, err := router.Resolve(context.Background(), "/category/news"); err == nil {
+		t.Fatal("expected store error to propagate")
+	}
+}
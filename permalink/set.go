@@ -0,0 +1,61 @@
+package permalink
+
+// Kind identifies the type of content a permalink points to.
+type Kind string
+
+const (
+	KindPost     Kind = "post"
+	KindPage     Kind = "page"
+	KindCategory Kind = "category"
+	KindTag      Kind = "tag"
+)
+
+// Set holds the URL pattern used for each content kind.
+type Set struct {
+	Post     Pattern
+	Page     Pattern
+	Category Pattern
+	Tag      Pattern
+}
+
+// DefaultSet returns the built-in permalink structure.
+func DefaultSet() Set {
+	return Set{
+		Post:     MustParse(DefaultPostPattern),
+		Page:     MustParse(DefaultPagePattern),
+		Category: MustParse(DefaultCategoryPattern),
+		Tag:      MustParse(DefaultTagPattern),
+	}
+}
+
+// WithDefaults fills unset patterns from DefaultSet.
+func (s Set) WithDefaults() Set {
+	defaults := DefaultSet()
+	if s.Post.String() == "" {
+		s.Post = defaults.Post
+	}
+	if s.Page.String() == "" {
+		s.Page = defaults.Page
+	}
+	if s.Category.String() == "" {
+		s.Category = defaults.Category
+	}
+	if s.Tag.String() == "" {
+		s.Tag = defaults.Tag
+	}
+	return s
+}
+
+// Pattern returns the pattern configured for kind.
+func (s Set) Pattern(kind Kind) Pattern {
+	switch kind {
+	case KindPost:
+		return s.Post
+	case KindPage:
+		return s.Page
+	case KindCategory:
+		return s.Category
+	default:
+		return s.Tag
+	}
+}
@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+type apiKeyContextKey struct{}
+
+// WithAPIKey records the id of an authenticated API key so its requests share
+// one budget. Authentication middleware calls it after verifying the key.
+func WithAPIKey(ctx context.Context, keyID string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, keyID)
+}
+
+func apiKeyFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(apiKeyContextKey{}).(string)
+	return id
+}
+
+// Identity returns the bucket identity for r: the API key, then the OIDC
+// subject, then the client address. API-key requests also carry their
+// owner's claims, so the key is checked first to give each key its own
+// budget apart from the owner's sessions.
+func (l *Limiter) Identity(r *http.Request) string {
+	if id := apiKeyFromContext(r.Context()); id != "" {
+		return "key:" + id
+	}
+	if claims, ok := oidc.FromContext(r.Context()); ok && claims.Subject != "" {
+		return "sub:" + claims.Subject
+	}
+	return "ip:" + l.clients.IP(r)
+}
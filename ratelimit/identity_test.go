@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deicod/ermblog/oidc"
+)
+
+func TestIdentityPrefersAPIKeyThenSubject(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := New(Config{}, NewMemoryStore())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	req := httptest.NewRequest("POST", "/graphql", nil)
+	req.RemoteAddr = "203.0.113.9:5000"
+	if got := limiter.Identity(req); got != "ip:203.0.113.9" {
+		t.Fatalf("expected ip identity, got %q", got)
+	}
+
+	ctx := oidc.ToContext(context.Background(), oidc.Claims{Subject: "user-1"})
+	req = req.WithContext(ctx)
+	if got := limiter.Identity(req); got != "sub:user-1" {
+		t.Fatalf("expected subject identity, got %q", got)
+	}
+
+	// API-key requests carry the owner's subject as well.
+	req = req.WithContext(WithAPIKey(ctx, "key-1"))
+	if got := limiter.Identity(req); got != "key:key-1" {
+		t.Fatalf("expected api key identity, got %q", got)
+	}
+}
@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval controls how often idle buckets are dropped.
+const sweepInterval = time.Minute
+
+// MemoryStore keeps buckets in process memory. Each replica enforces its own
+// budget; use PostgresStore to share budgets across replicas.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+type memoryBucket struct {
+	tokens float64
+	last   time.Time
+	budget Budget
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+// Take implements Store.
+func (s *MemoryStore) Take(_ context.Context, key string, budget Budget, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{tokens: budget.capacity(), last: now}
+		s.buckets[key] = bucket
+	}
+	tokens, result := refill(bucket.tokens, bucket.last, now, budget)
+	bucket.tokens, bucket.last, bucket.budget = tokens, now, budget
+	return result, nil
+}
+
+// sweep drops buckets that have refilled completely; recreating them later
+// yields the same state.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, bucket := range s.buckets {
+		full := bucket.tokens + now.Sub(bucket.last).Seconds()*bucket.budget.Rate
+		if full >= bucket.budget.capacity() {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/deicod/ermblog/graphql/gqlerrors"
+)
+
+// maxInspectedBody bounds how much of a POST body is read to classify it.
+const maxInspectedBody = 1 << 20
+
+// Middleware rejects requests over budget with 429 and Retry-After. Install
+// it inside authentication middleware so OIDC subjects are known. Store
+// failures let the request through rather than taking the API down.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		kind := classify(r, l.cfg.PersistedQueries)
+		result, err := l.Allow(r.Context(), kind, l.Identity(r))
+		if err != nil || result.Allowed {
+			next.ServeHTTP(w, r)
+			return
+		}
+		writeLimited(w, kind, result)
+	})
+}
+
+func writeLimited(w http.ResponseWriter, kind Kind, result Result) {
+	seconds := int(math.Ceil(result.RetryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"message": "rate limit exceeded for " + string(kind) + " requests",
+			"extensions": map[string]any{
+				"code":       gqlerrors.CodeRateLimited,
+				"retryAfter": seconds,
+			},
+		}},
+	})
+}
+
+// classify determines which budget a request draws from without executing
+// it. Websocket upgrades are subscription connections, GET requests can only
+// run queries, and POST bodies are parsed to find the selected operation.
+// Hash-only persisted queries are looked up in queries; documents that cannot
+// be classified count as queries.
+func classify(r *http.Request, queries QueryLookup) Kind {
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return KindSubscription
+	}
+	if r.Method != http.MethodPost || r.Body == nil {
+		return KindQuery
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		// Uploads are always mutations; leave the stream for the transport.
+		return KindMutation
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxInspectedBody+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if err != nil || len(body) > maxInspectedBody {
+		return KindQuery
+	}
+	var params struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+		Extensions    struct {
+			PersistedQuery struct {
+				Sha256Hash string `json:"sha256Hash"`
+			} `json:"persistedQuery"`
+		} `json:"extensions"`
+	}
+	if json.Unmarshal(body, &params) != nil {
+		return KindQuery
+	}
+	if hash := params.Extensions.PersistedQuery.Sha256Hash; params.Query == "" && hash != "" && queries != nil {
+		params.Query, _ = queries.Get(r.Context(), hash)
+	}
+	if params.Query == "" {
+		return KindQuery
+	}
+	return operationKind(params.Query, params.OperationName)
+}
+
+func operationKind(query, operationName string) Kind {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return KindQuery
+	}
+	for _, op := range doc.Operations {
+		if operationName != "" && op.Name != operationName {
+			continue
+		}
+		switch op.Operation {
+		case ast.Mutation:
+			return KindMutation
+		case ast.Subscription:
+			return KindSubscription
+		default:
+			return KindQuery
+		}
+	}
+	return KindQuery
+}
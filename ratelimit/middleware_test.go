@@ -0,0 +1,197 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		req  func() *http.Request
+		want Kind
+	}{
+		{name: "get", want: KindQuery, req: func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/graphql?query={viewer{id}}", nil)
+		}},
+		{name: "websocket", want: KindSubscription, req: func() *http.Request {
+			r := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+			r.Header.Set("Upgrade", "websocket")
+			return r
+		}},
+		{name: "mutation", want: KindMutation, req: func() *http.Request {
+			return jsonRequest(`{"query":"mutation Save { deletePost(id: \"1\") { id } }"}`)
+		}},
+		{name: "selected operation", want: KindQuery, req: func() *http.Request {
+			return jsonRequest(`{"query":"mutation A { a } query B { b }","operationName":"B"}`)
+		}},
+		{name: "persisted hash only", want: KindQuery, req: func() *http.Request {
+			return jsonRequest(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"abc"}}}`)
+		}},
+		{name: "upload", want: KindMutation, req: func() *http.Request {
+			r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("--x--"))
+			r.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+			return r
+		}},
+	}
+	for _, tc := range cases {
+		if got := classify(tc.req(), nil); got != tc.want {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestClassifyRestoresBody(t *testing.T) {
+	t.Parallel()
+
+	body := `{"query":"mutation { a }"}`
+	req := jsonRequest(body)
+	classify(req, nil)
+	got, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(got) != body {
+		t.Fatalf("expected body to be preserved, got %q", got)
+	}
+}
+
+type queryMap map[string]string
+
+func (m queryMap) Get(_ context.Context, hash string) (string, bool) {
+	query, ok := m[hash]
+	return query, ok
+}
+
+func TestClassifyResolvesPersistedHash(t *testing.T) {
+	t.Parallel()
+
+	queries := queryMap{
+		"save": "mutation Save { deletePost(id: \"1\") { id } }",
+		"feed": "subscription Feed { postCreated { id } }",
+	}
+	cases := map[string]Kind{
+		`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"save"}}}`:    KindMutation,
+		`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"feed"}}}`:    KindSubscription,
+		`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"unknown"}}}`: KindQuery,
+	}
+	for body, want := range cases {
+		if got := classify(jsonRequest(body), queries); got != want {
+			t.Errorf("%s: expected %s, got %s", body, want, got)
+		}
+	}
+}
+
+func TestMiddlewareChargesPersistedMutations(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := New(Config{
+		Query:            Budget{Rate: 10, Burst: 10},
+		Mutation:         Budget{Rate: 0.5, Burst: 1},
+		PersistedQueries: queryMap{"save": "mutation Save { deletePost(id: \"1\") { id } }"},
+	}, NewMemoryStore())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return now }
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	serve := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := jsonRequest(`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"save"}}}`)
+		req.RemoteAddr = "198.51.100.7:1234"
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	if rec := serve(); rec.Code != http.StatusOK {
+		t.Fatalf("expected first mutation to pass, got %d", rec.Code)
+	}
+	rec := serve()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the mutation budget to be spent, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), "mutation requests") {
+		t.Fatalf("expected a mutation limit error, got %s", rec.Body.String())
+	}
+}
+
+func TestMiddlewareRejectsWithRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := New(Config{Query: Budget{Rate: 0.5, Burst: 1}}, NewMemoryStore())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return now }
+
+	calls := 0
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+
+	serve := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := jsonRequest(`{"query":"{ viewer { id } }"}`)
+		req.RemoteAddr = "198.51.100.7:1234"
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := serve(); rec.Code != http.StatusOK {
+		t.Fatalf("expected first request to pass, got %d", rec.Code)
+	}
+	rec := serve()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Fatalf("expected Retry-After 2, got %q", got)
+	}
+	var payload struct {
+		Errors []struct {
+			Extensions map[string]any `json:"extensions"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if len(payload.Errors) != 1 || payload.Errors[0].Extensions["code"] != "RATE_LIMITED" {
+		t.Fatalf("unexpected error body: %s", rec.Body.String())
+	}
+	if calls != 1 {
+		t.Fatalf("expected limited request to skip handler, got %d calls", calls)
+	}
+}
+
+func TestMiddlewareFailsOpen(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := New(Config{Query: Budget{Rate: 1, Burst: 1}}, failingStore{})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected store errors to allow the request, got %d", rec.Code)
+	}
+}
+
+func jsonRequest(body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Querier is the subset of pg.DB.Pool used by PostgresStore.
+type Querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// takeQuery refills and consumes a bucket in one statement so concurrent
+// replicas cannot both spend the last token. It mirrors refill.
+const takeQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, true, $4::timestamptz)
+ON CONFLICT (key) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST($2::float8, b.tokens + GREATEST(0, EXTRACT(EPOCH FROM ($4::timestamptz - b.updated_at)))::float8 * $3::float8) >= 1
+        THEN LEAST($2::float8, b.tokens + GREATEST(0, EXTRACT(EPOCH FROM ($4::timestamptz - b.updated_at)))::float8 * $3::float8) - 1
+        ELSE LEAST($2::float8, b.tokens + GREATEST(0, EXTRACT(EPOCH FROM ($4::timestamptz - b.updated_at)))::float8 * $3::float8)
+    END,
+    allowed = LEAST($2::float8, b.tokens + GREATEST(0, EXTRACT(EPOCH FROM ($4::timestamptz - b.updated_at)))::float8 * $3::float8) >= 1,
+    updated_at = GREATEST(b.updated_at, $4::timestamptz)
+RETURNING tokens, allowed`
+
+// PostgresStore shares buckets between replicas through the
+// rate_limit_buckets table.
+type PostgresStore struct {
+	db Querier
+}
+
+// NewPostgresStore returns a store that keeps buckets in Postgres.
+func NewPostgresStore(db Querier) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Take implements Store.
+func (s *PostgresStore) Take(ctx context.Context, key string, budget Budget, now time.Time) (Result, error) {
+	var (
+		tokens  float64
+		allowed bool
+	)
+	err := s.db.QueryRow(ctx, takeQuery, key, budget.capacity(), budget.Rate, now).Scan(&tokens, &allowed)
+	if err != nil {
+		return Result{}, fmt.Errorf("ratelimit: take %s: %w", key, err)
+	}
+	if allowed {
+		return Result{Allowed: true, Remaining: tokens}, nil
+	}
+	return Result{Remaining: tokens, RetryAfter: retryAfter(tokens, budget)}, nil
+}
+
+// pruneLockKey is the advisory lock that lets one replica prune per tick.
+// It sits beside the lock keys in orm/gen/tx_custom.go.
+const pruneLockKey int64 = 0x65726d0004
+
+// pruneQuery deletes buckets idle since $2 when it wins the advisory lock,
+// which lasts for the statement's transaction.
+const pruneQuery = `
+WITH lock AS (
+    SELECT pg_try_advisory_xact_lock($1) AS held
+), pruned AS (
+    DELETE FROM rate_limit_buckets b USING lock
+    WHERE lock.held AND b.updated_at < $2::timestamptz
+    RETURNING 1
+)
+SELECT count(*) FROM pruned`
+
+// Prune implements Pruner. When another replica is pruning it deletes
+// nothing and returns zero.
+func (s *PostgresStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	var pruned int
+	if err := s.db.QueryRow(ctx, pruneQuery, pruneLockKey, before).Scan(&pruned); err != nil {
+		return 0, fmt.Errorf("ratelimit: prune buckets: %w", err)
+	}
+	return pruned, nil
+}
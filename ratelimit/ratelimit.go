@@ -0,0 +1,182 @@
+// Package ratelimit throttles GraphQL traffic with token buckets keyed by the
+// caller's identity. Queries, mutations and subscription connections draw on
+// separate budgets; exhausted callers receive 429 with Retry-After.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/deicod/ermblog/clientip"
+)
+
+// Kind selects the budget a request draws from.
+type Kind string
+
+const (
+	KindQuery        Kind = "query"
+	KindMutation     Kind = "mutation"
+	KindSubscription Kind = "subscription"
+)
+
+// Budget is a token bucket refilled at Rate tokens per second up to Burst.
+// A zero Rate disables limiting for the kind.
+type Budget struct {
+	Rate  float64
+	Burst int
+}
+
+func (b Budget) enabled() bool {
+	return b.Rate > 0
+}
+
+func (b Budget) capacity() float64 {
+	if b.Burst < 1 {
+		return 1
+	}
+	return float64(b.Burst)
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed bool
+	// Remaining is the number of tokens left after the request.
+	Remaining float64
+	// RetryAfter is how long a rejected caller must wait for the next token.
+	RetryAfter time.Duration
+}
+
+// Store persists buckets. Take refills the bucket for key, consumes one token
+// when available and reports the result atomically.
+type Store interface {
+	Take(ctx context.Context, key string, budget Budget, now time.Time) (Result, error)
+}
+
+// Pruner is implemented by stores that keep buckets outside the process and
+// must delete idle ones. Prune deletes buckets last touched before the cutoff
+// and returns how many it deleted.
+type Pruner interface {
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
+
+// refill applies the elapsed time to a bucket and consumes a token if one is
+// available. Both stores share it so they behave identically.
+func refill(tokens float64, last, now time.Time, budget Budget) (float64, Result) {
+	elapsed := now.Sub(last).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	tokens = math.Min(budget.capacity(), tokens+elapsed*budget.Rate)
+	if tokens >= 1 {
+		tokens--
+		return tokens, Result{Allowed: true, Remaining: tokens}
+	}
+	return tokens, Result{Remaining: tokens, RetryAfter: retryAfter(tokens, budget)}
+}
+
+func retryAfter(tokens float64, budget Budget) time.Duration {
+	wait := (1 - tokens) / budget.Rate
+	return time.Duration(math.Ceil(wait * float64(time.Second)))
+}
+
+// Config configures a Limiter.
+type Config struct {
+	Query        Budget
+	Mutation     Budget
+	Subscription Budget
+	// TrustedProxies lists the addresses or CIDR ranges allowed to set
+	// X-Forwarded-For. Requests from other peers are keyed by their address.
+	TrustedProxies []string
+	// PersistedQueries resolves hash-only persisted queries so they draw on
+	// the budget of the operation they run. Without it they count as queries.
+	PersistedQueries QueryLookup
+}
+
+// QueryLookup returns the query text registered for a persisted query hash.
+// *persisted.Store implements it.
+type QueryLookup interface {
+	Get(ctx context.Context, hash string) (string, bool)
+}
+
+func (cfg Config) budget(kind Kind) Budget {
+	switch kind {
+	case KindMutation:
+		return cfg.Mutation
+	case KindSubscription:
+		return cfg.Subscription
+	default:
+		return cfg.Query
+	}
+}
+
+// Limiter applies Config against a Store.
+type Limiter struct {
+	cfg     Config
+	store   Store
+	clients *clientip.Resolver
+	now     func() time.Time
+}
+
+// New validates cfg and returns a Limiter backed by store.
+func New(cfg Config, store Store) (*Limiter, error) {
+	if store == nil {
+		return nil, errors.New("ratelimit: store is required")
+	}
+	clients, err := clientip.New(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &Limiter{cfg: cfg, store: store, clients: clients, now: time.Now}, nil
+}
+
+// Allow takes a token for identity from the kind's budget.
+func (l *Limiter) Allow(ctx context.Context, kind Kind, identity string) (Result, error) {
+	budget := l.cfg.budget(kind)
+	if !budget.enabled() {
+		return Result{Allowed: true}, nil
+	}
+	return l.store.Take(ctx, string(kind)+":"+identity, budget, l.now())
+}
+
+// refillWindow is the longest time any enabled budget takes to refill from
+// empty. A bucket idle for that long is full, so deleting it loses nothing.
+func (cfg Config) refillWindow() time.Duration {
+	var window time.Duration
+	for _, budget := range []Budget{cfg.Query, cfg.Mutation, cfg.Subscription} {
+		if !budget.enabled() {
+			continue
+		}
+		seconds := budget.capacity() / budget.Rate
+		if d := time.Duration(math.Ceil(seconds * float64(time.Second))); d > window {
+			window = d
+		}
+	}
+	return window
+}
+
+// Run prunes idle buckets every interval until ctx is cancelled. It returns
+// at once for stores that do not implement Pruner.
+func (l *Limiter) Run(ctx context.Context, interval time.Duration) {
+	pruner, ok := l.store.(Pruner)
+	if !ok {
+		return
+	}
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := pruner.Prune(ctx, l.now().Add(-l.cfg.refillWindow())); err != nil && ctx.Err() == nil {
+			log.Printf("ratelimit: prune idle buckets: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
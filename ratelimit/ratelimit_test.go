@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreRefillsTokens(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	budget := Budget{Rate: 2, Burst: 2}
+	now := time.Unix(1700000000, 0)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		res, err := store.Take(ctx, "k", budget, now)
+		if err != nil || !res.Allowed {
+			t.Fatalf("take %d: expected allowed, got %+v, %v", i, res, err)
+		}
+	}
+	res, _ := store.Take(ctx, "k", budget, now)
+	if res.Allowed {
+		t.Fatal("expected empty bucket to reject")
+	}
+	if res.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("expected retry after 500ms, got %s", res.RetryAfter)
+	}
+
+	res, _ = store.Take(ctx, "k", budget, now.Add(500*time.Millisecond))
+	if !res.Allowed {
+		t.Fatal("expected refilled token after 500ms")
+	}
+	res, _ = store.Take(ctx, "other", budget, now)
+	if !res.Allowed {
+		t.Fatal("expected keys to have separate buckets")
+	}
+}
+
+func TestMemoryStoreSweepsFullBuckets(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	budget := Budget{Rate: 1, Burst: 1}
+	now := time.Unix(1700000000, 0)
+	if _, err := store.Take(context.Background(), "idle", budget, now); err != nil {
+		t.Fatalf("take: %v", err)
+	}
+	if _, err := store.Take(context.Background(), "fresh", budget, now.Add(2*sweepInterval)); err != nil {
+		t.Fatalf("take: %v", err)
+	}
+	if _, ok := store.buckets["idle"]; ok {
+		t.Fatal("expected refilled bucket to be swept")
+	}
+}
+
+func TestLimiterBudgetsPerKind(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := New(Config{
+		Query:    Budget{Rate: 1, Burst: 1},
+		Mutation: Budget{Rate: 1, Burst: 1},
+	}, NewMemoryStore())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if res, _ := limiter.Allow(ctx, KindQuery, "ip:1.2.3.4"); !res.Allowed {
+		t.Fatal("expected first query to pass")
+	}
+	if res, _ := limiter.Allow(ctx, KindQuery, "ip:1.2.3.4"); res.Allowed {
+		t.Fatal("expected second query to be limited")
+	}
+	if res, _ := limiter.Allow(ctx, KindMutation, "ip:1.2.3.4"); !res.Allowed {
+		t.Fatal("expected mutation budget to be independent")
+	}
+	for i := 0; i < 5; i++ {
+		if res, _ := limiter.Allow(ctx, KindSubscription, "ip:1.2.3.4"); !res.Allowed {
+			t.Fatal("expected unconfigured subscription budget to be unlimited")
+		}
+	}
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(Config{}, nil); err == nil {
+		t.Fatal("expected error without store")
+	}
+	if _, err := New(Config{TrustedProxies: []string{"10.0.0.0/33"}}, NewMemoryStore()); err == nil {
+		t.Fatal("expected error for invalid CIDR")
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Budget, time.Time) (Result, error) {
+	return Result{}, errors.New("database unavailable")
+}
+
+type pruningStore struct {
+	*MemoryStore
+	cutoffs chan time.Time
+}
+
+func (s *pruningStore) Prune(_ context.Context, before time.Time) (int, error) {
+	s.cutoffs <- before
+	return 0, nil
+}
+
+func TestRunPrunesBucketsIdleForTheRefillWindow(t *testing.T) {
+	t.Parallel()
+
+	store := &pruningStore{MemoryStore: NewMemoryStore(), cutoffs: make(chan time.Time, 1)}
+	limiter, err := New(Config{
+		Query:    Budget{Rate: 2, Burst: 10},
+		Mutation: Budget{Rate: 0.5, Burst: 5},
+	}, store)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		limiter.Run(ctx, time.Hour)
+		close(done)
+	}()
+	// The mutation budget takes longest to refill: 5 tokens at 0.5/s.
+	if cutoff := <-store.cutoffs; !cutoff.Equal(now.Add(-10 * time.Second)) {
+		t.Fatalf("expected cutoff 10s ago, got %s", now.Sub(cutoff))
+	}
+	cancel()
+	<-done
+}
@@ -0,0 +1,56 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// ApiKey is a personal API key for headless integrations. The secret part
+// of a key is stored only as a SHA-256 hex digest; prefix is the public part
+// keys are looked up and listed by. Scopes holds the role slugs a key may
+// exercise as a JSON array. Keys are removed together with their user and
+// have no GraphQL type of their own: the apiKeys package serves them.
+type ApiKey struct{ dsl.Schema }
+
+func (ApiKey) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.UUIDv7("user_id"),
+		dsl.String("name").NotEmpty(),
+		dsl.String("prefix").NotEmpty(),
+		dsl.String("secret_hash").NotEmpty(),
+		dsl.JSONB("scopes"),
+		dsl.TimestampTZ("expires_at").Optional(),
+		dsl.TimestampTZ("last_used_at").Optional(),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+	}
+}
+
+func (ApiKey) Edges() []dsl.Edge {
+	return []dsl.Edge{
+		dsl.ToOne("user", "User").Field("user_id").OnDeleteCascade(),
+	}
+}
+
+func (ApiKey) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("api_keys_prefix_key").On("prefix").Unique(),
+		dsl.Idx("api_keys_user_id_idx").On("user_id"),
+	}
+}
+
+func (ApiKey) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("user_id", dsl.OpEqual).Named("UserIDEq"),
+			dsl.NewPredicate("prefix", dsl.OpEqual).Named("PrefixEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("created_at", dsl.SortDesc).Named("CreatedAtDesc"),
+		).
+		WithDefaultLimit(200).
+		WithMaxLimit(200)
+}
+
+func (ApiKey) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+	}
+}
@@ -12,6 +12,7 @@ func (Category) Fields() []dsl.Field {
 		dsl.String("slug").NotEmpty(),
 		dsl.Text("description").Optional(),
 		dsl.UUIDv7("parent_id").Optional(),
+		dsl.Integer("position").Default(0),
 		dsl.TimestampTZ("created_at").DefaultNow(),
 		dsl.TimestampTZ("updated_at").UpdateNow(),
 	}
@@ -28,6 +29,7 @@ func (Category) Edges() []dsl.Edge {
 func (Category) Indexes() []dsl.Index {
 	return []dsl.Index{
 		dsl.Idx("categories_slug_key").On("slug").Unique(),
+		dsl.Idx("categories_parent_id_position").On("parent_id", "position"),
 	}
 }
 
@@ -40,6 +42,7 @@ func (Category) Query() dsl.QuerySpec {
 		).
 		WithOrders(
 			dsl.OrderBy("name", dsl.SortAsc).Named("NameAsc"),
+			dsl.OrderBy("position", dsl.SortAsc).Named("PositionAsc"),
 		).
 		WithDefaultLimit(100).
 		WithMaxLimit(500)
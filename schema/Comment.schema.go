@@ -2,7 +2,8 @@ package schema
 
 import "github.com/deicod/erm/orm/dsl"
 
-// Comment stores user feedback and discussion around posts.
+// Comment stores user feedback and discussion around posts. Deleting a
+// comment moves it to the trash by setting deleted_at.
 type Comment struct{ dsl.Schema }
 
 func (Comment) Fields() []dsl.Field {
@@ -19,6 +20,7 @@ func (Comment) Fields() []dsl.Field {
 		dsl.TimestampTZ("submitted_at").DefaultNow(),
 		dsl.TimestampTZ("published_at").Optional(),
 		dsl.TimestampTZ("updated_at").UpdateNow(),
+		dsl.TimestampTZ("deleted_at").Optional().ReadOnly(),
 	}
 }
 
@@ -35,6 +37,7 @@ func (Comment) Indexes() []dsl.Index {
 	return []dsl.Index{
 		dsl.Idx("comments_post_submitted_at").On("post_id", "submitted_at"),
 		dsl.Idx("comments_status_post").On("status", "post_id"),
+		dsl.Idx("comments_deleted_at").On("deleted_at"),
 	}
 }
 
@@ -55,6 +58,7 @@ func (Comment) Query() dsl.QuerySpec {
 func (Comment) Annotations() []dsl.Annotation {
 	return []dsl.Annotation{
 		dsl.Authorization(dsl.ContentAuth()),
+		dsl.SoftDelete(),
 		dsl.GraphQL("Comment",
 			dsl.GraphQLSubscriptions(
 				dsl.SubscriptionEventCreate,
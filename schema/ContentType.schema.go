@@ -0,0 +1,54 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// ContentType registers a custom post type: its labels, the editor features
+// it supports and a JSON Schema describing the custom fields stored as post
+// meta.
+type ContentType struct{ dsl.Schema }
+
+func (ContentType) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.String("name").NotEmpty(),
+		dsl.String("label").NotEmpty(),
+		dsl.String("plural_label").NotEmpty(),
+		dsl.Text("description").Optional(),
+		dsl.JSONB("supports").Optional(),
+		dsl.JSONB("fields").Optional(),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+		dsl.TimestampTZ("updated_at").UpdateNow(),
+	}
+}
+
+func (ContentType) Edges() []dsl.Edge {
+	return []dsl.Edge{
+		dsl.ToMany("posts", "Post").Ref("content_type"),
+	}
+}
+
+func (ContentType) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("content_types_name_key").On("name").Unique(),
+	}
+}
+
+func (ContentType) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("id", dsl.OpEqual).Named("IDEq"),
+			dsl.NewPredicate("name", dsl.OpEqual).Named("NameEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("name", dsl.SortAsc).Named("NameAsc"),
+		).
+		WithDefaultLimit(50).
+		WithMaxLimit(200)
+}
+
+func (ContentType) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+		dsl.GraphQL("ContentType"),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import "github.com/deicod/erm/orm/dsl"
 
 // Media stores uploaded assets such as images, audio, or documents.
+// Deleted assets stay in the trash, marked by deleted_at, until purged.
 type Media struct{ dsl.Schema }
 
 func (Media) Fields() []dsl.Field {
@@ -21,6 +22,7 @@ func (Media) Fields() []dsl.Field {
 		dsl.JSONB("metadata").Optional(),
 		dsl.TimestampTZ("created_at").DefaultNow(),
 		dsl.TimestampTZ("updated_at").UpdateNow(),
+		dsl.TimestampTZ("deleted_at").Optional().ReadOnly(),
 	}
 }
 
@@ -34,6 +36,7 @@ func (Media) Edges() []dsl.Edge {
 func (Media) Indexes() []dsl.Index {
 	return []dsl.Index{
 		dsl.Idx("media_storage_key_key").On("storage_key").Unique(),
+		dsl.Idx("media_deleted_at").On("deleted_at"),
 	}
 }
 
@@ -54,6 +57,7 @@ func (Media) Query() dsl.QuerySpec {
 func (Media) Annotations() []dsl.Annotation {
 	return []dsl.Annotation{
 		dsl.Authorization(dsl.ContentAuth()),
+		dsl.SoftDelete(),
 		dsl.GraphQL("Media"),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// Menu is a navigation menu. Themes look menus up by location, such as
+// "header" or "footer"; each location holds at most one menu.
+type Menu struct{ dsl.Schema }
+
+func (Menu) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.String("name").NotEmpty(),
+		dsl.String("location").Optional(),
+		dsl.Text("description").Optional(),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+		dsl.TimestampTZ("updated_at").UpdateNow(),
+	}
+}
+
+func (Menu) Edges() []dsl.Edge {
+	return []dsl.Edge{
+		dsl.ToMany("items", "MenuItem").Ref("menu"),
+	}
+}
+
+func (Menu) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("menus_location_key").On("location").Unique(),
+	}
+}
+
+func (Menu) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("id", dsl.OpEqual).Named("IDEq"),
+			dsl.NewPredicate("location", dsl.OpEqual).Named("LocationEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("name", dsl.SortAsc).Named("NameAsc"),
+		).
+		WithDefaultLimit(50).
+		WithMaxLimit(200)
+}
+
+func (Menu) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+		dsl.GraphQL("Menu"),
+	}
+}
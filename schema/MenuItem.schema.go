@@ -0,0 +1,59 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// MenuItem is an entry of a navigation menu. It links a post, page,
+// category or tag through target_id, or an arbitrary URL for custom items,
+// and nests beneath a parent item of the same menu ordered by position.
+type MenuItem struct{ dsl.Schema }
+
+func (MenuItem) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.UUIDv7("menu_id"),
+		dsl.UUIDv7("parent_id").Optional(),
+		dsl.Integer("position").Default(0),
+		dsl.Enum("kind", "post", "page", "category", "tag", "custom").Default("custom"),
+		dsl.UUIDv7("target_id").Optional(),
+		dsl.String("label").Optional(),
+		dsl.Text("url").Optional(),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+		dsl.TimestampTZ("updated_at").UpdateNow(),
+	}
+}
+
+func (MenuItem) Edges() []dsl.Edge {
+	return []dsl.Edge{
+		dsl.ToOne("menu", "Menu").Field("menu_id").OnDeleteCascade().Inverse("items"),
+		dsl.ToOne("parent", "MenuItem").Field("parent_id").Optional().OnDeleteCascade(),
+		dsl.ToMany("children", "MenuItem").Ref("parent"),
+	}
+}
+
+func (MenuItem) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("menu_items_menu_id_parent_id_position").On("menu_id", "parent_id", "position"),
+		dsl.Idx("menu_items_kind_target_id").On("kind", "target_id"),
+	}
+}
+
+func (MenuItem) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("id", dsl.OpEqual).Named("IDEq"),
+			dsl.NewPredicate("menu_id", dsl.OpEqual).Named("MenuIDEq"),
+			dsl.NewPredicate("parent_id", dsl.OpEqual).Named("ParentIDEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("position", dsl.SortAsc).Named("PositionAsc"),
+		).
+		WithDefaultLimit(100).
+		WithMaxLimit(500)
+}
+
+func (MenuItem) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+		dsl.GraphQL("MenuItem"),
+	}
+}
@@ -2,8 +2,11 @@ package schema
 
 import "github.com/deicod/erm/orm/dsl"
 
-// Post represents WordPress posts and pages.
+// Post represents WordPress posts and pages. Custom post types link a
+// ContentType whose fields describe the post's meta. Pages nest beneath a
+// parent page and are ordered among their siblings by menu_order.
 // It exposes author, featured media, category, and tag relationships via GraphQL.
+// Deleted posts are kept in the trash, marked by deleted_at, until purged.
 type Post struct{ dsl.Schema }
 
 func (Post) Fields() []dsl.Field {
@@ -11,6 +14,9 @@ func (Post) Fields() []dsl.Field {
 		dsl.UUIDv7("id").Primary(),
 		dsl.UUIDv7("author_id"),
 		dsl.UUIDv7("featured_media_id").Optional(),
+		dsl.UUIDv7("content_type_id").Optional(),
+		dsl.UUIDv7("parent_id").Optional(),
+		dsl.Integer("menu_order").Default(0),
 		dsl.String("title").NotEmpty(),
 		dsl.String("slug").NotEmpty(),
 		dsl.Enum("status", "draft", "pending", "private", "published", "archived").Default("draft"),
@@ -21,6 +27,7 @@ func (Post) Fields() []dsl.Field {
 		dsl.TimestampTZ("published_at").Optional(),
 		dsl.TimestampTZ("created_at").DefaultNow(),
 		dsl.TimestampTZ("updated_at").UpdateNow(),
+		dsl.TimestampTZ("deleted_at").Optional().ReadOnly(),
 	}
 }
 
@@ -35,6 +42,13 @@ func (Post) Edges() []dsl.Edge {
 			Optional().
 			OnDeleteSetNull().
 			Inverse("featured_in_posts"),
+		dsl.ToOne("content_type", "ContentType").
+			Field("content_type_id").
+			Optional().
+			OnDeleteRestrict().
+			Inverse("posts"),
+		dsl.ToOne("parent", "Post").Field("parent_id").Optional().OnDeleteSetNull(),
+		dsl.ToMany("children", "Post").Ref("parent"),
 		dsl.ToMany("comments", "Comment").Ref("post"),
 		dsl.ManyToMany("categories", "Category").ThroughTable("post_categories").Inverse("posts"),
 		dsl.ManyToMany("tags", "Tag").ThroughTable("post_tags").Inverse("posts"),
@@ -46,6 +60,8 @@ func (Post) Indexes() []dsl.Index {
 		dsl.Idx("posts_slug_key").On("slug").Unique(),
 		dsl.Idx("posts_status_published_at").On("status", "published_at"),
 		dsl.Idx("posts_author_created_at").On("author_id", "created_at"),
+		dsl.Idx("posts_parent_id_menu_order").On("parent_id", "menu_order"),
+		dsl.Idx("posts_deleted_at").On("deleted_at"),
 	}
 }
 
@@ -57,6 +73,8 @@ func (Post) Query() dsl.QuerySpec {
 			dsl.NewPredicate("author_id", dsl.OpEqual).Named("AuthorIDEq"),
 			dsl.NewPredicate("status", dsl.OpEqual).Named("StatusEq"),
 			dsl.NewPredicate("type", dsl.OpEqual).Named("TypeEq"),
+			dsl.NewPredicate("content_type_id", dsl.OpEqual).Named("ContentTypeIDEq"),
+			dsl.NewPredicate("parent_id", dsl.OpEqual).Named("ParentIDEq"),
 		).
 		WithOrders(
 			dsl.OrderBy("published_at", dsl.SortDesc).Named("PublishedAtDesc"),
@@ -69,6 +87,7 @@ func (Post) Query() dsl.QuerySpec {
 func (Post) Annotations() []dsl.Annotation {
 	return []dsl.Annotation{
 		dsl.Authorization(dsl.ContentAuth()),
+		dsl.SoftDelete(),
 		dsl.GraphQL("Post",
 			dsl.GraphQLSubscriptions(
 				dsl.SubscriptionEventCreate,
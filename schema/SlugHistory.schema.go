@@ -0,0 +1,48 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// SlugHistory remembers slugs that posts, pages and taxonomies used to have so
+// old permalinks can be redirected to the current URL.
+type SlugHistory struct{ dsl.Schema }
+
+func (SlugHistory) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.String("entity_type").NotEmpty(),
+		dsl.UUIDv7("entity_id"),
+		dsl.String("slug").NotEmpty(),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+		dsl.TimestampTZ("updated_at").UpdateNow(),
+	}
+}
+
+func (SlugHistory) Edges() []dsl.Edge { return nil }
+
+func (SlugHistory) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("slug_histories_entity_type_slug").On("entity_type", "slug"),
+		dsl.Idx("slug_histories_entity_id").On("entity_id"),
+	}
+}
+
+func (SlugHistory) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("entity_type", dsl.OpEqual).Named("EntityTypeEq"),
+			dsl.NewPredicate("entity_id", dsl.OpEqual).Named("EntityIDEq"),
+			dsl.NewPredicate("slug", dsl.OpEqual).Named("SlugEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("created_at", dsl.SortDesc).Named("CreatedAtDesc"),
+		).
+		WithDefaultLimit(50).
+		WithMaxLimit(200)
+}
+
+func (SlugHistory) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+		dsl.GraphQL("SlugHistory"),
+	}
+}
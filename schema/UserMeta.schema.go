@@ -0,0 +1,50 @@
+package schema
+
+import "github.com/deicod/erm/orm/dsl"
+
+// UserMeta holds per-user values such as notification preferences, one JSON
+// value per key and user. Meta is removed together with its user.
+type UserMeta struct{ dsl.Schema }
+
+func (UserMeta) Fields() []dsl.Field {
+	return []dsl.Field{
+		dsl.UUIDv7("id").Primary(),
+		dsl.UUIDv7("user_id"),
+		dsl.String("key").NotEmpty(),
+		dsl.JSONB("value"),
+		dsl.TimestampTZ("created_at").DefaultNow(),
+		dsl.TimestampTZ("updated_at").UpdateNow(),
+	}
+}
+
+func (UserMeta) Edges() []dsl.Edge {
+	return []dsl.Edge{
+		dsl.ToOne("user", "User").Field("user_id").OnDeleteCascade(),
+	}
+}
+
+func (UserMeta) Indexes() []dsl.Index {
+	return []dsl.Index{
+		dsl.Idx("user_metas_user_id_key").On("user_id", "key").Unique(),
+	}
+}
+
+func (UserMeta) Query() dsl.QuerySpec {
+	return dsl.Query().
+		WithPredicates(
+			dsl.NewPredicate("user_id", dsl.OpEqual).Named("UserIDEq"),
+			dsl.NewPredicate("key", dsl.OpEqual).Named("KeyEq"),
+		).
+		WithOrders(
+			dsl.OrderBy("key", dsl.SortAsc).Named("KeyAsc"),
+		).
+		WithDefaultLimit(50).
+		WithMaxLimit(200)
+}
+
+func (UserMeta) Annotations() []dsl.Annotation {
+	return []dsl.Annotation{
+		dsl.Authorization(dsl.ContentAuth()),
+		dsl.GraphQL("UserMeta"),
+	}
+}
@@ -0,0 +1,174 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// TTL bounds how long a cached option is served without being read again.
+// Invalidations reach only the process that made them; the TTL lets other
+// replicas pick up the change shortly after.
+const TTL = 30 * time.Second
+
+// Option is a stored option as the cache sees it.
+type Option struct {
+	ID    string
+	Name  string
+	Value json.RawMessage
+}
+
+// Store loads options.
+type Store interface {
+	// Autoload returns every option flagged for autoloading.
+	Autoload(ctx context.Context) ([]Option, error)
+	// Option returns the option called name, or nil.
+	Option(ctx context.Context, name string) (*Option, error)
+}
+
+// Cache keeps option values in memory for the whole process. Preload fills
+// it with the autoloaded options; other options are read through on first
+// use. Absent options are remembered too, so unset settings fall back to
+// their defaults without a query per request. Writers call Invalidate or
+// InvalidateID after changing an option; each process only sees its own
+// invalidations, and other processes reload the option once its entry is
+// older than TTL.
+type Cache struct {
+	store    Store
+	registry *Registry
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	entries map[string]entry
+	// generation changes on every invalidation so a read-through that
+	// raced with one does not cache the value it replaced.
+	generation uint64
+}
+
+type entry struct {
+	id     string
+	value  json.RawMessage
+	found  bool
+	loaded time.Time
+}
+
+// NewCache wraps store. A nil registry uses Defaults.
+func NewCache(store Store, registry *Registry) *Cache {
+	if registry == nil {
+		registry = Defaults()
+	}
+	return &Cache{store: store, registry: registry, ttl: TTL, now: time.Now, entries: make(map[string]entry)}
+}
+
+// Registry returns the settings the cache validates against.
+func (c *Cache) Registry() *Registry {
+	if c == nil {
+		return Defaults()
+	}
+	return c.registry
+}
+
+// Preload replaces the cached entries with the autoloaded options and
+// reports how many were loaded.
+func (c *Cache) Preload(ctx context.Context) (int, error) {
+	if c == nil || c.store == nil {
+		return 0, nil
+	}
+	options, err := c.store.Autoload(ctx)
+	if err != nil {
+		return 0, err
+	}
+	now := c.now()
+	entries := make(map[string]entry, len(options))
+	for _, option := range options {
+		entries[option.Name] = entry{id: option.ID, value: option.Value, found: true, loaded: now}
+	}
+	c.mu.Lock()
+	c.entries = entries
+	c.generation++
+	c.mu.Unlock()
+	return len(options), nil
+}
+
+// Raw returns the stored value of the option called name and whether one
+// exists.
+func (c *Cache) Raw(ctx context.Context, name string) (json.RawMessage, bool, error) {
+	if c == nil {
+		return nil, false, nil
+	}
+	c.mu.RLock()
+	cached, ok := c.entries[name]
+	generation := c.generation
+	c.mu.RUnlock()
+	now := c.now()
+	if ok && now.Sub(cached.loaded) < c.ttl {
+		return cached.value, cached.found, nil
+	}
+	if c.store == nil {
+		return nil, false, nil
+	}
+	option, err := c.store.Option(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+	cached = entry{loaded: now}
+	if option != nil {
+		cached = entry{id: option.ID, value: option.Value, found: true, loaded: now}
+	}
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[name] = cached
+	}
+	c.mu.Unlock()
+	return cached.value, cached.found, nil
+}
+
+// Invalidate drops the cached options with the given names.
+func (c *Cache) Invalidate(names ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for _, name := range names {
+		delete(c.entries, name)
+	}
+}
+
+// InvalidateID drops the cached option with the given ID, whatever its name
+// was when it was cached.
+func (c *Cache) InvalidateID(id string) {
+	if c == nil || id == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for name, cached := range c.entries {
+		if cached.id == id {
+			delete(c.entries, name)
+		}
+	}
+}
+
+// Get returns the value of key, or its default when no valid value is
+// stored.
+func Get[T any](ctx context.Context, c *Cache, key Key[T]) (T, error) {
+	value, _, err := Lookup(ctx, c, key)
+	return value, err
+}
+
+// Lookup is like Get but also reports whether a value is stored.
+func Lookup[T any](ctx context.Context, c *Cache, key Key[T]) (T, bool, error) {
+	raw, found, err := c.Raw(ctx, key.Name)
+	if err != nil {
+		return key.Default, false, err
+	}
+	if !found {
+		return key.Default, false, nil
+	}
+	return key.Decode(raw), true, nil
+}
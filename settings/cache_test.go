@@ -0,0 +1,101 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type stubStore struct {
+	options map[string]Option
+	lookups int
+}
+
+func (s *stubStore) Autoload(context.Context) ([]Option, error) {
+	return []Option{s.options["blogname"]}, nil
+}
+
+func (s *stubStore) Option(_ context.Context, name string) (*Option, error) {
+	s.lookups++
+	if option, ok := s.options[name]; ok {
+		return &option, nil
+	}
+	return nil, nil
+}
+
+func newStubStore() *stubStore {
+	return &stubStore{options: map[string]Option{
+		"blogname":       {ID: "1", Name: "blogname", Value: json.RawMessage(`"Preloaded"`)},
+		"posts_per_page": {ID: "2", Name: "posts_per_page", Value: json.RawMessage(`15`)},
+	}}
+}
+
+func TestCachePreloadsAutoloadedOptions(t *testing.T) {
+	store := newStubStore()
+	cache := NewCache(store, nil)
+	ctx := context.Background()
+
+	if n, err := cache.Preload(ctx); err != nil || n != 1 {
+		t.Fatalf("expected one preloaded option, got %d (%v)", n, err)
+	}
+	if title, _ := Get(ctx, cache, Title); title != "Preloaded" || store.lookups != 0 {
+		t.Fatalf("expected preloaded title without a lookup, got %q after %d lookups", title, store.lookups)
+	}
+
+	for range 2 {
+		if perPage, _ := Get(ctx, cache, PostsPerPage); perPage != 15 {
+			t.Fatalf("expected stored posts per page, got %d", perPage)
+		}
+		if tz, _ := Get(ctx, cache, Timezone); tz != "UTC" {
+			t.Fatalf("expected default timezone, got %q", tz)
+		}
+	}
+	if store.lookups != 2 {
+		t.Fatalf("expected misses and hits to be cached, got %d lookups", store.lookups)
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	store := newStubStore()
+	cache := NewCache(store, nil)
+	ctx := context.Background()
+	_, _ = cache.Preload(ctx)
+
+	store.options["blogname"] = Option{ID: "1", Name: "blogname", Value: json.RawMessage(`"Renamed"`)}
+	cache.Invalidate("blogname")
+	if title, _ := Get(ctx, cache, Title); title != "Renamed" {
+		t.Fatalf("expected fresh title after Invalidate, got %q", title)
+	}
+
+	delete(store.options, "blogname")
+	cache.InvalidateID("1")
+	if title, stored, _ := Lookup(ctx, cache, Title); title != "ermblog" || stored {
+		t.Fatalf("expected default title after InvalidateID, got %q (stored %v)", title, stored)
+	}
+
+	var nilCache *Cache
+	if title, err := Get(ctx, nilCache, Title); err != nil || title != "ermblog" {
+		t.Fatalf("expected nil cache to return defaults, got %q (%v)", title, err)
+	}
+}
+
+func TestCacheReloadsEntriesOlderThanTTL(t *testing.T) {
+	store := newStubStore()
+	cache := NewCache(store, nil)
+	now := time.Unix(1700000000, 0)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+	_, _ = cache.Preload(ctx)
+
+	// Another replica renames the site; this process is not told.
+	store.options["blogname"] = Option{ID: "1", Name: "blogname", Value: json.RawMessage(`"Elsewhere"`)}
+	now = now.Add(TTL - time.Second)
+	if title, _ := Get(ctx, cache, Title); title != "Preloaded" {
+		t.Fatalf("expected cached title within the TTL, got %q", title)
+	}
+	now = now.Add(time.Second)
+	if title, _ := Get(ctx, cache, Title); title != "Elsewhere" {
+		t.Fatalf("expected reloaded title after the TTL, got %q", title)
+	}
+}
@@ -0,0 +1,39 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// ORMStore reads options through the generated ORM client.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a cache Store.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// Autoload returns the options flagged for autoloading.
+func (s *ORMStore) Autoload(ctx context.Context) ([]Option, error) {
+	records, err := s.client.AutoloadOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Option, 0, len(records))
+	for _, record := range records {
+		out = append(out, Option{ID: record.ID, Name: record.Name, Value: record.Value})
+	}
+	return out, nil
+}
+
+// Option returns the option called name, or nil.
+func (s *ORMStore) Option(ctx context.Context, name string) (*Option, error) {
+	record, err := s.client.Options().Query().WhereNameEq(name).First(ctx)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return &Option{ID: record.ID, Name: record.Name, Value: record.Value}, nil
+}
@@ -0,0 +1,194 @@
+// Package settings gives the site-wide options stored in the options table
+// Go types. A Registry declares the known option names with a JSON Schema
+// for their values and a default; a Cache keeps autoloaded options in
+// memory so reads do not hit the database on every request.
+//
+// Schemas use the subset of JSON Schema understood by package contenttype.
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata" // timezone settings validate without the host's zoneinfo
+
+	"github.com/deicod/ermblog/contenttype"
+	"github.com/deicod/ermblog/permalink"
+)
+
+// Key names a setting whose value decodes into T.
+type Key[T any] struct {
+	Name    string
+	Default T
+}
+
+// Built-in settings. The names follow WordPress so imported options keep
+// working.
+var (
+	Title              = Key[string]{Name: "blogname", Default: "ermblog"}
+	Tagline            = Key[string]{Name: "blogdescription", Default: ""}
+	Timezone           = Key[string]{Name: "timezone_string", Default: "UTC"}
+	PermalinkStructure = Key[string]{Name: "permalink_structure", Default: permalink.DefaultPostPattern}
+	PostsPerPage       = Key[int]{Name: "posts_per_page", Default: 10}
+)
+
+// Definition declares a setting for a Registry.
+type Definition struct {
+	Name string
+	// Schema is the JSON Schema of the value, e.g. {"type": "string"}.
+	Schema json.RawMessage
+	// Check, when set, runs after the schema accepted a value.
+	Check func(value json.RawMessage) error
+	// ReadOnly settings are read once at startup, so the API refuses to
+	// change them while it runs.
+	ReadOnly bool
+}
+
+// Define declares key with the given value schema.
+func Define[T any](key Key[T], schema string, check func(json.RawMessage) error) Definition {
+	return Definition{Name: key.Name, Schema: json.RawMessage(schema), Check: check}
+}
+
+// AtStartup marks the definition ReadOnly.
+func (d Definition) AtStartup() Definition {
+	d.ReadOnly = true
+	return d
+}
+
+// Registry holds the declared settings.
+type Registry struct {
+	definitions map[string]Definition
+	schema      *contenttype.Schema
+}
+
+// NewRegistry validates the definitions' schemas. Names must be unique
+// lower-case identifiers.
+func NewRegistry(definitions ...Definition) (*Registry, error) {
+	properties := make(map[string]json.RawMessage, len(definitions))
+	registry := &Registry{definitions: make(map[string]Definition, len(definitions))}
+	for _, definition := range definitions {
+		if _, ok := registry.definitions[definition.Name]; ok {
+			return nil, fmt.Errorf("settings: %q is declared twice", definition.Name)
+		}
+		registry.definitions[definition.Name] = definition
+		properties[definition.Name] = definition.Schema
+	}
+	raw, err := json.Marshal(map[string]any{"type": "object", "properties": properties, "additionalProperties": true})
+	if err != nil {
+		return nil, err
+	}
+	schema, err := contenttype.ParseSchema(raw)
+	if err != nil {
+		return nil, fmt.Errorf("settings: %w", err)
+	}
+	registry.schema = schema
+	return registry, nil
+}
+
+// MustRegistry is like NewRegistry but panics on invalid definitions.
+func MustRegistry(definitions ...Definition) *Registry {
+	registry, err := NewRegistry(definitions...)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+var defaults = MustRegistry(
+	Define(Title, `{"type": "string", "maxLength": 200}`, nil),
+	Define(Tagline, `{"type": "string", "maxLength": 500}`, nil),
+	Define(Timezone, `{"type": "string", "minLength": 1, "maxLength": 64}`, checkTimezone),
+	Define(PermalinkStructure, `{"type": "string", "maxLength": 200}`, checkPermalink).AtStartup(),
+	Define(PostsPerPage, `{"type": "integer", "minimum": 1, "maximum": 100}`, nil),
+)
+
+// Defaults returns the registry of built-in settings.
+func Defaults() *Registry {
+	return defaults
+}
+
+// Names lists the declared settings, sorted.
+func (r *Registry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.definitions))
+	for name := range r.definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the definition of name.
+func (r *Registry) Lookup(name string) (Definition, bool) {
+	if r == nil {
+		return Definition{}, false
+	}
+	definition, ok := r.definitions[name]
+	return definition, ok
+}
+
+// ReadOnly reports whether name is a setting the API must not change.
+func (r *Registry) ReadOnly(name string) bool {
+	definition, ok := r.Lookup(name)
+	return ok && definition.ReadOnly
+}
+
+// Validate checks a value stored under name. Options the registry does not
+// declare are accepted as they are.
+func (r *Registry) Validate(name string, value json.RawMessage) error {
+	definition, ok := r.Lookup(name)
+	if !ok {
+		return nil
+	}
+	if len(value) == 0 {
+		return errors.New("is required")
+	}
+	if err := r.schema.Validate(map[string]json.RawMessage{name: value}); err != nil {
+		var fieldErr *contenttype.FieldError
+		if errors.As(err, &fieldErr) {
+			return errors.New(fieldErr.Message)
+		}
+		return err
+	}
+	if definition.Check != nil {
+		return definition.Check(value)
+	}
+	return nil
+}
+
+// Decode returns the value stored for key, or its default when raw is empty,
+// null or does not decode into T.
+func (k Key[T]) Decode(raw json.RawMessage) T {
+	if len(raw) == 0 || string(raw) == "null" {
+		return k.Default
+	}
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return k.Default
+	}
+	return value
+}
+
+func checkTimezone(raw json.RawMessage) error {
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return err
+	}
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		return fmt.Errorf("unknown timezone %q", name)
+	}
+	return nil
+}
+
+func checkPermalink(raw json.RawMessage) error {
+	var pattern string
+	if err := json.Unmarshal(raw, &pattern); err != nil {
+		return err
+	}
+	_, err := permalink.Parse(pattern)
+	return err
+}
@@ -0,0 +1,73 @@
+package settings
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDefaultsValidateValues(t *testing.T) {
+	registry := Defaults()
+	valid := map[string]string{
+		"blogname":            `"My blog"`,
+		"blogdescription":     `""`,
+		"timezone_string":     `"Europe/Berlin"`,
+		"permalink_structure": `"/blog/{slug}"`,
+		"posts_per_page":      `25`,
+		"unregistered":        `{"anything": true}`,
+	}
+	for name, value := range valid {
+		if err := registry.Validate(name, json.RawMessage(value)); err != nil {
+			t.Fatalf("%s: unexpected error for %s: %v", name, value, err)
+		}
+	}
+
+	invalid := []struct {
+		name, value, message string
+	}{
+		{"blogname", `42`, "must be a string"},
+		{"blogname", ``, "is required"},
+		{"timezone_string", `"Mars/Olympus"`, "unknown timezone"},
+		{"timezone_string", `"Local"`, "unknown timezone"},
+		{"permalink_structure", `"{slug}"`, "must start with /"},
+		{"posts_per_page", `0`, "at least 1"},
+		{"posts_per_page", `2.5`, "integer"},
+	}
+	for _, tc := range invalid {
+		err := registry.Validate(tc.name, json.RawMessage(tc.value))
+		if err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Fatalf("%s=%s: expected %q, got %v", tc.name, tc.value, tc.message, err)
+		}
+	}
+
+	if !registry.ReadOnly("permalink_structure") || registry.ReadOnly("blogname") || registry.ReadOnly("unregistered") {
+		t.Fatal("expected only permalink_structure to be read-only")
+	}
+}
+
+func TestNewRegistryRejectsInvalidDefinitions(t *testing.T) {
+	key := Key[string]{Name: "site_name"}
+	if _, err := NewRegistry(Define(key, `{"type": "string"}`, nil), Define(key, `{"type": "string"}`, nil)); err == nil {
+		t.Fatal("expected duplicate names to be rejected")
+	}
+	if _, err := NewRegistry(Define(key, `{"type": "object"}`, nil)); err == nil {
+		t.Fatal("expected unsupported schema to be rejected")
+	}
+	if _, err := NewRegistry(Define(Key[string]{Name: "Site Name"}, `{"type": "string"}`, nil)); err == nil {
+		t.Fatal("expected invalid name to be rejected")
+	}
+	if names := Defaults().Names(); len(names) != 5 || names[0] != "blogdescription" {
+		t.Fatalf("unexpected names %v", names)
+	}
+}
+
+func TestKeyDecodeFallsBackToDefault(t *testing.T) {
+	if got := PostsPerPage.Decode(json.RawMessage(`20`)); got != 20 {
+		t.Fatalf("expected stored value, got %d", got)
+	}
+	for _, raw := range []string{``, `null`, `"twenty"`} {
+		if got := PostsPerPage.Decode(json.RawMessage(raw)); got != 10 {
+			t.Fatalf("expected default for %q, got %d", raw, got)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package sitemap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// batchSize bounds the rows read per query.
+const batchSize = 200
+
+const publishedStatus = "published"
+
+// ORMSource reads sitemap entries through the ORM client in fixed-size
+// batches so memory use does not grow with site size.
+type ORMSource struct {
+	client *gen.Client
+}
+
+// NewORMSource wraps the ORM client as a sitemap Source.
+func NewORMSource(client *gen.Client) *ORMSource {
+	return &ORMSource{client: client}
+}
+
+// Count reports the number of URLs available for the kind.
+func (s *ORMSource) Count(ctx context.Context, kind Kind) (int, error) {
+	switch kind {
+	case KindPosts:
+		return s.client.Posts().Query().WhereStatusEq(publishedStatus).WhereTypeEq("post").Count(ctx)
+	case KindPages:
+		return s.client.Posts().Query().WhereStatusEq(publishedStatus).WhereTypeEq("page").Count(ctx)
+	case KindCategories:
+		return s.client.Categories().Query().Count(ctx)
+	case KindTags:
+		return s.client.Tags().Query().Count(ctx)
+	default:
+		return 0, fmt.Errorf("sitemap: unknown kind %q", kind)
+	}
+}
+
+// Each streams up to limit entries starting at offset. Only the first batch
+// skips rows by offset; later batches continue behind the last row read, so
+// the chunk stays consistent while content changes and deep chunks do not
+// rescan the rows before them.
+func (s *ORMSource) Each(ctx context.Context, kind Kind, offset, limit int, fn func(Entry) error) error {
+	var cursor any
+	for limit > 0 {
+		size := min(limit, batchSize)
+		read, last, err := s.batch(ctx, kind, cursor, offset, size, fn)
+		if err != nil {
+			return err
+		}
+		if read < size {
+			return nil
+		}
+		cursor, offset = last, 0
+		limit -= read
+	}
+	return nil
+}
+
+// batch emits one batch of kind behind cursor, the last record of the
+// previous batch, and returns how many entries it read and the last record.
+func (s *ORMSource) batch(ctx context.Context, kind Kind, cursor any, offset, size int, fn func(Entry) error) (int, any, error) {
+	switch kind {
+	case KindPosts, KindPages:
+		postType := "post"
+		if kind == KindPages {
+			postType = "page"
+		}
+		after, _ := cursor.(*gen.Post)
+		posts, err := s.client.SitemapPosts(ctx, postType, after, offset, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		return emit(posts, postEntry, fn)
+	case KindCategories:
+		after, _ := cursor.(*gen.Category)
+		categories, err := s.client.SitemapCategories(ctx, after, offset, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		return emit(categories, func(c *gen.Category) Entry {
+			return Entry{ID: c.ID, Slug: c.Slug, Date: c.CreatedAt, UpdatedAt: c.UpdatedAt}
+		}, fn)
+	case KindTags:
+		after, _ := cursor.(*gen.Tag)
+		tags, err := s.client.SitemapTags(ctx, after, offset, size)
+		if err != nil {
+			return 0, nil, err
+		}
+		return emit(tags, func(t *gen.Tag) Entry {
+			return Entry{ID: t.ID, Slug: t.Slug, Date: t.CreatedAt, UpdatedAt: t.UpdatedAt}
+		}, fn)
+	default:
+		return 0, nil, fmt.Errorf("sitemap: unknown kind %q", kind)
+	}
+}
+
+func postEntry(p *gen.Post) Entry {
+	date := p.CreatedAt
+	if p.PublishedAt != nil {
+		date = *p.PublishedAt
+	}
+	return Entry{ID: p.ID, Slug: p.Slug, Date: date, UpdatedAt: p.UpdatedAt}
+}
+
+func emit[T any](records []T, convert func(T) Entry, fn func(Entry) error) (int, any, error) {
+	for i, record := range records {
+		if err := fn(convert(record)); err != nil {
+			return i + 1, record, err
+		}
+	}
+	if len(records) == 0 {
+		return 0, nil, nil
+	}
+	return len(records), records[len(records)-1], nil
+}
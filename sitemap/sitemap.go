@@ -0,0 +1,239 @@
+// Package sitemap serves XML sitemaps for published content following the
+// sitemaps.org protocol. The index at /sitemap.xml references one child
+// sitemap per content kind and chunk, each capped at 50,000 URLs.
+package sitemap
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deicod/ermblog/permalink"
+)
+
+const (
+	// IndexPath is the public path of the sitemap index.
+	IndexPath = "/sitemap.xml"
+	// ChunkPrefix is the path prefix under which child sitemaps are served.
+	ChunkPrefix = "/sitemaps/"
+	// MaxURLsPerSitemap is the protocol limit for a single sitemap file.
+	MaxURLsPerSitemap = 50000
+
+	xmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+// Kind identifies a group of URLs published as its own set of sitemaps.
+type Kind string
+
+const (
+	KindPosts      Kind = "posts"
+	KindPages      Kind = "pages"
+	KindCategories Kind = "categories"
+	KindTags       Kind = "tags"
+)
+
+// Kinds lists all content kinds in index order.
+var Kinds = []Kind{KindPosts, KindPages, KindCategories, KindTags}
+
+// Entry is a single piece of content rendered as a sitemap URL.
+type Entry struct {
+	ID        string
+	Slug      string
+	Date      time.Time
+	UpdatedAt time.Time
+}
+
+// Source provides sitemap entries. Each must invoke fn for at most limit
+// entries starting at offset without buffering the whole result set.
+type Source interface {
+	Count(ctx context.Context, kind Kind) (int, error)
+	Each(ctx context.Context, kind Kind, offset, limit int, fn func(Entry) error) error
+}
+
+// kindOf maps a sitemap kind to the permalink kind whose pattern renders it.
+func kindOf(kind Kind) permalink.Kind {
+	switch kind {
+	case KindPosts:
+		return permalink.KindPost
+	case KindPages:
+		return permalink.KindPage
+	case KindCategories:
+		return permalink.KindCategory
+	default:
+		return permalink.KindTag
+	}
+}
+
+// Options configures the sitemap handler.
+type Options struct {
+	// BaseURL is the public origin prepended to every location, e.g. https://example.com.
+	BaseURL    string
+	Permalinks permalink.Set
+	// ChunkSize caps the URLs per child sitemap. Defaults to MaxURLsPerSitemap.
+	ChunkSize int
+}
+
+// Handler serves the sitemap index and its child sitemaps.
+type Handler struct {
+	source     Source
+	baseURL    string
+	permalinks permalink.Set
+	chunkSize  int
+}
+
+// NewHandler validates the options and returns a sitemap handler.
+func NewHandler(source Source, opts Options) (*Handler, error) {
+	if source == nil {
+		return nil, errors.New("sitemap: source is required")
+	}
+	baseURL := strings.TrimRight(strings.TrimSpace(opts.BaseURL), "/")
+	if baseURL == "" {
+		return nil, errors.New("sitemap: base url is required")
+	}
+	if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+		return nil, fmt.Errorf("sitemap: base url %q must be absolute", opts.BaseURL)
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 || chunkSize > MaxURLsPerSitemap {
+		chunkSize = MaxURLsPerSitemap
+	}
+	return &Handler{source: source, baseURL: baseURL, permalinks: opts.Permalinks.WithDefaults(), chunkSize: chunkSize}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path == IndexPath {
+		h.serveIndex(w, r)
+		return
+	}
+	kind, page, ok := parseChunkPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	h.serveChunk(w, r, kind, page)
+}
+
+func (h *Handler) serveIndex(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var locations []string
+	for _, kind := range Kinds {
+		total, err := h.source.Count(ctx, kind)
+		if err != nil {
+			http.Error(w, "failed to build sitemap index", http.StatusInternalServerError)
+			return
+		}
+		for page := 1; page <= chunks(total, h.chunkSize); page++ {
+			locations = append(locations, fmt.Sprintf("%s%s%s-%d.xml", h.baseURL, ChunkPrefix, kind, page))
+		}
+	}
+	writeXMLHeader(w)
+	if r.Method == http.MethodHead {
+		return
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%s<sitemapindex xmlns=%q>\n", xml.Header, xmlns)
+	for _, loc := range locations {
+		out.WriteString("  <sitemap><loc>")
+		escape(out, loc)
+		out.WriteString("</loc></sitemap>\n")
+	}
+	out.WriteString("</sitemapindex>\n")
+	_ = out.Flush()
+}
+
+func (h *Handler) serveChunk(w http.ResponseWriter, r *http.Request, kind Kind, page int) {
+	ctx := r.Context()
+	total, err := h.source.Count(ctx, kind)
+	if err != nil {
+		http.Error(w, "failed to build sitemap", http.StatusInternalServerError)
+		return
+	}
+	if page > chunks(total, h.chunkSize) {
+		http.NotFound(w, r)
+		return
+	}
+	writeXMLHeader(w)
+	if r.Method == http.MethodHead {
+		return
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%s<urlset xmlns=%q>\n", xml.Header, xmlns)
+	pattern := h.permalinks.Pattern(kindOf(kind))
+	offset := (page - 1) * h.chunkSize
+	err = h.source.Each(ctx, kind, offset, h.chunkSize, func(entry Entry) error {
+		out.WriteString("  <url><loc>")
+		escape(out, h.baseURL+pattern.Path(permalink.Values{ID: entry.ID, Slug: entry.Slug, Date: entry.Date}))
+		out.WriteString("</loc>")
+		if !entry.UpdatedAt.IsZero() {
+			out.WriteString("<lastmod>")
+			out.WriteString(entry.UpdatedAt.UTC().Format(time.RFC3339))
+			out.WriteString("</lastmod>")
+		}
+		out.WriteString("</url>\n")
+		return nil
+	})
+	if err != nil {
+		// Headers are already sent; leave the document truncated so crawlers
+		// reject it rather than caching a partial URL set.
+		_ = out.Flush()
+		return
+	}
+	out.WriteString("</urlset>\n")
+	_ = out.Flush()
+}
+
+func writeXMLHeader(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+func escape(w io.Writer, value string) {
+	_ = xml.EscapeText(w, []byte(value))
+}
+
+func chunks(total, size int) int {
+	if total <= 0 {
+		return 0
+	}
+	return (total + size - 1) / size
+}
+
+// parseChunkPath extracts the kind and 1-based page from /sitemaps/<kind>-<page>.xml.
+func parseChunkPath(path string) (Kind, int, bool) {
+	name, ok := strings.CutPrefix(path, ChunkPrefix)
+	if !ok {
+		return "", 0, false
+	}
+	name, ok = strings.CutSuffix(name, ".xml")
+	if !ok {
+		return "", 0, false
+	}
+	idx := strings.LastIndexByte(name, '-')
+	if idx <= 0 {
+		return "", 0, false
+	}
+	page, err := strconv.Atoi(name[idx+1:])
+	if err != nil || page < 1 {
+		return "", 0, false
+	}
+	kind := Kind(name[:idx])
+	for _, known := range Kinds {
+		if kind == known {
+			return kind, page, true
+		}
+	}
+	return "", 0, false
+}
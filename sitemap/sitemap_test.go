@@ -0,0 +1,148 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeSource struct {
+	entries map[Kind][]Entry
+	err     error
+	calls   []eachCall
+}
+
+type eachCall struct {
+	kind          Kind
+	offset, limit int
+}
+
+func (f *fakeSource) Count(ctx context.Context, kind Kind) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	return len(f.entries[kind]), nil
+}
+
+func (f *fakeSource) Each(ctx context.Context, kind Kind, offset, limit int, fn func(Entry) error) error {
+	f.calls = append(f.calls, eachCall{kind: kind, offset: offset, limit: limit})
+	items := f.entries[kind]
+	for i := offset; i < len(items) && i < offset+limit; i++ {
+		if err := fn(items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestHandler(t *testing.T, source Source, chunkSize int) *Handler {
+	t.Helper()
+	handler, err := NewHandler(source, Options{BaseURL: "https://blog.example.com/", ChunkSize: chunkSize})
+	if err != nil {
+		t.Fatalf("NewHandler returned error: %v", err)
+	}
+	return handler
+}
+
+func serve(handler http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestIndexListsChunksPerKind(t *testing.T) {
+	t.Parallel()
+
+	source := &fakeSource{entries: map[Kind][]Entry{
+		KindPosts: {{Slug: "a"}, {Slug: "b"}, {Slug: "c"}},
+		KindTags:  {{Slug: "go"}},
+	}}
+	rec := serve(newTestHandler(t, source, 2), IndexPath)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/xml") {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	body := rec.Body.String()
+	for _, loc := range []string{
+		"<loc>https://blog.example.com/sitemaps/posts-1.xml</loc>",
+		"<loc>https://blog.example.com/sitemaps/posts-2.xml</loc>",
+		"<loc>https://blog.example.com/sitemaps/tags-1.xml</loc>",
+	} {
+		if !strings.Contains(body, loc) {
+			t.Fatalf("expected index to contain %s, got:\n%s", loc, body)
+		}
+	}
+	if strings.Contains(body, "posts-3.xml") || strings.Contains(body, "pages-1.xml") {
+		t.Fatalf("index lists chunks that do not exist:\n%s", body)
+	}
+}
+
+func TestChunkRendersPermalinksAndLastmod(t *testing.T) {
+	t.Parallel()
+
+	published := time.Date(2024, time.May, 1, 8, 0, 0, 0, time.UTC)
+	updated := time.Date(2024, time.June, 2, 9, 30, 0, 0, time.UTC)
+	source := &fakeSource{entries: map[Kind][]Entry{
+		KindPosts: {
+			{ID: "1", Slug: "first", Date: published, UpdatedAt: updated},
+			{ID: "2", Slug: "second", Date: published, UpdatedAt: updated},
+			{ID: "3", Slug: "a&b", Date: published, UpdatedAt: updated},
+		},
+	}}
+	handler := newTestHandler(t, source, 2)
+
+	rec := serve(handler, "/sitemaps/posts-2.xml")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "<url><loc>https://blog.example.com/2024/05/a&amp;b</loc><lastmod>2024-06-02T09:30:00Z</lastmod></url>") {
+		t.Fatalf("unexpected chunk body:\n%s", body)
+	}
+	if strings.Contains(body, "first") {
+		t.Fatalf("second chunk must not contain first page entries:\n%s", body)
+	}
+	if !strings.HasSuffix(body, "</urlset>\n") {
+		t.Fatalf("expected closed urlset, got:\n%s", body)
+	}
+	if len(source.calls) != 1 || source.calls[0] != (eachCall{kind: KindPosts, offset: 2, limit: 2}) {
+		t.Fatalf("unexpected source calls %+v", source.calls)
+	}
+}
+
+func TestChunkOutOfRangeReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	source := &fakeSource{entries: map[Kind][]Entry{KindPosts: {{Slug: "only"}}}}
+	handler := newTestHandler(t, source, 0)
+
+	for _, path := range []string{"/sitemaps/posts-2.xml", "/sitemaps/pages-1.xml", "/sitemaps/users-1.xml", "/sitemaps/posts-0.xml"} {
+		if rec := serve(handler, path); rec.Code != http.StatusNotFound {
+			t.Fatalf("expected 404 for %s, got %d", path, rec.Code)
+		}
+	}
+}
+
+func TestIndexSourceErrorReturnsServerError(t *testing.T) {
+	t.Parallel()
+
+	rec := serve(newTestHandler(t, &fakeSource{err: errors.New("boom")}, 0), IndexPath)
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500, got %d", rec.Code)
+	}
+}
+
+func TestNewHandlerRequiresAbsoluteBaseURL(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewHandler(&fakeSource{}, Options{BaseURL: "blog.example.com"}); err == nil {
+		t.Fatal("expected error for relative base url")
+	}
+}
@@ -0,0 +1,140 @@
+// Package slug derives URL-safe slugs from titles and resolves collisions by
+// appending numeric suffixes such as "-2" and "-3".
+package slug
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength caps generated slugs, leaving room for collision suffixes.
+const MaxLength = 190
+
+// Taken lists the slugs in use among base and its suffixed variants, such as
+// "hello", "hello-2" and "hello-7". Implementations typically fetch every
+// slug equal to base or starting with base followed by a hyphen in one
+// query; unrelated slugs sharing that prefix are ignored.
+type Taken func(ctx context.Context, base string) ([]string, error)
+
+// transliterations covers letters that do not decompose into an ASCII base
+// letter plus combining marks.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "ae", 'œ': "oe", 'Œ': "oe",
+	'ø': "o", 'Ø': "o", 'ł': "l", 'Ł': "l", 'đ': "d", 'Đ': "d",
+	'ð': "d", 'Ð': "d", 'þ': "th", 'Þ': "th", 'ı': "i",
+}
+
+// Make converts free text into a lowercase ASCII slug. Accented letters are
+// transliterated, other characters collapse into single hyphens. Text with no
+// usable characters yields an empty string.
+func Make(text string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range norm.NFKD.String(text) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		var part string
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			part = string(unicode.ToLower(r))
+		case transliterations[r] != "":
+			part = transliterations[r]
+		default:
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if b.Len()+len(part)+1 > MaxLength {
+			break
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// Candidate returns the n-th collision variant of base; n <= 1 is base itself.
+func Candidate(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	return base + "-" + strconv.Itoa(n)
+}
+
+// Unique returns base, or the first of base-2, base-3, ... that is not taken.
+// Taken is consulted once; a record saved with the same slug in the meantime
+// is caught by the unique index.
+func Unique(ctx context.Context, base string, taken Taken) (string, error) {
+	used, err := usedVariants(ctx, base, taken)
+	if err != nil {
+		return "", err
+	}
+	n := 1
+	for used[n] {
+		n++
+	}
+	return Candidate(base, n), nil
+}
+
+// Suggest lists up to limit free variants of a taken slug, starting at base-2.
+func Suggest(ctx context.Context, base string, taken Taken, limit int) ([]string, error) {
+	used, err := usedVariants(ctx, base, taken)
+	if err != nil {
+		return nil, err
+	}
+	return suggestions(base, used, limit), nil
+}
+
+// Check reports whether base itself is taken and, if so, up to limit free
+// variants to offer instead, from a single call to taken.
+func Check(ctx context.Context, base string, taken Taken, limit int) (bool, []string, error) {
+	used, err := usedVariants(ctx, base, taken)
+	if err != nil {
+		return false, nil, err
+	}
+	if !used[1] {
+		return false, nil, nil
+	}
+	return true, suggestions(base, used, limit), nil
+}
+
+func suggestions(base string, used map[int]bool, limit int) []string {
+	out := make([]string, 0, limit)
+	for n := 2; len(out) < limit; n++ {
+		if !used[n] {
+			out = append(out, Candidate(base, n))
+		}
+	}
+	return out
+}
+
+// usedVariants maps the slugs returned by taken to their suffix number; base
+// itself is 1.
+func usedVariants(ctx context.Context, base string, taken Taken) (map[int]bool, error) {
+	slugs, err := taken(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[int]bool, len(slugs))
+	for _, value := range slugs {
+		if value == base {
+			used[1] = true
+			continue
+		}
+		suffix, ok := strings.CutPrefix(value, base+"-")
+		if !ok || suffix == "" || suffix[0] < '1' || suffix[0] > '9' {
+			continue
+		}
+		if n, err := strconv.Atoi(suffix); err == nil && n >= 2 {
+			used[n] = true
+		}
+	}
+	return used, nil
+}
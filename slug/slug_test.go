@@ -0,0 +1,116 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"Hello, World!":           "hello-world",
+		"  Crème brûlée  ":        "creme-brulee",
+		"Straße & Ærøskøbing":     "strasse-aeroskobing",
+		"Łódź -- 2024":            "lodz-2024",
+		"already-a-slug":          "already-a-slug",
+		"日本語":                     "",
+		"Ｆｕｌｌｗｉｄｔｈ ＡＢＣ":           "fullwidth-abc",
+		"trailing punctuation...": "trailing-punctuation",
+	}
+	for input, want := range cases {
+		if got := Make(input); got != want {
+			t.Fatalf("Make(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestMakeTruncates(t *testing.T) {
+	t.Parallel()
+
+	got := Make(strings.Repeat("word ", 100))
+	if len(got) > MaxLength {
+		t.Fatalf("expected at most %d characters, got %d", MaxLength, len(got))
+	}
+	if strings.HasSuffix(got, "-") {
+		t.Fatalf("expected no trailing hyphen, got %q", got)
+	}
+}
+
+func takenSet(slugs ...string) Taken {
+	return func(context.Context, string) ([]string, error) {
+		return slugs, nil
+	}
+}
+
+func TestUniqueAppendsSuffix(t *testing.T) {
+	t.Parallel()
+
+	got, err := Unique(context.Background(), "hello", takenSet("hello", "hello-2"))
+	if err != nil {
+		t.Fatalf("Unique returned error: %v", err)
+	}
+	if got != "hello-3" {
+		t.Fatalf("expected hello-3, got %q", got)
+	}
+
+	got, err = Unique(context.Background(), "fresh", takenSet("hello"))
+	if err != nil || got != "fresh" {
+		t.Fatalf("expected fresh, got %q (%v)", got, err)
+	}
+}
+
+func TestUniquePropagatesErrors(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	_, err := Unique(context.Background(), "hello", func(context.Context, string) ([]string, error) { return nil, boom })
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestSuggestSkipsTakenVariants(t *testing.T) {
+	t.Parallel()
+
+	got, err := Suggest(context.Background(), "hello", takenSet("hello", "hello-3"), 3)
+	if err != nil {
+		t.Fatalf("Suggest returned error: %v", err)
+	}
+	want := []string{"hello-2", "hello-4", "hello-5"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestUniqueIgnoresUnrelatedPrefixMatches(t *testing.T) {
+	t.Parallel()
+
+	// Only numeric suffixes count; "hello-world" and "hello-02" are other slugs.
+	got, err := Unique(context.Background(), "hello", takenSet("hello", "hello-world", "hello-02", "hello-3"))
+	if err != nil || got != "hello-2" {
+		t.Fatalf("expected hello-2, got %q (%v)", got, err)
+	}
+}
+
+func TestCheckLooksUpOnce(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	taken := func(context.Context, string) ([]string, error) {
+		calls++
+		return []string{"go", "go-2", "go-4"}, nil
+	}
+	inUse, suggestions, err := Check(context.Background(), "go", taken, 2)
+	if err != nil || !inUse {
+		t.Fatalf("expected go to be in use, got %v (%v)", inUse, err)
+	}
+	if strings.Join(suggestions, ",") != "go-3,go-5" || calls != 1 {
+		t.Fatalf("expected go-3,go-5 from one lookup, got %v after %d lookups", suggestions, calls)
+	}
+	if inUse, _, _ := Check(context.Background(), "rust", takenSet("rust-2"), 2); inUse {
+		t.Fatal("expected rust to be free")
+	}
+}
@@ -0,0 +1,28 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// ORMStore purges through the generated ORM client.
+type ORMStore struct {
+	client *gen.Client
+}
+
+// NewORMStore wraps the ORM client as a purger Store.
+func NewORMStore(client *gen.Client) *ORMStore {
+	return &ORMStore{client: client}
+}
+
+// PurgeTrash permanently deletes posts, comments and media trashed before
+// cutoff.
+func (s *ORMStore) PurgeTrash(ctx context.Context, cutoff time.Time) (int, error) {
+	purge, err := s.client.PurgeTrash(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return len(purge.PostIDs) + len(purge.CommentIDs) + len(purge.MediaIDs), nil
+}
@@ -0,0 +1,105 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deicod/erm/orm/pg"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/deicod/ermblog/orm/gen"
+)
+
+// lockPool hands out transactions that find the purge lock free or taken.
+type lockPool struct {
+	locked     bool
+	statements []string
+	committed  bool
+}
+
+func (p *lockPool) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query outside a transaction")
+}
+
+func (p *lockPool) QueryRow(context.Context, string, ...any) pgx.Row {
+	return boolRow{err: errors.New("unexpected query outside a transaction")}
+}
+
+func (p *lockPool) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, errors.New("unexpected exec outside a transaction")
+}
+
+func (p *lockPool) Close() {}
+
+func (p *lockPool) Begin(context.Context) (pgx.Tx, error) {
+	return &lockTx{pool: p}, nil
+}
+
+type lockTx struct {
+	pgx.Tx
+	pool *lockPool
+}
+
+func (t *lockTx) QueryRow(_ context.Context, sql string, _ ...any) pgx.Row {
+	t.pool.statements = append(t.pool.statements, sql)
+	if strings.Contains(sql, "pg_try_advisory_xact_lock") {
+		return boolRow{value: !t.pool.locked}
+	}
+	return boolRow{err: errors.New("unexpected query row: " + sql)}
+}
+
+func (t *lockTx) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	t.pool.statements = append(t.pool.statements, sql)
+	return &emptyRows{}, nil
+}
+
+func (t *lockTx) Commit(context.Context) error {
+	t.pool.committed = true
+	return nil
+}
+
+func (t *lockTx) Rollback(context.Context) error { return nil }
+
+type boolRow struct {
+	value bool
+	err   error
+}
+
+func (r boolRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*bool) = r.value
+	return nil
+}
+
+type emptyRows struct{ pgx.Rows }
+
+func (r *emptyRows) Next() bool        { return false }
+func (r *emptyRows) Err() error        { return nil }
+func (r *emptyRows) Close()            {}
+func (r *emptyRows) Scan(...any) error { return nil }
+
+func TestORMStoreLetsOneReplicaPurge(t *testing.T) {
+	t.Parallel()
+
+	free := &lockPool{}
+	if n, err := NewORMStore(gen.NewClient(&pg.DB{Pool: free})).PurgeTrash(context.Background(), time.Now()); err != nil || n != 0 {
+		t.Fatalf("unexpected purge result %d, %v", n, err)
+	}
+	if len(free.statements) != 2 || !free.committed {
+		t.Fatalf("expected the lock holder to look for expired content and commit, got %q", free.statements)
+	}
+
+	taken := &lockPool{locked: true}
+	if n, err := NewORMStore(gen.NewClient(&pg.DB{Pool: taken})).PurgeTrash(context.Background(), time.Now()); err != nil || n != 0 {
+		t.Fatalf("expected a skipped purge, got %d, %v", n, err)
+	}
+	if len(taken.statements) != 1 || taken.committed {
+		t.Fatalf("expected the other replica to stop after the lock, got %q", taken.statements)
+	}
+}
@@ -0,0 +1,67 @@
+// Package trash purges deleted content once it has outlived its retention.
+//
+// Deleting a post, comment or media item only stamps its deleted_at, so it
+// can still be restored from the trash. A Purger removes it for good once
+// it has been there longer than the retention window. Every replica runs a
+// Purger; the ORM store purges under a Postgres advisory lock, so only one
+// of them does the work each tick.
+package trash
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// DefaultRetention keeps trashed content for 30 days.
+const DefaultRetention = 30 * 24 * time.Hour
+
+// Store purges trashed content.
+type Store interface {
+	// PurgeTrash permanently deletes everything trashed before cutoff and
+	// returns how many records were removed.
+	PurgeTrash(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// Purger empties the trash of content older than its retention.
+type Purger struct {
+	store     Store
+	retention time.Duration
+	now       func() time.Time
+}
+
+// New builds a Purger. A retention of zero or less uses DefaultRetention.
+func New(store Store, retention time.Duration) (*Purger, error) {
+	if store == nil {
+		return nil, errors.New("trash: store is required")
+	}
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &Purger{store: store, retention: retention, now: time.Now}, nil
+}
+
+// Run purges expired content every interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.Purge(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("trash: purge expired content: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge permanently deletes content trashed longer ago than the retention.
+func (p *Purger) Purge(ctx context.Context) (int, error) {
+	return p.store.PurgeTrash(ctx, p.now().UTC().Add(-p.retention))
+}
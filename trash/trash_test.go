@@ -0,0 +1,88 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingStore struct {
+	mu      sync.Mutex
+	cutoffs []time.Time
+	err     error
+}
+
+func (s *recordingStore) PurgeTrash(_ context.Context, cutoff time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cutoffs = append(s.cutoffs, cutoff)
+	return 2, s.err
+}
+
+func (s *recordingStore) calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.cutoffs)
+}
+
+var fixedNow = time.Date(2026, 10, 19, 13, 45, 0, 0, time.UTC)
+
+func TestPurgeUsesRetention(t *testing.T) {
+	t.Parallel()
+
+	store := &recordingStore{}
+	purger, err := New(store, 48*time.Hour)
+	if err != nil {
+		t.Fatalf("new purger: %v", err)
+	}
+	purger.now = func() time.Time { return fixedNow }
+	if n, err := purger.Purge(context.Background()); err != nil || n != 2 {
+		t.Fatalf("unexpected purge result %d, %v", n, err)
+	}
+	want := time.Date(2026, 10, 17, 13, 45, 0, 0, time.UTC)
+	if len(store.cutoffs) != 1 || !store.cutoffs[0].Equal(want) {
+		t.Fatalf("expected cutoff %s, got %v", want, store.cutoffs)
+	}
+}
+
+func TestNewDefaultsRetention(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(nil, 0); err == nil {
+		t.Fatal("expected an error without a store")
+	}
+	purger, err := New(&recordingStore{}, 0)
+	if err != nil {
+		t.Fatalf("new purger: %v", err)
+	}
+	if purger.retention != DefaultRetention {
+		t.Fatalf("expected the default retention, got %s", purger.retention)
+	}
+}
+
+func TestRunPurgesUntilCancelled(t *testing.T) {
+	t.Parallel()
+
+	store := &recordingStore{err: errors.New("database unavailable")}
+	purger, err := New(store, time.Hour)
+	if err != nil {
+		t.Fatalf("new purger: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		purger.Run(ctx, time.Millisecond)
+		close(done)
+	}()
+	deadline := time.Now().Add(time.Second)
+	for store.calls() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+	if store.calls() < 2 {
+		t.Fatalf("expected repeated purges despite errors, got %d", store.calls())
+	}
+}
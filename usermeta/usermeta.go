@@ -0,0 +1,59 @@
+// Package usermeta reads and writes typed per-user values kept in the
+// user_metas table, such as notification preferences. Values are JSON
+// documents keyed by user and meta key and disappear with their user.
+package usermeta
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Store persists meta values; *gen.Client implements it.
+type Store interface {
+	// UserMeta returns the user's values keyed by meta key, limited to keys
+	// when any are given.
+	UserMeta(ctx context.Context, userID string, keys ...string) (map[string]json.RawMessage, error)
+	// SetUserMeta replaces the value stored under key. It returns
+	// gen.ErrUserNotFound when the user does not exist.
+	SetUserMeta(ctx context.Context, userID, key string, value json.RawMessage) error
+	// DeleteUserMeta removes key.
+	DeleteUserMeta(ctx context.Context, userID, key string) error
+}
+
+// Key names a meta value that decodes into T.
+type Key[T any] struct {
+	Name string
+}
+
+// Get returns the value stored under key for a user and whether one is
+// stored. Values that do not decode into T count as unset.
+func Get[T any](ctx context.Context, store Store, userID string, key Key[T]) (T, bool, error) {
+	var value T
+	values, err := store.UserMeta(ctx, userID, key.Name)
+	if err != nil {
+		return value, false, err
+	}
+	raw, ok := values[key.Name]
+	if !ok || len(raw) == 0 {
+		return value, false, nil
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var zero T
+		return zero, false, nil
+	}
+	return value, true, nil
+}
+
+// Set stores value under key for a user.
+func Set[T any](ctx context.Context, store Store, userID string, key Key[T], value T) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return store.SetUserMeta(ctx, userID, key.Name, raw)
+}
+
+// Delete removes key from a user's meta.
+func Delete[T any](ctx context.Context, store Store, userID string, key Key[T]) error {
+	return store.DeleteUserMeta(ctx, userID, key.Name)
+}
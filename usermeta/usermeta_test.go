@@ -0,0 +1,81 @@
+package usermeta
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+type memoryStore map[string]map[string]json.RawMessage
+
+func (s memoryStore) UserMeta(_ context.Context, userID string, keys ...string) (map[string]json.RawMessage, error) {
+	out := make(map[string]json.RawMessage)
+	for key, value := range s[userID] {
+		if len(keys) > 0 && !contains(keys, key) {
+			continue
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+func (s memoryStore) SetUserMeta(_ context.Context, userID, key string, value json.RawMessage) error {
+	if s[userID] == nil {
+		s[userID] = make(map[string]json.RawMessage)
+	}
+	s[userID][key] = value
+	return nil
+}
+
+func (s memoryStore) DeleteUserMeta(_ context.Context, userID, key string) error {
+	delete(s[userID], key)
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+type editorPrefs struct {
+	Theme string `json:"theme"`
+}
+
+var editorKey = Key[editorPrefs]{Name: "editor"}
+
+func TestSetGetDelete(t *testing.T) {
+	ctx := context.Background()
+	store := memoryStore{}
+
+	if _, found, err := Get(ctx, store, "u1", editorKey); err != nil || found {
+		t.Fatalf("expected no value, got found=%v err=%v", found, err)
+	}
+	if err := Set(ctx, store, "u1", editorKey, editorPrefs{Theme: "dark"}); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	value, found, err := Get(ctx, store, "u1", editorKey)
+	if err != nil || !found || value.Theme != "dark" {
+		t.Fatalf("unexpected value %+v found=%v err=%v", value, found, err)
+	}
+	if _, found, _ := Get(ctx, store, "u2", editorKey); found {
+		t.Fatalf("expected values to be per user")
+	}
+	if err := Delete(ctx, store, "u1", editorKey); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, found, _ := Get(ctx, store, "u1", editorKey); found {
+		t.Fatalf("expected value to be deleted")
+	}
+}
+
+func TestGetTreatsUndecodableValueAsUnset(t *testing.T) {
+	store := memoryStore{"u1": {"editor": json.RawMessage(`"not an object"`)}}
+	value, found, err := Get(context.Background(), store, "u1", editorKey)
+	if err != nil || found || value.Theme != "" {
+		t.Fatalf("expected unset zero value, got %+v found=%v err=%v", value, found, err)
+	}
+}